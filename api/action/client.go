@@ -5,9 +5,12 @@ package action
 
 import (
 	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/watcher"
 )
 
 // Client provides access to the action facade.
@@ -30,6 +33,37 @@ func (c *Client) Actions(arg params.Entities) (params.ActionResults, error) {
 	return results, err
 }
 
+// WatchActionProgress returns a watcher that reports the progress
+// messages logged by the action with the given id. The initial event
+// holds the messages logged so far, and each message is encoded as
+// JSON.
+func (c *Client) WatchActionProgress(actionId string) (watcher.StringsWatcher, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("watching action progress on this controller")
+	}
+	if !names.IsValidAction(actionId) {
+		return nil, errors.NotValidf("action ID %q", actionId)
+	}
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewActionTag(actionId).String()}},
+	}
+
+	var results params.StringsWatchResults
+	err := c.facade.FacadeCall("WatchActionsProgress", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	w := apiwatcher.NewStringsWatcher(c.facade.RawAPICaller(), result)
+	return w, nil
+}
+
 // FindActionTagsByPrefix takes a list of string prefixes and finds
 // corresponding ActionTags that match that prefix.
 func (c *Client) FindActionTagsByPrefix(arg params.FindTags) (params.FindTagsResults, error) {
@@ -84,12 +118,23 @@ func (c *Client) ListCompleted(arg params.Entities) (params.ActionsByReceivers,
 }
 
 // Cancel attempts to cancel a queued up Action from running.
-func (c *Client) Cancel(arg params.Actions) (params.ActionResults, error) {
+func (c *Client) Cancel(arg params.Entities) (params.ActionResults, error) {
 	results := params.ActionResults{}
 	err := c.facade.FacadeCall("Cancel", arg, &results)
 	return results, err
 }
 
+// Abort cancels queued up Actions, and asks the units running Actions
+// to stop them.
+func (c *Client) Abort(arg params.Entities) (params.ActionResults, error) {
+	if c.BestAPIVersion() < 3 {
+		return params.ActionResults{}, errors.NotSupportedf("aborting running actions on this controller")
+	}
+	results := params.ActionResults{}
+	err := c.facade.FacadeCall("Abort", arg, &results)
+	return results, err
+}
+
 // applicationsCharmActions is a batched query for the charm.Actions for a slice
 // of services by Entity.
 func (c *Client) applicationsCharmActions(arg params.Entities) (params.ApplicationsCharmActionsResults, error) {
@@ -11,6 +11,7 @@ import (
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/api/action"
+	basetesting "github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/apiserver/params"
 )
 
@@ -109,6 +110,49 @@ func (s *actionSuite) TestApplicationCharmActions(c *gc.C) {
 	}
 }
 
+func (s *actionSuite) TestAbortNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(string, int, string, string, interface{}, interface{}) error {
+		c.Fatalf("unexpected API call")
+		return nil
+	})
+	client := action.NewClient(apiCaller)
+	_, err := client.Abort(params.Entities{})
+	c.Assert(err, gc.ErrorMatches, "aborting running actions on this controller not supported")
+}
+
+func (s *actionSuite) TestWatchActionProgressNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(func(string, int, string, string, interface{}, interface{}) error {
+		c.Fatalf("unexpected API call")
+		return nil
+	})
+	client := action.NewClient(apiCaller)
+	_, err := client.WatchActionProgress("ff9a1ae7-2bd7-4e5c-af3e-1c9f1b1d6e4a")
+	c.Assert(err, gc.ErrorMatches, "watching action progress on this controller not supported")
+}
+
+func (s *actionSuite) TestWatchActionProgressErrors(c *gc.C) {
+	_, err := s.client.WatchActionProgress("deadbeef")
+	c.Check(err, gc.ErrorMatches, `action ID "deadbeef" not valid`)
+
+	actionId := "ff9a1ae7-2bd7-4e5c-af3e-1c9f1b1d6e4a"
+	cleanup := action.PatchClientFacadeCall(s.client,
+		func(req string, paramsIn interface{}, resp interface{}) error {
+			c.Assert(req, gc.Equals, "WatchActionsProgress")
+			c.Assert(paramsIn, jc.DeepEquals, params.Entities{
+				Entities: []params.Entity{{Tag: names.NewActionTag(actionId).String()}},
+			})
+			result := resp.(*params.StringsWatchResults)
+			result.Results = []params.StringsWatchResult{{
+				Error: &params.Error{Message: "permission denied"},
+			}}
+			return nil
+		},
+	)
+	defer cleanup()
+	_, err = s.client.WatchActionProgress(actionId)
+	c.Check(err, gc.ErrorMatches, "permission denied")
+}
+
 // replace sCharmActions" facade call with required results and error
 // if desired
 func patchApplicationCharmActions(c *gc.C, apiCli *action.Client, patchResults []params.ApplicationCharmActionsResult, err string) func() {
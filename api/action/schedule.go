@@ -0,0 +1,31 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"github.com/juju/juju/apiserver/params"
+)
+
+// AddSchedules schedules actions to be enqueued at a future time, once
+// or on a recurring schedule, returning the added schedule or an error
+// for each.
+func (c *Client) AddSchedules(arg params.ActionSchedules) (params.ActionScheduleResults, error) {
+	results := params.ActionScheduleResults{}
+	err := c.facade.FacadeCall("AddSchedules", arg, &results)
+	return results, err
+}
+
+// ListSchedules returns all the action schedules in the model.
+func (c *Client) ListSchedules() (params.ActionSchedules, error) {
+	results := params.ActionSchedules{}
+	err := c.facade.FacadeCall("ListSchedules", nil, &results)
+	return results, err
+}
+
+// RemoveSchedules removes the action schedules with the given ids.
+func (c *Client) RemoveSchedules(arg params.ActionScheduleIds) (params.ErrorResults, error) {
+	results := params.ErrorResults{}
+	err := c.facade.FacadeCall("RemoveSchedules", arg, &results)
+	return results, err
+}
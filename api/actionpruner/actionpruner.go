@@ -0,0 +1,42 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionpruner provides the client side of the API used by the
+// action pruner worker.
+package actionpruner
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/common"
+	"github.com/juju/juju/apiserver/params"
+)
+
+const actionPrunerFacade = "ActionPruner"
+
+// Facade provides access to the ActionPruner API facade.
+type Facade struct {
+	*common.ModelWatcher
+	facade base.FacadeCaller
+}
+
+// NewFacade creates a new client-side ActionPruner facade.
+func NewFacade(caller base.APICaller) *Facade {
+	facadeCaller := base.NewFacadeCaller(caller, actionPrunerFacade)
+	return &Facade{
+		ModelWatcher: common.NewModelWatcher(facadeCaller),
+		facade:       facadeCaller,
+	}
+}
+
+// Prune calls the server-side Prune method.
+func (f *Facade) Prune(maxHistoryTime time.Duration, maxHistoryMB int) error {
+	p := params.ActionPruneArgs{
+		MaxHistoryTime: maxHistoryTime,
+		MaxHistoryMB:   maxHistoryMB,
+	}
+	return errors.Trace(f.facade.FacadeCall("Prune", p, nil))
+}
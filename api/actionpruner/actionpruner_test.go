@@ -0,0 +1,63 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionpruner_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/actionpruner"
+	apitesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/apiserver/params"
+)
+
+type ActionPrunerSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ActionPrunerSuite{})
+
+func (s *ActionPrunerSuite) TestPrune(c *gc.C) {
+	var called bool
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called = true
+		c.Check(objType, gc.Equals, "ActionPruner")
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "Prune")
+		c.Check(arg, jc.DeepEquals, params.ActionPruneArgs{
+			MaxHistoryTime: 72 * time.Hour,
+			MaxHistoryMB:   500,
+		})
+		c.Check(result, gc.IsNil)
+		return nil
+	})
+	facade := actionpruner.NewFacade(apiCaller)
+	err := facade.Prune(72*time.Hour, 500)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+}
+
+func (s *ActionPrunerSuite) TestPruneError(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return errors.New("boom")
+	})
+	facade := actionpruner.NewFacade(apiCaller)
+	err := facade.Prune(time.Hour, 0)
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *ActionPrunerSuite) TestModelConfig(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "ActionPruner")
+		c.Check(request, gc.Equals, "ModelConfig")
+		return errors.New("boom")
+	})
+	facade := actionpruner.NewFacade(apiCaller)
+	_, err := facade.ModelConfig()
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
@@ -0,0 +1,61 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionscheduler provides the client side of the API used by
+// the action scheduler worker.
+package actionscheduler
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/watcher"
+)
+
+const actionSchedulerFacade = "ActionScheduler"
+
+// API provides access to the ActionScheduler API facade.
+type API struct {
+	facade base.FacadeCaller
+}
+
+// NewAPI creates a new client-side ActionScheduler facade.
+func NewAPI(caller base.APICaller) *API {
+	facadeCaller := base.NewFacadeCaller(caller, actionSchedulerFacade)
+	return &API{facade: facadeCaller}
+}
+
+// FireDueSchedules calls the server-side FireDueSchedules method. It
+// returns when the next action schedule is due, or the zero time if
+// there are none.
+func (api *API) FireDueSchedules() (time.Time, error) {
+	var result params.FireActionSchedulesResult
+	if err := api.facade.FacadeCall("FireDueSchedules", nil, &result); err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return time.Time{}, result.Error
+	}
+	if result.Next == nil {
+		return time.Time{}, nil
+	}
+	return *result.Next, nil
+}
+
+// WatchSchedules calls the server-side WatchSchedules method.
+func (api *API) WatchSchedules() (watcher.NotifyWatcher, error) {
+	var result params.NotifyWatchResult
+	err := api.facade.FacadeCall("WatchSchedules", nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if err := result.Error; err != nil {
+		return nil, result.Error
+	}
+	w := apiwatcher.NewNotifyWatcher(api.facade.RawAPICaller(), result)
+	return w, nil
+}
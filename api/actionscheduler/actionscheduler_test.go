@@ -0,0 +1,90 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionscheduler_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/actionscheduler"
+	apitesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/apiserver/params"
+)
+
+type ActionSchedulerSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&ActionSchedulerSuite{})
+
+func (s *ActionSchedulerSuite) TestFireDueSchedules(c *gc.C) {
+	next := time.Date(2017, 3, 2, 2, 0, 0, 0, time.UTC)
+	var called bool
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		called = true
+		c.Check(objType, gc.Equals, "ActionScheduler")
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "FireDueSchedules")
+		c.Check(arg, gc.IsNil)
+		c.Assert(result, gc.FitsTypeOf, &params.FireActionSchedulesResult{})
+		*(result.(*params.FireActionSchedulesResult)) = params.FireActionSchedulesResult{
+			Next: &next,
+		}
+		return nil
+	})
+	api := actionscheduler.NewAPI(apiCaller)
+	result, err := api.FireDueSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+	c.Check(result, gc.Equals, next)
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedulesNoneLeft(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return nil
+	})
+	api := actionscheduler.NewAPI(apiCaller)
+	result, err := api.FireDueSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.IsZero(), jc.IsTrue)
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedulesResultError(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		*(result.(*params.FireActionSchedulesResult)) = params.FireActionSchedulesResult{
+			Error: &params.Error{Message: "boom"},
+		}
+		return nil
+	})
+	api := actionscheduler.NewAPI(apiCaller)
+	_, err := api.FireDueSchedules()
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedulesCallError(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return errors.New("boom")
+	})
+	api := actionscheduler.NewAPI(apiCaller)
+	_, err := api.FireDueSchedules()
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *ActionSchedulerSuite) TestWatchSchedulesResultError(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(request, gc.Equals, "WatchSchedules")
+		*(result.(*params.NotifyWatchResult)) = params.NotifyWatchResult{
+			Error: &params.Error{Message: "boom"},
+		}
+		return nil
+	})
+	api := actionscheduler.NewAPI(apiCaller)
+	w, err := api.WatchSchedules()
+	c.Assert(err, gc.ErrorMatches, "boom")
+	c.Assert(w, gc.IsNil)
+}
@@ -269,6 +269,20 @@ func (c *Client) Expose(application string) error {
 	return c.facade.FacadeCall("Expose", params, nil)
 }
 
+// ExposeToCIDRs changes the juju-managed firewall to expose any ports
+// that were also explicitly marked by units as open, allowing access
+// to them only from the given source CIDRs.
+func (c *Client) ExposeToCIDRs(application string, cidrs []string) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("exposing applications to source CIDRs on this controller")
+	}
+	params := params.ApplicationExpose{
+		ApplicationName: application,
+		ExposedCIDRs:    cidrs,
+	}
+	return c.facade.FacadeCall("Expose", params, nil)
+}
+
 // Unexpose changes the juju-managed firewall to unexpose any ports that
 // were also explicitly marked by units as open.
 func (c *Client) Unexpose(application string) error {
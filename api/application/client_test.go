@@ -76,6 +76,32 @@ func (s *applicationSuite) TestSetServiceMetricCredentialsNoMocks(c *gc.C) {
 	c.Assert(application.MetricCredentials(), gc.DeepEquals, []byte("creds"))
 }
 
+func (s *applicationSuite) TestExposeToCIDRs(c *gc.C) {
+	var called bool
+	application.PatchFacadeCall(s, s.client, func(request string, a, response interface{}) error {
+		called = true
+		c.Assert(request, gc.Equals, "Expose")
+		c.Assert(a, jc.DeepEquals, params.ApplicationExpose{
+			ApplicationName: "wordpress",
+			ExposedCIDRs:    []string{"10.0.0.0/8", "192.168.1.0/24"},
+		})
+		return nil
+	})
+	err := s.client.ExposeToCIDRs("wordpress", []string{"10.0.0.0/8", "192.168.1.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *applicationSuite) TestExposeToCIDRsNotSupported(c *gc.C) {
+	application.PatchBestAPIVersion(s, s.client, 3)
+	application.PatchFacadeCall(s, s.client, func(request string, a, response interface{}) error {
+		c.Fatalf("unexpected API call")
+		return nil
+	})
+	err := s.client.ExposeToCIDRs("wordpress", []string{"10.0.0.0/8"})
+	c.Assert(err, gc.ErrorMatches, "exposing applications to source CIDRs on this controller not supported")
+}
+
 func (s *applicationSuite) TestSetServiceDeploy(c *gc.C) {
 	var called bool
 	application.PatchFacadeCall(s, s.client, func(request string, a, response interface{}) error {
@@ -4,6 +4,7 @@
 package application
 
 import (
+	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/base/testing"
 )
 
@@ -13,3 +14,18 @@ import (
 func PatchFacadeCall(p testing.Patcher, client *Client, f func(request string, params, response interface{}) error) {
 	testing.PatchFacadeCall(p, &client.facade, f)
 }
+
+// PatchBestAPIVersion patches the client's facade such that
+// BestAPIVersion returns the provided version.
+func PatchBestAPIVersion(p testing.Patcher, client *Client, version int) {
+	p.PatchValue(&client.ClientFacade, bestVersionFacade{client.ClientFacade, version})
+}
+
+type bestVersionFacade struct {
+	base.ClientFacade
+	version int
+}
+
+func (f bestVersionFacade) BestAPIVersion() int {
+	return f.version
+}
@@ -0,0 +1,67 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package auditlog provides access to the controller's audit log.
+package auditlog
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/audit"
+)
+
+// Client provides methods for querying the audit log.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new Client based on an existing authenticated
+// API connection.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "AuditLog")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// Query returns the audit log entries matching the supplied filter,
+// oldest first. The filter's OriginName, if set, must be a user tag.
+func (c *Client) Query(filter audit.Filter) ([]audit.AuditEntry, error) {
+	args := params.AuditLogFilter{
+		UserTag:   filter.OriginName,
+		Operation: filter.Operation,
+		Limit:     filter.Limit,
+	}
+	if filter.ModelUUID != "" {
+		if !names.IsValidModel(filter.ModelUUID) {
+			return nil, errors.NotValidf("model UUID %q", filter.ModelUUID)
+		}
+		args.ModelTag = names.NewModelTag(filter.ModelUUID).String()
+	}
+	if !filter.After.IsZero() {
+		args.After = &filter.After
+	}
+	if !filter.Before.IsZero() {
+		args.Before = &filter.Before
+	}
+	var result params.AuditLogResults
+	if err := c.facade.FacadeCall("Query", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	entries := make([]audit.AuditEntry, len(result.Entries))
+	for i, entry := range result.Entries {
+		entries[i] = audit.AuditEntry{
+			JujuServerVersion: entry.JujuServerVersion,
+			ModelUUID:         entry.ModelUUID,
+			Timestamp:         entry.Timestamp,
+			RemoteAddress:     entry.RemoteAddress,
+			OriginType:        entry.OriginType,
+			OriginName:        entry.OriginName,
+			Operation:         entry.Operation,
+			Data:              entry.Data,
+		}
+	}
+	return entries, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"time"
+
+	gitjujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/auditlog"
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/audit"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type clientSuite struct {
+	gitjujutesting.IsolationSuite
+}
+
+var _ = gc.Suite(&clientSuite{})
+
+func (s *clientSuite) TestQuery(c *gc.C) {
+	after := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	ts := after.Add(time.Hour)
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "AuditLog")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Query")
+			c.Check(a, jc.DeepEquals, params.AuditLogFilter{
+				ModelTag:  coretesting.ModelTag.String(),
+				UserTag:   "user-bob",
+				Operation: "Application:v3 - Deploy",
+				After:     &after,
+				Limit:     10,
+			})
+			c.Assert(result, gc.FitsTypeOf, &params.AuditLogResults{})
+			*(result.(*params.AuditLogResults)) = params.AuditLogResults{
+				Entries: []params.AuditLogEntry{{
+					JujuServerVersion: version.MustParse("2.1.0"),
+					ModelUUID:         coretesting.ModelTag.Id(),
+					Timestamp:         ts,
+					RemoteAddress:     "10.0.0.1",
+					OriginType:        "API request",
+					OriginName:        "user-bob",
+					Operation:         "Application:v3 - Deploy",
+				}},
+			}
+			return nil
+		},
+	)
+
+	client := auditlog.NewClient(apiCaller)
+	entries, err := client.Query(audit.Filter{
+		ModelUUID:  coretesting.ModelTag.Id(),
+		OriginName: "user-bob",
+		Operation:  "Application:v3 - Deploy",
+		After:      after,
+		Limit:      10,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []audit.AuditEntry{{
+		JujuServerVersion: version.MustParse("2.1.0"),
+		ModelUUID:         coretesting.ModelTag.Id(),
+		Timestamp:         ts,
+		RemoteAddress:     "10.0.0.1",
+		OriginType:        "API request",
+		OriginName:        "user-bob",
+		Operation:         "Application:v3 - Deploy",
+	}})
+}
+
+func (s *clientSuite) TestQueryInvalidModel(c *gc.C) {
+	client := auditlog.NewClient(basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	))
+	_, err := client.Query(audit.Filter{ModelUUID: "foo"})
+	c.Assert(err, gc.ErrorMatches, `model UUID "foo" not valid`)
+}
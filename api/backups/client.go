@@ -9,6 +9,7 @@ import (
 	"github.com/juju/loggo"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
 )
 
 var logger = loggo.GetLogger("juju.api.backups")
@@ -33,3 +34,18 @@ func NewClient(st base.APICallCloser) (*Client, error) {
 		client:       client,
 	}, nil
 }
+
+// checkSupported returns an error if a backup target or encryption
+// key is requested of a controller that does not support them.
+func (c *Client) checkSupported(target *params.BackupTarget, encryptionKey []byte) error {
+	if c.BestAPIVersion() >= 2 {
+		return nil
+	}
+	if target != nil {
+		return errors.NotSupportedf("backup targets on this controller")
+	}
+	if len(encryptionKey) > 0 {
+		return errors.NotSupportedf("backup encryption keys on this controller")
+	}
+	return nil
+}
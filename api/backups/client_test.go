@@ -7,6 +7,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api/backups"
+	"github.com/juju/juju/apiserver/params"
 )
 
 type backupsSuite struct {
@@ -20,3 +21,21 @@ func (s *backupsSuite) TestClient(c *gc.C) {
 
 	c.Check(facade.Name(), gc.Equals, "Backups")
 }
+
+func (s *backupsSuite) TestRestoreWithArgsNotSupported(c *gc.C) {
+	backups.PatchBestAPIVersion(&s.JujuConnSuite, s.client, 1)
+	newClient := func() (*backups.Client, error) {
+		c.Fatalf("unexpected connection")
+		return nil, nil
+	}
+	err := s.client.RestoreWithArgs(params.RestoreArgs{
+		BackupId: "spam",
+		Target:   &params.BackupTarget{URL: "file:///mnt/backups"},
+	}, newClient)
+	c.Check(err, gc.ErrorMatches, "backup targets on this controller not supported")
+	err = s.client.RestoreWithArgs(params.RestoreArgs{
+		BackupId:      "spam",
+		EncryptionKey: []byte("key"),
+	}, newClient)
+	c.Check(err, gc.ErrorMatches, "backup encryption keys on this controller not supported")
+}
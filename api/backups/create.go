@@ -12,8 +12,17 @@ import (
 // Create sends a request to create a backup of juju's state.  It
 // returns the metadata associated with the resulting backup.
 func (c *Client) Create(notes string) (*params.BackupsMetadataResult, error) {
+	return c.CreateWithArgs(params.BackupsCreateArgs{Notes: notes})
+}
+
+// CreateWithArgs sends a request to create a backup of juju's state,
+// with the given notes, target and encryption key. It returns the
+// metadata associated with the resulting backup.
+func (c *Client) CreateWithArgs(args params.BackupsCreateArgs) (*params.BackupsMetadataResult, error) {
+	if err := c.checkSupported(args.Target, args.EncryptionKey); err != nil {
+		return nil, errors.Trace(err)
+	}
 	var result params.BackupsMetadataResult
-	args := params.BackupsCreateArgs{Notes: notes}
 	if err := c.facade.FacadeCall("Create", args, &result); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -45,3 +45,57 @@ func (s *createSuite) TestCreate(c *gc.C) {
 	meta := backupstesting.UpdateNotes(s.Meta, "important")
 	s.checkMetadataResult(c, result, meta)
 }
+
+func (s *createSuite) TestCreateWithArgs(c *gc.C) {
+	cleanup := backups.PatchClientFacadeCall(s.client,
+		func(req string, paramsIn interface{}, resp interface{}) error {
+			c.Check(req, gc.Equals, "Create")
+
+			c.Assert(paramsIn, gc.FitsTypeOf, params.BackupsCreateArgs{})
+			p := paramsIn.(params.BackupsCreateArgs)
+			c.Check(p.Target, jc.DeepEquals, &params.BackupTarget{
+				URL:       "s3://juju-backups",
+				AccessKey: "access",
+				SecretKey: "secret",
+			})
+			c.Check(p.EncryptionKey, jc.DeepEquals, []byte("key"))
+
+			result := resp.(*params.BackupsMetadataResult)
+			*result = apiserverbackups.ResultFromMetadata(s.Meta)
+			result.Target = p.Target.URL
+			return nil
+		},
+	)
+	defer cleanup()
+
+	result, err := s.client.CreateWithArgs(params.BackupsCreateArgs{
+		Target: &params.BackupTarget{
+			URL:       "s3://juju-backups",
+			AccessKey: "access",
+			SecretKey: "secret",
+		},
+		EncryptionKey: []byte("key"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Target, gc.Equals, "s3://juju-backups")
+}
+
+func (s *createSuite) TestCreateWithArgsNotSupported(c *gc.C) {
+	backups.PatchBestAPIVersion(&s.JujuConnSuite, s.client, 1)
+	cleanup := backups.PatchClientFacadeCall(s.client,
+		func(req string, paramsIn interface{}, resp interface{}) error {
+			c.Fatalf("unexpected call to %s", req)
+			return nil
+		},
+	)
+	defer cleanup()
+
+	_, err := s.client.CreateWithArgs(params.BackupsCreateArgs{
+		Target: &params.BackupTarget{URL: "file:///mnt/backups"},
+	})
+	c.Check(err, gc.ErrorMatches, "backup targets on this controller not supported")
+	_, err = s.client.CreateWithArgs(params.BackupsCreateArgs{
+		EncryptionKey: []byte("key"),
+	})
+	c.Check(err, gc.ErrorMatches, "backup encryption keys on this controller not supported")
+}
@@ -20,16 +20,19 @@ type downloadParams struct {
 
 // Download returns an io.ReadCloser for the given backup id.
 func (c *Client) Download(id string) (io.ReadCloser, error) {
+	return c.DownloadWithArgs(params.BackupsDownloadArgs{ID: id})
+}
+
+// DownloadWithArgs returns an io.ReadCloser for the identified backup,
+// fetched from the given target if its archive is stored there rather
+// than in the controller.
+func (c *Client) DownloadWithArgs(args params.BackupsDownloadArgs) (io.ReadCloser, error) {
+	if err := c.checkSupported(args.Target, nil); err != nil {
+		return nil, errors.Trace(err)
+	}
 	// Send the request.
 	var resp *http.Response
-	err := c.client.Call(
-		&downloadParams{
-			Body: params.BackupsDownloadArgs{
-				ID: id,
-			},
-		},
-		&resp,
-	)
+	err := c.client.Call(&downloadParams{Body: args}, &resp)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -5,8 +5,24 @@ package backups
 
 import (
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/api/base/testing"
 )
 
+// PatchBestAPIVersion patches the client's facade such that
+// BestAPIVersion returns the provided version.
+func PatchBestAPIVersion(p testing.Patcher, client *Client, version int) {
+	p.PatchValue(&client.ClientFacade, bestVersionFacade{client.ClientFacade, version})
+}
+
+type bestVersionFacade struct {
+	base.ClientFacade
+	version int
+}
+
+func (f bestVersionFacade) BestAPIVersion() int {
+	return f.version
+}
+
 // ExposeFacade returns the client's underlying FacadeCaller.
 func ExposeFacade(c *Client) base.FacadeCaller {
 	return c.facade
@@ -10,7 +10,15 @@ import (
 )
 
 func (c *Client) Remove(id string) error {
-	args := params.BackupsRemoveArgs{ID: id}
+	return c.RemoveWithArgs(params.BackupsRemoveArgs{ID: id})
+}
+
+// RemoveWithArgs removes the identified backup, from the given target
+// if its archive is stored there rather than in the controller.
+func (c *Client) RemoveWithArgs(args params.BackupsRemoveArgs) error {
+	if err := c.checkSupported(args.Target, nil); err != nil {
+		return errors.Trace(err)
+	}
 	if err := c.facade.FacadeCall("Remove", args, nil); err != nil {
 		return errors.Trace(err)
 	}
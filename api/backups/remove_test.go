@@ -33,3 +33,38 @@ func (s *removeSuite) TestRemove(c *gc.C) {
 	err := s.client.Remove(s.Meta.ID())
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *removeSuite) TestRemoveWithArgs(c *gc.C) {
+	target := &params.BackupTarget{URL: "file:///mnt/backups"}
+	cleanup := backups.PatchClientFacadeCall(s.client,
+		func(req string, paramsIn interface{}, resp interface{}) error {
+			c.Check(req, gc.Equals, "Remove")
+			c.Check(paramsIn, jc.DeepEquals, params.BackupsRemoveArgs{
+				ID:     "spam",
+				Target: target,
+			})
+			return nil
+		},
+	)
+	defer cleanup()
+
+	err := s.client.RemoveWithArgs(params.BackupsRemoveArgs{ID: "spam", Target: target})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *removeSuite) TestRemoveWithArgsNotSupported(c *gc.C) {
+	backups.PatchBestAPIVersion(&s.JujuConnSuite, s.client, 1)
+	cleanup := backups.PatchClientFacadeCall(s.client,
+		func(req string, paramsIn interface{}, resp interface{}) error {
+			c.Fatalf("unexpected call to %s", req)
+			return nil
+		},
+	)
+	defer cleanup()
+
+	err := s.client.RemoveWithArgs(params.BackupsRemoveArgs{
+		ID:     "spam",
+		Target: &params.BackupTarget{URL: "file:///mnt/backups"},
+	})
+	c.Check(err, gc.ErrorMatches, "backup targets on this controller not supported")
+}
@@ -81,7 +81,7 @@ func (c *Client) RestoreReader(r io.ReadSeeker, meta *params.BackupsMetadataResu
 		logger.Errorf("could not clean up after failed backup upload: %v", finishErr)
 		return errors.Annotatef(err, "cannot upload backup file")
 	}
-	return c.restore(backupId, newClient)
+	return c.restore(params.RestoreArgs{BackupId: backupId}, newClient)
 }
 
 // Restore performs restore using a backup id corresponding to a backup stored in the server.
@@ -90,7 +90,21 @@ func (c *Client) Restore(backupId string, newClient ClientConnection) error {
 		return errors.Trace(err)
 	}
 	logger.Debugf("Server in 'about to restore' mode")
-	return c.restore(backupId, newClient)
+	return c.restore(params.RestoreArgs{BackupId: backupId}, newClient)
+}
+
+// RestoreWithArgs performs restore using a backup id corresponding to
+// a backup stored in the server, along with the target holding its
+// archive and the key with which it was encrypted, if any.
+func (c *Client) RestoreWithArgs(args params.RestoreArgs, newClient ClientConnection) error {
+	if err := c.checkSupported(args.Target, args.EncryptionKey); err != nil {
+		return errors.Trace(err)
+	}
+	if err := prepareRestore(newClient); err != nil {
+		return errors.Trace(err)
+	}
+	logger.Debugf("Server in 'about to restore' mode")
+	return c.restore(args, newClient)
 }
 
 func restoreAttempt(client *Client, restoreArgs params.RestoreArgs) (error, error) {
@@ -103,16 +117,12 @@ func restoreAttempt(client *Client, restoreArgs params.RestoreArgs) (error, erro
 // restore is responsible for triggering the whole restore process in a remote
 // machine. The backup information for the process should already be in the
 // server and loaded in the backup storage under the backupId id.
-// It takes backupId as the identifier for the remote backup file and a
-// client connection factory newClient (newClient should no longer be
+// It takes the restore args, identifying the remote backup file, and
+// a client connection factory newClient (newClient should no longer be
 // necessary when lp:1399722 is sorted out).
-func (c *Client) restore(backupId string, newClient ClientConnection) error {
+func (c *Client) restore(restoreArgs params.RestoreArgs, newClient ClientConnection) error {
 	var err, remoteError error
-
-	// Restore
-	restoreArgs := params.RestoreArgs{
-		BackupId: backupId,
-	}
+	backupId := restoreArgs.BackupId
 
 	cleanExit := false
 	for a := restoreStrategy.Start(); a.Next(); {
@@ -0,0 +1,40 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package bundle provides access to the bundle API facade.
+package bundle
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+)
+
+// Client allows access to the bundle API end point.
+type Client struct {
+	base.ClientFacade
+	facade base.FacadeCaller
+}
+
+// NewClient creates a new client for accessing the bundle API.
+func NewClient(st base.APICallCloser) *Client {
+	frontend, backend := base.NewClientFacade(st, "Bundle")
+	return &Client{ClientFacade: frontend, facade: backend}
+}
+
+// ExportBundle returns the YAML of a bundle that deploys an equivalent
+// of the current model.
+func (c *Client) ExportBundle() (string, error) {
+	if c.BestAPIVersion() < 2 {
+		return "", errors.NotSupportedf("exporting bundles on this controller")
+	}
+	var result params.StringResult
+	if err := c.facade.FacadeCall("ExportBundle", nil, &result); err != nil {
+		return "", errors.Trace(err)
+	}
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.Result, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"errors"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	basetesting "github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/api/bundle"
+	"github.com/juju/juju/apiserver/params"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type bundleSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&bundleSuite{})
+
+func (s *bundleSuite) TestExportBundle(c *gc.C) {
+	var called bool
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			called = true
+			c.Check(objType, gc.Equals, "Bundle")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ExportBundle")
+			c.Check(a, gc.IsNil)
+			*(result.(*params.StringResult)) = params.StringResult{
+				Result: "applications: {}\n",
+			}
+			return nil
+		})
+	client := bundle.NewClient(bestVersionCaller{apiCaller, 2})
+	data, err := client.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, gc.Equals, "applications: {}\n")
+	c.Check(called, jc.IsTrue)
+}
+
+func (s *bundleSuite) TestExportBundleError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("boom")
+		})
+	client := bundle.NewClient(bestVersionCaller{apiCaller, 2})
+	_, err := client.ExportBundle()
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *bundleSuite) TestExportBundleResultError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			*(result.(*params.StringResult)) = params.StringResult{
+				Error: &params.Error{Message: "cannot export bundle"},
+			}
+			return nil
+		})
+	client := bundle.NewClient(bestVersionCaller{apiCaller, 2})
+	_, err := client.ExportBundle()
+	c.Check(err, gc.ErrorMatches, "cannot export bundle")
+}
+
+func (s *bundleSuite) TestExportBundleNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		})
+	client := bundle.NewClient(bestVersionCaller{apiCaller, 1})
+	_, err := client.ExportBundle()
+	c.Check(err, gc.ErrorMatches, "exporting bundles on this controller not supported")
+}
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
@@ -447,6 +447,12 @@ func (s *clientSuite) TestWatchDebugLogParamsEncoded(c *gc.C) {
 		Replay:        true,
 		NoTail:        true,
 		StartTime:     time.Date(2016, 11, 30, 11, 48, 0, 100, time.UTC),
+		EndTime:       time.Date(2016, 11, 30, 12, 48, 0, 0, time.UTC),
+
+		IncludeMessage:  []string{"i", "j"},
+		ExcludeMessage:  []string{"k"},
+		IncludeLocation: []string{"l.go"},
+		ExcludeLocation: []string{"m.go:1"},
 	}
 
 	client := s.APIState.Client()
@@ -456,16 +462,21 @@ func (s *clientSuite) TestWatchDebugLogParamsEncoded(c *gc.C) {
 	connectURL := catcher.location
 	values := connectURL.Query()
 	c.Assert(values, jc.DeepEquals, url.Values{
-		"includeEntity": params.IncludeEntity,
-		"includeModule": params.IncludeModule,
-		"excludeEntity": params.ExcludeEntity,
-		"excludeModule": params.ExcludeModule,
-		"maxLines":      {"100"},
-		"backlog":       {"200"},
-		"level":         {"ERROR"},
-		"replay":        {"true"},
-		"noTail":        {"true"},
-		"startTime":     {"2016-11-30T11:48:00.0000001Z"},
+		"includeEntity":   params.IncludeEntity,
+		"includeModule":   params.IncludeModule,
+		"excludeEntity":   params.ExcludeEntity,
+		"excludeModule":   params.ExcludeModule,
+		"includeMessage":  params.IncludeMessage,
+		"excludeMessage":  params.ExcludeMessage,
+		"includeLocation": params.IncludeLocation,
+		"excludeLocation": params.ExcludeLocation,
+		"maxLines":        {"100"},
+		"backlog":         {"200"},
+		"level":           {"ERROR"},
+		"replay":          {"true"},
+		"noTail":          {"true"},
+		"startTime":       {"2016-11-30T11:48:00.0000001Z"},
+		"endTime":         {"2016-11-30T12:48:00Z"},
 	})
 }
 
@@ -53,14 +53,39 @@ type DebugLogParams struct {
 	// StartTime should be a time in the past - only records with a
 	// log time on or after StartTime will be returned.
 	StartTime time.Time
+	// EndTime, if set, means only records with a log time before EndTime
+	// will be returned. The server does not wait for new logs to arrive.
+	EndTime time.Time
+	// IncludeMessage lists regular expressions matched against the log
+	// message. If any are set, only messages matching one are included.
+	IncludeMessage []string
+	// ExcludeMessage lists regular expressions matched against the log
+	// message. Messages matching any of them are excluded.
+	ExcludeMessage []string
+	// IncludeLocation lists source locations, of the form filename:lineno,
+	// to include in the response. The line number may be omitted to match
+	// the whole file, and '*' matches any sequence of characters.
+	IncludeLocation []string
+	// ExcludeLocation lists source locations to exclude from the response.
+	// Values take the same form as for IncludeLocation.
+	ExcludeLocation []string
+	// IncludeModel lists the names or UUIDs of the models whose logs are
+	// included in the response. It is only used when streaming the logs of
+	// all models in a controller; if none are set, all models are included.
+	IncludeModel []string
 }
 
 func (args DebugLogParams) URLQuery() url.Values {
 	attrs := url.Values{
-		"includeEntity": args.IncludeEntity,
-		"includeModule": args.IncludeModule,
-		"excludeEntity": args.ExcludeEntity,
-		"excludeModule": args.ExcludeModule,
+		"includeEntity":   args.IncludeEntity,
+		"includeModule":   args.IncludeModule,
+		"excludeEntity":   args.ExcludeEntity,
+		"excludeModule":   args.ExcludeModule,
+		"includeMessage":  args.IncludeMessage,
+		"excludeMessage":  args.ExcludeMessage,
+		"includeLocation": args.IncludeLocation,
+		"excludeLocation": args.ExcludeLocation,
+		"includeModel":    args.IncludeModel,
 	}
 	if args.Replay {
 		attrs.Set("replay", fmt.Sprint(args.Replay))
@@ -80,6 +105,9 @@ func (args DebugLogParams) URLQuery() url.Values {
 	if !args.StartTime.IsZero() {
 		attrs.Set("startTime", args.StartTime.Format(time.RFC3339Nano))
 	}
+	if !args.EndTime.IsZero() {
+		attrs.Set("endTime", args.EndTime.Format(time.RFC3339Nano))
+	}
 	return attrs
 }
 
@@ -91,6 +119,11 @@ type LogMessage struct {
 	Module    string
 	Location  string
 	Message   string
+
+	// ModelUUID and ModelName identify the model the entry was logged
+	// in. They are only set when streaming the logs of all models.
+	ModelUUID string
+	ModelName string
 }
 
 // StreamDebugLog requests the specified debug log records from the
@@ -110,7 +143,22 @@ func StreamDebugLog(source base.StreamConnector, args DebugLogParams) (<-chan Lo
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	return readLogMessages(connection), nil
+}
 
+// StreamControllerDebugLog requests the specified debug log records of
+// all the models in a controller, or those chosen by args.IncludeModel,
+// and returns a channel of the messages that come back. Only controller
+// superusers may do this.
+func StreamControllerDebugLog(source base.ControllerStreamConnector, args DebugLogParams) (<-chan LogMessage, error) {
+	connection, err := source.ConnectControllerStream("/controller/log", args.URLQuery(), nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return readLogMessages(connection), nil
+}
+
+func readLogMessages(connection base.Stream) <-chan LogMessage {
 	messages := make(chan LogMessage)
 	go func() {
 		defer close(messages)
@@ -128,9 +176,10 @@ func StreamDebugLog(source base.StreamConnector, args DebugLogParams) (<-chan Lo
 				Module:    msg.Module,
 				Location:  msg.Location,
 				Message:   msg.Message,
+				ModelUUID: msg.ModelUUID,
+				ModelName: msg.ModelName,
 			}
 		}
 	}()
-
-	return messages, nil
+	return messages
 }
@@ -8,6 +8,7 @@ import (
 	apiwatcher "github.com/juju/juju/api/watcher"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/logfwd"
 	"github.com/juju/juju/logfwd/syslog"
 	"github.com/juju/juju/watcher"
 )
@@ -68,3 +69,13 @@ func (e *ModelWatcher) LogForwardConfig() (*syslog.RawConfig, bool, error) {
 	cfg, ok := modelConfig.LogFwdSyslog()
 	return cfg, ok, nil
 }
+
+// LogForwardSinks returns the configuration of the additional log
+// forwarding sinks, read from the model config.
+func (e *ModelWatcher) LogForwardSinks() ([]logfwd.SinkConfig, error) {
+	modelConfig, err := e.ModelConfig()
+	if err != nil {
+		return nil, err
+	}
+	return modelConfig.LogFwdSinks()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/common/cloudspec"
 	"github.com/juju/juju/apiserver/params"
+	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/permission"
 )
@@ -158,6 +159,13 @@ func (c *Client) WatchAllModels() (*api.AllWatcher, error) {
 	return api.NewAllModelWatcher(c.facade.RawAPICaller(), &info.AllWatcherId), nil
 }
 
+// WatchDebugLog returns a channel of structured log messages from all
+// of the models in the controller, or those chosen by the
+// IncludeModel field of the supplied params.
+func (c *Client) WatchDebugLog(args common.DebugLogParams) (<-chan common.LogMessage, error) {
+	return common.StreamControllerDebugLog(c.facade.RawAPICaller(), args)
+}
+
 // GrantController grants a user access to the controller.
 func (c *Client) GrantController(user, access string) error {
 	return c.modifyControllerUser(params.GrantControllerAccess, user, access)
@@ -259,16 +267,75 @@ func (s *MigrationSpec) Validate() error {
 // but we don't need that at the client side yet (and may never) so
 // this call just supports starting one migration at a time.
 func (c *Client) InitiateMigration(spec MigrationSpec) (string, error) {
-	if err := spec.Validate(); err != nil {
+	args, err := specToArgs(spec)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	response := params.InitiateMigrationResults{}
+	if err := c.facade.FacadeCall("InitiateMigration", args, &response); err != nil {
 		return "", errors.Trace(err)
 	}
+	if len(response.Results) != 1 {
+		return "", errors.New("unexpected number of results returned")
+	}
+	result := response.Results[0]
+	if result.Error != nil {
+		return "", errors.Trace(result.Error)
+	}
+	return result.MigrationId, nil
+}
+
+// MigrationPrecheckReport runs every source and target precheck for
+// the specified migration without starting it, and returns a report
+// of all the problems found.
+func (c *Client) MigrationPrecheckReport(spec MigrationSpec) (coremigration.PrecheckReport, error) {
+	var report coremigration.PrecheckReport
+	if c.BestAPIVersion() < 4 {
+		return report, errors.NotSupportedf("migration precheck reports on this controller")
+	}
+	args, err := specToArgs(spec)
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	response := params.MigrationPrecheckResults{}
+	if err := c.facade.FacadeCall("MigrationPrecheckReports", args, &response); err != nil {
+		return report, errors.Trace(err)
+	}
+	if len(response.Results) != 1 {
+		return report, errors.New("unexpected number of results returned")
+	}
+	result := response.Results[0]
+	if result.Error != nil {
+		return report, errors.Trace(result.Error)
+	}
+	report.Source = precheckProblemsFromParams(result.Source)
+	report.Target = precheckProblemsFromParams(result.Target)
+	report.ExportSize = result.ExportSize
+	return report, nil
+}
+
+func precheckProblemsFromParams(problems []params.MigrationPrecheckProblem) []coremigration.PrecheckProblem {
+	var out []coremigration.PrecheckProblem
+	for _, problem := range problems {
+		out = append(out, coremigration.PrecheckProblem{
+			Entity:  problem.Entity,
+			Message: problem.Message,
+		})
+	}
+	return out
+}
+
+func specToArgs(spec MigrationSpec) (params.InitiateMigrationArgs, error) {
+	if err := spec.Validate(); err != nil {
+		return params.InitiateMigrationArgs{}, errors.Trace(err)
+	}
 
 	macsJSON, err := macaroonsToJSON(spec.TargetMacaroons)
 	if err != nil {
-		return "", errors.Trace(err)
+		return params.InitiateMigrationArgs{}, errors.Trace(err)
 	}
 
-	args := params.InitiateMigrationArgs{
+	return params.InitiateMigrationArgs{
 		Specs: []params.MigrationSpec{{
 			ModelTag: names.NewModelTag(spec.ModelUUID).String(),
 			TargetInfo: params.MigrationTargetInfo{
@@ -282,19 +349,7 @@ func (c *Client) InitiateMigration(spec MigrationSpec) (string, error) {
 			ExternalControl:      spec.ExternalControl,
 			SkipInitialPrechecks: spec.SkipInitialPrechecks,
 		}},
-	}
-	response := params.InitiateMigrationResults{}
-	if err := c.facade.FacadeCall("InitiateMigration", args, &response); err != nil {
-		return "", errors.Trace(err)
-	}
-	if len(response.Results) != 1 {
-		return "", errors.New("unexpected number of results returned")
-	}
-	result := response.Results[0]
-	if result.Error != nil {
-		return "", errors.Trace(result.Error)
-	}
-	return result.MigrationId, nil
+	}, nil
 }
 
 func macaroonsToJSON(macs []macaroon.Slice) (string, error) {
@@ -6,16 +6,21 @@ package controller_test
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/url"
 
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/macaroon.v1"
 
+	"github.com/juju/juju/api/base"
 	apitesting "github.com/juju/juju/api/base/testing"
+	apicommon "github.com/juju/juju/api/common"
 	"github.com/juju/juju/api/controller"
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/params"
+	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/environs"
 	jujutesting "github.com/juju/testing"
 	"github.com/juju/utils"
@@ -126,6 +131,66 @@ func (s *Suite) TestInitiateMigrationValidationError(c *gc.C) {
 	c.Check(stub.Calls(), gc.HasLen, 0) // API call shouldn't have happened
 }
 
+func (s *Suite) TestMigrationPrecheckReport(c *gc.C) {
+	client, stub := makePrecheckClient(params.MigrationPrecheckResults{
+		Results: []params.MigrationPrecheckResult{{
+			Source: []params.MigrationPrecheckProblem{{
+				Entity:  "machine 0",
+				Message: "machine 0 is dying",
+			}},
+			Target: []params.MigrationPrecheckProblem{{
+				Entity:  "model",
+				Message: `model named "foo" already exists`,
+			}},
+			ExportSize: 2048,
+		}},
+	})
+	spec := makeSpec()
+	report, err := client.MigrationPrecheckReport(spec)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(report, jc.DeepEquals, coremigration.PrecheckReport{
+		Source: []coremigration.PrecheckProblem{{
+			Entity:  "machine 0",
+			Message: "machine 0 is dying",
+		}},
+		Target: []coremigration.PrecheckProblem{{
+			Entity:  "model",
+			Message: `model named "foo" already exists`,
+		}},
+		ExportSize: 2048,
+	})
+	stub.CheckCalls(c, []jujutesting.StubCall{
+		{"Controller.MigrationPrecheckReports", []interface{}{specToArgs(spec)}},
+	})
+}
+
+func (s *Suite) TestMigrationPrecheckReportError(c *gc.C) {
+	client, _ := makePrecheckClient(params.MigrationPrecheckResults{
+		Results: []params.MigrationPrecheckResult{{
+			Error: common.ServerError(errors.New("boom")),
+		}},
+	})
+	_, err := client.MigrationPrecheckReport(makeSpec())
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *Suite) TestMigrationPrecheckReportValidationError(c *gc.C) {
+	client, stub := makePrecheckClient(params.MigrationPrecheckResults{})
+	spec := makeSpec()
+	spec.ModelUUID = "not-a-uuid"
+	_, err := client.MigrationPrecheckReport(spec)
+	c.Check(err, gc.ErrorMatches, "model UUID not valid")
+	c.Check(stub.Calls(), gc.HasLen, 0)
+}
+
+func (s *Suite) TestMigrationPrecheckReportNotSupported(c *gc.C) {
+	client, stub := makePrecheckClientVersion(params.MigrationPrecheckResults{}, 3)
+	_, err := client.MigrationPrecheckReport(makeSpec())
+	c.Check(err, jc.Satisfies, errors.IsNotSupported)
+	c.Check(err, gc.ErrorMatches, "migration precheck reports on this controller not supported")
+	c.Check(stub.Calls(), gc.HasLen, 0)
+}
+
 func (s *Suite) TestHostedModelConfigs_CallError(c *gc.C) {
 	apiCaller := apitesting.APICallerFunc(func(string, int, string, string, interface{}, interface{}) error {
 		return errors.New("boom")
@@ -210,6 +275,39 @@ func makeClient(results params.InitiateMigrationResults) (
 	return client, &stub
 }
 
+func makePrecheckClient(results params.MigrationPrecheckResults) (
+	*controller.Client, *jujutesting.Stub,
+) {
+	return makePrecheckClientVersion(results, 4)
+}
+
+func makePrecheckClientVersion(results params.MigrationPrecheckResults, version int) (
+	*controller.Client, *jujutesting.Stub,
+) {
+	var stub jujutesting.Stub
+	apiCaller := apitesting.APICallerFunc(
+		func(objType string, version int, id, request string, arg, result interface{}) error {
+			stub.AddCall(objType+"."+request, arg)
+			out := result.(*params.MigrationPrecheckResults)
+			*out = results
+			return nil
+		},
+	)
+	client := controller.NewClient(bestVersionCaller{apiCaller, version})
+	return client, &stub
+}
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	apitesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
+
 func makeSpec() controller.MigrationSpec {
 	mac, err := macaroon.New([]byte("secret"), "id", "location")
 	if err != nil {
@@ -229,3 +327,34 @@ func makeSpec() controller.MigrationSpec {
 func randomUUID() string {
 	return utils.MustNewUUID().String()
 }
+
+func (s *Suite) TestWatchDebugLog(c *gc.C) {
+	caller := fakeConnector{Stub: &jujutesting.Stub{}}
+	client := controller.NewClient(caller)
+	messages, err := client.WatchDebugLog(apicommon.DebugLogParams{
+		IncludeModel: []string{"default"},
+		NoTail:       true,
+	})
+	c.Assert(messages, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "sound hound")
+
+	caller.Stub.CheckCallNames(c, "ConnectControllerStream")
+	args := caller.Stub.Calls()[0].Args
+	c.Assert(args[0], gc.Equals, "/controller/log")
+	c.Assert(args[1].(url.Values).Encode(), gc.Equals, "includeModel=default&noTail=true")
+}
+
+type fakeConnector struct {
+	base.APICallCloser
+
+	*jujutesting.Stub
+}
+
+func (fakeConnector) BestFacadeVersion(string) int {
+	return 0
+}
+
+func (c fakeConnector) ConnectControllerStream(path string, attrs url.Values, headers http.Header) (base.Stream, error) {
+	c.Stub.AddCall("ConnectControllerStream", path, attrs, headers)
+	return nil, errors.New("sound hound")
+}
@@ -0,0 +1,14 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package firewaller
+
+import (
+	"gopkg.in/juju/names.v2"
+)
+
+// NewApplication returns the application with the given tag, for
+// testing calls that are answered without consulting the controller.
+func NewApplication(st *State, tag names.ApplicationTag) *Application {
+	return &Application{st: st, tag: tag}
+}
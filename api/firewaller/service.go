@@ -75,3 +75,29 @@ func (s *Application) IsExposed() (bool, error) {
 	}
 	return result.Result, nil
 }
+
+// ExposedCIDRs returns the source CIDRs from which the opened ports of
+// the application may be accessed when it is exposed. If no CIDRs are
+// returned, the ports may be accessed from anywhere, as they always
+// may be on controllers that do not support restricting them.
+func (s *Application) ExposedCIDRs() ([]string, error) {
+	if s.st.BestAPIVersion() < 4 {
+		return nil, nil
+	}
+	var results params.StringsResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: s.tag.String()}},
+	}
+	err := s.st.facade.FacadeCall("GetExposedCIDRs", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Result, nil
+}
@@ -8,6 +8,7 @@ import (
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
 
+	basetesting "github.com/juju/juju/api/base/testing"
 	"github.com/juju/juju/api/firewaller"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/watcher/watchertest"
@@ -91,3 +92,44 @@ func (s *serviceSuite) TestIsExposed(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(isExposed, jc.IsFalse)
 }
+
+func (s *serviceSuite) TestExposedCIDRs(c *gc.C) {
+	err := s.application.SetExposedCIDRs([]string{"10.0.0.0/8", "192.168.1.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	cidrs, err := s.apiApplication.ExposedCIDRs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cidrs, jc.DeepEquals, []string{"10.0.0.0/8", "192.168.1.0/24"})
+
+	err = s.application.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+
+	cidrs, err = s.apiApplication.ExposedCIDRs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cidrs, gc.HasLen, 0)
+}
+
+func (s *serviceSuite) TestExposedCIDRsOldController(c *gc.C) {
+	apiCaller := bestVersionCaller{basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, result interface{}) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		},
+	), 3}
+	st := firewaller.NewState(apiCaller)
+	app := firewaller.NewApplication(st, names.NewApplicationTag("wordpress"))
+	cidrs, err := app.ExposedCIDRs()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cidrs, gc.HasLen, 0)
+}
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
@@ -43,14 +43,53 @@ type Client struct {
 }
 
 func (c *Client) Prechecks(model coremigration.ModelInfo) error {
+	args := modelInfoToParams(model)
+	return c.caller.FacadeCall("Prechecks", args, nil)
+}
+
+// PrecheckProblems runs every precheck for the model in the target
+// controller, and returns all the problems found. Targets that predate
+// PrecheckProblems stop at the first problem, so at most one problem is
+// reported for them.
+func (c *Client) PrecheckProblems(model coremigration.ModelInfo) ([]coremigration.PrecheckProblem, error) {
+	args := modelInfoToParams(model)
+	if c.caller.BestAPIVersion() < 2 {
+		if err := c.caller.FacadeCall("Prechecks", args, nil); err != nil {
+			return []coremigration.PrecheckProblem{{
+				Entity:  "controller",
+				Message: err.Error(),
+			}}, nil
+		}
+		return nil, nil
+	}
+	var result params.MigrationPrecheckProblems
+	if err := c.caller.FacadeCall("PrecheckProblems", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var problems []coremigration.PrecheckProblem
+	for _, problem := range result.Problems {
+		problems = append(problems, coremigration.PrecheckProblem{
+			Entity:  problem.Entity,
+			Message: problem.Message,
+		})
+	}
+	return problems, nil
+}
+
+func modelInfoToParams(model coremigration.ModelInfo) params.MigrationModelInfo {
 	args := params.MigrationModelInfo{
 		UUID:                   model.UUID,
 		Name:                   model.Name,
 		OwnerTag:               model.Owner.String(),
 		AgentVersion:           model.AgentVersion,
 		ControllerAgentVersion: model.ControllerAgentVersion,
+		CloudName:              model.CloudName,
 	}
-	return c.caller.FacadeCall("Prechecks", args, nil)
+	if cred := model.CloudCredential; cred != nil {
+		args.CloudCredentialTag = cred.Tag.String()
+		args.CloudCredentialAuthType = cred.AuthType
+	}
+	return args
 }
 
 // Import takes a serialized model and imports it into the target
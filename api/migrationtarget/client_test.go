@@ -77,6 +77,98 @@ func (s *ClientSuite) TestPrechecks(c *gc.C) {
 	})
 }
 
+func (s *ClientSuite) TestPrecheckProblems(c *gc.C) {
+	var stub jujutesting.Stub
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		stub.AddCall(objType+"."+request, id, arg)
+		*(result.(*params.MigrationPrecheckProblems)) = params.MigrationPrecheckProblems{
+			Problems: []params.MigrationPrecheckProblem{{
+				Entity:  "user owner",
+				Message: "model owner owner does not exist in target controller",
+			}},
+		}
+		return nil
+	})
+	client := migrationtarget.NewClient(bestVersionCaller{apiCaller, 2})
+
+	ownerTag := names.NewUserTag("owner")
+	credTag := names.NewCloudCredentialTag("dummy/owner/default")
+	vers := version.MustParse("1.2.3")
+	problems, err := client.PrecheckProblems(coremigration.ModelInfo{
+		UUID:                   "uuid",
+		Owner:                  ownerTag,
+		Name:                   "name",
+		AgentVersion:           vers,
+		ControllerAgentVersion: vers,
+		CloudName:              "dummy",
+		CloudCredential: &coremigration.CredentialInfo{
+			Tag:      credTag,
+			AuthType: "userpass",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(problems, jc.DeepEquals, []coremigration.PrecheckProblem{{
+		Entity:  "user owner",
+		Message: "model owner owner does not exist in target controller",
+	}})
+
+	expectedArg := params.MigrationModelInfo{
+		UUID:                    "uuid",
+		Name:                    "name",
+		OwnerTag:                ownerTag.String(),
+		AgentVersion:            vers,
+		ControllerAgentVersion:  vers,
+		CloudName:               "dummy",
+		CloudCredentialTag:      credTag.String(),
+		CloudCredentialAuthType: "userpass",
+	}
+	stub.CheckCalls(c, []jujutesting.StubCall{
+		{"MigrationTarget.PrecheckProblems", []interface{}{"", expectedArg}},
+	})
+}
+
+func (s *ClientSuite) TestPrecheckProblemsOldTarget(c *gc.C) {
+	var stub jujutesting.Stub
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		stub.AddCall(objType+"."+request, id, arg)
+		return errors.New("model owner owner does not exist in target controller")
+	})
+	client := migrationtarget.NewClient(bestVersionCaller{apiCaller, 1})
+
+	problems, err := client.PrecheckProblems(coremigration.ModelInfo{
+		UUID:  "uuid",
+		Owner: names.NewUserTag("owner"),
+		Name:  "name",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(problems, jc.DeepEquals, []coremigration.PrecheckProblem{{
+		Entity:  "controller",
+		Message: "model owner owner does not exist in target controller",
+	}})
+	c.Check(stub.Calls(), gc.HasLen, 1)
+	stub.CheckCall(c, 0, "MigrationTarget.Prechecks", "", params.MigrationModelInfo{
+		UUID:     "uuid",
+		Name:     "name",
+		OwnerTag: "user-owner",
+	})
+}
+
+func (s *ClientSuite) TestPrecheckProblemsOldTargetNoProblems(c *gc.C) {
+	apiCaller := apitesting.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(request, gc.Equals, "Prechecks")
+		return nil
+	})
+	client := migrationtarget.NewClient(bestVersionCaller{apiCaller, 1})
+
+	problems, err := client.PrecheckProblems(coremigration.ModelInfo{
+		UUID:  "uuid",
+		Owner: names.NewUserTag("owner"),
+		Name:  "name",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(problems, gc.HasLen, 0)
+}
+
 func (s *ClientSuite) TestImport(c *gc.C) {
 	client, stub := s.getClientAndStub(c)
 
@@ -317,3 +409,14 @@ func (d *fakeDoer) Do(req *http.Request) (*http.Response, error) {
 	d.body = string(body)
 	return d.response, nil
 }
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	apitesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
@@ -315,6 +315,53 @@ func (c *Client) modifyModelUser(action params.ModelAction, user, access string,
 	return result.Combine()
 }
 
+// GrantApplication grants a user access to the specified application
+// in the model with the given UUID.
+func (c *Client) GrantApplication(user, access, modelUUID, application string) error {
+	return c.modifyApplicationUser(params.GrantModelAccess, user, access, modelUUID, application)
+}
+
+// RevokeApplication revokes a user's access to the specified
+// application in the model with the given UUID.
+func (c *Client) RevokeApplication(user, access, modelUUID, application string) error {
+	return c.modifyApplicationUser(params.RevokeModelAccess, user, access, modelUUID, application)
+}
+
+func (c *Client) modifyApplicationUser(action params.ModelAction, user, access, modelUUID, application string) error {
+	if c.BestAPIVersion() < 3 {
+		return errors.NotSupportedf("application access on this controller")
+	}
+	if !names.IsValidUser(user) {
+		return errors.Errorf("invalid username: %q", user)
+	}
+	if !names.IsValidModel(modelUUID) {
+		return errors.Errorf("invalid model: %q", modelUUID)
+	}
+	if !names.IsValidApplication(application) {
+		return errors.Errorf("invalid application: %q", application)
+	}
+	appAccess := permission.Access(access)
+	if err := permission.ValidateApplicationAccess(appAccess); err != nil {
+		return errors.Trace(err)
+	}
+	args := params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:        names.NewUserTag(user).String(),
+			Action:         action,
+			Access:         params.UserAccessPermission(appAccess),
+			ModelTag:       names.NewModelTag(modelUUID).String(),
+			ApplicationTag: names.NewApplicationTag(application).String(),
+		}},
+	}
+
+	var result params.ErrorResults
+	err := c.facade.FacadeCall("ModifyApplicationAccess", args, &result)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return result.OneError()
+}
+
 // ModelDefaults returns the default values for various sources used when
 // creating a new model.
 func (c *Client) ModelDefaults() (config.ModelDefaultAttributes, error) {
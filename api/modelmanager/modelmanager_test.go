@@ -118,6 +118,56 @@ func (s *modelmanagerSuite) TestDestroyModel(c *gc.C) {
 	c.Assert(called, jc.IsTrue)
 }
 
+func (s *modelmanagerSuite) TestGrantApplication(c *gc.C) {
+	modelManager := s.OpenAPI(c)
+	defer modelManager.Close()
+	var called bool
+	modelmanager.PatchFacadeCall(&s.CleanupSuite, modelManager,
+		func(req string, args interface{}, resp interface{}) error {
+			c.Assert(req, gc.Equals, "ModifyApplicationAccess")
+			c.Assert(args, jc.DeepEquals, params.ModifyApplicationAccessRequest{
+				Changes: []params.ModifyApplicationAccess{{
+					UserTag:        "user-bob",
+					Action:         params.GrantModelAccess,
+					Access:         params.ModelWriteAccess,
+					ModelTag:       testing.ModelTag.String(),
+					ApplicationTag: "application-mysql",
+				}},
+			})
+			results := resp.(*params.ErrorResults)
+			*results = params.ErrorResults{
+				Results: []params.ErrorResult{{}},
+			}
+			called = true
+			return nil
+		})
+
+	err := modelManager.GrantApplication("bob", "write", testing.ModelTag.Id(), "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *modelmanagerSuite) TestGrantApplicationReadAccess(c *gc.C) {
+	modelManager := s.OpenAPI(c)
+	defer modelManager.Close()
+	err := modelManager.GrantApplication("bob", "read", testing.ModelTag.Id(), "mysql")
+	c.Assert(err, gc.ErrorMatches, `"read" application access not valid`)
+}
+
+func (s *modelmanagerSuite) TestGrantApplicationNotSupported(c *gc.C) {
+	apiCaller := bestVersionCaller{basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, result interface{}) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		},
+	), 2}
+	modelManager := modelmanager.NewClient(apiCaller)
+	err := modelManager.GrantApplication("bob", "write", testing.ModelTag.Id(), "mysql")
+	c.Assert(err, gc.ErrorMatches, "application access on this controller not supported")
+	err = modelManager.RevokeApplication("bob", "write", testing.ModelTag.Id(), "mysql")
+	c.Assert(err, gc.ErrorMatches, "application access on this controller not supported")
+}
+
 func (s *modelmanagerSuite) TestModelDefaults(c *gc.C) {
 	apiCaller := basetesting.APICallerFunc(
 		func(objType string,
@@ -335,3 +385,14 @@ func (s *dumpModelSuite) TestDumpModelDBError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "fake error")
 	c.Assert(out, gc.IsNil)
 }
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
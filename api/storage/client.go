@@ -89,6 +89,42 @@ func (c *Client) CreatePool(pname, provider string, attrs map[string]interface{}
 	return c.facade.FacadeCall("CreatePool", args, nil)
 }
 
+// UpdatePool replaces the configuration attributes of the pool
+// with the specified name.
+func (c *Client) UpdatePool(pname string, attrs map[string]interface{}) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("updating storage pools on this controller")
+	}
+	args := params.StoragePoolArgs{
+		Pools: []params.StoragePool{{
+			Name:  pname,
+			Attrs: attrs,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("UpdatePool", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// RemovePool removes the pool with the specified name.
+func (c *Client) RemovePool(pname string) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("removing storage pools on this controller")
+	}
+	args := params.StoragePoolDeleteArgs{
+		Pools: []params.StoragePoolDeleteArg{{
+			Name: pname,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("RemovePool", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // ListVolumes lists volumes for desired machines.
 // If no machines provided, a list of all volumes is returned.
 func (c *Client) ListVolumes(machines []string) ([]params.VolumeDetailsListResult, error) {
@@ -139,6 +175,11 @@ func (c *Client) ListFilesystems(machines []string) ([]params.FilesystemDetailsL
 
 // AddToUnit adds specified storage to desired units.
 func (c *Client) AddToUnit(storages []params.StorageAddParams) ([]params.ErrorResult, error) {
+	for _, s := range storages {
+		if s.FromSnapshot != "" && c.BestAPIVersion() < 4 {
+			return nil, errors.NotSupportedf("restoring storage from snapshots on this controller")
+		}
+	}
 	out := params.ErrorResults{}
 	in := params.StoragesAddParams{Storages: storages}
 	err := c.facade.FacadeCall("AddToUnit", in, &out)
@@ -147,3 +188,169 @@ func (c *Client) AddToUnit(storages []params.StorageAddParams) ([]params.ErrorRe
 	}
 	return out.Results, nil
 }
+
+// Detach detaches the specified storage instances from the units they
+// are attached to, leaving them in the model.
+func (c *Client) Detach(storageIds []string) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("detaching storage on this controller")
+	}
+	ids := make([]params.StorageAttachmentId, len(storageIds))
+	for i, storageId := range storageIds {
+		if !names.IsValidStorage(storageId) {
+			return nil, errors.NotValidf("storage ID %q", storageId)
+		}
+		ids[i] = params.StorageAttachmentId{
+			StorageTag: names.NewStorageTag(storageId).String(),
+		}
+	}
+	return c.attachments("Detach", ids)
+}
+
+// Attach attaches the specified, detached storage instances to the
+// unit.
+func (c *Client) Attach(unitId string, storageIds []string) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("attaching storage on this controller")
+	}
+	if !names.IsValidUnit(unitId) {
+		return nil, errors.NotValidf("unit ID %q", unitId)
+	}
+	ids := make([]params.StorageAttachmentId, len(storageIds))
+	for i, storageId := range storageIds {
+		if !names.IsValidStorage(storageId) {
+			return nil, errors.NotValidf("storage ID %q", storageId)
+		}
+		ids[i] = params.StorageAttachmentId{
+			StorageTag: names.NewStorageTag(storageId).String(),
+			UnitTag:    names.NewUnitTag(unitId).String(),
+		}
+	}
+	return c.attachments("Attach", ids)
+}
+
+func (c *Client) attachments(method string, ids []params.StorageAttachmentId) ([]params.ErrorResult, error) {
+	args := params.StorageAttachmentIds{ids}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(method, args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(ids) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(ids), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// Remove removes the specified storage instances from the model. If
+// release is true, the cloud storage is left in the cloud rather than
+// destroyed.
+func (c *Client) Remove(storageIds []string, release bool) ([]params.ErrorResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("removing storage on this controller")
+	}
+	args := params.RemoveStorage{
+		Storage: make([]params.RemoveStorageInstance, len(storageIds)),
+	}
+	for i, storageId := range storageIds {
+		if !names.IsValidStorage(storageId) {
+			return nil, errors.NotValidf("storage ID %q", storageId)
+		}
+		args.Storage[i] = params.RemoveStorageInstance{
+			Tag:     names.NewStorageTag(storageId).String(),
+			Release: release,
+		}
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("Remove", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// Resize requests that the specified storage instance be grown to
+// the given size, in MiB.
+func (c *Client) Resize(storageId string, size uint64) error {
+	if c.BestAPIVersion() < 4 {
+		return errors.NotSupportedf("resizing storage on this controller")
+	}
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	args := params.ResizeStorage{
+		Storage: []params.ResizeStorageInstance{{
+			Tag:  names.NewStorageTag(storageId).String(),
+			Size: size,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall("Resize", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// CreateSnapshots creates snapshots of the volumes of the specified
+// storage instances.
+func (c *Client) CreateSnapshots(storageIds []string) ([]params.SnapshotResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("creating storage snapshots on this controller")
+	}
+	args, err := storageEntities(storageIds)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results params.SnapshotResults
+	if err := c.facade.FacadeCall("CreateSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+// ListSnapshots lists the snapshots of the volumes of the specified
+// storage instances.
+func (c *Client) ListSnapshots(storageIds []string) ([]params.SnapshotListResult, error) {
+	if c.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("listing storage snapshots on this controller")
+	}
+	args, err := storageEntities(storageIds)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results params.SnapshotListResults
+	if err := c.facade.FacadeCall("ListSnapshots", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(storageIds) {
+		return nil, errors.Errorf(
+			"expected %d result(s), got %d",
+			len(storageIds), len(results.Results),
+		)
+	}
+	return results.Results, nil
+}
+
+func storageEntities(storageIds []string) (params.Entities, error) {
+	entities := make([]params.Entity, len(storageIds))
+	for i, storageId := range storageIds {
+		if !names.IsValidStorage(storageId) {
+			return params.Entities{}, errors.NotValidf("storage ID %q", storageId)
+		}
+		entities[i].Tag = names.NewStorageTag(storageId).String()
+	}
+	return params.Entities{entities}, nil
+}
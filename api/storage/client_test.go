@@ -288,6 +288,81 @@ func (s *storageMockSuite) TestCreatePoolFacadeCallError(c *gc.C) {
 	c.Assert(errors.Cause(err), gc.ErrorMatches, msg)
 }
 
+func (s *storageMockSuite) TestUpdatePool(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "UpdatePool")
+			c.Check(a, jc.DeepEquals, params.StoragePoolArgs{[]params.StoragePool{{
+				Name:  "poolName",
+				Attrs: map[string]interface{}{"test": "one"},
+			}}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{&params.Error{Message: "baz"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	err := client.UpdatePool("poolName", map[string]interface{}{"test": "one"})
+	c.Check(err, gc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestUpdatePoolNotSupported(c *gc.C) {
+	client := storage.NewClient(bestVersionCaller{basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	), 3})
+	err := client.UpdatePool("poolName", map[string]interface{}{"test": "one"})
+	c.Check(err, gc.ErrorMatches, "updating storage pools on this controller not supported")
+}
+
+func (s *storageMockSuite) TestRemovePool(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "RemovePool")
+			c.Check(a, jc.DeepEquals, params.StoragePoolDeleteArgs{[]params.StoragePoolDeleteArg{{
+				Name: "poolName",
+			}}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{&params.Error{Message: "baz"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	err := client.RemovePool("poolName")
+	c.Check(err, gc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestRemovePoolNotSupported(c *gc.C) {
+	client := storage.NewClient(bestVersionCaller{basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	), 3})
+	err := client.RemovePool("poolName")
+	c.Check(err, gc.ErrorMatches, "removing storage pools on this controller not supported")
+}
+
 func (s *storageMockSuite) TestListVolumes(c *gc.C) {
 	var called bool
 	machines := []string{"0", "1"}
@@ -581,3 +656,339 @@ func (s *storageMockSuite) TestAddToUnitFacadeCallError(c *gc.C) {
 	c.Assert(errors.Cause(err), gc.ErrorMatches, msg)
 	c.Assert(found, gc.HasLen, 0)
 }
+
+func (s *storageMockSuite) TestDetach(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Detach")
+			c.Check(a, jc.DeepEquals, params.StorageAttachmentIds{[]params.StorageAttachmentId{
+				{StorageTag: "storage-foo-0"},
+				{StorageTag: "storage-bar-1"},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{nil},
+					{&params.Error{Message: "bar"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	results, err := client.Detach([]string{"foo/0", "bar/1"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{
+		{nil},
+		{&params.Error{Message: "bar"}},
+	})
+}
+
+func (s *storageMockSuite) TestDetachInvalidStorageId(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call")
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	_, err := client.Detach([]string{"foo/bar"})
+	c.Check(err, gc.ErrorMatches, `storage ID "foo/bar" not valid`)
+}
+
+func (s *storageMockSuite) TestDetachArityMismatch(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{{nil}, {nil}, {nil}},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	_, err := client.Detach([]string{"foo/0", "bar/1"})
+	c.Check(err, gc.ErrorMatches, `expected 2 result\(s\), got 3`)
+}
+
+func (s *storageMockSuite) TestAttach(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Attach")
+			c.Check(a, jc.DeepEquals, params.StorageAttachmentIds{[]params.StorageAttachmentId{
+				{StorageTag: "storage-bar-1", UnitTag: "unit-foo-0"},
+				{StorageTag: "storage-baz-2", UnitTag: "unit-foo-0"},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{nil},
+					{&params.Error{Message: "qux"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	results, err := client.Attach("foo/0", []string{"bar/1", "baz/2"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{
+		{nil},
+		{&params.Error{Message: "qux"}},
+	})
+}
+
+func (s *storageMockSuite) TestAttachInvalidUnitId(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Fatalf("unexpected call")
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	_, err := client.Attach("foo", []string{"bar/1"})
+	c.Check(err, gc.ErrorMatches, `unit ID "foo" not valid`)
+}
+
+func (s *storageMockSuite) TestRemove(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Remove")
+			c.Check(a, jc.DeepEquals, params.RemoveStorage{[]params.RemoveStorageInstance{
+				{Tag: "storage-foo-0", Release: true},
+				{Tag: "storage-bar-1", Release: true},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{nil},
+					{&params.Error{Message: "baz"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	results, err := client.Remove([]string{"foo/0", "bar/1"}, true)
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.ErrorResult{
+		{nil},
+		{&params.Error{Message: "baz"}},
+	})
+}
+
+func (s *storageMockSuite) TestDetachAttachRemoveNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, result interface{}) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 3})
+	_, err := client.Detach([]string{"foo/0"})
+	c.Check(err, gc.ErrorMatches, "detaching storage on this controller not supported")
+	_, err = client.Attach("foo/0", []string{"bar/1"})
+	c.Check(err, gc.ErrorMatches, "attaching storage on this controller not supported")
+	_, err = client.Remove([]string{"foo/0"}, false)
+	c.Check(err, gc.ErrorMatches, "removing storage on this controller not supported")
+}
+
+func (s *storageMockSuite) TestRemoveFacadeCallError(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return errors.New("facade failure")
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	_, err := client.Remove([]string{"foo/0"}, false)
+	c.Check(err, gc.ErrorMatches, "facade failure")
+}
+
+func (s *storageMockSuite) TestResize(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "Resize")
+			c.Check(a, jc.DeepEquals, params.ResizeStorage{[]params.ResizeStorageInstance{
+				{Tag: "storage-foo-0", Size: 2048},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+			*(result.(*params.ErrorResults)) = params.ErrorResults{
+				[]params.ErrorResult{
+					{&params.Error{Message: "baz"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	err := client.Resize("foo/0", 2048)
+	c.Check(err, gc.ErrorMatches, "baz")
+}
+
+func (s *storageMockSuite) TestResizeInvalidStorageId(c *gc.C) {
+	client := storage.NewClient(bestVersionCaller{basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	), 4})
+	err := client.Resize("foo", 2048)
+	c.Check(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
+
+func (s *storageMockSuite) TestResizeNotSupported(c *gc.C) {
+	client := storage.NewClient(bestVersionCaller{basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatalf("unexpected API call")
+			return nil
+		},
+	), 3})
+	err := client.Resize("foo/0", 2048)
+	c.Check(err, gc.ErrorMatches, "resizing storage on this controller not supported")
+}
+
+func (s *storageMockSuite) TestCreateSnapshots(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "CreateSnapshots")
+			c.Check(a, jc.DeepEquals, params.Entities{[]params.Entity{
+				{Tag: "storage-foo-0"},
+				{Tag: "storage-bar-1"},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.SnapshotResults{})
+			*(result.(*params.SnapshotResults)) = params.SnapshotResults{
+				[]params.SnapshotResult{
+					{Result: &params.Snapshot{SnapshotId: "snap-0"}},
+					{Error: &params.Error{Message: "baz"}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	results, err := client.CreateSnapshots([]string{"foo/0", "bar/1"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.SnapshotResult{
+		{Result: &params.Snapshot{SnapshotId: "snap-0"}},
+		{Error: &params.Error{Message: "baz"}},
+	})
+}
+
+func (s *storageMockSuite) TestCreateSnapshotsInvalidStorageId(c *gc.C) {
+	client := storage.NewClient(bestVersionCaller{basetesting.APICallerFunc(
+		func(string, int, string, string, interface{}, interface{}) error {
+			c.Fatal("unexpected facade call")
+			return nil
+		},
+	), 4})
+	_, err := client.CreateSnapshots([]string{"foo/bar"})
+	c.Check(err, gc.ErrorMatches, `storage ID "foo/bar" not valid`)
+}
+
+func (s *storageMockSuite) TestListSnapshots(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			c.Check(objType, gc.Equals, "Storage")
+			c.Check(id, gc.Equals, "")
+			c.Check(request, gc.Equals, "ListSnapshots")
+			c.Check(a, jc.DeepEquals, params.Entities{[]params.Entity{
+				{Tag: "storage-foo-0"},
+			}})
+			c.Assert(result, gc.FitsTypeOf, &params.SnapshotListResults{})
+			*(result.(*params.SnapshotListResults)) = params.SnapshotListResults{
+				[]params.SnapshotListResult{
+					{Result: []params.Snapshot{{SnapshotId: "snap-0"}, {SnapshotId: "snap-1"}}},
+				},
+			}
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	results, err := client.ListSnapshots([]string{"foo/0"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []params.SnapshotListResult{
+		{Result: []params.Snapshot{{SnapshotId: "snap-0"}, {SnapshotId: "snap-1"}}},
+	})
+}
+
+func (s *storageMockSuite) TestListSnapshotsResultCountMismatch(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string,
+			version int,
+			id, request string,
+			a, result interface{},
+		) error {
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 4})
+	_, err := client.ListSnapshots([]string{"foo/0"})
+	c.Check(err, gc.ErrorMatches, `expected 1 result\(s\), got 0`)
+}
+
+func (s *storageMockSuite) TestSnapshotsNotSupported(c *gc.C) {
+	apiCaller := basetesting.APICallerFunc(
+		func(objType string, version int, id, request string, a, result interface{}) error {
+			c.Fatalf("unexpected call to %s", request)
+			return nil
+		})
+	client := storage.NewClient(bestVersionCaller{apiCaller, 3})
+	_, err := client.CreateSnapshots([]string{"foo/0"})
+	c.Check(err, gc.ErrorMatches, "creating storage snapshots on this controller not supported")
+	_, err = client.ListSnapshots([]string{"foo/0"})
+	c.Check(err, gc.ErrorMatches, "listing storage snapshots on this controller not supported")
+	_, err = client.AddToUnit([]params.StorageAddParams{{
+		UnitTag:      "unit-u-0",
+		StorageName:  "data",
+		FromSnapshot: "snap-0",
+	}})
+	c.Check(err, gc.ErrorMatches, "restoring storage from snapshots on this controller not supported")
+}
+
+// bestVersionCaller reports the given facade version as the best
+// version supported by the controller.
+type bestVersionCaller struct {
+	basetesting.APICallerFunc
+	version int
+}
+
+func (b bestVersionCaller) BestFacadeVersion(facade string) int {
+	return b.version
+}
@@ -4,6 +4,7 @@
 package uniter_test
 
 import (
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
@@ -11,6 +12,7 @@ import (
 	"github.com/juju/juju/api/uniter"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/watcher/watchertest"
 )
 
 type actionSuite struct {
@@ -122,3 +124,82 @@ func (s *actionSuite) TestActionFail(c *gc.C) {
 	c.Assert(res, gc.DeepEquals, map[string]interface{}{})
 	c.Assert(completed[0].Name(), gc.Equals, "fakeaction")
 }
+
+func (s *actionSuite) TestActionAborted(c *gc.C) {
+	action, err := s.uniterSuite.wordpressUnit.AddAction("fakeaction", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.uniter.ActionBegin(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	status, err := s.uniter.ActionStatus(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(status, gc.Equals, params.ActionRunning)
+
+	action, err = s.State.Action(action.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = action.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	status, err = s.uniter.ActionStatus(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(status, gc.Equals, params.ActionAborting)
+
+	err = s.uniter.ActionFinish(action.ActionTag(), params.ActionAborted, nil, "action aborted")
+	c.Assert(err, jc.ErrorIsNil)
+	completed, err := s.uniterSuite.wordpressUnit.CompletedActions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(completed, gc.HasLen, 1)
+	c.Assert(completed[0].Status(), gc.Equals, state.ActionAborted)
+}
+
+func (s *actionSuite) TestLogActionMessage(c *gc.C) {
+	action, err := s.uniterSuite.wordpressUnit.AddAction("fakeaction", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.uniter.LogActionMessage(action.ActionTag(), "copying tables")
+	c.Assert(err, gc.ErrorMatches, `cannot log message to action ".*": action not running`)
+
+	err = s.uniter.ActionBegin(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.uniter.LogActionMessage(action.ActionTag(), "copying tables")
+	c.Assert(err, jc.ErrorIsNil)
+
+	action, err = s.State.Action(action.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	messages := action.Messages()
+	c.Assert(messages, gc.HasLen, 1)
+	c.Assert(messages[0].Message, gc.Equals, "copying tables")
+}
+
+func (s *actionSuite) TestWatchActionStatus(c *gc.C) {
+	action, err := s.uniterSuite.wordpressUnit.AddAction("fakeaction", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.uniter.ActionBegin(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	w, err := s.uniter.WatchActionStatus(action.ActionTag())
+	c.Assert(err, jc.ErrorIsNil)
+	wc := watchertest.NewNotifyWatcherC(c, w, s.BackingState.StartSync)
+	defer wc.AssertStops()
+
+	// Initial event.
+	wc.AssertOneChange()
+
+	action, err = s.State.Action(action.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = action.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
+
+func (s *actionSuite) TestActionStatusNotImplemented(c *gc.C) {
+	action, err := s.uniterSuite.wordpressUnit.AddAction("fakeaction", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	st := uniter.NewStateV4(s.st, s.uniterSuite.wordpressUnit.UnitTag())
+	_, err = st.ActionStatus(action.ActionTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotImplemented)
+	_, err = st.WatchActionStatus(action.ActionTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotImplemented)
+	err = st.LogActionMessage(action.ActionTag(), "copying tables")
+	c.Assert(err, jc.Satisfies, errors.IsNotImplemented)
+}
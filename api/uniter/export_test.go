@@ -14,6 +14,7 @@ import (
 
 var (
 	NewSettings = newSettings
+	NewStateV4  = newStateV4
 )
 
 // PatchUnitResponse changes the internal FacadeCaller to one that lets you return
@@ -711,6 +711,31 @@ func (u *Unit) AddStorage(constraints map[string][]params.StorageConstraints) er
 	return results.Combine()
 }
 
+// GoalState returns the units expected for the unit's application,
+// and the applications and units expected at the other end of each of
+// its relations, keyed by the local endpoint name.
+func (u *Unit) GoalState() (*params.GoalState, error) {
+	if u.st.facade.BestAPIVersion() < 5 {
+		return nil, errors.NotSupportedf("goal state on this controller")
+	}
+	var results params.GoalStateResults
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: u.tag.String()}},
+	}
+	err := u.st.facade.FacadeCall("GoalStates", args, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return result.Result, nil
+}
+
 // NetworkConfig requests network config information for the unit and the given
 // bindingName.
 func (u *Unit) NetworkConfig(bindingName string) ([]params.NetworkConfig, error) {
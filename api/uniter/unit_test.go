@@ -365,6 +365,41 @@ func (s *unitSuite) TestAvailabilityZone(c *gc.C) {
 	c.Check(zone, gc.Equals, "a-zone")
 }
 
+func (s *unitSuite) TestGoalState(c *gc.C) {
+	expected := &params.GoalState{
+		Units: params.UnitsGoalState{
+			"wordpress/0": {Status: "active"},
+		},
+		Relations: map[string]params.UnitsGoalState{
+			"db": {
+				"mysql":   {Status: "joined"},
+				"mysql/0": {Status: "waiting"},
+			},
+		},
+	}
+	uniter.PatchUnitResponse(s, s.apiUnit, "GoalStates",
+		func(result interface{}) error {
+			if results, ok := result.(*params.GoalStateResults); ok {
+				results.Results = []params.GoalStateResult{{
+					Result: expected,
+				}}
+			}
+			return nil
+		},
+	)
+
+	goalState, err := s.apiUnit.GoalState()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(goalState, jc.DeepEquals, expected)
+}
+
+func (s *unitSuite) TestGoalStateNotSupported(c *gc.C) {
+	st := uniter.NewStateV4(s.st, s.wordpressUnit.UnitTag())
+	unit := uniter.CreateUnit(st, s.wordpressUnit.UnitTag())
+	_, err := unit.GoalState()
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
 func (s *unitSuite) TestOpenClosePortRanges(c *gc.C) {
 	ports, err := s.wordpressUnit.OpenedPorts()
 	c.Assert(err, jc.ErrorIsNil)
@@ -72,9 +72,12 @@ func newStateForVersionFn(version int) func(base.APICaller, names.UnitTag) *Stat
 // newStateV4 creates a new client-side Uniter facade, version 4.
 var newStateV4 = newStateForVersionFn(4)
 
+// newStateV5 creates a new client-side Uniter facade, version 5.
+var newStateV5 = newStateForVersionFn(5)
+
 // NewState creates a new client-side Uniter facade.
 // Defined like this to allow patching during tests.
-var NewState = newStateV4
+var NewState = newStateV5
 
 // BestAPIVersion returns the API version that we were able to
 // determine is supported by both the client and the API Server.
@@ -222,6 +225,87 @@ func (st *State) Action(tag names.ActionTag) (*Action, error) {
 	}, nil
 }
 
+// ActionStatus returns the current status of the action with the
+// given tag.
+func (st *State) ActionStatus(tag names.ActionTag) (string, error) {
+	if st.BestAPIVersion() < 5 {
+		return "", errors.NotImplementedf("ActionStatus")
+	}
+	var results params.StringResults
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: tag.String()},
+		},
+	}
+
+	err := st.facade.FacadeCall("ActionStatus", args, &results)
+	if err != nil {
+		return "", err
+	}
+	if len(results.Results) != 1 {
+		return "", fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return "", result.Error
+	}
+	return result.Result, nil
+}
+
+// WatchActionStatus returns a watcher that notifies of changes to the
+// action with the given tag, such as a request to abort it.
+func (st *State) WatchActionStatus(tag names.ActionTag) (watcher.NotifyWatcher, error) {
+	if st.BestAPIVersion() < 5 {
+		return nil, errors.NotImplementedf("WatchActionStatus")
+	}
+	var results params.NotifyWatchResults
+	args := params.Entities{
+		Entities: []params.Entity{
+			{Tag: tag.String()},
+		},
+	}
+
+	err := st.facade.FacadeCall("WatchActionStatus", args, &results)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Results) != 1 {
+		return nil, fmt.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return apiwatcher.NewNotifyWatcher(st.facade.RawAPICaller(), result), nil
+}
+
+// LogActionMessage adds a progress message to the running action with
+// the given tag.
+func (st *State) LogActionMessage(tag names.ActionTag, message string) error {
+	if st.BestAPIVersion() < 5 {
+		return errors.NotImplementedf("LogActionMessage")
+	}
+	var outcome params.ErrorResults
+	args := params.ActionMessageParams{
+		Messages: []params.EntityString{
+			{Tag: tag.String(), Value: message},
+		},
+	}
+
+	err := st.facade.FacadeCall("LogActionsMessages", args, &outcome)
+	if err != nil {
+		return err
+	}
+	if len(outcome.Results) != 1 {
+		return fmt.Errorf("expected 1 result, got %d", len(outcome.Results))
+	}
+	result := outcome.Results[0]
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
 // ActionBegin marks an action as running.
 func (st *State) ActionBegin(tag names.ActionTag) error {
 	var outcome params.ErrorResults
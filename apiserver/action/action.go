@@ -12,10 +12,13 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/permission"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
 )
 
 func init() {
 	common.RegisterStandardFacade("Action", 2, NewActionAPI)
+	// Facade version 3 adds Abort() and WatchActionsProgress().
+	common.RegisterStandardFacade("Action", 3, NewActionAPI)
 }
 
 // ActionAPI implements the client API for interacting with Actions
@@ -51,28 +54,84 @@ func (a *ActionAPI) checkCanRead() error {
 	return nil
 }
 
-func (a *ActionAPI) checkCanWrite() error {
-	canWrite, err := a.authorizer.HasPermission(permission.WriteAccess, a.state.ModelTag())
+// checkCanRunActions checks that the user may run actions, which
+// requires write access or the run-actions capability.
+func (a *ActionAPI) checkCanRunActions() error {
+	canRun, err := common.HasAnyPermission(
+		a.authorizer, a.state.ModelTag(),
+		permission.WriteAccess, permission.RunActionsAccess,
+	)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if !canWrite {
+	if !canRun {
 		return common.ErrPerm
 	}
 	return nil
 }
 
-func (a *ActionAPI) checkCanAdmin() error {
-	canAdmin, err := a.authorizer.HasPermission(permission.AdminAccess, a.state.ModelTag())
+// checkCanRunCommands checks that the user may run arbitrary commands,
+// which requires admin access or the run-actions capability.
+func (a *ActionAPI) checkCanRunCommands() error {
+	canRun, err := common.HasAnyPermission(
+		a.authorizer, a.state.ModelTag(),
+		permission.AdminAccess, permission.RunActionsAccess,
+	)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if !canAdmin {
+	if !canRun {
 		return common.ErrPerm
 	}
 	return nil
 }
 
+// checkCanRunActionsOrRead checks that the user may run actions on
+// every receiver, returning false if the user may only run actions on
+// the applications they have been granted write access to, in which
+// case the user must still have read access to the model.
+func (a *ActionAPI) checkCanRunActionsOrRead() (bool, error) {
+	err := a.checkCanRunActions()
+	if err == nil {
+		return true, nil
+	}
+	if err != common.ErrPerm {
+		return false, errors.Trace(err)
+	}
+	if err = a.checkCanRead(); err != nil {
+		return false, errors.Trace(err)
+	}
+	return false, nil
+}
+
+// checkCanWriteApplication checks that the user has been granted
+// write access to the application.
+func (a *ActionAPI) checkCanWriteApplication(tag names.ApplicationTag) error {
+	canWrite, err := a.authorizer.HasPermission(permission.WriteAccess, tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canWrite {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// checkCanRunActionsOn checks that the user may run actions on the
+// receiver with the given tag, which must be a unit of an application
+// the user has been granted write access to.
+func (a *ActionAPI) checkCanRunActionsOn(receiver string) error {
+	unitTag, err := names.ParseUnitTag(receiver)
+	if err != nil {
+		return common.ErrPerm
+	}
+	appName, err := names.UnitApplication(unitTag.Id())
+	if err != nil {
+		return common.ErrPerm
+	}
+	return a.checkCanWriteApplication(names.NewApplicationTag(appName))
+}
+
 // Actions takes a list of ActionTags, and returns the full Action for
 // each ID.
 func (a *ActionAPI) Actions(arg params.Entities) (params.ActionResults, error) {
@@ -108,6 +167,40 @@ func (a *ActionAPI) Actions(arg params.Entities) (params.ActionResults, error) {
 	return response, nil
 }
 
+// WatchActionsProgress starts a StringsWatcher for the progress messages
+// logged by each of the given Actions. The watchers' initial events hold
+// the messages logged so far, and each message is encoded as JSON.
+func (a *ActionAPI) WatchActionsProgress(arg params.Entities) (params.StringsWatchResults, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.StringsWatchResults{}, errors.Trace(err)
+	}
+
+	results := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(arg.Entities)),
+	}
+	for i, entity := range arg.Entities {
+		result := &results.Results[i]
+		actionTag, err := names.ParseActionTag(entity.Tag)
+		if err != nil {
+			result.Error = common.ServerError(common.ErrBadId)
+			continue
+		}
+		if _, err := a.state.ActionByTag(actionTag); err != nil {
+			result.Error = common.ServerError(err)
+			continue
+		}
+		w := a.state.WatchActionLogs(actionTag.Id())
+		changes, ok := <-w.Changes()
+		if !ok {
+			result.Error = common.ServerError(watcher.EnsureErr(w))
+			continue
+		}
+		result.StringsWatcherId = a.resources.Register(w)
+		result.Changes = changes
+	}
+	return results, nil
+}
+
 // FindActionTagsByPrefix takes a list of string prefixes and finds
 // corresponding ActionTags that match that prefix.
 func (a *ActionAPI) FindActionTagsByPrefix(arg params.FindTags) (params.FindTagsResults, error) {
@@ -128,7 +221,7 @@ func (a *ActionAPI) FindActionTagsByPrefix(arg params.FindTags) (params.FindTags
 }
 
 func (a *ActionAPI) FindActionsByNames(arg params.FindActionsByNames) (params.ActionsByNames, error) {
-	if err := a.checkCanWrite(); err != nil {
+	if err := a.checkCanRunActions(); err != nil {
 		return params.ActionsByNames{}, errors.Trace(err)
 	}
 
@@ -160,7 +253,8 @@ func (a *ActionAPI) FindActionsByNames(arg params.FindActionsByNames) (params.Ac
 // enqueued Action, or an error if there was a problem enqueueing the
 // Action.
 func (a *ActionAPI) Enqueue(arg params.Actions) (params.ActionResults, error) {
-	if err := a.checkCanWrite(); err != nil {
+	canRunAll, err := a.checkCanRunActionsOrRead()
+	if err != nil {
 		return params.ActionResults{}, errors.Trace(err)
 	}
 
@@ -172,6 +266,12 @@ func (a *ActionAPI) Enqueue(arg params.Actions) (params.ActionResults, error) {
 	response := params.ActionResults{Results: make([]params.ActionResult, len(arg.Actions))}
 	for i, action := range arg.Actions {
 		currentResult := &response.Results[i]
+		if !canRunAll {
+			if err := a.checkCanRunActionsOn(action.Receiver); err != nil {
+				currentResult.Error = common.ServerError(err)
+				continue
+			}
+		}
 		receiver, err := tagToActionReceiver(action.Receiver)
 		if err != nil {
 			currentResult.Error = common.ServerError(err)
@@ -234,7 +334,34 @@ func (a *ActionAPI) ListCompleted(arg params.Entities) (params.ActionsByReceiver
 
 // Cancel attempts to cancel enqueued Actions from running.
 func (a *ActionAPI) Cancel(arg params.Entities) (params.ActionResults, error) {
-	if err := a.checkCanWrite(); err != nil {
+	return a.stopActions(arg, func(action state.Action) (state.Action, error) {
+		return action.Finish(state.ActionResults{Status: state.ActionCancelled, Message: "action cancelled via the API"})
+	})
+}
+
+// Abort cancels enqueued Actions like Cancel, and also asks the units
+// running Actions to stop them. The units record running Actions as
+// aborted once they have stopped.
+func (a *ActionAPI) Abort(arg params.Entities) (params.ActionResults, error) {
+	return a.stopActions(arg, func(action state.Action) (state.Action, error) {
+		if action.Status() == state.ActionPending {
+			result, err := action.Finish(state.ActionResults{Status: state.ActionCancelled, Message: "action cancelled via the API"})
+			if err == nil {
+				return result, nil
+			}
+			// The action may have started in the meantime.
+			if action, err = a.state.ActionByTag(action.ActionTag()); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+		return action.Abort()
+	})
+}
+
+// stopActions calls stop on each of the Actions represented by the
+// passed in Tags, and returns the resulting Actions.
+func (a *ActionAPI) stopActions(arg params.Entities, stop func(state.Action) (state.Action, error)) (params.ActionResults, error) {
+	if err := a.checkCanRunActions(); err != nil {
 		return params.ActionResults{}, errors.Trace(err)
 	}
 
@@ -260,7 +387,7 @@ func (a *ActionAPI) Cancel(arg params.Entities) (params.ActionResults, error) {
 			currentResult.Error = common.ServerError(err)
 			continue
 		}
-		result, err := action.Finish(state.ActionResults{Status: state.ActionCancelled, Message: "action cancelled via the API"})
+		result, err := stop(action)
 		if err != nil {
 			currentResult.Error = common.ServerError(err)
 			continue
@@ -280,7 +407,8 @@ func (a *ActionAPI) Cancel(arg params.Entities) (params.ActionResults, error) {
 // services.
 func (a *ActionAPI) ApplicationsCharmsActions(args params.Entities) (params.ApplicationsCharmActionsResults, error) {
 	result := params.ApplicationsCharmActionsResults{Results: make([]params.ApplicationCharmActionsResult, len(args.Entities))}
-	if err := a.checkCanWrite(); err != nil {
+	canRunAll, err := a.checkCanRunActionsOrRead()
+	if err != nil {
 		return result, errors.Trace(err)
 	}
 
@@ -292,6 +420,12 @@ func (a *ActionAPI) ApplicationsCharmsActions(args params.Entities) (params.Appl
 			continue
 		}
 		currentResult.ApplicationTag = svcTag.String()
+		if !canRunAll {
+			if err := a.checkCanWriteApplication(svcTag); err != nil {
+				currentResult.Error = common.ServerError(err)
+				continue
+			}
+		}
 		svc, err := a.state.Application(svcTag.Id())
 		if err != nil {
 			currentResult.Error = common.ServerError(err)
@@ -18,6 +18,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	jujutesting "github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/permission"
 	"github.com/juju/juju/state"
 	coretesting "github.com/juju/juju/testing"
 	jujuFactory "github.com/juju/juju/testing/factory"
@@ -630,6 +631,139 @@ func (s *actionSuite) TestCancel(c *gc.C) {
 	c.Assert(myActions[1].Status, gc.Equals, params.ActionCancelled)
 }
 
+func (s *actionSuite) TestAbort(c *gc.C) {
+	results, err := s.action.Enqueue(params.Actions{
+		Actions: []params.Action{{
+			Receiver: s.wordpressUnit.Tag().String(),
+			Name:     "fakeaction",
+		}, {
+			Receiver: s.wordpressUnit.Tag().String(),
+			Name:     "fakeaction",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+
+	// Start the first action; the second is still pending.
+	tag, err := names.ParseActionTag(results.Results[0].Action.Tag)
+	c.Assert(err, jc.ErrorIsNil)
+	running, err := s.State.ActionByTag(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = running.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+
+	arg := params.Entities{
+		Entities: []params.Entity{
+			{Tag: results.Results[0].Action.Tag},
+			{Tag: results.Results[1].Action.Tag},
+		}}
+	aborted, err := s.action.Abort(arg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(aborted.Results, gc.HasLen, 2)
+	c.Assert(aborted.Results[0].Error, gc.IsNil)
+	c.Assert(aborted.Results[0].Status, gc.Equals, params.ActionAborting)
+	c.Assert(aborted.Results[1].Error, gc.IsNil)
+	c.Assert(aborted.Results[1].Status, gc.Equals, params.ActionCancelled)
+
+	// Finished actions cannot be aborted.
+	aborted, err = s.action.Abort(params.Entities{
+		Entities: []params.Entity{{Tag: results.Results[1].Action.Tag}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(aborted.Results[0].Error, gc.ErrorMatches, `cannot abort action ".*" with status "cancelled"`)
+}
+
+func (s *actionSuite) TestWatchActionsProgress(c *gc.C) {
+	results, err := s.action.Enqueue(params.Actions{
+		Actions: []params.Action{{
+			Receiver: s.wordpressUnit.Tag().String(),
+			Name:     "fakeaction",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	tag, err := names.ParseActionTag(results.Results[0].Action.Tag)
+	c.Assert(err, jc.ErrorIsNil)
+	running, err := s.State.ActionByTag(tag)
+	c.Assert(err, jc.ErrorIsNil)
+	running, err = running.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+	err = running.Log("copying tables")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.resources.Count(), gc.Equals, 0)
+	watchResults, err := s.action.WatchActionsProgress(params.Entities{
+		Entities: []params.Entity{
+			{Tag: tag.String()},
+			{Tag: "action-00000000-0000-0000-0000-000000000000"},
+			{Tag: "unit-wordpress-0"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(watchResults.Results, gc.HasLen, 3)
+	c.Assert(watchResults.Results[0].Error, gc.IsNil)
+	c.Assert(watchResults.Results[0].Changes, gc.HasLen, 1)
+	c.Check(watchResults.Results[0].Changes[0], gc.Matches, `\{"timestamp":".*","message":"copying tables"\}`)
+	c.Check(watchResults.Results[1].Error, gc.ErrorMatches, `action "00000000-0000-0000-0000-000000000000" not found`)
+	c.Check(watchResults.Results[2].Error, gc.ErrorMatches, common.ErrBadId.Error())
+	c.Assert(s.resources.Count(), gc.Equals, 1)
+	c.Check(s.resources.Get(watchResults.Results[0].StringsWatcherId), gc.NotNil)
+}
+
+// applicationAuthorizer grants read access to the model and write
+// access to a single application.
+type applicationAuthorizer struct {
+	apiservertesting.FakeAuthorizer
+	application names.ApplicationTag
+}
+
+func (a applicationAuthorizer) HasPermission(operation permission.Access, target names.Tag) (bool, error) {
+	if target == a.application {
+		return operation == permission.WriteAccess, nil
+	}
+	return operation == permission.ReadAccess && target.Kind() == names.ModelTagKind, nil
+}
+
+func (s *actionSuite) TestEnqueueApplicationAccess(c *gc.C) {
+	auth := applicationAuthorizer{
+		FakeAuthorizer: apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")},
+		application:    s.wordpress.ApplicationTag(),
+	}
+	client, err := action.NewActionAPI(s.State, nil, auth)
+	c.Assert(err, jc.ErrorIsNil)
+
+	res, err := client.Enqueue(params.Actions{
+		Actions: []params.Action{
+			{Receiver: s.wordpressUnit.Tag().String(), Name: "fakeaction"},
+			{Receiver: s.mysqlUnit.Tag().String(), Name: "fakeaction"},
+			{Receiver: s.machine0.Tag().String(), Name: "fakeaction"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 3)
+	c.Check(res.Results[0].Error, gc.IsNil)
+	c.Check(res.Results[1].Error, gc.ErrorMatches, "permission denied")
+	c.Check(res.Results[2].Error, gc.ErrorMatches, "permission denied")
+
+	actions, err := s.wordpressUnit.Actions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(actions, gc.HasLen, 1)
+	actions, err = s.mysqlUnit.Actions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(actions, gc.HasLen, 0)
+
+	charmActions, err := client.ApplicationsCharmsActions(params.Entities{
+		Entities: []params.Entity{
+			{Tag: s.wordpress.Tag().String()},
+			{Tag: s.mysql.Tag().String()},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(charmActions.Results, gc.HasLen, 2)
+	c.Check(charmActions.Results[0].Error, gc.IsNil)
+	c.Check(charmActions.Results[1].Error, gc.ErrorMatches, "permission denied")
+}
+
 func (s *actionSuite) TestApplicationsCharmsActions(c *gc.C) {
 	actionSchemas := map[string]map[string]interface{}{
 		"snapshot": {
@@ -44,7 +44,7 @@ func getAllUnitNames(st *state.State, units, services []string) (result []names.
 // Run the commands specified on the machines identified through the
 // list of machines, units and services.
 func (a *ActionAPI) Run(run params.RunParams) (results params.ActionResults, err error) {
-	if err := a.checkCanAdmin(); err != nil {
+	if err := a.checkCanRunCommands(); err != nil {
 		return results, err
 	}
 	if err := a.check.ChangeAllowed(); err != nil {
@@ -71,7 +71,7 @@ func (a *ActionAPI) Run(run params.RunParams) (results params.ActionResults, err
 
 // RunOnAllMachines attempts to run the specified command on all the machines.
 func (a *ActionAPI) RunOnAllMachines(run params.RunParams) (results params.ActionResults, err error) {
-	if err := a.checkCanAdmin(); err != nil {
+	if err := a.checkCanRunCommands(); err != nil {
 		return results, err
 	}
 
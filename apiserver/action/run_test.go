@@ -278,3 +278,32 @@ func (s *runSuite) TestRunOnAllMachinesRequiresAdmin(c *gc.C) {
 	_, err = client.RunOnAllMachines(params.RunParams{})
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *runSuite) TestRunWithRunActionsAccess(c *gc.C) {
+	auth := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("run-actions"),
+	}
+	client, err := action.NewActionAPI(s.State, nil, auth)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = client.Run(params.RunParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = client.RunOnAllMachines(params.RunParams{})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = client.Enqueue(params.Actions{})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *runSuite) TestRunRefusesOtherCapabilities(c *gc.C) {
+	for _, name := range []string{"debug", "deploy"} {
+		c.Logf("user %s", name)
+		auth := apiservertesting.FakeAuthorizer{
+			Tag: names.NewUserTag(name),
+		}
+		client, err := action.NewActionAPI(s.State, nil, auth)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = client.Run(params.RunParams{})
+		c.Check(errors.Cause(err), gc.Equals, common.ErrPerm)
+		_, err = client.Enqueue(params.Actions{})
+		c.Check(errors.Cause(err), gc.Equals, common.ErrPerm)
+	}
+}
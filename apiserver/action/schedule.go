@@ -0,0 +1,179 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// AddSchedules schedules actions to be enqueued at a future time, once
+// or on a recurring schedule, on units or on every unit of applications.
+// Users who have only been granted write access to some applications
+// may schedule actions on those applications and their units.
+func (a *ActionAPI) AddSchedules(args params.ActionSchedules) (params.ActionScheduleResults, error) {
+	results := params.ActionScheduleResults{
+		Results: make([]params.ActionScheduleResult, len(args.Schedules)),
+	}
+	canRunAll, err := a.checkCanRunActionsOrRead()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := a.check.ChangeAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	userTag, ok := a.authorizer.GetAuthTag().(names.UserTag)
+	if !ok {
+		return results, common.ErrPerm
+	}
+
+	for i, arg := range args.Schedules {
+		if !canRunAll {
+			if err := a.checkCanScheduleOn(arg.Receivers); err != nil {
+				results.Results[i].Error = common.ServerError(err)
+				continue
+			}
+		}
+		schedule, err := a.addSchedule(arg, userTag)
+		if err != nil {
+			results.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		result := makeActionSchedule(schedule)
+		results.Results[i].Schedule = &result
+	}
+	return results, nil
+}
+
+func (a *ActionAPI) addSchedule(arg params.ActionSchedule, userTag names.UserTag) (*state.ActionSchedule, error) {
+	receivers := make([]names.Tag, len(arg.Receivers))
+	for i, receiver := range arg.Receivers {
+		tag, err := names.ParseTag(receiver)
+		if err != nil {
+			return nil, common.ErrBadId
+		}
+		receivers[i] = tag
+	}
+	var start time.Time
+	if arg.Start != nil {
+		start = *arg.Start
+	}
+	return a.state.AddActionSchedule(state.AddActionScheduleArgs{
+		Receivers:  receivers,
+		Name:       arg.Name,
+		Parameters: arg.Parameters,
+		Start:      start,
+		Recurrence: arg.Recurrence,
+		CreatedBy:  userTag,
+	})
+}
+
+// ListSchedules returns all the action schedules in the model.
+func (a *ActionAPI) ListSchedules() (params.ActionSchedules, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.ActionSchedules{}, errors.Trace(err)
+	}
+	schedules, err := a.state.ActionSchedules()
+	if err != nil {
+		return params.ActionSchedules{}, errors.Trace(err)
+	}
+	result := params.ActionSchedules{
+		Schedules: make([]params.ActionSchedule, len(schedules)),
+	}
+	for i, schedule := range schedules {
+		result.Schedules[i] = makeActionSchedule(schedule)
+	}
+	return result, nil
+}
+
+// RemoveSchedules removes the action schedules with the given ids.
+// Users who have only been granted write access to some applications
+// may remove the schedules that enqueue actions only on those
+// applications and their units.
+func (a *ActionAPI) RemoveSchedules(args params.ActionScheduleIds) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Ids)),
+	}
+	canRunAll, err := a.checkCanRunActionsOrRead()
+	if err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := a.check.RemoveAllowed(); err != nil {
+		return results, errors.Trace(err)
+	}
+	for i, id := range args.Ids {
+		if !canRunAll {
+			if err := a.checkCanRemoveSchedule(id); err != nil {
+				results.Results[i].Error = common.ServerError(err)
+				continue
+			}
+		}
+		results.Results[i].Error = common.ServerError(a.state.RemoveActionSchedule(id))
+	}
+	return results, nil
+}
+
+// checkCanScheduleOn checks that the user may run actions on each of
+// the receivers, which must be applications the user has been granted
+// write access to, or units of them.
+func (a *ActionAPI) checkCanScheduleOn(receivers []string) error {
+	for _, receiver := range receivers {
+		if appTag, err := names.ParseApplicationTag(receiver); err == nil {
+			if err := a.checkCanWriteApplication(appTag); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := a.checkCanRunActionsOn(receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCanRemoveSchedule checks that the user may run actions on each
+// of the receivers of the schedule with the given id.
+func (a *ActionAPI) checkCanRemoveSchedule(id string) error {
+	schedule, err := a.state.ActionSchedule(id)
+	if errors.IsNotFound(err) {
+		// Don't reveal which schedules exist.
+		return common.ErrPerm
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	receivers := schedule.Receivers()
+	tags := make([]string, len(receivers))
+	for i, receiver := range receivers {
+		tags[i] = receiver.String()
+	}
+	return a.checkCanScheduleOn(tags)
+}
+
+func makeActionSchedule(schedule *state.ActionSchedule) params.ActionSchedule {
+	receivers := schedule.Receivers()
+	result := params.ActionSchedule{
+		Id:         schedule.Id(),
+		Receivers:  make([]string, len(receivers)),
+		Name:       schedule.Name(),
+		Parameters: schedule.Parameters(),
+		Recurrence: schedule.Recurrence(),
+		CreatedBy:  schedule.CreatedBy().String(),
+	}
+	for i, receiver := range receivers {
+		result.Receivers[i] = receiver.String()
+	}
+	if next := schedule.Next(); !next.IsZero() {
+		result.Next = &next
+	}
+	if lastFired := schedule.LastFired(); !lastFired.IsZero() {
+		result.LastFired = &lastFired
+	}
+	return result
+}
@@ -0,0 +1,198 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/action"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+)
+
+func (s *actionSuite) TestAddSchedules(c *gc.C) {
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	results, err := s.action.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Parameters: map[string]interface{}{"outfile": "out.tar.bz2"},
+			Recurrence: "0 2 * * *",
+		}, {
+			Receivers: []string{s.wordpressUnit.Tag().String()},
+			Name:      "fakeaction",
+			Start:     &start,
+		}, {
+			Receivers: []string{"unit-mysql-9"},
+			Name:      "snapshot",
+			Start:     &start,
+		}, {
+			Receivers: []string{"foo"},
+			Name:      "snapshot",
+			Start:     &start,
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 4)
+
+	c.Assert(results.Results[0].Error, gc.IsNil)
+	schedule := results.Results[0].Schedule
+	c.Assert(schedule, gc.NotNil)
+	c.Check(schedule.Receivers, jc.DeepEquals, []string{"application-mysql"})
+	c.Check(schedule.Name, gc.Equals, "snapshot")
+	c.Check(schedule.Parameters, jc.DeepEquals, map[string]interface{}{"outfile": "out.tar.bz2"})
+	c.Check(schedule.Recurrence, gc.Equals, "0 2 * * *")
+	c.Check(schedule.Next, gc.NotNil)
+	c.Check(schedule.LastFired, gc.IsNil)
+	c.Check(schedule.CreatedBy, gc.Equals, s.AdminUserTag(c).String())
+
+	c.Assert(results.Results[1].Error, gc.IsNil)
+	c.Assert(results.Results[1].Schedule, gc.NotNil)
+	c.Assert(results.Results[1].Schedule.Next, gc.NotNil)
+	c.Check(results.Results[1].Schedule.Next.Equal(start), jc.IsTrue)
+	c.Check(results.Results[1].Schedule.Recurrence, gc.Equals, "")
+
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `unit mysql/9 not found`)
+	c.Check(results.Results[3].Error, gc.ErrorMatches, `id not found`)
+}
+
+func (s *actionSuite) TestListSchedules(c *gc.C) {
+	added, err := s.action.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(added.Results[0].Error, gc.IsNil)
+
+	schedules, err := s.action.ListSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(schedules.Schedules, gc.HasLen, 1)
+	c.Check(schedules.Schedules[0], jc.DeepEquals, *added.Results[0].Schedule)
+}
+
+func (s *actionSuite) TestRemoveSchedules(c *gc.C) {
+	added, err := s.action.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	id := added.Results[0].Schedule.Id
+
+	results, err := s.action.RemoveSchedules(params.ActionScheduleIds{Ids: []string{id, "42"}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 2)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[1].Error, gc.ErrorMatches, `action schedule "42" not found`)
+
+	schedules, err := s.action.ListSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(schedules.Schedules, gc.HasLen, 0)
+}
+
+func (s *actionSuite) TestBlockAddSchedules(c *gc.C) {
+	s.BlockAllChanges(c, "AddSchedules")
+	_, err := s.action.AddSchedules(params.ActionSchedules{})
+	s.AssertBlocked(c, err, "AddSchedules")
+}
+
+func (s *actionSuite) TestSchedulesReadOnly(c *gc.C) {
+	auth := apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("read")}
+	client, err := action.NewActionAPI(s.State, nil, auth)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = client.ListSchedules()
+	c.Check(err, jc.ErrorIsNil)
+	added, err := client.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(added.Results, gc.HasLen, 1)
+	c.Check(added.Results[0].Error, gc.ErrorMatches, "permission denied")
+
+	added, err = s.action.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	removed, err := client.RemoveSchedules(params.ActionScheduleIds{
+		Ids: []string{added.Results[0].Schedule.Id},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removed.Results, gc.HasLen, 1)
+	c.Check(removed.Results[0].Error, gc.ErrorMatches, "permission denied")
+}
+
+func (s *actionSuite) TestSchedulesApplicationAccess(c *gc.C) {
+	auth := applicationAuthorizer{
+		FakeAuthorizer: apiservertesting.FakeAuthorizer{Tag: names.NewUserTag("bob")},
+		application:    s.wordpress.ApplicationTag(),
+	}
+	client, err := action.NewActionAPI(s.State, nil, auth)
+	c.Assert(err, jc.ErrorIsNil)
+
+	added, err := client.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.wordpress.Tag().String()},
+			Name:       "fakeaction",
+			Recurrence: "@daily",
+		}, {
+			Receivers:  []string{s.wordpressUnit.Tag().String()},
+			Name:       "fakeaction",
+			Recurrence: "@daily",
+		}, {
+			Receivers:  []string{s.wordpressUnit.Tag().String(), s.mysqlUnit.Tag().String()},
+			Name:       "fakeaction",
+			Recurrence: "@daily",
+		}, {
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(added.Results, gc.HasLen, 4)
+	c.Assert(added.Results[0].Error, gc.IsNil)
+	c.Assert(added.Results[1].Error, gc.IsNil)
+	c.Check(added.Results[2].Error, gc.ErrorMatches, "permission denied")
+	c.Check(added.Results[3].Error, gc.ErrorMatches, "permission denied")
+
+	adminAdded, err := s.action.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{s.mysql.Tag().String()},
+			Name:       "snapshot",
+			Recurrence: "@daily",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	removed, err := client.RemoveSchedules(params.ActionScheduleIds{
+		Ids: []string{
+			added.Results[0].Schedule.Id,
+			adminAdded.Results[0].Schedule.Id,
+			"42",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(removed.Results, gc.HasLen, 3)
+	c.Check(removed.Results[0].Error, gc.IsNil)
+	c.Check(removed.Results[1].Error, gc.ErrorMatches, "permission denied")
+	c.Check(removed.Results[2].Error, gc.ErrorMatches, "permission denied")
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionpruner implements the API interface used by the action
+// pruner worker.
+package actionpruner
+
+import (
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+func init() {
+	common.RegisterStandardFacade("ActionPruner", 1, NewAPI)
+}
+
+// API implements the API used by the action pruner worker.
+type API struct {
+	*common.ModelWatcher
+	st *state.State
+}
+
+// NewAPI creates a new instance of the ActionPruner API.
+func NewAPI(st *state.State, r facade.Resources, auth facade.Authorizer) (*API, error) {
+	if !auth.AuthModelManager() {
+		return nil, common.ErrPerm
+	}
+	return &API{
+		ModelWatcher: common.NewModelWatcher(st, r, auth),
+		st:           st,
+	}, nil
+}
+
+// Prune removes the model's completed actions that are older than
+// p.MaxHistoryTime, and then the oldest remaining ones until the
+// actions collection is smaller than p.MaxHistoryMB.
+func (api *API) Prune(p params.ActionPruneArgs) error {
+	return pruneActions(api.st, p.MaxHistoryTime, p.MaxHistoryMB)
+}
+
+var pruneActions = state.PruneActions
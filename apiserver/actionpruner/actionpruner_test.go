@@ -0,0 +1,71 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionpruner_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/actionpruner"
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type ActionPrunerSuite struct {
+	coretesting.BaseSuite
+
+	stub       *testing.Stub
+	api        *actionpruner.API
+	authoriser apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&ActionPrunerSuite{})
+
+func (s *ActionPrunerSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+
+	s.stub = &testing.Stub{}
+	s.PatchValue(actionpruner.PruneActions, func(st *state.State, maxAge time.Duration, maxSizeMB int) error {
+		s.stub.AddCall("PruneActions", maxAge, maxSizeMB)
+		return s.stub.NextErr()
+	})
+	s.authoriser = apiservertesting.FakeAuthorizer{
+		EnvironManager: true,
+	}
+	var err error
+	s.api, err = actionpruner.NewAPI(nil, common.NewResources(), s.authoriser)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ActionPrunerSuite) TestNewAPIRequiresModelManager(c *gc.C) {
+	anAuthoriser := s.authoriser
+	anAuthoriser.EnvironManager = false
+	api, err := actionpruner.NewAPI(nil, nil, anAuthoriser)
+	c.Assert(api, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *ActionPrunerSuite) TestPrune(c *gc.C) {
+	err := s.api.Prune(params.ActionPruneArgs{
+		MaxHistoryTime: 72 * time.Hour,
+		MaxHistoryMB:   500,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCalls(c, []testing.StubCall{{
+		"PruneActions", []interface{}{72 * time.Hour, 500},
+	}})
+}
+
+func (s *ActionPrunerSuite) TestPruneError(c *gc.C) {
+	s.stub.SetErrors(errors.New("boom"))
+	err := s.api.Prune(params.ActionPruneArgs{MaxHistoryTime: time.Hour})
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
@@ -0,0 +1,73 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionscheduler implements the API interface used by the
+// action scheduler worker.
+package actionscheduler
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/watcher"
+)
+
+func init() {
+	common.RegisterStandardFacade("ActionScheduler", 1, NewActionSchedulerAPI)
+}
+
+// ActionSchedulerAPI implements the API used by the action scheduler
+// worker.
+type ActionSchedulerAPI struct {
+	st        StateInterface
+	resources facade.Resources
+}
+
+// NewActionSchedulerAPI creates a new instance of the ActionScheduler
+// API.
+func NewActionSchedulerAPI(
+	st *state.State,
+	res facade.Resources,
+	authorizer facade.Authorizer,
+) (*ActionSchedulerAPI, error) {
+	if !authorizer.AuthModelManager() {
+		return nil, common.ErrPerm
+	}
+	return &ActionSchedulerAPI{
+		st:        getState(st),
+		resources: res,
+	}, nil
+}
+
+// FireDueSchedules enqueues the actions of all the action schedules
+// that are due, and returns when the next one is due.
+func (api *ActionSchedulerAPI) FireDueSchedules() (params.FireActionSchedulesResult, error) {
+	next, err := api.st.FireDueActionSchedules()
+	if err != nil {
+		return params.FireActionSchedulesResult{
+			Error: common.ServerError(errors.Trace(err)),
+		}, nil
+	}
+	var result params.FireActionSchedulesResult
+	if !next.IsZero() {
+		result.Next = &next
+	}
+	return result, nil
+}
+
+// WatchSchedules watches for action schedules being added, removed or
+// fired.
+func (api *ActionSchedulerAPI) WatchSchedules() (params.NotifyWatchResult, error) {
+	watch := api.st.WatchActionSchedules()
+	if _, ok := <-watch.Changes(); ok {
+		return params.NotifyWatchResult{
+			NotifyWatcherId: api.resources.Register(watch),
+		}, nil
+	}
+	return params.NotifyWatchResult{
+		Error: common.ServerError(watcher.EnsureErr(watch)),
+	}, nil
+}
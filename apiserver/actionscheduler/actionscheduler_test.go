@@ -0,0 +1,108 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionscheduler_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/actionscheduler"
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type ActionSchedulerSuite struct {
+	coretesting.BaseSuite
+
+	st         *mockState
+	api        *actionscheduler.ActionSchedulerAPI
+	authoriser apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&ActionSchedulerSuite{})
+
+func (s *ActionSchedulerSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+
+	s.authoriser = apiservertesting.FakeAuthorizer{
+		EnvironManager: true,
+	}
+	s.st = &mockState{Stub: &testing.Stub{}}
+	actionscheduler.PatchState(s, s.st)
+	var err error
+	s.api, err = actionscheduler.NewActionSchedulerAPI(nil, common.NewResources(), s.authoriser)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ActionSchedulerSuite) TestNewAPIRequiresModelManager(c *gc.C) {
+	anAuthoriser := s.authoriser
+	anAuthoriser.EnvironManager = false
+	api, err := actionscheduler.NewActionSchedulerAPI(nil, nil, anAuthoriser)
+	c.Assert(api, gc.IsNil)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedules(c *gc.C) {
+	s.st.next = time.Date(2017, 3, 2, 2, 0, 0, 0, time.UTC)
+	result, err := s.api.FireDueSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.Next, gc.NotNil)
+	c.Check(*result.Next, gc.Equals, s.st.next)
+	s.st.CheckCallNames(c, "FireDueActionSchedules")
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedulesNoneLeft(c *gc.C) {
+	result, err := s.api.FireDueSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.FireActionSchedulesResult{})
+}
+
+func (s *ActionSchedulerSuite) TestFireDueSchedulesFailure(c *gc.C) {
+	s.st.SetErrors(errors.New("boom"))
+	result, err := s.api.FireDueSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.ErrorMatches, "boom")
+}
+
+func (s *ActionSchedulerSuite) TestWatchSchedules(c *gc.C) {
+	result, err := s.api.WatchSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Error, gc.IsNil)
+	c.Assert(result.NotifyWatcherId, gc.Not(gc.Equals), "")
+	s.st.CheckCallNames(c, "WatchActionSchedules")
+}
+
+type mockState struct {
+	*testing.Stub
+	next time.Time
+}
+
+func (st *mockState) FireDueActionSchedules() (time.Time, error) {
+	st.MethodCall(st, "FireDueActionSchedules")
+	return st.next, st.NextErr()
+}
+
+func (st *mockState) WatchActionSchedules() state.NotifyWatcher {
+	st.MethodCall(st, "WatchActionSchedules")
+	w := &mockWatcher{out: make(chan struct{}, 1)}
+	w.out <- struct{}{}
+	return w
+}
+
+type mockWatcher struct {
+	state.NotifyWatcher
+	out chan struct{}
+}
+
+func (w *mockWatcher) Changes() <-chan struct{} {
+	return w.out
+}
@@ -0,0 +1,25 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionscheduler
+
+import (
+	"time"
+
+	"github.com/juju/juju/state"
+)
+
+// StateInterface holds the methods of state.State used by the
+// ActionScheduler API.
+type StateInterface interface {
+	FireDueActionSchedules() (time.Time, error)
+	WatchActionSchedules() state.NotifyWatcher
+}
+
+type stateShim struct {
+	*state.State
+}
+
+var getState = func(st *state.State) StateInterface {
+	return stateShim{st}
+}
@@ -11,11 +11,14 @@ package apiserver
 // place, not scattering it across packages and depending on magic import lists.
 import (
 	_ "github.com/juju/juju/apiserver/action" // ModelUser Write
+	_ "github.com/juju/juju/apiserver/actionpruner"
+	_ "github.com/juju/juju/apiserver/actionscheduler"
 	_ "github.com/juju/juju/apiserver/agent"
 	_ "github.com/juju/juju/apiserver/agenttools"
 	_ "github.com/juju/juju/apiserver/annotations" // ModelUser Write
 	_ "github.com/juju/juju/apiserver/application" // ModelUser Write
 	_ "github.com/juju/juju/apiserver/applicationscaler"
+	_ "github.com/juju/juju/apiserver/auditlog"
 	_ "github.com/juju/juju/apiserver/backups" // ModelUser Write
 	_ "github.com/juju/juju/apiserver/block"   // ModelUser Write
 	_ "github.com/juju/juju/apiserver/bundle"
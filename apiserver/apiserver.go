@@ -18,7 +18,6 @@ import (
 	"github.com/bmizerany/pat"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
-	"github.com/juju/pubsub"
 	"github.com/juju/utils"
 	"github.com/juju/utils/clock"
 	"golang.org/x/crypto/acme"
@@ -62,7 +61,7 @@ type Server struct {
 	modelUUID         string
 	authCtxt          *authContext
 	lastConnectionID  uint64
-	centralHub        *pubsub.StructuredHub
+	centralHub        Hub
 	newObserver       observer.ObserverFactory
 	connCount         int64
 	certChanged       <-chan params.StateServingInfo
@@ -95,7 +94,7 @@ type ServerConfig struct {
 	DataDir     string
 	LogDir      string
 	Validator   LoginValidator
-	Hub         *pubsub.StructuredHub
+	Hub         Hub
 	CertChanged <-chan params.StateServingInfo
 
 	// AutocertDNSName holds the DNS name for which
@@ -118,7 +117,8 @@ type ServerConfig struct {
 	// notified of key events during API requests.
 	NewObserver observer.ObserverFactory
 
-	// StatePool only exists to support testing.
+	// StatePool is the pool of State objects used by the server.
+	// If it is nil, a new pool is created for the server's State.
 	StatePool *state.StatePool
 }
 
@@ -373,9 +373,13 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 	strictCtxt.strictValidation = true
 	strictCtxt.controllerModelOnly = true
 
+	controllerCtxt := httpCtxt
+	controllerCtxt.controllerModelOnly = true
+
 	mainAPIHandler := srv.trackRequests(http.HandlerFunc(srv.apiHandler))
 	logStreamHandler := srv.trackRequests(newLogStreamEndpointHandler(strictCtxt))
 	debugLogHandler := srv.trackRequests(newDebugLogDBHandler(httpCtxt))
+	controllerLogHandler := srv.trackRequests(newDebugLogControllerHandler(controllerCtxt))
 	pubsubHandler := srv.trackRequests(newPubSubHandler(httpCtxt, srv.centralHub))
 
 	// This handler is model specific even though it only ever makes sense
@@ -393,6 +397,10 @@ func (srv *Server) endpoints() []apihttp.Endpoint {
 	logTransferHandler := newLogSinkHandler(httpCtxt, ioutil.Discard, newMigrationLoggingStrategy)
 	add("/migrate/logtransfer", srv.trackRequests(logTransferHandler))
 
+	// The controller-wide debug log isn't bound to a model, since it
+	// merges the logs of all of them.
+	add("/controller/log", controllerLogHandler)
+
 	modelRestHandler := &modelRestHandler{
 		ctxt:          httpCtxt,
 		dataDir:       srv.dataDir,
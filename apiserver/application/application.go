@@ -42,6 +42,10 @@ func init() {
 
 	// Version 3 adds support for cross model relations.
 	common.RegisterStandardFacade("Application", 3, newAPI)
+
+	// Version 4 adds support for exposing applications
+	// to a restricted set of source CIDRs.
+	common.RegisterStandardFacade("Application", 4, newAPI)
 }
 
 // API implements the application interface and is the concrete
@@ -122,6 +126,59 @@ func (api *API) checkCanWrite() error {
 	return nil
 }
 
+// checkCanDeploy checks that the user may deploy and upgrade
+// applications, which requires write access or the deploy capability.
+func (api *API) checkCanDeploy() error {
+	canDeploy, err := common.HasAnyPermission(
+		api.authorizer, api.backend.ModelTag(),
+		permission.WriteAccess, permission.DeployAccess,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !canDeploy {
+		return common.ErrPerm
+	}
+	return nil
+}
+
+// checkCanWriteApplication checks that the user may change the named
+// application, which requires write access to the model or to the
+// application itself.
+func (api *API) checkCanWriteApplication(name string) error {
+	return api.checkApplicationPermission(name, permission.WriteAccess)
+}
+
+// checkCanDeployApplication checks that the user may upgrade or add
+// units to the named application, which requires write access or the
+// deploy capability on the model, or write access to the application.
+func (api *API) checkCanDeployApplication(name string) error {
+	return api.checkApplicationPermission(name, permission.WriteAccess, permission.DeployAccess)
+}
+
+// checkApplicationPermission checks that the user has any of the given
+// model permissions, or write access to the named application.
+func (api *API) checkApplicationPermission(name string, modelPermissions ...permission.Access) error {
+	ok, err := common.HasAnyPermission(api.authorizer, api.backend.ModelTag(), modelPermissions...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if ok {
+		return nil
+	}
+	if !names.IsValidApplication(name) {
+		return common.ErrPerm
+	}
+	ok, err = api.authorizer.HasPermission(permission.WriteAccess, names.NewApplicationTag(name))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !ok {
+		return common.ErrPerm
+	}
+	return nil
+}
+
 // SetMetricCredentials sets credentials on the application.
 func (api *API) SetMetricCredentials(args params.ApplicationMetricCredentials) (params.ErrorResults, error) {
 	if err := api.checkCanWrite(); err != nil {
@@ -150,7 +207,7 @@ func (api *API) SetMetricCredentials(args params.ApplicationMetricCredentials) (
 // Deploy fetches the charms from the charm store and deploys them
 // using the specified placement directives.
 func (api *API) Deploy(args params.ApplicationsDeploy) (params.ErrorResults, error) {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanDeploy(); err != nil {
 		return params.ErrorResults{}, errors.Trace(err)
 	}
 	result := params.ErrorResults{
@@ -285,7 +342,7 @@ func parseSettingsCompatible(charmConfig *charm.Config, settings map[string]stri
 // minimum number of units, settings and constraints.
 // All parameters in params.ApplicationUpdate except the application name are optional.
 func (api *API) Update(args params.ApplicationUpdate) error {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanWriteApplication(args.ApplicationName); err != nil {
 		return err
 	}
 	if !args.ForceCharmURL {
@@ -342,7 +399,7 @@ func (api *API) Update(args params.ApplicationUpdate) error {
 
 // SetCharm sets the charm for a given for the application.
 func (api *API) SetCharm(args params.ApplicationSetCharm) error {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanDeployApplication(args.ApplicationName); err != nil {
 		return err
 	}
 	// when forced units in error, don't block
@@ -486,7 +543,7 @@ func applicationSetSettingsYAML(appName string, application Application, setting
 // GetCharmURL returns the charm URL the given application is
 // running at present.
 func (api *API) GetCharmURL(args params.ApplicationGet) (params.StringResult, error) {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanDeployApplication(args.ApplicationName); err != nil {
 		return params.StringResult{}, errors.Trace(err)
 	}
 	application, err := api.backend.Application(args.ApplicationName)
@@ -501,7 +558,7 @@ func (api *API) GetCharmURL(args params.ApplicationGet) (params.StringResult, er
 // It does not unset values that are set to an empty string.
 // Unset should be used for that.
 func (api *API) Set(p params.ApplicationSet) error {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanWriteApplication(p.ApplicationName); err != nil {
 		return err
 	}
 	if err := api.check.ChangeAllowed(); err != nil {
@@ -527,7 +584,7 @@ func (api *API) Set(p params.ApplicationSet) error {
 
 // Unset implements the server side of Client.Unset.
 func (api *API) Unset(p params.ApplicationUnset) error {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanWriteApplication(p.ApplicationName); err != nil {
 		return err
 	}
 	if err := api.check.ChangeAllowed(); err != nil {
@@ -567,7 +624,8 @@ func (api *API) CharmRelations(p params.ApplicationCharmRelations) (params.Appli
 }
 
 // Expose changes the juju-managed firewall to expose any ports that
-// were also explicitly marked by units as open.
+// were also explicitly marked by units as open. If source CIDRs are
+// specified, the ports are exposed only to those CIDRs.
 func (api *API) Expose(args params.ApplicationExpose) error {
 	if err := api.checkCanWrite(); err != nil {
 		return err
@@ -575,11 +633,20 @@ func (api *API) Expose(args params.ApplicationExpose) error {
 	if err := api.check.ChangeAllowed(); err != nil {
 		return errors.Trace(err)
 	}
+	if len(args.ExposedCIDRs) > 0 {
+		supported, err := api.backend.SupportsIngressRules()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if !supported {
+			return errors.NotSupportedf("exposing applications to source CIDRs on this cloud")
+		}
+	}
 	app, err := api.backend.Application(args.ApplicationName)
 	if err != nil {
 		return err
 	}
-	return app.SetExposed()
+	return app.SetExposedCIDRs(args.ExposedCIDRs)
 }
 
 // Unexpose changes the juju-managed firewall to unexpose any ports that
@@ -612,7 +679,7 @@ func addApplicationUnits(backend Backend, args params.AddApplicationUnits) ([]*s
 
 // AddUnits adds a given number of units to an application.
 func (api *API) AddUnits(args params.AddApplicationUnits) (params.AddApplicationUnitsResults, error) {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanDeployApplication(args.ApplicationName); err != nil {
 		return params.AddApplicationUnitsResults{}, errors.Trace(err)
 	}
 	if err := api.check.ChangeAllowed(); err != nil {
@@ -718,7 +785,7 @@ var applicationUrlEndpointParse = regexp.MustCompile("(?P<url>.*[/.][^:]*)(:(?P<
 
 // AddRelation adds a relation between the specified endpoints and returns the relation info.
 func (api *API) AddRelation(args params.AddRelation) (params.AddRelationResults, error) {
-	if err := api.checkCanWrite(); err != nil {
+	if err := api.checkCanDeploy(); err != nil {
 		return params.AddRelationResults{}, errors.Trace(err)
 	}
 	if err := api.check.ChangeAllowed(); err != nil {
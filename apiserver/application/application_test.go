@@ -1832,7 +1832,7 @@ func (s *serviceSuite) TestServiceExpose(c *gc.C) {
 	c.Assert(svcs[1].IsExposed(), jc.IsTrue)
 	for i, t := range serviceExposeTests {
 		c.Logf("test %d. %s", i, t.about)
-		err = s.applicationAPI.Expose(params.ApplicationExpose{t.service})
+		err = s.applicationAPI.Expose(params.ApplicationExpose{ApplicationName: t.service})
 		if t.err != "" {
 			c.Assert(err, gc.ErrorMatches, t.err)
 		} else {
@@ -1844,6 +1844,38 @@ func (s *serviceSuite) TestServiceExpose(c *gc.C) {
 	}
 }
 
+func (s *serviceSuite) TestServiceExposeCIDRs(c *gc.C) {
+	charm := s.AddTestingCharm(c, "dummy")
+	svc := s.AddTestingService(c, "dummy-service", charm)
+	err := s.applicationAPI.Expose(params.ApplicationExpose{
+		ApplicationName: "dummy-service",
+		ExposedCIDRs:    []string{"192.168.1.0/24", "10.0.0.0/8"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = svc.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.IsExposed(), jc.IsTrue)
+	c.Assert(svc.ExposedCIDRs(), jc.DeepEquals, []string{"10.0.0.0/8", "192.168.1.0/24"})
+
+	// Exposing again without CIDRs exposes to anywhere.
+	err = s.applicationAPI.Expose(params.ApplicationExpose{ApplicationName: "dummy-service"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = svc.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.IsExposed(), jc.IsTrue)
+	c.Assert(svc.ExposedCIDRs(), gc.HasLen, 0)
+}
+
+func (s *serviceSuite) TestServiceExposeInvalidCIDRs(c *gc.C) {
+	charm := s.AddTestingCharm(c, "dummy")
+	s.AddTestingService(c, "dummy-service", charm)
+	err := s.applicationAPI.Expose(params.ApplicationExpose{
+		ApplicationName: "dummy-service",
+		ExposedCIDRs:    []string{"10.0.0.0"},
+	})
+	c.Assert(err, gc.ErrorMatches, `cannot set exposed flag for application "dummy-service": source CIDR "10.0.0.0" not valid`)
+}
+
 func (s *serviceSuite) setupServiceExpose(c *gc.C) {
 	charm := s.AddTestingCharm(c, "dummy")
 	serviceNames := []string{"dummy-service", "exposed-service"}
@@ -1884,7 +1916,7 @@ var serviceExposeTests = []struct {
 func (s *serviceSuite) assertServiceExpose(c *gc.C) {
 	for i, t := range serviceExposeTests {
 		c.Logf("test %d. %s", i, t.about)
-		err := s.applicationAPI.Expose(params.ApplicationExpose{t.service})
+		err := s.applicationAPI.Expose(params.ApplicationExpose{ApplicationName: t.service})
 		if t.err != "" {
 			c.Assert(err, gc.ErrorMatches, t.err)
 		} else {
@@ -1899,7 +1931,7 @@ func (s *serviceSuite) assertServiceExpose(c *gc.C) {
 func (s *serviceSuite) assertServiceExposeBlocked(c *gc.C, msg string) {
 	for i, t := range serviceExposeTests {
 		c.Logf("test %d. %s", i, t.about)
-		err := s.applicationAPI.Expose(params.ApplicationExpose{t.service})
+		err := s.applicationAPI.Expose(params.ApplicationExpose{ApplicationName: t.service})
 		s.AssertBlocked(c, err, msg)
 	}
 }
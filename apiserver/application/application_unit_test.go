@@ -51,6 +51,10 @@ func (s *ApplicationSuite) SetUpTest(c *gc.C) {
 		charm:       &s.charm,
 	}
 	s.blockChecker = mockBlockChecker{}
+	s.api = s.newAPI(c)
+}
+
+func (s *ApplicationSuite) newAPI(c *gc.C) *application.API {
 	offersApiFactory := &mockApplicationOffersFactory{}
 	resources := common.NewResources()
 	resources.RegisterNamed("applicationOffersApiFactory", offersApiFactory)
@@ -65,7 +69,7 @@ func (s *ApplicationSuite) SetUpTest(c *gc.C) {
 		},
 	)
 	c.Assert(err, jc.ErrorIsNil)
-	s.api = api
+	return api
 }
 
 func (s *ApplicationSuite) TestSetCharmStorageConstraints(c *gc.C) {
@@ -131,11 +135,64 @@ postgresql:
 	})
 }
 
+func (s *ApplicationSuite) TestSetCharmApplicationWriteAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("writepostgresql")
+	api := s.newAPI(c)
+	err := api.SetCharm(params.ApplicationSetCharm{
+		ApplicationName: "postgresql",
+		CharmURL:        "cs:postgresql",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.application.CheckCallNames(c, "SetCharm")
+}
+
+func (s *ApplicationSuite) TestSetCharmOtherApplicationWriteAccess(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("writemysql")
+	api := s.newAPI(c)
+	err := api.SetCharm(params.ApplicationSetCharm{
+		ApplicationName: "postgresql",
+		CharmURL:        "cs:postgresql",
+	})
+	c.Assert(err, gc.Equals, common.ErrPerm)
+	s.application.CheckNoCalls(c)
+}
+
+func (s *ApplicationSuite) TestExposeCIDRs(c *gc.C) {
+	s.backend.ingressRulesSupported = true
+	err := s.api.Expose(params.ApplicationExpose{
+		ApplicationName: "postgresql",
+		ExposedCIDRs:    []string{"10.0.0.0/8"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.backend.CheckCallNames(c, "ModelTag", "SupportsIngressRules", "Application")
+	s.application.CheckCall(c, 0, "SetExposedCIDRs", []string{"10.0.0.0/8"})
+}
+
+func (s *ApplicationSuite) TestExposeCIDRsNotSupported(c *gc.C) {
+	err := s.api.Expose(params.ApplicationExpose{
+		ApplicationName: "postgresql",
+		ExposedCIDRs:    []string{"10.0.0.0/8"},
+	})
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, "exposing applications to source CIDRs on this cloud not supported")
+	s.backend.CheckCallNames(c, "ModelTag", "SupportsIngressRules")
+	s.application.CheckNoCalls(c)
+}
+
 type mockBackend struct {
 	application.Backend
 	testing.Stub
-	application *mockApplication
-	charm       *mockCharm
+	application           *mockApplication
+	charm                 *mockCharm
+	ingressRulesSupported bool
+}
+
+func (b *mockBackend) SupportsIngressRules() (bool, error) {
+	b.MethodCall(b, "SupportsIngressRules")
+	if err := b.NextErr(); err != nil {
+		return false, err
+	}
+	return b.ingressRulesSupported, nil
 }
 
 func (b *mockBackend) ModelTag() names.ModelTag {
@@ -176,6 +233,11 @@ func (a *mockApplication) SetCharm(cfg state.SetCharmConfig) error {
 	return a.NextErr()
 }
 
+func (a *mockApplication) SetExposedCIDRs(cidrs []string) error {
+	a.MethodCall(a, "SetExposedCIDRs", cidrs)
+	return a.NextErr()
+}
+
 type mockCharm struct {
 	application.Charm
 	testing.Stub
@@ -4,13 +4,16 @@
 package application
 
 import (
+	"github.com/juju/errors"
 	"gopkg.in/juju/charm.v6-unstable"
 	csparams "gopkg.in/juju/charmrepo.v2-unstable/csclient/params"
 	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/constraints"
+	"github.com/juju/juju/environs"
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/stateenvirons"
 	"github.com/juju/juju/state/storage"
 )
 
@@ -34,6 +37,10 @@ type Backend interface {
 	ModelTag() names.ModelTag
 	Unit(string) (Unit, error)
 	NewStorage() storage.Storage
+
+	// SupportsIngressRules reports whether the model's cloud can
+	// restrict the sources from which exposed ports are accessed.
+	SupportsIngressRules() (bool, error)
 }
 
 // BlockChecker defines the block-checking functionality required by
@@ -63,6 +70,7 @@ type Application interface {
 	SetCharm(state.SetCharmConfig) error
 	SetConstraints(constraints.Value) error
 	SetExposed() error
+	SetExposedCIDRs([]string) error
 	SetMetricCredentials([]byte) error
 	SetMinUnits(int) error
 	UpdateConfigSettings(charm.Settings) error
@@ -134,6 +142,15 @@ func (s stateShim) NewStorage() storage.Storage {
 	return storage.NewStorage(s.State.ModelUUID(), s.State.MongoSession())
 }
 
+func (s stateShim) SupportsIngressRules() (bool, error) {
+	env, err := stateenvirons.GetNewEnvironFunc(environs.New)(s.State)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	_, ok := env.(environs.IngressRuleFirewaller)
+	return ok, nil
+}
+
 func (s stateShim) Application(name string) (Application, error) {
 	a, err := s.State.Application(name)
 	if err != nil {
@@ -0,0 +1,113 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package auditlog defines an API end point for querying the
+// controller's audit log.
+package auditlog
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+)
+
+func init() {
+	common.RegisterStandardFacade("AuditLog", 1, newFacade)
+}
+
+// Backend defines the state functionality required by the
+// audit log facade.
+type Backend interface {
+	AuditEntries(audit.Filter) ([]audit.AuditEntry, error)
+	ControllerTag() names.ControllerTag
+}
+
+// API implements the audit log facade.
+type API struct {
+	backend Backend
+}
+
+func newFacade(st *state.State, _ facade.Resources, auth facade.Authorizer) (*API, error) {
+	return NewAPI(st, auth)
+}
+
+// NewAPI returns a new audit log API facade. Only controller
+// superusers may query the audit log.
+func NewAPI(backend Backend, authorizer facade.Authorizer) (*API, error) {
+	if !authorizer.AuthClient() {
+		return nil, common.ErrPerm
+	}
+	isAdmin, err := authorizer.HasPermission(permission.SuperuserAccess, backend.ControllerTag())
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.Trace(err)
+	}
+	if !isAdmin {
+		return nil, common.ErrPerm
+	}
+	return &API{backend: backend}, nil
+}
+
+// Query returns the audit log entries matching the supplied filter,
+// oldest first.
+func (api *API) Query(args params.AuditLogFilter) (params.AuditLogResults, error) {
+	var result params.AuditLogResults
+	filter, err := filterFromParams(args)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	entries, err := api.backend.AuditEntries(filter)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Entries = make([]params.AuditLogEntry, len(entries))
+	for i, entry := range entries {
+		result.Entries[i] = params.AuditLogEntry{
+			JujuServerVersion: entry.JujuServerVersion,
+			ModelUUID:         entry.ModelUUID,
+			Timestamp:         entry.Timestamp,
+			RemoteAddress:     entry.RemoteAddress,
+			OriginType:        entry.OriginType,
+			OriginName:        entry.OriginName,
+			Operation:         entry.Operation,
+			Data:              entry.Data,
+		}
+	}
+	return result, nil
+}
+
+func filterFromParams(args params.AuditLogFilter) (audit.Filter, error) {
+	filter := audit.Filter{
+		Operation: args.Operation,
+		Limit:     args.Limit,
+	}
+	if args.ModelTag != "" {
+		tag, err := names.ParseModelTag(args.ModelTag)
+		if err != nil {
+			return audit.Filter{}, errors.Trace(err)
+		}
+		filter.ModelUUID = tag.Id()
+	}
+	if args.UserTag != "" {
+		tag, err := names.ParseUserTag(args.UserTag)
+		if err != nil {
+			return audit.Filter{}, errors.Trace(err)
+		}
+		filter.OriginName = tag.String()
+	}
+	if args.After != nil {
+		filter.After = args.After.UTC()
+	}
+	if args.Before != nil {
+		filter.Before = args.Before.UTC()
+	}
+	if err := filter.Validate(); err != nil {
+		return audit.Filter{}, errors.Trace(err)
+	}
+	return filter, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package auditlog_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	gitjujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/auditlog"
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/audit"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type auditLogSuite struct {
+	gitjujutesting.IsolationSuite
+	backend    *mockBackend
+	authorizer apiservertesting.FakeAuthorizer
+}
+
+var _ = gc.Suite(&auditLogSuite{})
+
+func (s *auditLogSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.backend = &mockBackend{
+		entries: []audit.AuditEntry{{
+			JujuServerVersion: version.MustParse("2.1.0"),
+			ModelUUID:         coretesting.ModelTag.Id(),
+			Timestamp:         time.Date(2016, time.June, 1, 10, 0, 0, 0, time.UTC),
+			RemoteAddress:     "10.0.0.1",
+			OriginType:        "API request",
+			OriginName:        "user-bob",
+			Operation:         "Application:v3 - Deploy",
+			Data:              map[string]interface{}{"request-body": "x"},
+		}},
+	}
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("superuser-admin"),
+	}
+}
+
+func (s *auditLogSuite) TestNewAPIRequiresSuperuser(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("admin-bob")
+	_, err := auditlog.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *auditLogSuite) TestNewAPIRequiresClient(c *gc.C) {
+	s.authorizer.Tag = names.NewMachineTag("0")
+	_, err := auditlog.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, gc.Equals, common.ErrPerm)
+}
+
+func (s *auditLogSuite) TestQuery(c *gc.C) {
+	api, err := auditlog.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+
+	after := time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC)
+	before := after.Add(24 * time.Hour)
+	result, err := api.Query(params.AuditLogFilter{
+		ModelTag:  coretesting.ModelTag.String(),
+		UserTag:   "user-bob",
+		Operation: "Application:v3 - Deploy",
+		After:     &after,
+		Before:    &before,
+		Limit:     5,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.backend.filter, jc.DeepEquals, audit.Filter{
+		ModelUUID:  coretesting.ModelTag.Id(),
+		OriginName: "user-bob",
+		Operation:  "Application:v3 - Deploy",
+		After:      after,
+		Before:     before,
+		Limit:      5,
+	})
+	c.Assert(result.Entries, jc.DeepEquals, []params.AuditLogEntry{{
+		JujuServerVersion: version.MustParse("2.1.0"),
+		ModelUUID:         coretesting.ModelTag.Id(),
+		Timestamp:         time.Date(2016, time.June, 1, 10, 0, 0, 0, time.UTC),
+		RemoteAddress:     "10.0.0.1",
+		OriginType:        "API request",
+		OriginName:        "user-bob",
+		Operation:         "Application:v3 - Deploy",
+		Data:              map[string]interface{}{"request-body": "x"},
+	}})
+}
+
+func (s *auditLogSuite) TestQueryInvalidTags(c *gc.C) {
+	api, err := auditlog.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = api.Query(params.AuditLogFilter{ModelTag: "machine-0"})
+	c.Assert(err, gc.ErrorMatches, `"machine-0" is not a valid model tag`)
+	_, err = api.Query(params.AuditLogFilter{UserTag: "bob"})
+	c.Assert(err, gc.ErrorMatches, `"bob" is not a valid tag`)
+}
+
+func (s *auditLogSuite) TestQueryBackendError(c *gc.C) {
+	s.backend.err = errors.New("boom")
+	api, err := auditlog.NewAPI(s.backend, s.authorizer)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = api.Query(params.AuditLogFilter{})
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+type mockBackend struct {
+	entries []audit.AuditEntry
+	filter  audit.Filter
+	err     error
+}
+
+func (b *mockBackend) AuditEntries(filter audit.Filter) ([]audit.AuditEntry, error) {
+	b.filter = filter
+	return b.entries, b.err
+}
+
+func (b *mockBackend) ControllerTag() names.ControllerTag {
+	return coretesting.ControllerTag
+}
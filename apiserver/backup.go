@@ -24,6 +24,11 @@ var newBackups = func(st *state.State) (backups.Backups, io.Closer) {
 	return backups.NewBackups(stor), stor
 }
 
+var newBackupsWithTarget = func(st *state.State, target backups.BackupTarget) (backups.Backups, io.Closer) {
+	stor := backups.NewStorageWithTarget(st, target)
+	return backups.NewBackups(stor), stor
+}
+
 // backupHandler handles backup requests.
 type backupHandler struct {
 	ctxt httpContext
@@ -39,13 +44,10 @@ func (h *backupHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	}
 	defer h.ctxt.release(st)
 
-	backups, closer := newBackups(st)
-	defer closer.Close()
-
 	switch req.Method {
 	case "GET":
 		logger.Infof("handling backups download request")
-		id, err := h.download(backups, resp, req)
+		id, err := h.download(st, resp, req)
 		if err != nil {
 			h.sendError(resp, err)
 			return
@@ -53,6 +55,8 @@ func (h *backupHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 		logger.Infof("backups download request successful for %q", id)
 	case "PUT":
 		logger.Infof("handling backups upload request")
+		backups, closer := newBackups(st)
+		defer closer.Close()
 		id, err := h.upload(backups, resp, req)
 		if err != nil {
 			h.sendError(resp, err)
@@ -64,14 +68,31 @@ func (h *backupHandler) ServeHTTP(resp http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (h *backupHandler) download(backups backups.Backups, resp http.ResponseWriter, req *http.Request) (string, error) {
+func (h *backupHandler) download(st *state.State, resp http.ResponseWriter, req *http.Request) (string, error) {
 	args, err := h.parseGETArgs(req)
 	if err != nil {
 		return "", err
 	}
 	logger.Infof("backups download request for %q", args.ID)
 
-	meta, archive, err := backups.Get(args.ID)
+	var backupsMethods backups.Backups
+	var closer io.Closer
+	if args.Target == nil {
+		backupsMethods, closer = newBackups(st)
+	} else {
+		target, err := backups.NewTarget(backups.TargetSpec{
+			URL:       args.Target.URL,
+			AccessKey: args.Target.AccessKey,
+			SecretKey: args.Target.SecretKey,
+		})
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		backupsMethods, closer = newBackupsWithTarget(st, target)
+	}
+	defer closer.Close()
+
+	meta, archive, err := backupsMethods.Get(args.ID)
 	if err != nil {
 		return "", err
 	}
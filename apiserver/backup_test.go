@@ -13,6 +13,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"path/filepath"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -129,7 +130,7 @@ func (s *backupsWithMacaroonsSuite) SetUpTest(c *gc.C) {
 func (s *backupsWithMacaroonsSuite) TestWithNoBasicAuthReturnsDischargeRequiredError(c *gc.C) {
 	resp := s.sendRequest(c, httpRequestParams{
 		method:   "GET",
-		jsonBody: &params.BackupsDownloadArgs{"bad-id"},
+		jsonBody: &params.BackupsDownloadArgs{ID: "bad-id"},
 		url:      s.backupURL(c),
 	})
 
@@ -149,7 +150,7 @@ func (s *backupsWithMacaroonsSuite) TestCanGetWithDischargedMacaroon(c *gc.C) {
 	resp := s.sendRequest(c, httpRequestParams{
 		do:       s.doer(),
 		method:   "GET",
-		jsonBody: &params.BackupsDownloadArgs{"bad-id"},
+		jsonBody: &params.BackupsDownloadArgs{ID: "bad-id"},
 		url:      s.backupURL(c),
 	})
 	s.assertErrorResponse(c, resp, http.StatusInternalServerError, "failed!")
@@ -230,6 +231,37 @@ func (s *backupsDownloadSuite) TestCalls(c *gc.C) {
 	c.Check(s.fake.IDArg, gc.Equals, s.fake.Meta.ID())
 }
 
+func (s *backupsDownloadSuite) TestFromTarget(c *gc.C) {
+	var targetURL string
+	s.PatchValue(apiserver.NewBackupsWithTarget,
+		func(st *state.State, target backups.BackupTarget) (backups.Backups, io.Closer) {
+			targetURL = target.URL()
+			return s.fake, ioutil.NopCloser(nil)
+		},
+	)
+	dir := c.MkDir()
+	meta := backupstesting.NewMetadata()
+	archive, err := backupstesting.NewArchiveBasic(meta)
+	c.Assert(err, jc.ErrorIsNil)
+	s.fake.Meta = meta
+	s.fake.Archive = ioutil.NopCloser(archive)
+
+	resp := s.authRequest(c, httpRequestParams{
+		method:      "GET",
+		url:         s.backupURL(c),
+		contentType: params.ContentTypeJSON,
+		jsonBody: params.BackupsDownloadArgs{
+			ID:     meta.ID(),
+			Target: &params.BackupTarget{URL: "file://" + dir},
+		},
+	})
+	defer resp.Body.Close()
+
+	c.Check(resp.StatusCode, gc.Equals, http.StatusOK)
+	c.Check(s.fake.Calls, gc.DeepEquals, []string{"Get"})
+	c.Check(targetURL, gc.Equals, "file://"+filepath.ToSlash(dir))
+}
+
 func (s *backupsDownloadSuite) TestResponse(c *gc.C) {
 	resp, _ := s.sendValidGet(c)
 	defer resp.Body.Close()
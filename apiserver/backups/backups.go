@@ -110,6 +110,52 @@ var newBackups = func(backend Backend) (backups.Backups, io.Closer) {
 	return backups.NewBackups(stor), stor
 }
 
+var newBackupsWithTarget = func(backend Backend, target backups.BackupTarget) (backups.Backups, io.Closer) {
+	stor := backups.NewStorageWithTarget(backend, target)
+	return backups.NewBackups(stor), stor
+}
+
+// openBackups returns the backups stored in the given target, or in
+// the controller if it is nil, along with the URL of the target.
+func openBackups(backend Backend, target *params.BackupTarget) (backups.Backups, io.Closer, string, error) {
+	if target == nil {
+		backupsMethods, closer := newBackups(backend)
+		return backupsMethods, closer, "", nil
+	}
+	backupTarget, err := backups.NewTarget(backups.TargetSpec{
+		URL:       target.URL,
+		AccessKey: target.AccessKey,
+		SecretKey: target.SecretKey,
+	})
+	if err != nil {
+		return nil, nil, "", errors.Trace(err)
+	}
+	backupsMethods, closer := newBackupsWithTarget(backend, backupTarget)
+	return backupsMethods, closer, backupTarget.URL(), nil
+}
+
+// encryptionKey returns the backup encryption key supplied with a
+// request or, if none was supplied, the controller's own key. It
+// returns nil if neither is available.
+func encryptionKey(backend Backend, key []byte) ([]byte, error) {
+	if len(key) > 0 {
+		if err := backups.ValidateEncryptionKey(key); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return key, nil
+	}
+	controllerConfig, err := backend.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	path := controllerConfig.BackupEncryptionKeyFile()
+	if path == "" {
+		return nil, nil
+	}
+	key, err = backups.ReadEncryptionKey(path)
+	return key, errors.Trace(err)
+}
+
 // ResultFromMetadata updates the result with the information in the
 // metadata value.
 func ResultFromMetadata(meta *backups.Metadata) params.BackupsMetadataResult {
@@ -129,6 +175,9 @@ func ResultFromMetadata(meta *backups.Metadata) params.BackupsMetadataResult {
 		result.Finished = *meta.Finished
 	}
 	result.Notes = meta.Notes
+	result.Scheduled = meta.Scheduled
+	result.Target = meta.Target
+	result.KeyFingerprint = meta.KeyFingerprint
 
 	result.Model = meta.Origin.Model
 	result.Machine = meta.Origin.Machine
@@ -163,6 +212,9 @@ func MetadataFromResult(result params.BackupsMetadataResult) *backups.Metadata {
 	meta.Origin.Version = result.Version
 	meta.Origin.Series = result.Series
 	meta.Notes = result.Notes
+	meta.Scheduled = result.Scheduled
+	meta.Target = result.Target
+	meta.KeyFingerprint = result.KeyFingerprint
 	meta.SetFileInfo(result.Size, result.Checksum, result.ChecksumFormat)
 	return meta
 }
@@ -17,7 +17,14 @@ var waitUntilReady = replicaset.WaitUntilReady
 // Create is the API method that requests juju to create a new backup
 // of its state.  It returns the metadata for that backup.
 func (a *API) Create(args params.BackupsCreateArgs) (p params.BackupsMetadataResult, err error) {
-	backupsMethods, closer := newBackups(a.backend)
+	key, err := encryptionKey(a.backend, args.EncryptionKey)
+	if err != nil {
+		return p, errors.Trace(err)
+	}
+	backupsMethods, closer, targetURL, err := openBackups(a.backend, args.Target)
+	if err != nil {
+		return p, errors.Trace(err)
+	}
 	defer closer.Close()
 
 	session := a.backend.MongoSession().Copy()
@@ -52,8 +59,9 @@ func (a *API) Create(args params.BackupsCreateArgs) (p params.BackupsMetadataRes
 		return p, errors.Trace(err)
 	}
 	meta.Notes = args.Notes
+	meta.Target = targetURL
 
-	err = backupsMethods.Create(meta, a.paths, dbInfo)
+	err = backupsMethods.Create(meta, a.paths, dbInfo, key)
 	if err != nil {
 		return p, errors.Trace(err)
 	}
@@ -4,12 +4,18 @@
 package backups_test
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/mgo.v2"
 
 	"github.com/juju/juju/apiserver/backups"
 	"github.com/juju/juju/apiserver/params"
+	statebackups "github.com/juju/juju/state/backups"
+	backupstesting "github.com/juju/juju/state/backups/testing"
 )
 
 func (s *backupsSuite) TestCreateOkay(c *gc.C) {
@@ -53,3 +59,58 @@ func (s *backupsSuite) TestCreateError(c *gc.C) {
 	c.Logf("%v", err)
 	c.Check(err, gc.ErrorMatches, "failed!")
 }
+
+func (s *backupsSuite) TestCreateTarget(c *gc.C) {
+	s.PatchValue(backups.WaitUntilReady,
+		func(*mgo.Session, int) error { return nil },
+	)
+	dir := c.MkDir()
+	fake := &backupstesting.FakeBackups{}
+	var targetURL string
+	s.PatchValue(backups.NewBackupsWithTarget,
+		func(_ backups.Backend, target statebackups.BackupTarget) (statebackups.Backups, io.Closer) {
+			targetURL = target.URL()
+			return fake, ioutil.NopCloser(nil)
+		},
+	)
+	args := params.BackupsCreateArgs{
+		Target: &params.BackupTarget{URL: "file://" + dir},
+	}
+	result, err := s.api.Create(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(targetURL, gc.Equals, "file://"+dir)
+	c.Check(result.Target, gc.Equals, "file://"+dir)
+	c.Check(fake.Calls, jc.DeepEquals, []string{"Create"})
+}
+
+func (s *backupsSuite) TestCreateTargetInvalid(c *gc.C) {
+	args := params.BackupsCreateArgs{
+		Target: &params.BackupTarget{URL: "ftp://example.com/backups"},
+	}
+	_, err := s.api.Create(args)
+	c.Check(err, gc.ErrorMatches, `backup target scheme "ftp" not supported`)
+}
+
+func (s *backupsSuite) TestCreateEncryptionKey(c *gc.C) {
+	s.PatchValue(backups.WaitUntilReady,
+		func(*mgo.Session, int) error { return nil },
+	)
+	fake := s.setBackups(c, s.meta, "")
+	key := bytes.Repeat([]byte{0x42}, statebackups.EncryptionKeySize)
+	args := params.BackupsCreateArgs{
+		EncryptionKey: key,
+	}
+	_, err := s.api.Create(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fake.EncryptionKeyArg, jc.DeepEquals, key)
+}
+
+func (s *backupsSuite) TestCreateEncryptionKeyInvalid(c *gc.C) {
+	fake := s.setBackups(c, s.meta, "")
+	args := params.BackupsCreateArgs{
+		EncryptionKey: []byte("sekrit"),
+	}
+	_, err := s.api.Create(args)
+	c.Check(err, gc.ErrorMatches, `backup encryption key of 6 bytes \(expected 32\) not valid`)
+	c.Check(fake.Calls, gc.HasLen, 0)
+}
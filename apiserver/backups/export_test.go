@@ -4,6 +4,7 @@
 package backups
 
 var (
-	NewBackups     = &newBackups
-	WaitUntilReady = &waitUntilReady
+	NewBackups           = &newBackups
+	NewBackupsWithTarget = &newBackupsWithTarget
+	WaitUntilReady       = &waitUntilReady
 )
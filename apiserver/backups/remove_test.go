@@ -4,10 +4,17 @@
 package backups_test
 
 import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/backups"
 	"github.com/juju/juju/apiserver/params"
+	statebackups "github.com/juju/juju/state/backups"
+	backupstesting "github.com/juju/juju/state/backups/testing"
 )
 
 func (s *backupsSuite) TestRemoveOkay(c *gc.C) {
@@ -28,3 +35,24 @@ func (s *backupsSuite) TestRemoveError(c *gc.C) {
 
 	c.Check(err, gc.ErrorMatches, "failed!")
 }
+
+func (s *backupsSuite) TestRemoveFromTarget(c *gc.C) {
+	dir := c.MkDir()
+	fake := &backupstesting.FakeBackups{}
+	var targetURL string
+	s.PatchValue(backups.NewBackupsWithTarget,
+		func(_ backups.Backend, target statebackups.BackupTarget) (statebackups.Backups, io.Closer) {
+			targetURL = target.URL()
+			return fake, ioutil.NopCloser(nil)
+		},
+	)
+	args := params.BackupsRemoveArgs{
+		ID:     "some-id",
+		Target: &params.BackupTarget{URL: "file://" + dir},
+	}
+	err := s.api.Remove(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(targetURL, gc.Equals, "file://"+filepath.ToSlash(dir))
+	c.Check(fake.Calls, jc.DeepEquals, []string{"Remove"})
+	c.Check(fake.IDArg, gc.Equals, "some-id")
+}
@@ -24,8 +24,16 @@ var bootstrapNode = names.NewMachineTag("0")
 func (a *API) Restore(p params.RestoreArgs) error {
 	logger.Infof("Starting server side restore")
 
+	key, err := encryptionKey(a.backend, p.EncryptionKey)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	// Get hold of a backup file Reader
-	backup, closer := newBackups(a.backend)
+	backup, closer, _, err := openBackups(a.backend, p.Target)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	defer closer.Close()
 
 	// Obtain the address of current machine, where we will be performing restore.
@@ -69,6 +77,7 @@ func (a *API) Restore(p params.RestoreArgs) error {
 		NewInstId:      instanceId,
 		NewInstTag:     machine.Tag(),
 		NewInstSeries:  machine.Series(),
+		EncryptionKey:  key,
 	}
 
 	session := a.backend.MongoSession().Copy()
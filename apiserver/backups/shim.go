@@ -17,6 +17,8 @@ import (
 
 func init() {
 	common.RegisterStandardFacade("Backups", 1, newAPI)
+	// Version 2 adds backup targets and encryption keys.
+	common.RegisterStandardFacade("Backups", 2, newAPI)
 }
 
 type stateShim struct {
@@ -0,0 +1,297 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/charm.v6-unstable"
+	csparams "gopkg.in/juju/charmrepo.v2-unstable/csclient/params"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+)
+
+// bundleOutput holds the bundle data written by ExportBundle. It
+// follows the bundle format read by charm.ReadBundleData, and also
+// records the charm store channel of each application.
+type bundleOutput struct {
+	Series       string                        `yaml:"series,omitempty"`
+	Applications map[string]*applicationOutput `yaml:"applications"`
+	Machines     map[string]*machineOutput     `yaml:"machines,omitempty"`
+	Relations    [][]string                    `yaml:"relations,omitempty"`
+}
+
+type applicationOutput struct {
+	Charm            string                 `yaml:"charm"`
+	Channel          string                 `yaml:"channel,omitempty"`
+	Series           string                 `yaml:"series,omitempty"`
+	NumUnits         int                    `yaml:"num_units,omitempty"`
+	To               []string               `yaml:"to,omitempty"`
+	Expose           bool                   `yaml:"expose,omitempty"`
+	Options          map[string]interface{} `yaml:"options,omitempty"`
+	Annotations      map[string]string      `yaml:"annotations,omitempty"`
+	Constraints      string                 `yaml:"constraints,omitempty"`
+	Storage          map[string]string      `yaml:"storage,omitempty"`
+	EndpointBindings map[string]string      `yaml:"bindings,omitempty"`
+}
+
+type machineOutput struct {
+	Series      string            `yaml:"series,omitempty"`
+	Constraints string            `yaml:"constraints,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// ExportBundle returns the YAML of a bundle that deploys the
+// applications, machines and relations of the current model. Models
+// with applications deployed from local charms cannot be exported.
+func (b *bundleAPI) ExportBundle() (params.StringResult, error) {
+	var result params.StringResult
+	canRead, err := b.authorizer.HasPermission(permission.ReadAccess, b.state.ModelTag())
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if !canRead {
+		return result, common.ErrPerm
+	}
+	data, err := b.exportBundle()
+	if err != nil {
+		return result, errors.Annotate(err, "cannot export bundle")
+	}
+	out, err := yaml.Marshal(data)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Result = string(out)
+	return result, nil
+}
+
+func (b *bundleAPI) exportBundle() (*bundleOutput, error) {
+	cfg, err := b.state.ModelConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	data := &bundleOutput{
+		Applications: make(map[string]*applicationOutput),
+	}
+	data.Series, _ = cfg.DefaultSeries()
+
+	machines, err := b.state.AllMachines()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, m := range machines {
+		// Containers are created by the placement of the units
+		// they host.
+		if names.IsContainerMachine(m.Id()) {
+			continue
+		}
+		spec, err := b.exportMachine(m, data.Series)
+		if err != nil {
+			return nil, errors.Annotatef(err, "machine %s", m.Id())
+		}
+		if data.Machines == nil {
+			data.Machines = make(map[string]*machineOutput)
+		}
+		data.Machines[m.Id()] = spec
+	}
+
+	applications, err := b.state.AllApplications()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, app := range applications {
+		spec, err := b.exportApplication(app, data.Series)
+		if err != nil {
+			return nil, errors.Annotatef(err, "application %q", app.Name())
+		}
+		data.Applications[app.Name()] = spec
+	}
+
+	relations, err := b.state.AllRelations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, rel := range relations {
+		endpoints := rel.Endpoints()
+		// Peer relations are established when the application is
+		// deployed.
+		if len(endpoints) != 2 {
+			continue
+		}
+		var relation []string
+		for _, ep := range endpoints {
+			// Only relations between the exported applications can
+			// be deployed; remote applications are left out.
+			if _, ok := data.Applications[ep.ApplicationName]; !ok {
+				break
+			}
+			relation = append(relation, ep.ApplicationName+":"+ep.Name)
+		}
+		if len(relation) == 2 {
+			sort.Strings(relation)
+			data.Relations = append(data.Relations, relation)
+		}
+	}
+	sort.Sort(relationsByName(data.Relations))
+	return data, nil
+}
+
+func (b *bundleAPI) exportMachine(m *state.Machine, defaultSeries string) (*machineOutput, error) {
+	spec := &machineOutput{}
+	if m.Series() != defaultSeries {
+		spec.Series = m.Series()
+	}
+	cons, err := m.Constraints()
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.Trace(err)
+	}
+	spec.Constraints = cons.String()
+	if spec.Annotations, err = b.state.Annotations(m); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return spec, nil
+}
+
+func (b *bundleAPI) exportApplication(app *state.Application, defaultSeries string) (*applicationOutput, error) {
+	curl, _ := app.CharmURL()
+	if curl.Schema == "local" {
+		// There is no way to refer to the charm's source from
+		// the bundle, so it could not be deployed.
+		return nil, errors.NotSupportedf("local charm %q", curl)
+	}
+	spec := &applicationOutput{
+		Charm:  curl.String(),
+		Expose: app.IsExposed(),
+	}
+	if channel := app.Channel(); channel != "" && channel != csparams.StableChannel {
+		spec.Channel = string(channel)
+	}
+	if app.Series() != defaultSeries {
+		spec.Series = app.Series()
+	}
+
+	ch, _, err := app.Charm()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	settings, err := app.ConfigSettings()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	spec.Options = changedOptions(ch.Config(), settings)
+
+	if app.IsPrincipal() {
+		cons, err := app.Constraints()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		spec.Constraints = cons.String()
+		if spec.NumUnits, spec.To, err = unitPlacement(app); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	storage, err := app.StorageConstraints()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for name, cons := range storage {
+		if spec.Storage == nil {
+			spec.Storage = make(map[string]string)
+		}
+		spec.Storage[name] = fmt.Sprintf("%s,%d,%dM", cons.Pool, cons.Count, cons.Size)
+	}
+
+	bindings, err := app.EndpointBindings()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for endpoint, space := range bindings {
+		if space == "" {
+			continue
+		}
+		if spec.EndpointBindings == nil {
+			spec.EndpointBindings = make(map[string]string)
+		}
+		spec.EndpointBindings[endpoint] = space
+	}
+
+	if spec.Annotations, err = b.state.Annotations(app); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return spec, nil
+}
+
+// changedOptions returns the settings whose values differ from the
+// defaults in the charm config.
+func changedOptions(config *charm.Config, settings charm.Settings) map[string]interface{} {
+	var options map[string]interface{}
+	for name, value := range settings {
+		if option, ok := config.Options[name]; ok && option.Default == value {
+			continue
+		}
+		if options == nil {
+			options = make(map[string]interface{})
+		}
+		options[name] = value
+	}
+	return options
+}
+
+// unitPlacement returns the number of units of the principal
+// application, and the bundle placement directives that put them on
+// the machines they are currently assigned to, ordered by unit number.
+func unitPlacement(app *state.Application) (int, []string, error) {
+	units, err := app.AllUnits()
+	if err != nil {
+		return 0, nil, errors.Trace(err)
+	}
+	sort.Sort(unitsByNumber(units))
+	var to []string
+	for _, unit := range units {
+		machineId, err := unit.AssignedMachineId()
+		if errors.IsNotAssigned(err) {
+			continue
+		} else if err != nil {
+			return 0, nil, errors.Trace(err)
+		}
+		if names.IsContainerMachine(machineId) {
+			to = append(to, fmt.Sprintf("%s:%s",
+				state.ContainerTypeFromId(machineId), state.ParentId(machineId)))
+		} else {
+			to = append(to, machineId)
+		}
+	}
+	return len(units), to, nil
+}
+
+type unitsByNumber []*state.Unit
+
+func (u unitsByNumber) Len() int      { return len(u) }
+func (u unitsByNumber) Swap(i, j int) { u[i], u[j] = u[j], u[i] }
+func (u unitsByNumber) Less(i, j int) bool {
+	return unitNumber(u[i].Name()) < unitNumber(u[j].Name())
+}
+
+func unitNumber(name string) int {
+	n, _ := strconv.Atoi(name[strings.LastIndex(name, "/")+1:])
+	return n
+}
+
+type relationsByName [][]string
+
+func (r relationsByName) Len() int      { return len(r) }
+func (r relationsByName) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r relationsByName) Less(i, j int) bool {
+	return strings.Join(r[i], " ") < strings.Join(r[j], " ")
+}
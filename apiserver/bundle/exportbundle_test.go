@@ -0,0 +1,198 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"fmt"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/charm.v6-unstable"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/bundle"
+	"github.com/juju/juju/apiserver/params"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/constraints"
+	"github.com/juju/juju/instance"
+	jujutesting "github.com/juju/juju/juju/testing"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/storage"
+	"github.com/juju/juju/testing/factory"
+)
+
+type exportBundleSuite struct {
+	jujutesting.JujuConnSuite
+	facade bundle.Bundle
+}
+
+var _ = gc.Suite(&exportBundleSuite{})
+
+func (s *exportBundleSuite) SetUpTest(c *gc.C) {
+	s.JujuConnSuite.SetUpTest(c)
+	auth := apiservertesting.FakeAuthorizer{
+		Tag: s.AdminUserTag(c),
+	}
+	facade, err := bundle.NewFacade(s.State, auth)
+	c.Assert(err, jc.ErrorIsNil)
+	s.facade = facade
+
+	err = s.State.UpdateModelConfig(map[string]interface{}{"default-series": "quantal"}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *exportBundleSuite) TestExportBundleEmpty(c *gc.C) {
+	result, err := s.facade.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, params.StringResult{
+		Result: "series: quantal\napplications: {}\n",
+	})
+}
+
+func (s *exportBundleSuite) TestExportBundle(c *gc.C) {
+	machine0, err := s.State.AddMachine("quantal", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetAnnotations(machine0, map[string]string{"gui-x": "10"})
+	c.Assert(err, jc.ErrorIsNil)
+	machine1, err := s.State.AddMachine("trusty", state.JobHostUnits)
+	c.Assert(err, jc.ErrorIsNil)
+	err = machine1.SetConstraints(constraints.MustParse("mem=8G"))
+	c.Assert(err, jc.ErrorIsNil)
+	container, err := s.State.AddMachineInsideMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+	}, machine0.Id(), instance.LXD)
+	c.Assert(err, jc.ErrorIsNil)
+
+	wordpress := s.AddTestingService(c, "wordpress", s.addCharm(c, "wordpress"))
+	err = wordpress.UpdateConfigSettings(charm.Settings{"blog-title": "Juju"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = wordpress.SetConstraints(constraints.MustParse("mem=4G"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = wordpress.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.SetAnnotations(wordpress, map[string]string{"gui-y": "20"})
+	c.Assert(err, jc.ErrorIsNil)
+	for _, m := range []*state.Machine{machine0, container} {
+		unit, err := wordpress.AddUnit()
+		c.Assert(err, jc.ErrorIsNil)
+		err = unit.AssignToMachine(m)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	mysql := s.AddTestingService(c, "mysql", s.addCharm(c, "mysql"))
+	unit, err := mysql.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = unit.AssignToMachine(machine1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.AddTestingService(c, "logging", s.addCharm(c, "logging"))
+
+	for _, endpoints := range [][]string{{"wordpress", "mysql"}, {"wordpress:juju-info", "logging:info"}} {
+		eps, err := s.State.InferEndpoints(endpoints...)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = s.State.AddRelation(eps...)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	result, err := s.facade.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Error, gc.IsNil)
+	c.Check(result.Result, gc.Equals, `
+series: quantal
+applications:
+  logging:
+    charm: cs:quantal/logging-1
+  mysql:
+    charm: cs:quantal/mysql-1
+    num_units: 1
+    to:
+    - "1"
+  wordpress:
+    charm: cs:quantal/wordpress-1
+    num_units: 2
+    to:
+    - "0"
+    - lxd:0
+    expose: true
+    options:
+      blog-title: Juju
+    annotations:
+      gui-y: "20"
+    constraints: mem=4096M
+machines:
+  "0":
+    annotations:
+      gui-x: "10"
+  "1":
+    series: trusty
+    constraints: mem=8192M
+relations:
+- - logging:info
+  - wordpress:juju-info
+- - mysql:server
+  - wordpress:db
+`[1:])
+	s.assertRoundTrip(c, result.Result)
+}
+
+func (s *exportBundleSuite) TestExportBundleDefaultOptions(c *gc.C) {
+	// Options set to their default values are left out of the bundle.
+	wordpress := s.AddTestingService(c, "wordpress", s.addCharm(c, "wordpress"))
+	err := wordpress.UpdateConfigSettings(charm.Settings{"blog-title": "My Title"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err := s.facade.ExportBundle()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Result, gc.Equals, `
+series: quantal
+applications:
+  wordpress:
+    charm: cs:quantal/wordpress-1
+`[1:])
+	s.assertRoundTrip(c, result.Result)
+}
+
+func (s *exportBundleSuite) TestExportBundleLocalCharm(c *gc.C) {
+	s.AddTestingService(c, "wordpress", s.AddTestingCharm(c, "wordpress"))
+	_, err := s.facade.ExportBundle()
+	c.Assert(err, gc.ErrorMatches, `cannot export bundle: application "wordpress": local charm "local:quantal/wordpress-3" not supported`)
+}
+
+func (s *exportBundleSuite) TestExportBundlePermissionDenied(c *gc.C) {
+	auth := apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("bob"),
+	}
+	facade, err := bundle.NewFacade(s.State, auth)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = facade.ExportBundle()
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+// addCharm adds the named testing charm to state as if it were
+// deployed from the charm store.
+func (s *exportBundleSuite) addCharm(c *gc.C, name string) *state.Charm {
+	return s.Factory.MakeCharm(c, &factory.CharmParams{
+		Name: name,
+		URL:  fmt.Sprintf("cs:quantal/%s-1", name),
+	})
+}
+
+// assertRoundTrip checks that the exported bundle can be read and
+// verified as it would be when deployed.
+func (s *exportBundleSuite) assertRoundTrip(c *gc.C, bundleYAML string) {
+	data, err := charm.ReadBundleData(strings.NewReader(bundleYAML))
+	c.Assert(err, jc.ErrorIsNil)
+	verifyConstraints := func(s string) error {
+		_, err := constraints.Parse(s)
+		return err
+	}
+	verifyStorage := func(s string) error {
+		_, err := storage.ParseConstraints(s)
+		return err
+	}
+	err = data.Verify(verifyConstraints, verifyStorage)
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -83,6 +83,36 @@ func (c *Client) checkCanWrite() error {
 	return nil
 }
 
+// checkCanDeploy checks that the user may add charms to the model,
+// which requires write access or the deploy capability, or write
+// access to any of its applications so that they may be upgraded.
+func (c *Client) checkCanDeploy() error {
+	canDeploy, err := common.HasAnyPermission(
+		c.api.auth, c.api.stateAccessor.ModelTag(),
+		permission.WriteAccess, permission.DeployAccess,
+	)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if canDeploy {
+		return nil
+	}
+	applications, err := c.api.stateAccessor.AllApplications()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, app := range applications {
+		canWrite, err := c.api.auth.HasPermission(permission.WriteAccess, app.ApplicationTag())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if canWrite {
+			return nil
+		}
+	}
+	return common.ErrPerm
+}
+
 func newClient(st *state.State, resources facade.Resources, authorizer facade.Authorizer) (*Client, error) {
 	urlGetter := common.NewToolsURLGetter(st.ModelUUID(), st)
 	configGetter := stateenvirons.EnvironConfigGetter{st}
@@ -545,7 +575,7 @@ func (c *Client) FindTools(args params.FindToolsParams) (params.FindToolsResult,
 }
 
 func (c *Client) AddCharm(args params.AddCharm) error {
-	if err := c.checkCanWrite(); err != nil {
+	if err := c.checkCanDeploy(); err != nil {
 		return err
 	}
 
@@ -562,7 +592,7 @@ func (c *Client) AddCharm(args params.AddCharm) error {
 // The authorization macaroon, args.CharmStoreMacaroon, may be
 // omitted, in which case this call is equivalent to AddCharm.
 func (c *Client) AddCharmWithAuthorization(args params.AddCharmWithAuthorization) error {
-	if err := c.checkCanWrite(); err != nil {
+	if err := c.checkCanDeploy(); err != nil {
 		return err
 	}
 
@@ -572,7 +602,7 @@ func (c *Client) AddCharmWithAuthorization(args params.AddCharmWithAuthorization
 // ResolveCharm resolves the best available charm URLs with series, for charm
 // locations without a series specified.
 func (c *Client) ResolveCharms(args params.ResolveCharms) (params.ResolveCharmResults, error) {
-	if err := c.checkCanWrite(); err != nil {
+	if err := c.checkCanDeploy(); err != nil {
 		return params.ResolveCharmResults{}, err
 	}
 
@@ -62,10 +62,13 @@ func (s *serverSuite) SetUpTest(c *gc.C) {
 }
 
 func (s *serverSuite) clientForState(c *gc.C, st *state.State) *client.Client {
-	auth := testing.FakeAuthorizer{
+	return s.clientForStateAs(c, st, testing.FakeAuthorizer{
 		Tag:            s.AdminUserTag(c),
 		EnvironManager: true,
-	}
+	})
+}
+
+func (s *serverSuite) clientForStateAs(c *gc.C, st *state.State, auth testing.FakeAuthorizer) *client.Client {
 	urlGetter := common.NewToolsURLGetter(st.ModelUUID(), st)
 	configGetter := stateenvirons.EnvironConfigGetter{st}
 	statusSetter := common.NewStatusSetter(st, common.AuthAlways())
@@ -286,6 +289,24 @@ func (s *serverSuite) TestUserModelSetEnvironAgentVersionNotAffectedByMigration(
 	s.assertModelVersion(c, otherSt, "2.0.4")
 }
 
+func (s *serverSuite) TestResolveCharmsApplicationWriteUser(c *gc.C) {
+	s.Factory.MakeApplication(c, &factory.ApplicationParams{Name: "wordpress"})
+	client := s.clientForStateAs(c, s.State, testing.FakeAuthorizer{
+		Tag: names.NewUserTag("writewordpress"),
+	})
+	_, err := client.ResolveCharms(params.ResolveCharms{})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serverSuite) TestResolveCharmsOtherApplicationWriteUser(c *gc.C) {
+	s.Factory.MakeApplication(c, &factory.ApplicationParams{Name: "wordpress"})
+	client := s.clientForStateAs(c, s.State, testing.FakeAuthorizer{
+		Tag: names.NewUserTag("writemysql"),
+	})
+	_, err := client.ResolveCharms(params.ResolveCharms{})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
 type mockEnviron struct {
 	environs.Environ
 	allInstancesCalled bool
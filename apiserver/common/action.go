@@ -27,6 +27,8 @@ func ParamsActionExecutionResultsToStateActionResults(arg params.ActionExecution
 		status = state.ActionFailed
 	case params.ActionPending:
 		status = state.ActionPending
+	case params.ActionAborted:
+		status = state.ActionAborted
 	default:
 		return state.ActionResults{}, errors.Errorf("unrecognized action status '%s'", arg.Status)
 	}
@@ -80,6 +82,42 @@ func AuthAndActionFromTagFn(canAccess AuthFunc, getActionByTag func(names.Action
 	}
 }
 
+// ActionStatus returns the status of every action passed in through args.
+// It needs an actionFn that can fetch an action from state using it's id, that's usually created by AuthAndActionFromTagFn
+func ActionStatus(args params.Entities, actionFn func(string) (state.Action, error)) params.StringResults {
+	results := params.StringResults{Results: make([]params.StringResult, len(args.Entities))}
+
+	for i, arg := range args.Entities {
+		action, err := actionFn(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = ServerError(err)
+			continue
+		}
+		results.Results[i].Result = string(action.Status())
+	}
+
+	return results
+}
+
+// LogActionsMessages logs the progress messages passed in through args.
+// It needs an actionFn that can fetch an action from state using it's id, that's usually created by AuthAndActionFromTagFn
+func LogActionsMessages(args params.ActionMessageParams, actionFn func(string) (state.Action, error)) params.ErrorResults {
+	results := params.ErrorResults{Results: make([]params.ErrorResult, len(args.Messages))}
+
+	for i, arg := range args.Messages {
+		action, err := actionFn(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = ServerError(err)
+			continue
+		}
+		if err := action.Log(arg.Value); err != nil {
+			results.Results[i].Error = ServerError(err)
+		}
+	}
+
+	return results
+}
+
 // BeginActions calls begin on every action passed in through args.
 // It's a helper function currently used by the uniter and by machineactions
 // It needs an actionFn that can fetch an action from state using it's id, that's usually created by AuthAndActionFromTagFn
@@ -233,6 +271,13 @@ func ConvertActions(ar state.ActionReceiver, fn GetActionsFn) ([]params.ActionRe
 // to params.ActionResult.
 func MakeActionResult(actionReceiverTag names.Tag, action state.Action) params.ActionResult {
 	output, message := action.Results()
+	var log []params.ActionMessage
+	for _, msg := range action.Messages() {
+		log = append(log, params.ActionMessage{
+			Timestamp: msg.Timestamp,
+			Message:   msg.Message,
+		})
+	}
 	return params.ActionResult{
 		Action: &params.Action{
 			Receiver:   actionReceiverTag.String(),
@@ -243,6 +288,7 @@ func MakeActionResult(actionReceiverTag names.Tag, action state.Action) params.A
 		Status:    string(action.Status()),
 		Message:   message,
 		Output:    output,
+		Log:       log,
 		Enqueued:  action.Enqueued(),
 		Started:   action.Started(),
 		Completed: action.Completed(),
@@ -141,6 +141,49 @@ func (s *actionsSuite) TestBeginActions(c *gc.C) {
 	})
 }
 
+func (s *actionsSuite) TestActionStatus(c *gc.C) {
+	args := entities("running", "aborting", "fail")
+	actionFn := makeGetActionByTagString(map[string]state.Action{
+		"running":  fakeAction{status: state.ActionRunning},
+		"aborting": fakeAction{status: state.ActionAborting},
+	})
+
+	results := common.ActionStatus(args, actionFn)
+
+	c.Assert(results, jc.DeepEquals, params.StringResults{
+		[]params.StringResult{
+			{Result: "running"},
+			{Result: "aborting"},
+			{Error: common.ServerError(actionNotFoundErr)},
+		},
+	})
+}
+
+func (s *actionsSuite) TestLogActionsMessages(c *gc.C) {
+	args := params.ActionMessageParams{
+		Messages: []params.EntityString{
+			{Tag: "success", Value: "copying tables"},
+			{Tag: "notRunning", Value: "copying tables"},
+			{Tag: "notfound", Value: "copying tables"},
+		},
+	}
+	expectErr := errors.New("action not running")
+	actionFn := makeGetActionByTagString(map[string]state.Action{
+		"success":    fakeAction{},
+		"notRunning": fakeAction{logErr: expectErr},
+	})
+
+	results := common.LogActionsMessages(args, actionFn)
+
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		[]params.ErrorResult{
+			{},
+			{common.ServerError(expectErr)},
+			{common.ServerError(actionNotFoundErr)},
+		},
+	})
+}
+
 func (s *actionsSuite) TestGetActions(c *gc.C) {
 	args := entities("success", "fail", "notPending")
 	actionFn := makeGetActionByTagString(map[string]state.Action{
@@ -334,6 +377,7 @@ type fakeAction struct {
 	name      string
 	beginErr  error
 	finishErr error
+	logErr    error
 	status    state.ActionStatus
 }
 
@@ -361,6 +405,10 @@ func (mock fakeAction) Finish(state.ActionResults) (state.Action, error) {
 	return nil, mock.finishErr
 }
 
+func (mock fakeAction) Log(string) error {
+	return mock.logErr
+}
+
 // entities is a convenience constructor for params.Entities.
 func entities(tags ...string) params.Entities {
 	entities := params.Entities{
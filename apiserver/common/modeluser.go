@@ -53,6 +53,12 @@ func StateToParamsUserAccessPermission(descriptionAccess permission.Access) (par
 		return params.ModelWriteAccess, nil
 	case permission.AdminAccess:
 		return params.ModelAdminAccess, nil
+	case permission.RunActionsAccess:
+		return params.ModelRunActionsAccess, nil
+	case permission.DebugAccess:
+		return params.ModelDebugAccess, nil
+	case permission.DeployAccess:
+		return params.ModelDeployAccess, nil
 	}
 
 	return "", errors.NotValidf("model access permission %q", descriptionAccess)
@@ -9,6 +9,7 @@ import (
 	"github.com/juju/errors"
 	"gopkg.in/juju/names.v2"
 
+	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/permission"
 	"github.com/juju/juju/state"
 )
@@ -59,9 +60,14 @@ func HasPermission(userGetter userAccessFunc, utag names.Tag,
 	switch requestedPermission {
 	case permission.LoginAccess, permission.AddModelAccess, permission.SuperuserAccess:
 		validForKind = target.Kind() == names.ControllerTagKind
-	case permission.ReadAccess, permission.WriteAccess, permission.AdminAccess:
+	case permission.ReadAccess, permission.WriteAccess, permission.AdminAccess,
+		permission.RunActionsAccess, permission.DebugAccess, permission.DeployAccess:
 		validForKind = target.Kind() == names.ModelTagKind
 	}
+	if target.Kind() == names.ApplicationTagKind {
+		validForKind = requestedPermission == permission.WriteAccess ||
+			requestedPermission == permission.AdminAccess
+	}
 
 	if !validForKind {
 		return false, nil
@@ -103,12 +109,30 @@ func HasPermission(userGetter userAccessFunc, utag names.Tag,
 	}
 	modelPermission := user.Access.EqualOrGreaterModelAccessThan(requestedPermission) && target.Kind() == names.ModelTagKind
 	controllerPermission := user.Access.EqualOrGreaterControllerAccessThan(requestedPermission) && target.Kind() == names.ControllerTagKind
-	if !controllerPermission && !modelPermission {
+	applicationPermission := user.Access.EqualOrGreaterModelAccessThan(requestedPermission) && target.Kind() == names.ApplicationTagKind
+	if !controllerPermission && !modelPermission && !applicationPermission {
 		return false, nil
 	}
 	return true, nil
 }
 
+// HasAnyPermission returns true if the authenticated entity has any of
+// the specified permissions on target. It allows facade methods to
+// accept one of the model capability accesses as an alternative to
+// the wider access they otherwise require.
+func HasAnyPermission(authorizer facade.Authorizer, target names.Tag, permissions ...permission.Access) (bool, error) {
+	for _, p := range permissions {
+		ok, err := authorizer.HasPermission(p, target)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // maybeUseGroupPermission returns a permission.UserAccess updated
 // with the group permissions that apply to it if higher than
 // current.
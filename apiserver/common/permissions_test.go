@@ -98,6 +98,30 @@ func (r *PermissionSuite) TestHasPermission(c *gc.C) {
 			access:           permission.AddModelAccess,
 			expected:         true,
 		},
+		{
+			title:            "application permissions also work",
+			userGetterAccess: permission.WriteAccess,
+			user:             names.NewUserTag("validuser"),
+			target:           names.NewApplicationTag("mysql"),
+			access:           permission.WriteAccess,
+			expected:         true,
+		},
+		{
+			title:            "user has lesser application permission than required",
+			userGetterAccess: permission.WriteAccess,
+			user:             names.NewUserTag("validuser"),
+			target:           names.NewApplicationTag("mysql"),
+			access:           permission.AdminAccess,
+			expected:         false,
+		},
+		{
+			title:            "user requests read permission on application",
+			userGetterAccess: permission.AdminAccess,
+			user:             names.NewUserTag("validuser"),
+			target:           names.NewApplicationTag("mysql"),
+			access:           permission.ReadAccess,
+			expected:         false,
+		},
 	}
 	for i, t := range testCases {
 		userGetter := &fakeUserAccess{
@@ -46,6 +46,9 @@ type StorageInterface interface {
 	// attachment corresponding to the identfified machine and filesystem.
 	WatchFilesystemAttachment(names.MachineTag, names.FilesystemTag) state.NotifyWatcher
 
+	// WatchFilesystem watches for changes to the specified filesystem.
+	WatchFilesystem(names.FilesystemTag) state.NotifyWatcher
+
 	// WatchVolumeAttachment watches for changes to the volume attachment
 	// corresponding to the identfified machine and volume.
 	WatchVolumeAttachment(names.MachineTag, names.VolumeTag) state.NotifyWatcher
@@ -133,6 +136,7 @@ func volumeStorageAttachmentInfo(
 	return &storage.StorageAttachmentInfo{
 		storage.StorageKindBlock,
 		devicePath,
+		blockDevice.Size,
 	}, nil
 }
 
@@ -154,9 +158,19 @@ func filesystemStorageAttachmentInfo(
 	if err != nil {
 		return nil, errors.Annotate(err, "getting filesystem attachment info")
 	}
+	// The filesystem's size is informational only, so we
+	// don't fail if the filesystem has not yet been provisioned.
+	var size uint64
+	filesystemInfo, err := filesystem.Info()
+	if err == nil {
+		size = filesystemInfo.Size
+	} else if !errors.IsNotProvisioned(err) {
+		return nil, errors.Annotate(err, "getting filesystem info")
+	}
 	return &storage.StorageAttachmentInfo{
 		storage.StorageKindFilesystem,
 		filesystemAttachmentInfo.MountPoint,
+		size,
 	}, nil
 }
 
@@ -199,8 +213,12 @@ func WatchStorageAttachment(
 		if err != nil {
 			return nil, errors.Annotate(err, "getting storage filesystem")
 		}
+		// We need to watch both the filesystem attachment, and
+		// the filesystem. The filesystem's size will change if
+		// it is resized.
 		watchers = []state.NotifyWatcher{
 			st.WatchFilesystemAttachment(machineTag, filesystem.FilesystemTag()),
+			st.WatchFilesystem(filesystem.FilesystemTag()),
 		}
 	default:
 		return nil, errors.Errorf("invalid storage kind %v", storageInstance.Kind())
@@ -263,7 +281,9 @@ func storageTags(
 	)
 	if storageInstance != nil {
 		storageTags[tags.JujuStorageInstance] = storageInstance.Tag().Id()
-		storageTags[tags.JujuStorageOwner] = storageInstance.Owner().Id()
+		if owner, ok := storageInstance.Owner(); ok {
+			storageTags[tags.JujuStorageOwner] = owner.Id()
+		}
 	}
 	return storageTags, nil
 }
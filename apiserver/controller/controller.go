@@ -7,6 +7,7 @@ package controller
 
 import (
 	"encoding/json"
+	"fmt"
 	"sort"
 
 	"github.com/juju/errors"
@@ -33,6 +34,8 @@ var logger = loggo.GetLogger("juju.apiserver.controller")
 
 func init() {
 	common.RegisterStandardFacade("Controller", 3, NewControllerAPI)
+	// Facade version 4 adds MigrationPrecheckReports().
+	common.RegisterStandardFacade("Controller", 4, NewControllerAPI)
 }
 
 // Controller defines the methods on the controller API end point.
@@ -48,6 +51,7 @@ type Controller interface {
 	WatchAllModels() (params.AllWatcherId, error)
 	ModelStatus(params.Entities) (params.ModelStatusResults, error)
 	InitiateMigration(params.InitiateMigrationArgs) (params.InitiateMigrationResults, error)
+	MigrationPrecheckReports(params.InitiateMigrationArgs) (params.MigrationPrecheckResults, error)
 	ModifyControllerAccess(params.ModifyControllerAccessRequest) (params.ErrorResults, error)
 }
 
@@ -390,39 +394,113 @@ func (c *ControllerAPI) InitiateMigration(reqArgs params.InitiateMigrationArgs)
 }
 
 func (c *ControllerAPI) initiateOneMigration(spec params.MigrationSpec) (string, error) {
-	modelTag, err := names.ParseModelTag(spec.ModelTag)
+	hostedState, targetInfo, err := c.migrationSpecState(spec)
 	if err != nil {
-		return "", errors.Annotate(err, "model tag")
+		return "", errors.Trace(err)
 	}
+	defer hostedState.Close()
 
-	// Ensure the model exists.
-	if _, err := c.state.GetModel(modelTag); err != nil {
-		return "", errors.Annotate(err, "unable to read model")
+	// Check if the migration is likely to succeed.
+	if !(spec.ExternalControl && spec.SkipInitialPrechecks) {
+		if err := runMigrationPrechecks(hostedState, targetInfo); err != nil {
+			return "", errors.Trace(err)
+		}
 	}
 
-	hostedState, err := c.state.ForModel(modelTag)
+	// Trigger the migration.
+	mig, err := hostedState.CreateMigration(state.MigrationSpec{
+		InitiatedBy:     c.apiUser,
+		TargetInfo:      targetInfo,
+		ExternalControl: spec.ExternalControl,
+	})
 	if err != nil {
 		return "", errors.Trace(err)
 	}
+	return mig.Id(), nil
+}
+
+// MigrationPrecheckReports runs every source and target precheck for
+// the given model migrations without starting them, and reports all
+// the problems found along with the estimated size of each model
+// export.
+func (c *ControllerAPI) MigrationPrecheckReports(reqArgs params.InitiateMigrationArgs) (
+	params.MigrationPrecheckResults, error,
+) {
+	out := params.MigrationPrecheckResults{
+		Results: make([]params.MigrationPrecheckResult, len(reqArgs.Specs)),
+	}
+	if err := c.checkHasAdmin(); err != nil {
+		return out, errors.Trace(err)
+	}
+
+	for i, spec := range reqArgs.Specs {
+		result := &out.Results[i]
+		result.ModelTag = spec.ModelTag
+		report, err := c.oneMigrationPrecheckReport(spec)
+		if err != nil {
+			result.Error = common.ServerError(err)
+			continue
+		}
+		result.Source = precheckProblemsToParams(report.Source)
+		result.Target = precheckProblemsToParams(report.Target)
+		result.ExportSize = report.ExportSize
+	}
+	return out, nil
+}
+
+func (c *ControllerAPI) oneMigrationPrecheckReport(spec params.MigrationSpec) (coremigration.PrecheckReport, error) {
+	hostedState, targetInfo, err := c.migrationSpecState(spec)
+	if err != nil {
+		return coremigration.PrecheckReport{}, errors.Trace(err)
+	}
 	defer hostedState.Close()
+	report, err := runMigrationPrecheckReport(hostedState, targetInfo)
+	return report, errors.Trace(err)
+}
+
+func precheckProblemsToParams(problems []coremigration.PrecheckProblem) []params.MigrationPrecheckProblem {
+	var out []params.MigrationPrecheckProblem
+	for _, problem := range problems {
+		out = append(out, params.MigrationPrecheckProblem{
+			Entity:  problem.Entity,
+			Message: problem.Message,
+		})
+	}
+	return out
+}
+
+// migrationSpecState returns the state for the model to be migrated
+// and the details of the target controller, as given in the
+// migration spec. The caller is responsible for closing the state.
+func (c *ControllerAPI) migrationSpecState(spec params.MigrationSpec) (*state.State, coremigration.TargetInfo, error) {
+	var targetInfo coremigration.TargetInfo
+	modelTag, err := names.ParseModelTag(spec.ModelTag)
+	if err != nil {
+		return nil, targetInfo, errors.Annotate(err, "model tag")
+	}
+
+	// Ensure the model exists.
+	if _, err := c.state.GetModel(modelTag); err != nil {
+		return nil, targetInfo, errors.Annotate(err, "unable to read model")
+	}
 
 	// Construct target info.
 	specTarget := spec.TargetInfo
 	controllerTag, err := names.ParseControllerTag(specTarget.ControllerTag)
 	if err != nil {
-		return "", errors.Annotate(err, "controller tag")
+		return nil, targetInfo, errors.Annotate(err, "controller tag")
 	}
 	authTag, err := names.ParseUserTag(specTarget.AuthTag)
 	if err != nil {
-		return "", errors.Annotate(err, "auth tag")
+		return nil, targetInfo, errors.Annotate(err, "auth tag")
 	}
 	var macs []macaroon.Slice
 	if specTarget.Macaroons != "" {
 		if err := json.Unmarshal([]byte(specTarget.Macaroons), &macs); err != nil {
-			return "", errors.Annotate(err, "invalid macaroons")
+			return nil, targetInfo, errors.Annotate(err, "invalid macaroons")
 		}
 	}
-	targetInfo := coremigration.TargetInfo{
+	targetInfo = coremigration.TargetInfo{
 		ControllerTag: controllerTag,
 		Addrs:         specTarget.Addrs,
 		CACert:        specTarget.CACert,
@@ -431,23 +509,11 @@ func (c *ControllerAPI) initiateOneMigration(spec params.MigrationSpec) (string,
 		Macaroons:     macs,
 	}
 
-	// Check if the migration is likely to succeed.
-	if !(spec.ExternalControl && spec.SkipInitialPrechecks) {
-		if err := runMigrationPrechecks(hostedState, targetInfo); err != nil {
-			return "", errors.Trace(err)
-		}
-	}
-
-	// Trigger the migration.
-	mig, err := hostedState.CreateMigration(state.MigrationSpec{
-		InitiatedBy:     c.apiUser,
-		TargetInfo:      targetInfo,
-		ExternalControl: spec.ExternalControl,
-	})
+	hostedState, err := c.state.ForModel(modelTag)
 	if err != nil {
-		return "", errors.Trace(err)
+		return nil, targetInfo, errors.Trace(err)
 	}
-	return mig.Id(), nil
+	return hostedState, targetInfo, nil
 }
 
 // ModifyControllerAccess changes the model access granted to users.
@@ -512,6 +578,49 @@ var runMigrationPrechecks = func(st *state.State, targetInfo coremigration.Targe
 	return errors.Annotate(err, "target prechecks failed")
 }
 
+var runMigrationPrecheckReport = func(st *state.State, targetInfo coremigration.TargetInfo) (coremigration.PrecheckReport, error) {
+	var report coremigration.PrecheckReport
+
+	// Check model and source controller.
+	backend, err := migration.PrecheckShim(st)
+	if err != nil {
+		return report, errors.Annotate(err, "creating backend")
+	}
+	report.Source, err = migration.SourcePrecheckProblems(backend)
+	if err != nil {
+		return report, errors.Annotate(err, "source prechecks")
+	}
+
+	// Estimate the size of the model export.
+	serialized, err := migration.ExportModel(st)
+	if err != nil {
+		return report, errors.Annotate(err, "exporting model")
+	}
+	report.ExportSize = int64(len(serialized))
+
+	// Check target controller.
+	modelInfo, err := makeModelInfo(st)
+	if err != nil {
+		return report, errors.Trace(err)
+	}
+	conn, err := api.Open(targetToAPIInfo(targetInfo), migration.ControllerDialOpts())
+	if err != nil {
+		// The target checks can't be run, which itself blocks the
+		// migration.
+		report.Target = []coremigration.PrecheckProblem{{
+			Entity:  "controller",
+			Message: fmt.Sprintf("cannot connect to target controller: %v", err),
+		}}
+		return report, nil
+	}
+	defer conn.Close()
+	report.Target, err = migrationtarget.NewClient(conn).PrecheckProblems(modelInfo)
+	if err != nil {
+		return report, errors.Annotate(err, "target prechecks")
+	}
+	return report, nil
+}
+
 func makeModelInfo(st *state.State) (coremigration.ModelInfo, error) {
 	var empty coremigration.ModelInfo
 
@@ -538,13 +647,25 @@ func makeModelInfo(st *state.State) (coremigration.ModelInfo, error) {
 	}
 	controllerVersion, _ := controllerConfig.AgentVersion()
 
-	return coremigration.ModelInfo{
+	modelInfo := coremigration.ModelInfo{
 		UUID:                   model.UUID(),
 		Name:                   model.Name(),
 		Owner:                  model.Owner(),
 		AgentVersion:           agentVersion,
 		ControllerAgentVersion: controllerVersion,
-	}, nil
+		CloudName:              model.Cloud(),
+	}
+	if credTag, ok := model.CloudCredential(); ok {
+		cred, err := st.CloudCredential(credTag)
+		if err != nil {
+			return empty, errors.Trace(err)
+		}
+		modelInfo.CloudCredential = &coremigration.CredentialInfo{
+			Tag:      credTag,
+			AuthType: string(cred.AuthType()),
+		}
+	}
+	return modelInfo, nil
 }
 
 func targetToAPIInfo(ti coremigration.TargetInfo) *api.Info {
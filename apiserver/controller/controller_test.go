@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/migration"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/permission"
@@ -550,6 +551,85 @@ func (s *controllerSuite) TestInitiateMigrationSkipPrechecks(c *gc.C) {
 	c.Check(out.Results[0].Error, gc.IsNil)
 }
 
+func (s *controllerSuite) TestMigrationPrecheckReports(c *gc.C) {
+	st := s.Factory.MakeModel(c, nil)
+	defer st.Close()
+
+	controller.SetPrecheckResult(s, errors.New("should not happen"))
+	controller.SetPrecheckReport(s, migration.PrecheckReport{
+		Source: []migration.PrecheckProblem{{
+			Entity:  "machine 0",
+			Message: "machine 0 is dying",
+		}},
+		Target: []migration.PrecheckProblem{{
+			Entity:  "user bob",
+			Message: "model owner bob does not exist in target controller",
+		}},
+		ExportSize: 1024,
+	}, nil)
+
+	args := params.InitiateMigrationArgs{
+		Specs: []params.MigrationSpec{{
+			ModelTag: st.ModelTag().String(),
+			TargetInfo: params.MigrationTargetInfo{
+				ControllerTag: randomControllerTag(),
+				Addrs:         []string{"1.1.1.1:1111"},
+				CACert:        "cert1",
+				AuthTag:       names.NewUserTag("admin1").String(),
+				Password:      "secret1",
+			},
+		}, {
+			ModelTag: randomModelTag(), // Doesn't exist.
+		}},
+	}
+	out, err := s.controller.MigrationPrecheckReports(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.Results, gc.HasLen, 2)
+	c.Check(out.Results[0], jc.DeepEquals, params.MigrationPrecheckResult{
+		ModelTag: st.ModelTag().String(),
+		Source: []params.MigrationPrecheckProblem{{
+			Entity:  "machine 0",
+			Message: "machine 0 is dying",
+		}},
+		Target: []params.MigrationPrecheckProblem{{
+			Entity:  "user bob",
+			Message: "model owner bob does not exist in target controller",
+		}},
+		ExportSize: 1024,
+	})
+	c.Check(out.Results[1].ModelTag, gc.Equals, args.Specs[1].ModelTag)
+	c.Check(out.Results[1].Error, gc.ErrorMatches, "unable to read model: .+")
+
+	// No migration is started.
+	active, err := st.IsMigrationActive()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(active, jc.IsFalse)
+}
+
+func (s *controllerSuite) TestMigrationPrecheckReportsError(c *gc.C) {
+	st := s.Factory.MakeModel(c, nil)
+	defer st.Close()
+
+	controller.SetPrecheckReport(s, migration.PrecheckReport{}, errors.New("boom"))
+
+	args := params.InitiateMigrationArgs{
+		Specs: []params.MigrationSpec{{
+			ModelTag: st.ModelTag().String(),
+			TargetInfo: params.MigrationTargetInfo{
+				ControllerTag: randomControllerTag(),
+				Addrs:         []string{"1.1.1.1:1111"},
+				CACert:        "cert1",
+				AuthTag:       names.NewUserTag("admin1").String(),
+				Password:      "secret1",
+			},
+		}},
+	}
+	out, err := s.controller.MigrationPrecheckReports(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(out.Results, gc.HasLen, 1)
+	c.Check(out.Results[0].Error, gc.ErrorMatches, "boom")
+}
+
 func randomControllerTag() string {
 	uuid := utils.MustNewUUID().String()
 	return names.NewControllerTag(uuid).String()
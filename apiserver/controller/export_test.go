@@ -17,3 +17,9 @@ func SetPrecheckResult(p patcher, err error) {
 		return err
 	})
 }
+
+func SetPrecheckReport(p patcher, report migration.PrecheckReport, err error) {
+	p.PatchValue(&runMigrationPrecheckReport, func(*state.State, migration.TargetInfo) (migration.PrecheckReport, error) {
+		return report, err
+	})
+}
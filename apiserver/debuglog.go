@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"syscall"
 	"time"
@@ -14,7 +15,6 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"golang.org/x/net/websocket"
-	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/params"
@@ -27,12 +27,26 @@ import (
 // variants. The supplied handle func allows for varied handling of
 // requests.
 type debugLogHandler struct {
-	ctxt   httpContext
-	handle debugLogHandlerFunc
+	ctxt         httpContext
+	authenticate debugLogAuthFunc
+	handle       debugLogHandlerFunc
 }
 
+// debugLogState describes the state methods required by the debug-log
+// handlers.
+type debugLogState interface {
+	state.LogTailerState
+
+	// AllModels returns all the models in the controller.
+	AllModels() ([]*state.Model, error)
+}
+
+// debugLogAuthFunc authenticates and authorizes a debug-log request,
+// returning the state for the model the logs should be read from.
+type debugLogAuthFunc func(*http.Request) (*state.State, error)
+
 type debugLogHandlerFunc func(
-	state.LogTailerState,
+	debugLogState,
 	*debugLogParams,
 	debugLogSocket,
 	<-chan struct{},
@@ -40,11 +54,13 @@ type debugLogHandlerFunc func(
 
 func newDebugLogHandler(
 	ctxt httpContext,
+	authenticate debugLogAuthFunc,
 	handle debugLogHandlerFunc,
 ) *debugLogHandler {
 	return &debugLogHandler{
-		ctxt:   ctxt,
-		handle: handle,
+		ctxt:         ctxt,
+		authenticate: authenticate,
+		handle:       handle,
 	}
 }
 
@@ -68,13 +84,25 @@ func newDebugLogHandler(
 //   replay -> string - one of [true, false], if true, start the file from the start
 //   noTail -> string - one of [true, false], if true, existing logs are sent back,
 //      - but the command does not wait for new ones.
+//   startTime -> string - RFC3339 time, only logs recorded at or after this time are sent
+//   endTime -> string - RFC3339 time, only logs recorded before this time are sent
+//      - existing logs are sent back, but the command does not wait for new ones.
+//   includeMessage -> []string - regular expressions, only messages matching one are sent
+//   excludeMessage -> []string - regular expressions, messages matching any are not sent
+//   includeLocation -> []string - lists source locations to include in the response
+//      - locations are of the form filename:lineno, and may omit the line number
+//        to match the whole file or include '*' to match any sequence of characters
+//   excludeLocation -> []string - lists source locations to exclude from the response
+//   includeModel -> []string - lists the names or UUIDs of models to include
+//      - only meaningful for the controller-wide log; if none are set, all models
+//        are included
 func (h *debugLogHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	server := websocket.Server{
 		Handler: func(conn *websocket.Conn) {
 			socket := &debugLogSocketImpl{conn}
 			defer conn.Close()
 
-			st, _, err := h.ctxt.stateForRequestAuthenticatedTag(req, names.MachineTagKind, names.UserTagKind)
+			st, err := h.authenticate(req)
 			if err != nil {
 				socket.sendError(err)
 				return
@@ -145,16 +173,22 @@ func (s *debugLogSocketImpl) sendLogRecord(record *params.LogMessage) error {
 
 // debugLogParams contains the parsed debuglog API request parameters.
 type debugLogParams struct {
-	startTime     time.Time
-	maxLines      uint
-	fromTheStart  bool
-	noTail        bool
-	backlog       uint
-	filterLevel   loggo.Level
-	includeEntity []string
-	excludeEntity []string
-	includeModule []string
-	excludeModule []string
+	startTime       time.Time
+	endTime         time.Time
+	maxLines        uint
+	fromTheStart    bool
+	noTail          bool
+	backlog         uint
+	filterLevel     loggo.Level
+	includeEntity   []string
+	excludeEntity   []string
+	includeModule   []string
+	excludeModule   []string
+	includeMessage  []string
+	excludeMessage  []string
+	includeLocation []string
+	excludeLocation []string
+	includeModel    []string
 }
 
 func readDebugLogParams(queryMap url.Values) (*debugLogParams, error) {
@@ -210,10 +244,34 @@ func readDebugLogParams(queryMap url.Values) (*debugLogParams, error) {
 		params.startTime = startTime
 	}
 
+	if value := queryMap.Get("endTime"); value != "" {
+		endTime, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, errors.Errorf("end time %q is not a valid time in RFC3339 format", value)
+		}
+		if !params.startTime.IsZero() && !endTime.After(params.startTime) {
+			return nil, errors.Errorf("end time %q is not after start time", value)
+		}
+		params.endTime = endTime
+	}
+
+	for _, key := range []string{"includeMessage", "excludeMessage"} {
+		for _, value := range queryMap[key] {
+			if _, err := regexp.Compile(value); err != nil {
+				return nil, errors.Errorf("%s value %q is not a valid regular expression", key, value)
+			}
+		}
+	}
+
 	params.includeEntity = queryMap["includeEntity"]
 	params.excludeEntity = queryMap["excludeEntity"]
 	params.includeModule = queryMap["includeModule"]
 	params.excludeModule = queryMap["excludeModule"]
+	params.includeMessage = queryMap["includeMessage"]
+	params.excludeMessage = queryMap["excludeMessage"]
+	params.includeLocation = queryMap["includeLocation"]
+	params.excludeLocation = queryMap["excludeLocation"]
+	params.includeModel = queryMap["includeModel"]
 
 	return params, nil
 }
@@ -0,0 +1,148 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// newDebugLogControllerHandler returns a handler which streams the
+// logs of every model hosted by the controller, or a chosen set of
+// them, merged into a single stream. Only controller superusers may
+// use it.
+func newDebugLogControllerHandler(ctxt httpContext) http.Handler {
+	authenticate := func(req *http.Request) (*state.State, error) {
+		st, entity, err := ctxt.stateAndEntityForRequestAuthenticatedUser(req)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if !st.IsController() {
+			ctxt.release(st)
+			return nil, errors.BadRequestf("model is not controller model")
+		}
+		superuser, err := st.IsControllerAdmin(entity.Tag().(names.UserTag))
+		if err != nil {
+			ctxt.release(st)
+			return nil, errors.Trace(err)
+		}
+		if !superuser {
+			ctxt.release(st)
+			return nil, common.ErrPerm
+		}
+		return st, nil
+	}
+	return newDebugLogHandler(ctxt, authenticate, handleDebugLogControllerRequest)
+}
+
+func handleDebugLogControllerRequest(
+	st debugLogState,
+	reqParams *debugLogParams,
+	socket debugLogSocket,
+	stop <-chan struct{},
+) error {
+	models := newModelNameCache(st)
+	params := makeLogTailerParams(reqParams)
+	params.AllModels = true
+	for _, model := range reqParams.includeModel {
+		uuids, err := models.lookup(model)
+		if err != nil {
+			socket.sendError(err)
+			return nil
+		}
+		params.ModelUUIDs = append(params.ModelUUIDs, uuids...)
+	}
+	return streamLogs(st, params, reqParams.maxLines, socket, stop, models.formatLogRecord)
+}
+
+// modelNameCache maps model UUIDs to model names, refreshing itself
+// when it encounters a model it doesn't know about.
+type modelNameCache struct {
+	st    debugLogState
+	names map[string]string
+}
+
+func newModelNameCache(st debugLogState) *modelNameCache {
+	return &modelNameCache{st: st}
+}
+
+func (c *modelNameCache) refresh() error {
+	modelNames, err := allModelNames(c.st)
+	if err != nil {
+		return errors.Annotate(err, "getting model names")
+	}
+	c.names = modelNames
+	return nil
+}
+
+// name returns the name of the model with the given UUID. If the model
+// no longer exists, the UUID is returned.
+func (c *modelNameCache) name(modelUUID string) (string, error) {
+	if name, ok := c.names[modelUUID]; ok {
+		return name, nil
+	}
+	if err := c.refresh(); err != nil {
+		return "", errors.Trace(err)
+	}
+	if name, ok := c.names[modelUUID]; ok {
+		return name, nil
+	}
+	// Remember the model so that we don't look it up again.
+	c.names[modelUUID] = modelUUID
+	return modelUUID, nil
+}
+
+// lookup returns the UUIDs of the models with the given name or UUID.
+// Model names are only unique per owner, so more than one model may
+// match.
+func (c *modelNameCache) lookup(model string) ([]string, error) {
+	if c.names == nil {
+		if err := c.refresh(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	var uuids []string
+	for uuid, name := range c.names {
+		if uuid == model || name == model {
+			uuids = append(uuids, uuid)
+		}
+	}
+	if len(uuids) == 0 {
+		return nil, errors.NotFoundf("model %q", model)
+	}
+	sort.Strings(uuids)
+	return uuids, nil
+}
+
+func (c *modelNameCache) formatLogRecord(r *state.LogRecord) (*params.LogMessage, error) {
+	name, err := c.name(r.ModelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	msg := formatLogRecord(r)
+	msg.ModelUUID = r.ModelUUID
+	msg.ModelName = name
+	return msg, nil
+}
+
+var allModelNames = _allModelNames // For replacing in tests
+
+func _allModelNames(st debugLogState) (map[string]string, error) {
+	models, err := st.AllModels()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	modelNames := make(map[string]string, len(models))
+	for _, model := range models {
+		modelNames[model.UUID()] = model.Name()
+	}
+	return modelNames, nil
+}
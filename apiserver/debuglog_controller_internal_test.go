@@ -0,0 +1,152 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/state"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type debugLogControllerIntSuite struct {
+	coretesting.BaseSuite
+	sock       *fakeDebugLogSocket
+	modelNames map[string]string
+}
+
+var _ = gc.Suite(&debugLogControllerIntSuite{})
+
+func (s *debugLogControllerIntSuite) SetUpTest(c *gc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.sock = newFakeDebugLogSocket()
+	s.modelNames = map[string]string{
+		"uuid-0": "controller",
+		"uuid-1": "default",
+		"uuid-2": "default",
+		"uuid-3": "other",
+	}
+	s.PatchValue(&allModelNames, func(debugLogState) (map[string]string, error) {
+		result := make(map[string]string)
+		for uuid, name := range s.modelNames {
+			result[uuid] = name
+		}
+		return result, nil
+	})
+}
+
+func (s *debugLogControllerIntSuite) TestParamConversion(c *gc.C) {
+	reqParams := &debugLogParams{
+		noTail:       true,
+		includeModel: []string{"default", "uuid-3"},
+	}
+
+	called := false
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params *state.LogTailerParams) (state.LogTailer, error) {
+		called = true
+		c.Assert(params.AllModels, jc.IsTrue)
+		c.Assert(params.NoTail, jc.IsTrue)
+		c.Assert(params.ModelUUIDs, jc.DeepEquals, []string{"uuid-1", "uuid-2", "uuid-3"})
+		return newFakeLogTailer(), nil
+	})
+
+	stop := make(chan struct{})
+	close(stop) // Stop the request immediately.
+	err := handleDebugLogControllerRequest(nil, reqParams, s.sock, stop)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(called, jc.IsTrue)
+}
+
+func (s *debugLogControllerIntSuite) TestUnknownModel(c *gc.C) {
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params *state.LogTailerParams) (state.LogTailer, error) {
+		c.Fatal("tailer should not be created")
+		return nil, nil
+	})
+	err := handleDebugLogControllerRequest(nil, &debugLogParams{includeModel: []string{"nope"}}, s.sock, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertOutput(c, []string{`err: model "nope" not found`})
+}
+
+func (s *debugLogControllerIntSuite) TestModelNamesIncluded(c *gc.C) {
+	tailer := newFakeLogTailer()
+	tailer.logsCh <- &state.LogRecord{
+		ModelUUID: "uuid-1",
+		Time:      time.Date(2015, 6, 19, 15, 34, 37, 0, time.UTC),
+		Entity:    names.NewMachineTag("99"),
+		Module:    "some.where",
+		Location:  "code.go:42",
+		Level:     loggo.INFO,
+		Message:   "stuff happened",
+	}
+	tailer.logsCh <- &state.LogRecord{
+		ModelUUID: "uuid-4",
+		Time:      time.Date(2015, 6, 19, 15, 36, 40, 0, time.UTC),
+		Entity:    names.NewUnitTag("foo/2"),
+		Module:    "else.where",
+		Location:  "go.go:22",
+		Level:     loggo.ERROR,
+		Message:   "whoops",
+	}
+	// The second record is from a model created after the request
+	// started, so the names must be refreshed.
+	s.modelNames["uuid-4"] = "new"
+	s.PatchValue(&newLogTailer, func(_ state.LogTailerState, params *state.LogTailerParams) (state.LogTailer, error) {
+		return tailer, nil
+	})
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- handleDebugLogControllerRequest(nil, &debugLogParams{}, s.sock, stop)
+	}()
+
+	s.assertOutput(c, []string{
+		"ok",
+		"default machine-99: 2015-06-19 15:34:37 INFO some.where code.go:42 stuff happened\n",
+		"new unit-foo-2: 2015-06-19 15:36:40 ERROR else.where go.go:22 whoops\n",
+	})
+
+	close(stop)
+	select {
+	case err := <-done:
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(tailer.stopped, jc.IsTrue)
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for request handler to stop")
+	}
+}
+
+func (s *debugLogControllerIntSuite) TestModelNameCacheRemovedModel(c *gc.C) {
+	cache := newModelNameCache(nil)
+	name, err := cache.name("uuid-9")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(name, gc.Equals, "uuid-9")
+}
+
+func (s *debugLogControllerIntSuite) TestModelNameCacheError(c *gc.C) {
+	s.PatchValue(&allModelNames, func(debugLogState) (map[string]string, error) {
+		return nil, errors.New("boom")
+	})
+	cache := newModelNameCache(nil)
+	_, err := cache.name("uuid-1")
+	c.Assert(err, gc.ErrorMatches, "getting model names: boom")
+}
+
+func (s *debugLogControllerIntSuite) assertOutput(c *gc.C, expectedWrites []string) {
+	timeout := time.After(coretesting.LongWait)
+	for i, expectedWrite := range expectedWrites {
+		select {
+		case actualWrite := <-s.sock.writes:
+			c.Assert(actualWrite, gc.Equals, expectedWrite)
+		case <-timeout:
+			c.Fatalf("timed out waiting for socket write (received %d)", i)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver_test
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils"
+	gc "gopkg.in/check.v1"
+)
+
+type debugLogControllerSuite struct {
+	authHTTPSuite
+}
+
+var _ = gc.Suite(&debugLogControllerSuite{})
+
+// See debuglog_controller_internal_test.go for unit tests of the
+// request handling.
+
+func (s *debugLogControllerSuite) TestNoAuth(c *gc.C) {
+	reader := s.openWebsocket(c, nil, nil)
+	assertJSONError(c, reader, "no credentials provided")
+	assertWebsocketClosed(c, reader)
+}
+
+func (s *debugLogControllerSuite) TestNonSuperuserRejected(c *gc.C) {
+	header := utils.BasicAuthHeader(s.userTag.String(), s.password)
+	reader := s.openWebsocket(c, noResultsPlease, header)
+	assertJSONError(c, reader, "permission denied")
+	assertWebsocketClosed(c, reader)
+}
+
+func (s *debugLogControllerSuite) TestSuperuserAccepted(c *gc.C) {
+	header := utils.BasicAuthHeader(s.AdminUserTag(c).String(), "dummy-secret")
+	reader := s.openWebsocket(c, noResultsPlease, header)
+	result := readJSONErrorLine(c, reader)
+	c.Assert(result.Error, gc.IsNil)
+	_, err := ioutil.ReadAll(reader)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *debugLogControllerSuite) TestUnknownModel(c *gc.C) {
+	header := utils.BasicAuthHeader(s.AdminUserTag(c).String(), "dummy-secret")
+	reader := s.openWebsocket(c, url.Values{"includeModel": {"nope"}}, header)
+	assertJSONError(c, reader, `model "nope" not found`)
+	assertWebsocketClosed(c, reader)
+}
+
+func (s *debugLogControllerSuite) openWebsocket(c *gc.C, values url.Values, header http.Header) *bufio.Reader {
+	server := s.makeURL(c, "wss", "/controller/log", values).String()
+	conn := dialWebsocketFromURL(c, server, header)
+	s.AddCleanup(func(_ *gc.C) { conn.Close() })
+	return bufio.NewReader(conn)
+}
@@ -7,23 +7,45 @@ import (
 	"net/http"
 
 	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/state"
 )
 
 func newDebugLogDBHandler(ctxt httpContext) http.Handler {
-	return newDebugLogHandler(ctxt, handleDebugLogDBRequest)
+	authenticate := func(req *http.Request) (*state.State, error) {
+		st, _, err := ctxt.stateForRequestAuthenticatedTag(req, names.MachineTagKind, names.UserTagKind)
+		return st, err
+	}
+	return newDebugLogHandler(ctxt, authenticate, handleDebugLogDBRequest)
 }
 
 func handleDebugLogDBRequest(
-	st state.LogTailerState,
+	st debugLogState,
 	reqParams *debugLogParams,
 	socket debugLogSocket,
 	stop <-chan struct{},
 ) error {
-	params := makeLogTailerParams(reqParams)
-	tailer, err := newLogTailer(st, params)
+	tailerParams := makeLogTailerParams(reqParams)
+	format := func(r *state.LogRecord) (*params.LogMessage, error) {
+		return formatLogRecord(r), nil
+	}
+	return streamLogs(st, tailerParams, reqParams.maxLines, socket, stop, format)
+}
+
+// streamLogs sends the log records matching the given tailer params
+// to the socket, formatted by the supplied function, until maxLines
+// (if non-zero) have been sent or the stop channel is closed.
+func streamLogs(
+	st state.LogTailerState,
+	tailerParams *state.LogTailerParams,
+	maxLines uint,
+	socket debugLogSocket,
+	stop <-chan struct{},
+	format func(*state.LogRecord) (*params.LogMessage, error),
+) error {
+	tailer, err := newLogTailer(st, tailerParams)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -42,12 +64,16 @@ func handleDebugLogDBRequest(
 				return errors.Annotate(tailer.Err(), "tailer stopped")
 			}
 
-			if err := socket.sendLogRecord(formatLogRecord(rec)); err != nil {
+			msg, err := format(rec)
+			if err != nil {
+				return errors.Annotate(err, "formatting failed")
+			}
+			if err := socket.sendLogRecord(msg); err != nil {
 				return errors.Annotate(err, "sending failed")
 			}
 
 			lineCount++
-			if reqParams.maxLines > 0 && lineCount == reqParams.maxLines {
+			if maxLines > 0 && lineCount == maxLines {
 				return nil
 			}
 		}
@@ -56,14 +82,19 @@ func handleDebugLogDBRequest(
 
 func makeLogTailerParams(reqParams *debugLogParams) *state.LogTailerParams {
 	params := &state.LogTailerParams{
-		MinLevel:      reqParams.filterLevel,
-		NoTail:        reqParams.noTail,
-		StartTime:     reqParams.startTime,
-		InitialLines:  int(reqParams.backlog),
-		IncludeEntity: reqParams.includeEntity,
-		ExcludeEntity: reqParams.excludeEntity,
-		IncludeModule: reqParams.includeModule,
-		ExcludeModule: reqParams.excludeModule,
+		MinLevel:        reqParams.filterLevel,
+		NoTail:          reqParams.noTail,
+		StartTime:       reqParams.startTime,
+		EndTime:         reqParams.endTime,
+		InitialLines:    int(reqParams.backlog),
+		IncludeEntity:   reqParams.includeEntity,
+		ExcludeEntity:   reqParams.excludeEntity,
+		IncludeModule:   reqParams.includeModule,
+		ExcludeModule:   reqParams.excludeModule,
+		IncludeMessage:  reqParams.includeMessage,
+		ExcludeMessage:  reqParams.excludeMessage,
+		IncludeLocation: reqParams.includeLocation,
+		ExcludeLocation: reqParams.excludeLocation,
 	}
 	if reqParams.fromTheStart {
 		params.InitialLines = 0
@@ -31,16 +31,22 @@ func (s *debugLogDBIntSuite) SetUpTest(c *gc.C) {
 
 func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 	t1 := time.Date(2016, 11, 30, 10, 51, 0, 0, time.UTC)
+	t2 := time.Date(2016, 11, 30, 11, 51, 0, 0, time.UTC)
 	reqParams := &debugLogParams{
-		fromTheStart:  false,
-		noTail:        true,
-		backlog:       11,
-		startTime:     t1,
-		filterLevel:   loggo.INFO,
-		includeEntity: []string{"foo"},
-		includeModule: []string{"bar"},
-		excludeEntity: []string{"baz"},
-		excludeModule: []string{"qux"},
+		fromTheStart:    false,
+		noTail:          true,
+		backlog:         11,
+		startTime:       t1,
+		endTime:         t2,
+		filterLevel:     loggo.INFO,
+		includeEntity:   []string{"foo"},
+		includeModule:   []string{"bar"},
+		excludeEntity:   []string{"baz"},
+		excludeModule:   []string{"qux"},
+		includeMessage:  []string{"^hook"},
+		excludeMessage:  []string{"failed$"},
+		includeLocation: []string{"uniter.go"},
+		excludeLocation: []string{"op.go:10"},
 	}
 
 	called := false
@@ -50,6 +56,7 @@ func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 		// Start time will be used once the client is extended to send
 		// time range arguments.
 		c.Assert(params.StartTime, gc.Equals, t1)
+		c.Assert(params.EndTime, gc.Equals, t2)
 		c.Assert(params.NoTail, jc.IsTrue)
 		c.Assert(params.MinLevel, gc.Equals, loggo.INFO)
 		c.Assert(params.InitialLines, gc.Equals, 11)
@@ -57,6 +64,10 @@ func (s *debugLogDBIntSuite) TestParamConversion(c *gc.C) {
 		c.Assert(params.IncludeModule, jc.DeepEquals, []string{"bar"})
 		c.Assert(params.ExcludeEntity, jc.DeepEquals, []string{"baz"})
 		c.Assert(params.ExcludeModule, jc.DeepEquals, []string{"qux"})
+		c.Assert(params.IncludeMessage, jc.DeepEquals, []string{"^hook"})
+		c.Assert(params.ExcludeMessage, jc.DeepEquals, []string{"failed$"})
+		c.Assert(params.IncludeLocation, jc.DeepEquals, []string{"uniter.go"})
+		c.Assert(params.ExcludeLocation, jc.DeepEquals, []string{"op.go:10"})
 
 		return newFakeLogTailer(), nil
 	})
@@ -201,7 +212,7 @@ func (s *debugLogDBIntSuite) assertStops(c *gc.C, done chan error, tailer *fakeL
 }
 
 type fakeState struct {
-	state.LogTailerState
+	debugLogState
 }
 
 func newFakeLogTailer() *fakeLogTailer {
@@ -248,7 +259,12 @@ func (s *fakeDebugLogSocket) sendError(err error) {
 }
 
 func (s *fakeDebugLogSocket) sendLogRecord(r *params.LogMessage) error {
-	s.writes <- fmt.Sprintf("%s: %s %s %s %s %s\n",
+	var model string
+	if r.ModelName != "" {
+		model = r.ModelName + " "
+	}
+	s.writes <- fmt.Sprintf("%s%s: %s %s %s %s %s\n",
+		model,
 		r.Entity,
 		s.formatTime(r.Timestamp),
 		r.Severity,
@@ -26,6 +26,7 @@ var (
 	MaxClientPingInterval = maxClientPingInterval
 	MongoPingInterval     = mongoPingInterval
 	NewBackups            = &newBackups
+	NewBackupsWithTarget  = &newBackupsWithTarget
 	BZMimeType            = bzMimeType
 	JSMimeType            = jsMimeType
 	SpritePath            = spritePath
@@ -20,6 +20,8 @@ import (
 
 func init() {
 	common.RegisterStandardFacade("MigrationTarget", 1, NewAPI)
+	// Facade version 2 adds PrecheckProblems().
+	common.RegisterStandardFacade("MigrationTarget", 2, NewAPI)
 }
 
 // API implements the API required for the model migration
@@ -63,7 +65,7 @@ func checkAuth(authorizer facade.Authorizer, st *state.State) error {
 // Prechecks ensure that the target controller is ready to accept a
 // model migration.
 func (api *API) Prechecks(model params.MigrationModelInfo) error {
-	ownerTag, err := names.ParseUserTag(model.OwnerTag)
+	modelInfo, err := modelInfoFromParams(model)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -71,16 +73,60 @@ func (api *API) Prechecks(model params.MigrationModelInfo) error {
 	if err != nil {
 		return errors.Annotate(err, "creating backend")
 	}
-	return migration.TargetPrecheck(
-		backend,
-		coremigration.ModelInfo{
-			UUID:                   model.UUID,
-			Name:                   model.Name,
-			Owner:                  ownerTag,
-			AgentVersion:           model.AgentVersion,
-			ControllerAgentVersion: model.ControllerAgentVersion,
-		},
-	)
+	return migration.TargetPrecheck(backend, modelInfo)
+}
+
+// PrecheckProblems runs every check made by Prechecks, and reports all
+// the problems that would prevent the target controller from accepting
+// the model migration.
+func (api *API) PrecheckProblems(model params.MigrationModelInfo) (params.MigrationPrecheckProblems, error) {
+	var result params.MigrationPrecheckProblems
+	modelInfo, err := modelInfoFromParams(model)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	backend, err := migration.PrecheckShim(api.state)
+	if err != nil {
+		return result, errors.Annotate(err, "creating backend")
+	}
+	problems, err := migration.TargetPrecheckProblems(backend, modelInfo)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Problems = make([]params.MigrationPrecheckProblem, len(problems))
+	for i, problem := range problems {
+		result.Problems[i] = params.MigrationPrecheckProblem{
+			Entity:  problem.Entity,
+			Message: problem.Message,
+		}
+	}
+	return result, nil
+}
+
+func modelInfoFromParams(model params.MigrationModelInfo) (coremigration.ModelInfo, error) {
+	ownerTag, err := names.ParseUserTag(model.OwnerTag)
+	if err != nil {
+		return coremigration.ModelInfo{}, errors.Trace(err)
+	}
+	modelInfo := coremigration.ModelInfo{
+		UUID:                   model.UUID,
+		Name:                   model.Name,
+		Owner:                  ownerTag,
+		AgentVersion:           model.AgentVersion,
+		ControllerAgentVersion: model.ControllerAgentVersion,
+		CloudName:              model.CloudName,
+	}
+	if model.CloudCredentialTag != "" {
+		credTag, err := names.ParseCloudCredentialTag(model.CloudCredentialTag)
+		if err != nil {
+			return coremigration.ModelInfo{}, errors.Trace(err)
+		}
+		modelInfo.CloudCredential = &coremigration.CredentialInfo{
+			Tag:      credTag,
+			AuthType: model.CloudCredentialAuthType,
+		}
+	}
+	return modelInfo, nil
 }
 
 // Import takes a serialized Juju model, deserializes it, and
@@ -4,6 +4,7 @@
 package migrationtarget_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/juju/errors"
@@ -111,6 +112,50 @@ func (s *Suite) TestPrechecksFail(c *gc.C) {
 	c.Assert(err, gc.NotNil)
 }
 
+func (s *Suite) TestPrecheckProblems(c *gc.C) {
+	controllerVersion := s.controllerVersion(c)
+	modelVersion := controllerVersion
+	modelVersion.Minor++
+
+	api := s.mustNewAPI(c)
+	args := params.MigrationModelInfo{
+		UUID:                   "uuid",
+		Name:                   "some-model",
+		OwnerTag:               names.NewUserTag("someone").String(),
+		AgentVersion:           modelVersion,
+		ControllerAgentVersion: controllerVersion,
+		CloudName:              "elsewhere",
+	}
+	result, err := api.PrecheckProblems(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Problems, jc.DeepEquals, []params.MigrationPrecheckProblem{{
+		Entity: "controller",
+		Message: fmt.Sprintf("model has higher version than target controller (%s > %s)",
+			modelVersion, controllerVersion),
+	}, {
+		Entity:  "user someone",
+		Message: "model owner someone does not exist in target controller",
+	}, {
+		Entity:  "controller",
+		Message: "model cloud elsewhere does not match target controller cloud dummy",
+	}})
+}
+
+func (s *Suite) TestPrecheckProblemsNone(c *gc.C) {
+	api := s.mustNewAPI(c)
+	args := params.MigrationModelInfo{
+		UUID:                   "uuid",
+		Name:                   "some-model",
+		OwnerTag:               s.Owner.String(),
+		AgentVersion:           s.controllerVersion(c),
+		ControllerAgentVersion: s.controllerVersion(c),
+		CloudName:              "dummy",
+	}
+	result, err := api.PrecheckProblems(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Problems, gc.HasLen, 0)
+}
+
 func (s *Suite) TestImport(c *gc.C) {
 	api := s.mustNewAPI(c)
 	tag := s.importModel(c, api)
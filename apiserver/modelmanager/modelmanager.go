@@ -38,6 +38,8 @@ var logger = loggo.GetLogger("juju.apiserver.modelmanager")
 
 func init() {
 	common.RegisterStandardFacade("ModelManager", 2, newFacade)
+	// Version 3 adds ModifyApplicationAccess.
+	common.RegisterStandardFacade("ModelManager", 3, newFacade)
 }
 
 // ModelManager defines the methods on the modelmanager API endpoint.
@@ -701,6 +703,61 @@ func (m *ModelManagerAPI) ModifyModelAccess(args params.ModifyModelAccessRequest
 	return result, nil
 }
 
+// ModifyApplicationAccess changes the access granted to users on
+// applications. Application access is granted in addition to the
+// access the users have on the applications' models.
+func (m *ModelManagerAPI) ModifyApplicationAccess(args params.ModifyApplicationAccessRequest) (result params.ErrorResults, _ error) {
+	result = params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Changes)),
+	}
+
+	canModifyController, err := m.authorizer.HasPermission(permission.SuperuserAccess, m.state.ControllerTag())
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	if len(args.Changes) == 0 {
+		return result, nil
+	}
+
+	for i, arg := range args.Changes {
+		access := permission.Access(arg.Access)
+		if err := permission.ValidateApplicationAccess(access); err != nil {
+			err = errors.Annotate(err, "could not modify application access")
+			result.Results[i].Error = common.ServerError(err)
+			continue
+		}
+
+		modelTag, err := names.ParseModelTag(arg.ModelTag)
+		if err != nil {
+			result.Results[i].Error = common.ServerError(errors.Annotate(err, "could not modify application access"))
+			continue
+		}
+		canModifyModel, err := m.authorizer.HasPermission(permission.AdminAccess, modelTag)
+		if err != nil {
+			return result, errors.Trace(err)
+		}
+		if !canModifyController && !canModifyModel {
+			result.Results[i].Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+
+		applicationTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+		if err != nil {
+			result.Results[i].Error = common.ServerError(errors.Annotate(err, "could not modify application access"))
+			continue
+		}
+		targetUserTag, err := names.ParseUserTag(arg.UserTag)
+		if err != nil {
+			result.Results[i].Error = common.ServerError(errors.Annotate(err, "could not modify application access"))
+			continue
+		}
+
+		result.Results[i].Error = common.ServerError(
+			changeApplicationAccess(m.state, modelTag, applicationTag, m.apiUser, targetUserTag, arg.Action, access, m.isAdmin))
+	}
+	return result, nil
+}
+
 func userAuthorizedToChangeAccess(st common.ModelManagerBackend, userIsAdmin bool, userTag names.UserTag) error {
 	if userIsAdmin {
 		// Just confirm that the model that has been given is a valid model.
@@ -757,6 +814,11 @@ func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.Model
 			if modelUser.Access.EqualOrGreaterModelAccessThan(access) {
 				return errors.Errorf("user already has %q access or greater", access)
 			}
+			// Capabilities are only granted in addition to read access;
+			// granting one must not take away write access.
+			if access.IsCapabilityModelAccess() && modelUser.Access.GreaterModelAccessThan(permission.ReadAccess) {
+				return errors.Errorf("user already has %q access", modelUser.Access)
+			}
 			if _, err = st.SetUserAccess(modelUser.UserTag, modelUser.Object, access); err != nil {
 				return errors.Annotate(err, "could not set model access for user")
 			}
@@ -778,6 +840,17 @@ func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.Model
 			}
 			_, err = st.SetUserAccess(modelUser.UserTag, modelUser.Object, permission.ReadAccess)
 			return errors.Annotate(err, "could not set model access to read-only")
+		case permission.RunActionsAccess, permission.DebugAccess, permission.DeployAccess:
+			// Revoking a capability sets read-only.
+			modelUser, err := st.UserAccess(targetUserTag, modelTag)
+			if err != nil {
+				return errors.Annotate(err, "could not look up model access for user")
+			}
+			if modelUser.Access != access {
+				return errors.Errorf("user does not have %q access", access)
+			}
+			_, err = st.SetUserAccess(modelUser.UserTag, modelUser.Object, permission.ReadAccess)
+			return errors.Annotate(err, "could not set model access to read-only")
 		case permission.AdminAccess:
 			// Revoking admin access sets read-write.
 			modelUser, err := st.UserAccess(targetUserTag, modelTag)
@@ -796,6 +869,63 @@ func changeModelAccess(accessor common.ModelManagerBackend, modelTag names.Model
 	}
 }
 
+// changeApplicationAccess performs the requested access grant or revoke
+// action for the specified user on the specified application.
+func changeApplicationAccess(
+	accessor common.ModelManagerBackend,
+	modelTag names.ModelTag, applicationTag names.ApplicationTag,
+	apiUser, targetUserTag names.UserTag,
+	action params.ModelAction, access permission.Access, userIsAdmin bool,
+) error {
+	st, err := accessor.ForModel(modelTag)
+	if err != nil {
+		return errors.Annotate(err, "could not lookup model")
+	}
+	defer st.Close()
+
+	if err := userAuthorizedToChangeAccess(st, userIsAdmin, apiUser); err != nil {
+		return errors.Trace(err)
+	}
+
+	switch action {
+	case params.GrantModelAccess:
+		appUser, err := st.UserAccess(targetUserTag, applicationTag)
+		if err == nil && appUser.Access.EqualOrGreaterModelAccessThan(access) {
+			return errors.Errorf("user already has %q access or greater", access)
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.Annotate(err, "could not look up application access for user")
+		}
+		_, err = st.SetUserAccess(targetUserTag, applicationTag, access)
+		return errors.Annotate(err, "could not grant application access")
+
+	case params.RevokeModelAccess:
+		switch access {
+		case permission.WriteAccess:
+			// Revoking write access removes all access to the application.
+			err := st.RemoveUserAccess(targetUserTag, applicationTag)
+			return errors.Annotate(err, "could not revoke application access")
+		case permission.AdminAccess:
+			// Revoking admin access sets read-write.
+			appUser, err := st.UserAccess(targetUserTag, applicationTag)
+			if err != nil {
+				return errors.Annotate(err, "could not look up application access for user")
+			}
+			if appUser.Access != permission.AdminAccess {
+				return errors.Errorf("user does not have %q access", access)
+			}
+			_, err = st.SetUserAccess(targetUserTag, applicationTag, permission.WriteAccess)
+			return errors.Annotate(err, "could not set application access to read-write")
+
+		default:
+			return errors.Errorf("don't know how to revoke %q access", access)
+		}
+
+	default:
+		return errors.Errorf("unknown action %q", action)
+	}
+}
+
 // ModelDefaults returns the default config values used when creating a new model.
 func (m *ModelManagerAPI) ModelDefaults() (params.ModelDefaultsResult, error) {
 	result := params.ModelDefaultsResult{}
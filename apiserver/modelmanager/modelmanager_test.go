@@ -1187,6 +1187,125 @@ func (s *modelManagerStateSuite) TestGrantModelIncreaseAccess(c *gc.C) {
 	c.Assert(modelUser.Access, gc.Equals, permission.WriteAccess)
 }
 
+func (s *modelManagerStateSuite) TestGrantModelCapability(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	st := s.Factory.MakeModel(c, nil)
+	defer st.Close()
+	stFactory := factory.NewFactory(st)
+	user := stFactory.MakeModelUser(c, &factory.ModelUserParams{Access: permission.ReadAccess})
+
+	err := s.grant(c, user.UserTag, params.ModelRunActionsAccess, st.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	modelUser, err := st.UserAccess(user.UserTag, st.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modelUser.Access, gc.Equals, permission.RunActionsAccess)
+}
+
+func (s *modelManagerStateSuite) TestGrantModelCapabilityKeepsWriteAccess(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	st := s.Factory.MakeModel(c, nil)
+	defer st.Close()
+	stFactory := factory.NewFactory(st)
+	user := stFactory.MakeModelUser(c, &factory.ModelUserParams{Access: permission.WriteAccess})
+
+	err := s.grant(c, user.UserTag, params.ModelDeployAccess, st.ModelTag())
+	c.Assert(err, gc.ErrorMatches, `user already has "write" access`)
+
+	modelUser, err := st.UserAccess(user.UserTag, st.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modelUser.Access, gc.Equals, permission.WriteAccess)
+}
+
+func (s *modelManagerStateSuite) TestRevokeModelCapabilityLeavesReadAccess(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	user := s.Factory.MakeModelUser(c, &factory.ModelUserParams{Access: permission.DebugAccess})
+
+	err := s.revoke(c, user.UserTag, params.ModelRunActionsAccess, user.Object.(names.ModelTag))
+	c.Assert(err, gc.ErrorMatches, `user does not have "run-actions" access`)
+
+	err = s.revoke(c, user.UserTag, params.ModelDebugAccess, user.Object.(names.ModelTag))
+	c.Assert(err, jc.ErrorIsNil)
+
+	modelUser, err := s.State.UserAccess(user.UserTag, user.Object)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modelUser.Access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *modelManagerStateSuite) modifyApplicationAccess(c *gc.C, user names.UserTag, action params.ModelAction, access params.UserAccessPermission, app names.ApplicationTag) error {
+	args := params.ModifyApplicationAccessRequest{
+		Changes: []params.ModifyApplicationAccess{{
+			UserTag:        user.String(),
+			Action:         action,
+			Access:         access,
+			ModelTag:       s.State.ModelTag().String(),
+			ApplicationTag: app.String(),
+		}}}
+
+	result, err := s.modelmanager.ModifyApplicationAccess(args)
+	if err != nil {
+		return err
+	}
+	return result.OneError()
+}
+
+func (s *modelManagerStateSuite) TestGrantApplicationAccess(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	app := s.Factory.MakeApplication(c, nil)
+	user := s.Factory.MakeModelUser(c, &factory.ModelUserParams{Access: permission.ReadAccess})
+
+	err := s.modifyApplicationAccess(c, user.UserTag, params.GrantModelAccess, params.ModelWriteAccess, app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	appUser, err := s.State.UserAccess(user.UserTag, app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(appUser.Access, gc.Equals, permission.WriteAccess)
+
+	err = s.modifyApplicationAccess(c, user.UserTag, params.GrantModelAccess, params.ModelWriteAccess, app.ApplicationTag())
+	c.Assert(err, gc.ErrorMatches, `user already has "write" access or greater`)
+
+	// The model access is unchanged.
+	modelUser, err := s.State.UserAccess(user.UserTag, s.State.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modelUser.Access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *modelManagerStateSuite) TestGrantApplicationReadAccessFails(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	app := s.Factory.MakeApplication(c, nil)
+	user := s.Factory.MakeModelUser(c, nil)
+
+	err := s.modifyApplicationAccess(c, user.UserTag, params.GrantModelAccess, params.ModelReadAccess, app.ApplicationTag())
+	c.Assert(err, gc.ErrorMatches, `could not modify application access: "read" application access not valid`)
+}
+
+func (s *modelManagerStateSuite) TestGrantApplicationAccessRequiresModelAdmin(c *gc.C) {
+	app := s.Factory.MakeApplication(c, nil)
+	user := s.Factory.MakeModelUser(c, &factory.ModelUserParams{Access: permission.WriteAccess})
+	s.setAPIUser(c, user.UserTag)
+
+	err := s.modifyApplicationAccess(c, user.UserTag, params.GrantModelAccess, params.ModelAdminAccess, app.ApplicationTag())
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+func (s *modelManagerStateSuite) TestRevokeApplicationAccess(c *gc.C) {
+	s.setAPIUser(c, s.AdminUserTag(c))
+	app := s.Factory.MakeApplication(c, nil)
+	user := s.Factory.MakeModelUser(c, nil)
+	_, err := s.State.SetUserAccess(user.UserTag, app.ApplicationTag(), permission.AdminAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.modifyApplicationAccess(c, user.UserTag, params.RevokeModelAccess, params.ModelAdminAccess, app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	appUser, err := s.State.UserAccess(user.UserTag, app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(appUser.Access, gc.Equals, permission.WriteAccess)
+
+	err = s.modifyApplicationAccess(c, user.UserTag, params.RevokeModelAccess, params.ModelWriteAccess, app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.UserAccess(user.UserTag, app.ApplicationTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
 func (s *modelManagerStateSuite) TestGrantToModelNoAccess(c *gc.C) {
 	s.setAPIUser(c, s.AdminUserTag(c))
 	st := s.Factory.MakeModel(c, nil)
@@ -25,6 +25,10 @@ type AuditContext struct {
 	// ModelUUID is the UUID of the model the audit observer is
 	// currently running on.
 	ModelUUID string
+
+	// Policy determines how much detail is recorded for each API
+	// call, and which argument values are redacted.
+	Policy AuditPolicy
 }
 
 type ErrorHandler func(error)
@@ -34,6 +38,7 @@ func NewAudit(ctx *AuditContext, handleAuditEntry audit.AuditEntrySinkFn, errorH
 	return &Audit{
 		jujuServerVersion: ctx.JujuServerVersion,
 		modelUUID:         ctx.ModelUUID,
+		policy:            ctx.Policy,
 		errorHandler:      errorHandler,
 		handleAuditEntry:  handleAuditEntry,
 	}
@@ -44,6 +49,7 @@ func NewAudit(ctx *AuditContext, handleAuditEntry audit.AuditEntrySinkFn, errorH
 type Audit struct {
 	jujuServerVersion version.Number
 	modelUUID         string
+	policy            AuditPolicy
 	errorHandler      ErrorHandler
 	handleAuditEntry  audit.AuditEntrySinkFn
 
@@ -79,6 +85,7 @@ func (a *Audit) RPCObserver() rpc.Observer {
 	return &AuditRPCObserver{
 		jujuServerVersion: a.jujuServerVersion,
 		modelUUID:         a.modelUUID,
+		policy:            a.policy,
 		errorHandler:      a.errorHandler,
 		handleAuditEntry:  a.handleAuditEntry,
 		authenticatedTag:  a.state.authenticatedTag,
@@ -87,32 +94,75 @@ func (a *Audit) RPCObserver() rpc.Observer {
 }
 
 // AuditRPCObserver is an observer which will log RPC requests using
-// the function provided.
+// the function provided. A new AuditRPCObserver is created for each
+// request, so it is safe to carry state from ServerRequest through
+// to ServerReply.
 type AuditRPCObserver struct {
 	jujuServerVersion version.Number
 	modelUUID         string
+	policy            AuditPolicy
 	errorHandler      ErrorHandler
 	handleAuditEntry  audit.AuditEntrySinkFn
 	authenticatedTag  string
 	remoteAddress     string
+
+	// captured holds the redacted arguments of the request being
+	// observed, when the policy requires them to be recorded.
+	captured *capturedRequest
+}
+
+type capturedRequest struct {
+	timestamp time.Time
+	args      interface{}
 }
 
 // ServerRequest implements Observer.
 func (a *AuditRPCObserver) ServerRequest(hdr *rpc.Header, body interface{}) {
+	if a.policy.CaptureArgs {
+		// The entry is recorded once the reply is known, so that
+		// it can include the outcome of the call.
+		if !a.policy.Excluded(hdr.Request) {
+			a.captured = &capturedRequest{
+				timestamp: time.Now().UTC(),
+				args:      a.policy.Redact(body),
+			}
+		}
+		return
+	}
 	auditEntry := a.boilerplateAuditEntry()
-	auditEntry.OriginName = a.authenticatedTag
-
 	auditEntry.OriginType = "API request"
 	auditEntry.Operation = rpcRequestToOperation(hdr.Request)
-	auditEntry.Data = map[string]interface{}{"request-body": body}
-	err := a.handleAuditEntry(auditEntry)
-	if err != nil {
-		a.errorHandler(errors.Trace(err))
-	}
+	a.writeEntry(auditEntry)
 }
 
 // ServerReply implements Observer.
-func (a *AuditRPCObserver) ServerReply(rpc.Request, *rpc.Header, interface{}) {}
+func (a *AuditRPCObserver) ServerReply(req rpc.Request, hdr *rpc.Header, _ interface{}) {
+	if a.captured == nil {
+		return
+	}
+	auditEntry := a.boilerplateAuditEntry()
+	auditEntry.Timestamp = a.captured.timestamp
+	auditEntry.OriginType = "API request"
+	auditEntry.Operation = rpcRequestToOperation(req)
+	auditEntry.Data = map[string]interface{}{
+		"facade":     req.Type,
+		"method":     req.Action,
+		"version":    req.Version,
+		"args":       a.captured.args,
+		"error-code": hdr.ErrorCode,
+	}
+	if hdr.Error != "" {
+		auditEntry.Data["error"] = hdr.Error
+	}
+	a.captured = nil
+	a.writeEntry(auditEntry)
+}
+
+func (a *AuditRPCObserver) writeEntry(auditEntry audit.AuditEntry) {
+	if err := a.handleAuditEntry(auditEntry); err != nil {
+		a.errorHandler(errors.Trace(err))
+	}
+}
 
 func (a *AuditRPCObserver) boilerplateAuditEntry() audit.AuditEntry {
 	return audit.AuditEntry{
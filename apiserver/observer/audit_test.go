@@ -0,0 +1,173 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer_test
+
+import (
+	"net/http"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/set"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/rpc"
+)
+
+type auditSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&auditSuite{})
+
+func (*auditSuite) newObserver(c *gc.C, policy observer.AuditPolicy) (rpc.Observer, *[]audit.AuditEntry) {
+	var entries []audit.AuditEntry
+	a := observer.NewAudit(&observer.AuditContext{
+		JujuServerVersion: version.MustParse("2.0.0"),
+		ModelUUID:         "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		Policy:            policy,
+	}, func(entry audit.AuditEntry) error {
+		entries = append(entries, entry)
+		return nil
+	}, func(err error) {
+		c.Errorf("unexpected error: %v", err)
+	})
+	a.Join(&http.Request{RemoteAddr: "10.0.0.1:1234"}, 1)
+	a.Login(names.NewUserTag("bob"), names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"), false, "")
+	return a.RPCObserver(), &entries
+}
+
+func (s *auditSuite) TestOperationOnly(c *gc.C) {
+	obs, entries := s.newObserver(c, observer.AuditPolicy{})
+	req := rpc.Request{Type: "Application", Version: 1, Action: "Deploy"}
+	obs.ServerRequest(&rpc.Header{Request: req}, map[string]string{"password": "sekrit"})
+	obs.ServerReply(req, &rpc.Header{}, nil)
+
+	c.Assert(*entries, gc.HasLen, 1)
+	entry := (*entries)[0]
+	c.Check(entry.OriginName, gc.Equals, "user-bob")
+	c.Check(entry.RemoteAddress, gc.Equals, "10.0.0.1:1234")
+	c.Check(entry.Operation, gc.Equals, "Application:v1 - Deploy")
+	c.Check(entry.Data, gc.IsNil)
+}
+
+func (s *auditSuite) TestCaptureArgs(c *gc.C) {
+	obs, entries := s.newObserver(c, observer.AuditPolicy{CaptureArgs: true})
+	req := rpc.Request{Type: "Application", Version: 1, Action: "Deploy"}
+	obs.ServerRequest(&rpc.Header{Request: req}, map[string]string{
+		"application": "mysql",
+		"password":    "sekrit",
+	})
+	c.Assert(*entries, gc.HasLen, 0)
+
+	obs.ServerReply(req, &rpc.Header{Error: "boom", ErrorCode: "not found"}, nil)
+	c.Assert(*entries, gc.HasLen, 1)
+	entry := (*entries)[0]
+	c.Check(entry.Operation, gc.Equals, "Application:v1 - Deploy")
+	c.Check(entry.Data, jc.DeepEquals, map[string]interface{}{
+		"facade":  "Application",
+		"method":  "Deploy",
+		"version": 1,
+		"args": map[string]interface{}{
+			"application": "mysql",
+			"password":    "<redacted>",
+		},
+		"error-code": "not found",
+		"error":      "boom",
+	})
+}
+
+func (s *auditSuite) TestCaptureArgsExcluded(c *gc.C) {
+	obs, entries := s.newObserver(c, observer.AuditPolicy{
+		CaptureArgs:    true,
+		ExcludeMethods: set.NewStrings(observer.ReadOnlyMethodsKey),
+	})
+	req := rpc.Request{Type: "Client", Version: 1, Action: "FullStatus"}
+	obs.ServerRequest(&rpc.Header{Request: req}, nil)
+	obs.ServerReply(req, &rpc.Header{}, nil)
+	c.Assert(*entries, gc.HasLen, 0)
+}
+
+func (s *auditSuite) capturedArgs(c *gc.C, req rpc.Request, args interface{}) map[string]interface{} {
+	obs, entries := s.newObserver(c, observer.AuditPolicy{CaptureArgs: true})
+	obs.ServerRequest(&rpc.Header{Request: req}, args)
+	obs.ServerReply(req, &rpc.Header{}, nil)
+	c.Assert(*entries, gc.HasLen, 1)
+	data, ok := (*entries)[0].Data["args"].(map[string]interface{})
+	c.Assert(ok, jc.IsTrue)
+	return data
+}
+
+func (s *auditSuite) assertRedactedYAML(c *gc.C, value interface{}, expect map[interface{}]interface{}) {
+	data, ok := value.(string)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(data, gc.Not(gc.Matches), "(?s).*hunter2.*")
+	var parsed map[interface{}]interface{}
+	err := yaml.Unmarshal([]byte(data), &parsed)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(parsed, jc.DeepEquals, expect)
+}
+
+func (s *auditSuite) TestCaptureArgsRedactsDeployConfigYAML(c *gc.C) {
+	args := s.capturedArgs(c, rpc.Request{Type: "Application", Version: 3, Action: "Deploy"}, params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{{
+			ApplicationName: "mysql",
+			ConfigYAML:      "mysql:\n  admin-password: hunter2\n  port: 3306\n",
+		}},
+	})
+	apps, ok := args["applications"].([]interface{})
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(apps, gc.HasLen, 1)
+	app, ok := apps[0].(map[string]interface{})
+	c.Assert(ok, jc.IsTrue)
+	s.assertRedactedYAML(c, app["config-yaml"], map[interface{}]interface{}{
+		"mysql": map[interface{}]interface{}{
+			"admin-password": "<redacted>",
+			"port":           3306,
+		},
+	})
+}
+
+func (s *auditSuite) TestCaptureArgsRedactsUpdateSettingsYAML(c *gc.C) {
+	args := s.capturedArgs(c, rpc.Request{Type: "Application", Version: 3, Action: "Update"}, params.ApplicationUpdate{
+		ApplicationName: "mysql",
+		SettingsYAML:    "mysql:\n  secret-key: hunter2\n  flavour: fast\n",
+	})
+	s.assertRedactedYAML(c, args["settings-yaml"], map[interface{}]interface{}{
+		"mysql": map[interface{}]interface{}{
+			"secret-key": "<redacted>",
+			"flavour":    "fast",
+		},
+	})
+}
+
+func (s *auditSuite) TestCaptureArgsRedactsBackupEncryptionKey(c *gc.C) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	args := s.capturedArgs(c, rpc.Request{Type: "Backups", Version: 1, Action: "Create"}, params.BackupsCreateArgs{
+		Notes:         "nightly",
+		EncryptionKey: key,
+	})
+	c.Check(args["notes"], gc.Equals, "nightly")
+	c.Check(args["encryption-key"], gc.Equals, "<redacted>")
+
+	args = s.capturedArgs(c, rpc.Request{Type: "Backups", Version: 1, Action: "Restore"}, params.RestoreArgs{
+		BackupId:      "spam",
+		EncryptionKey: key,
+	})
+	c.Check(args["backup-id"], gc.Equals, "spam")
+	c.Check(args["encryption-key"], gc.Equals, "<redacted>")
+}
+
+func (s *auditSuite) TestCaptureArgsRedactsUnparseableYAML(c *gc.C) {
+	args := s.capturedArgs(c, rpc.Request{Type: "Application", Version: 3, Action: "Update"}, params.ApplicationUpdate{
+		ApplicationName: "mysql",
+		SettingsYAML:    "mysql: [hunter2",
+	})
+	c.Assert(args["settings-yaml"], gc.Equals, "<redacted>")
+}
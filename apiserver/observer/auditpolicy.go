@@ -0,0 +1,260 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/juju/utils/set"
+	"gopkg.in/yaml.v2"
+
+	"github.com/juju/juju/rpc"
+)
+
+const (
+	// ReadOnlyMethodsKey may be included in an audit policy's
+	// excluded methods to exclude every method in ReadOnlyMethods.
+	ReadOnlyMethodsKey = "ReadOnlyMethods"
+
+	// redactedValue replaces any argument value which is redacted
+	// by an audit policy.
+	redactedValue = "<redacted>"
+)
+
+// DefaultRedactKeys holds the argument field name fragments whose
+// values are always redacted from audited API calls, regardless of
+// the configured policy. A field is redacted if its name contains
+// any of these, ignoring case.
+var DefaultRedactKeys = []string{
+	"password",
+	"secret",
+	"credential",
+	"private-key",
+	"macaroon",
+	"token",
+	"encryption-key",
+}
+
+// ReadOnlyMethods holds the "Facade.Method" names of API calls which
+// do not change anything, and so are not worth auditing in detail.
+// A method of "*" matches every method on the facade.
+var ReadOnlyMethods = set.NewStrings(
+	"Action.Actions",
+	"Action.ApplicationsCharmsActions",
+	"Action.FindActionTagsByPrefix",
+	"Action.FindActionsByNames",
+	"Action.ListAll",
+	"Action.ListCompleted",
+	"Action.ListPending",
+	"Action.ListRunning",
+	"AllModelWatcher.*",
+	"AllWatcher.*",
+	"Annotations.Get",
+	"Application.CharmRelations",
+	"Application.Get",
+	"Application.GetCharmURL",
+	"Application.GetConstraints",
+	"AuditLog.Query",
+	"Backups.Info",
+	"Backups.List",
+	"Block.List",
+	"Bundle.GetChanges",
+	"Charms.CharmInfo",
+	"Charms.IsMetered",
+	"Charms.List",
+	"Client.AgentVersion",
+	"Client.APIHostPorts",
+	"Client.FindTools",
+	"Client.FullStatus",
+	"Client.GetModelConstraints",
+	"Client.ModelInfo",
+	"Client.ModelUserInfo",
+	"Client.PrivateAddress",
+	"Client.PublicAddress",
+	"Client.StatusHistory",
+	"Client.WatchAll",
+	"Cloud.Cloud",
+	"Cloud.Clouds",
+	"Cloud.Credential",
+	"Cloud.DefaultCloud",
+	"Cloud.UserCredentials",
+	"Controller.AllModels",
+	"Controller.ControllerConfig",
+	"Controller.GetControllerAccess",
+	"Controller.ListBlockedModels",
+	"Controller.ModelConfig",
+	"Controller.ModelStatus",
+	"Controller.WatchAllModels",
+	"KeyManager.ListKeys",
+	"ModelConfig.ModelGet",
+	"ModelManager.ListModels",
+	"ModelManager.ModelDefaults",
+	"ModelManager.ModelInfo",
+	"ModelManager.ModelStatus",
+	"Pinger.Ping",
+	"Spaces.ListSpaces",
+	"SSHClient.PrivateAddress",
+	"SSHClient.Proxy",
+	"SSHClient.PublicAddress",
+	"SSHClient.PublicKeys",
+	"Storage.ListFilesystems",
+	"Storage.ListPools",
+	"Storage.ListStorageDetails",
+	"Storage.ListVolumes",
+	"Storage.StorageDetails",
+	"Subnets.AllSpaces",
+	"Subnets.AllZones",
+	"Subnets.ListSubnets",
+	"UserManager.UserInfo",
+)
+
+// AuditPolicy describes how much detail the audit observer records
+// for each API call, and which argument values must be redacted
+// before an entry reaches an audit sink.
+type AuditPolicy struct {
+	// CaptureArgs, if true, causes the facade, method, version,
+	// redacted arguments and error code of every API call not
+	// excluded by ExcludeMethods to be recorded once the call has
+	// completed. Otherwise only the operation is recorded, for
+	// every call, when the request is received.
+	CaptureArgs bool
+
+	// ExcludeMethods holds the "Facade.Method" names of calls which
+	// are not recorded when CaptureArgs is set. A method of "*"
+	// matches every method on the facade, and ReadOnlyMethodsKey
+	// matches every method in ReadOnlyMethods.
+	ExcludeMethods set.Strings
+
+	// RedactKeys holds argument field name fragments whose values
+	// are redacted in addition to DefaultRedactKeys.
+	RedactKeys []string
+}
+
+// Excluded reports whether the policy excludes the given request
+// from being recorded in detail.
+func (p AuditPolicy) Excluded(req rpc.Request) bool {
+	if p.ExcludeMethods == nil {
+		return false
+	}
+	names := []string{req.Type + "." + req.Action, req.Type + ".*"}
+	for _, name := range names {
+		if p.ExcludeMethods.Contains(name) {
+			return true
+		}
+		if p.ExcludeMethods.Contains(ReadOnlyMethodsKey) && ReadOnlyMethods.Contains(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact returns a copy of the supplied API call arguments, converted
+// to their generic JSON form, with the value of every field whose name
+// matches the policy's redact keys replaced. String fields whose names
+// end in "yaml", such as the charm config passed to Application.Deploy
+// and Application.Update, are parsed and redacted by the same rules.
+// Arguments which cannot be converted are replaced entirely, so that
+// nothing unredacted can ever be returned.
+func (p AuditPolicy) Redact(args interface{}) interface{} {
+	if args == nil {
+		return nil
+	}
+	data, err := json.Marshal(args)
+	if err != nil {
+		return redactedValue
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return redactedValue
+	}
+	var keys []string
+	for _, keySet := range [][]string{DefaultRedactKeys, p.RedactKeys} {
+		for _, key := range keySet {
+			if key = strings.ToLower(strings.TrimSpace(key)); key != "" {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return redactValue(generic, keys)
+}
+
+func redactValue(value interface{}, keys []string) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			if shouldRedact(k, keys) {
+				result[k] = redactedValue
+			} else if data, ok := v.(string); ok && isYAMLField(k) {
+				result[k] = redactYAML(data, keys)
+			} else {
+				result[k] = redactValue(v, keys)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, v := range value {
+			result[i] = redactValue(v, keys)
+		}
+		return result
+	}
+	return value
+}
+
+// isYAMLField reports whether the named field holds a YAML document,
+// whose keys are opaque to redaction until it is parsed.
+func isYAMLField(name string) bool {
+	return strings.HasSuffix(strings.ToLower(name), "yaml")
+}
+
+// redactYAML parses the YAML document, redacts it, and returns it
+// marshalled back to YAML. A document which cannot be parsed is
+// replaced entirely.
+func redactYAML(data string, keys []string) interface{} {
+	if strings.TrimSpace(data) == "" {
+		return data
+	}
+	var generic interface{}
+	if err := yaml.Unmarshal([]byte(data), &generic); err != nil {
+		return redactedValue
+	}
+	out, err := yaml.Marshal(redactValue(stringKeys(generic), keys))
+	if err != nil {
+		return redactedValue
+	}
+	return string(out)
+}
+
+// stringKeys converts the maps in a value unmarshalled from YAML to
+// maps with string keys, so that they can be redacted.
+func stringKeys(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			result[fmt.Sprint(k)] = stringKeys(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, v := range value {
+			result[i] = stringKeys(v)
+		}
+		return result
+	}
+	return value
+}
+
+func shouldRedact(name string, keys []string) bool {
+	name = strings.ToLower(name)
+	for _, key := range keys {
+		if strings.Contains(name, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package observer_test
+
+import (
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/set"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/observer"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/rpc"
+)
+
+type auditPolicySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&auditPolicySuite{})
+
+func (*auditPolicySuite) TestExcluded(c *gc.C) {
+	policy := observer.AuditPolicy{
+		ExcludeMethods: set.NewStrings("ReadOnlyMethods", "Client.AddMachines", "Pinger.*"),
+	}
+	for i, test := range []struct {
+		req      rpc.Request
+		excluded bool
+	}{
+		{rpc.Request{Type: "Client", Action: "FullStatus"}, true},
+		{rpc.Request{Type: "AllWatcher", Action: "Next"}, true},
+		{rpc.Request{Type: "Client", Action: "AddMachines"}, true},
+		{rpc.Request{Type: "Pinger", Action: "Anything"}, true},
+		{rpc.Request{Type: "Application", Action: "Deploy"}, false},
+		{rpc.Request{Type: "Client", Action: "DestroyMachines"}, false},
+	} {
+		c.Logf("test %d: %v", i, test.req)
+		c.Check(policy.Excluded(test.req), gc.Equals, test.excluded)
+	}
+}
+
+func (*auditPolicySuite) TestExcludedNothing(c *gc.C) {
+	policy := observer.AuditPolicy{}
+	c.Assert(policy.Excluded(rpc.Request{Type: "Client", Action: "FullStatus"}), jc.IsFalse)
+}
+
+func (*auditPolicySuite) TestRedactDefaultKeys(c *gc.C) {
+	policy := observer.AuditPolicy{}
+	redacted := policy.Redact(params.EntityPasswords{
+		Changes: []params.EntityPassword{{
+			Tag:      "user-bob",
+			Password: "sekrit",
+		}},
+	})
+	c.Assert(redacted, jc.DeepEquals, map[string]interface{}{
+		"changes": []interface{}{
+			map[string]interface{}{
+				"tag":      "user-bob",
+				"password": "<redacted>",
+			},
+		},
+	})
+}
+
+func (*auditPolicySuite) TestRedactConfiguredKeys(c *gc.C) {
+	policy := observer.AuditPolicy{RedactKeys: []string{" DB-Pass "}}
+	redacted := policy.Redact(map[string]interface{}{
+		"application": "mysql",
+		"options": map[string]interface{}{
+			"db-pass":        "hunter2",
+			"admin-password": "hunter3",
+			"port":           3306,
+		},
+	})
+	c.Assert(redacted, jc.DeepEquals, map[string]interface{}{
+		"application": "mysql",
+		"options": map[string]interface{}{
+			"db-pass":        "<redacted>",
+			"admin-password": "<redacted>",
+			"port":           float64(3306),
+		},
+	})
+}
+
+func (*auditPolicySuite) TestRedactNil(c *gc.C) {
+	c.Assert(observer.AuditPolicy{}.Redact(nil), gc.IsNil)
+}
+
+func (*auditPolicySuite) TestRedactUnserializable(c *gc.C) {
+	redacted := observer.AuditPolicy{}.Redact(map[string]interface{}{
+		"password": make(chan int),
+	})
+	c.Assert(redacted, gc.Equals, "<redacted>")
+}
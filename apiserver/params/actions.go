@@ -25,6 +25,14 @@ const (
 	// ActionRunning is the status of an Action that has been started but
 	// not completed yet.
 	ActionRunning string = "running"
+
+	// ActionAborting is the status of a running Action that has been
+	// asked to stop.
+	ActionAborting string = "aborting"
+
+	// ActionAborted is the status of an Action that was stopped while
+	// running.
+	ActionAborted string = "aborted"
 )
 
 // Actions is a slice of Action for bulk requests.
@@ -54,9 +62,34 @@ type ActionResult struct {
 	Status    string                 `json:"status,omitempty"`
 	Message   string                 `json:"message,omitempty"`
 	Output    map[string]interface{} `json:"output,omitempty"`
+	Log       []ActionMessage        `json:"log,omitempty"`
 	Error     *Error                 `json:"error,omitempty"`
 }
 
+// ActionPruneArgs holds the limits used to prune completed actions.
+type ActionPruneArgs struct {
+	MaxHistoryTime time.Duration `json:"max-history-time"`
+	MaxHistoryMB   int           `json:"max-history-mb"`
+}
+
+// ActionMessage is a progress message logged by a running Action.
+type ActionMessage struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+}
+
+// ActionMessageParams holds the progress messages to log for a bulk
+// action API call.
+type ActionMessageParams struct {
+	Messages []EntityString `json:"messages"`
+}
+
+// EntityString holds an entity tag and a string value.
+type EntityString struct {
+	Tag   string `json:"tag"`
+	Value string `json:"value"`
+}
+
 // ActionsByReceivers wrap a slice of Actions for API calls.
 type ActionsByReceivers struct {
 	Actions []ActionsByReceiver `json:"actions,omitempty"`
@@ -138,3 +171,47 @@ type ActionSpec struct {
 	Description string                 `json:"description"`
 	Params      map[string]interface{} `json:"params"`
 }
+
+// ActionSchedule describes an action that is enqueued at a future
+// time, once or on a recurring cron-style schedule.
+type ActionSchedule struct {
+	Id         string                 `json:"id,omitempty"`
+	Receivers  []string               `json:"receivers"`
+	Name       string                 `json:"name"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	Start      *time.Time             `json:"start,omitempty"`
+	Recurrence string                 `json:"recurrence,omitempty"`
+	Next       *time.Time             `json:"next,omitempty"`
+	LastFired  *time.Time             `json:"last-fired,omitempty"`
+	CreatedBy  string                 `json:"created-by,omitempty"`
+}
+
+// ActionSchedules holds a slice of ActionSchedule for bulk requests.
+type ActionSchedules struct {
+	Schedules []ActionSchedule `json:"schedules"`
+}
+
+// ActionScheduleResult holds an action schedule, or an error if it
+// could not be added.
+type ActionScheduleResult struct {
+	Schedule *ActionSchedule `json:"schedule,omitempty"`
+	Error    *Error          `json:"error,omitempty"`
+}
+
+// ActionScheduleResults holds a slice of ActionScheduleResult for a
+// bulk API call.
+type ActionScheduleResults struct {
+	Results []ActionScheduleResult `json:"results"`
+}
+
+// ActionScheduleIds holds the ids of action schedules.
+type ActionScheduleIds struct {
+	Ids []string `json:"ids"`
+}
+
+// FireActionSchedulesResult holds when the next action schedule is
+// due, after the due schedules were fired.
+type FireActionSchedulesResult struct {
+	Next  *time.Time `json:"next,omitempty"`
+	Error *Error     `json:"error,omitempty"`
+}
@@ -0,0 +1,38 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"time"
+
+	"github.com/juju/version"
+)
+
+// AuditLogFilter holds the criteria used to select entries from the
+// controller's audit log. Empty fields match everything.
+type AuditLogFilter struct {
+	ModelTag  string     `json:"model-tag,omitempty"`
+	UserTag   string     `json:"user-tag,omitempty"`
+	Operation string     `json:"operation,omitempty"`
+	After     *time.Time `json:"after,omitempty"`
+	Before    *time.Time `json:"before,omitempty"`
+	Limit     int        `json:"limit,omitempty"`
+}
+
+// AuditLogEntry holds a single audit log entry.
+type AuditLogEntry struct {
+	JujuServerVersion version.Number         `json:"juju-server-version"`
+	ModelUUID         string                 `json:"model-uuid"`
+	Timestamp         time.Time              `json:"timestamp"`
+	RemoteAddress     string                 `json:"remote-address"`
+	OriginType        string                 `json:"origin-type"`
+	OriginName        string                 `json:"origin-name"`
+	Operation         string                 `json:"operation"`
+	Data              map[string]interface{} `json:"data,omitempty"`
+}
+
+// AuditLogResults holds the results of an audit log query.
+type AuditLogResults struct {
+	Entries []AuditLogEntry `json:"entries"`
+}
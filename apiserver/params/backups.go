@@ -12,6 +12,29 @@ import (
 // BackupsCreateArgs holds the args for the API Create method.
 type BackupsCreateArgs struct {
 	Notes string `json:"notes"`
+
+	// Target, if set, is where the backup archive is stored instead
+	// of the controller.
+	Target *BackupTarget `json:"target,omitempty"`
+
+	// EncryptionKey, if set, is the key with which the backup archive
+	// is encrypted, in place of the controller's own key.
+	EncryptionKey []byte `json:"encryption-key,omitempty"`
+}
+
+// BackupTarget identifies storage outside the controller that holds
+// backup archives.
+type BackupTarget struct {
+	// URL is "file:///<directory>" for a directory on the controller
+	// machine, or "s3://<bucket>[/<prefix>]" for S3-compatible object
+	// storage, optionally with "endpoint" and "region" query
+	// parameters.
+	URL string `json:"url"`
+
+	// AccessKey and SecretKey are the credentials for S3-compatible
+	// object storage.
+	AccessKey string `json:"access-key,omitempty"`
+	SecretKey string `json:"secret-key,omitempty"`
 }
 
 // BackupsInfoArgs holds the args for the API Info method.
@@ -26,6 +49,9 @@ type BackupsListArgs struct {
 // BackupsDownloadArgs holds the args for the API Download method.
 type BackupsDownloadArgs struct {
 	ID string `json:"id"`
+
+	// Target, if set, is where the backup archive is fetched from.
+	Target *BackupTarget `json:"target,omitempty"`
 }
 
 // BackupsUploadArgs holds the args for the API Upload method.
@@ -37,6 +63,9 @@ type BackupsUploadArgs struct {
 // BackupsRemoveArgs holds the args for the API Remove method.
 type BackupsRemoveArgs struct {
 	ID string `json:"id"`
+
+	// Target, if set, is where the backup archive is removed from.
+	Target *BackupTarget `json:"target,omitempty"`
 }
 
 // BackupsListResult holds the list of all stored backups.
@@ -68,6 +97,18 @@ type BackupsMetadataResult struct {
 	Version  version.Number `json:"version"`
 	Series   string         `json:"series"`
 
+	// Scheduled is set for backups taken by the controller's backup
+	// scheduler rather than requested by a user.
+	Scheduled bool `json:"scheduled,omitempty"`
+
+	// Target is the URL of the backup target holding the archive, or
+	// empty if the archive is stored in the controller.
+	Target string `json:"target,omitempty"`
+
+	// KeyFingerprint is the fingerprint of the key with which the
+	// archive was encrypted, or empty if it was not encrypted.
+	KeyFingerprint string `json:"key-fingerprint,omitempty"`
+
 	CACert       string `json:"ca-cert"`
 	CAPrivateKey string `json:"ca-private-key"`
 }
@@ -76,4 +117,11 @@ type BackupsMetadataResult struct {
 type RestoreArgs struct {
 	// BackupId holds the id of the backup in server if any
 	BackupId string `json:"backup-id"`
+
+	// Target, if set, is where the backup archive is fetched from.
+	Target *BackupTarget `json:"target,omitempty"`
+
+	// EncryptionKey, if set, is the key with which the backup archive
+	// was encrypted, in place of the controller's own key.
+	EncryptionKey []byte `json:"encryption-key,omitempty"`
 }
@@ -334,6 +334,36 @@ type EntityWorkloadVersions struct {
 	Entities []EntityWorkloadVersion `json:"entities"`
 }
 
+// GoalStateStatus holds the status of a unit or relation in the goal
+// state reported to a unit's charm.
+type GoalStateStatus struct {
+	Status string     `json:"status"`
+	Since  *time.Time `json:"since,omitempty"`
+}
+
+// UnitsGoalState maps unit names, or application names in the case of
+// relations, to their goal state status.
+type UnitsGoalState map[string]GoalStateStatus
+
+// GoalState holds the units expected for a unit's application, and
+// the applications and units expected for each of its relations,
+// keyed by the local endpoint name.
+type GoalState struct {
+	Units     UnitsGoalState            `json:"units"`
+	Relations map[string]UnitsGoalState `json:"relations"`
+}
+
+// GoalStateResult holds the goal state for a unit, or an error.
+type GoalStateResult struct {
+	Result *GoalState `json:"result,omitempty"`
+	Error  *Error     `json:"error,omitempty"`
+}
+
+// GoalStateResults holds the results of a GoalStates call.
+type GoalStateResults struct {
+	Results []GoalStateResult `json:"results"`
+}
+
 // BytesResult holds the result of an API call that returns a slice
 // of bytes.
 type BytesResult struct {
@@ -721,6 +751,11 @@ type LogMessage struct {
 	Module    string    `json:"mod"`
 	Location  string    `json:"loc"`
 	Message   string    `json:"msg"`
+
+	// ModelUUID and ModelName are only set when streaming the
+	// logs of all models in a controller.
+	ModelUUID string `json:"model-uuid,omitempty"`
+	ModelName string `json:"model-name,omitempty"`
 }
 
 // ResourceUploadResult is used to return some details about an
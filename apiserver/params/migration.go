@@ -58,6 +58,36 @@ type InitiateMigrationResult struct {
 	MigrationId string `json:"migration-id"`
 }
 
+// MigrationPrecheckResults is used to return the results of running
+// every migration precheck for one or more models.
+type MigrationPrecheckResults struct {
+	Results []MigrationPrecheckResult `json:"results"`
+}
+
+// MigrationPrecheckResult holds the problems found by the source and
+// target prechecks for a model migration, and the estimated size of
+// the model export.
+type MigrationPrecheckResult struct {
+	ModelTag   string                     `json:"model-tag"`
+	Error      *Error                     `json:"error,omitempty"`
+	Source     []MigrationPrecheckProblem `json:"source,omitempty"`
+	Target     []MigrationPrecheckProblem `json:"target,omitempty"`
+	ExportSize int64                      `json:"export-size"`
+}
+
+// MigrationPrecheckProblems holds the problems found by a set of
+// migration prechecks.
+type MigrationPrecheckProblems struct {
+	Problems []MigrationPrecheckProblem `json:"problems"`
+}
+
+// MigrationPrecheckProblem describes a condition that would prevent a
+// model migration from succeeding.
+type MigrationPrecheckProblem struct {
+	Entity  string `json:"entity"`
+	Message string `json:"message"`
+}
+
 // SetMigrationPhaseArgs provides a migration phase to the
 // migrationmaster.SetPhase API method.
 type SetMigrationPhaseArgs struct {
@@ -133,11 +163,14 @@ type MasterMigrationStatus struct {
 // MigrationModelInfo is used to report basic model information to the
 // migrationmaster worker.
 type MigrationModelInfo struct {
-	UUID                   string         `json:"uuid"`
-	Name                   string         `json:"name"`
-	OwnerTag               string         `json:"owner-tag"`
-	AgentVersion           version.Number `json:"agent-version"`
-	ControllerAgentVersion version.Number `json:"controller-agent-version"`
+	UUID                    string         `json:"uuid"`
+	Name                    string         `json:"name"`
+	OwnerTag                string         `json:"owner-tag"`
+	AgentVersion            version.Number `json:"agent-version"`
+	ControllerAgentVersion  version.Number `json:"controller-agent-version"`
+	CloudName               string         `json:"cloud-name,omitempty"`
+	CloudCredentialTag      string         `json:"cloud-credential-tag,omitempty"`
+	CloudCredentialAuthType string         `json:"cloud-credential-auth-type,omitempty"`
 }
 
 // MigrationStatus reports the current status of a model migration.
@@ -232,6 +232,22 @@ type ModifyModelAccess struct {
 	ModelTag string               `json:"model-tag"`
 }
 
+// ModifyApplicationAccessRequest holds the parameters for making grant
+// and revoke application calls.
+type ModifyApplicationAccessRequest struct {
+	Changes []ModifyApplicationAccess `json:"changes"`
+}
+
+// ModifyApplicationAccess describes a change to the access a user has
+// been granted on an application in a model.
+type ModifyApplicationAccess struct {
+	UserTag        string               `json:"user-tag"`
+	Action         ModelAction          `json:"action"`
+	Access         UserAccessPermission `json:"access"`
+	ModelTag       string               `json:"model-tag"`
+	ApplicationTag string               `json:"application-tag"`
+}
+
 // ModelAction is an action that can be performed on a model.
 type ModelAction string
 
@@ -247,7 +263,10 @@ type UserAccessPermission string
 
 // Model access permissions that may be set on a user.
 const (
-	ModelAdminAccess UserAccessPermission = "admin"
-	ModelReadAccess  UserAccessPermission = "read"
-	ModelWriteAccess UserAccessPermission = "write"
+	ModelAdminAccess      UserAccessPermission = "admin"
+	ModelReadAccess       UserAccessPermission = "read"
+	ModelWriteAccess      UserAccessPermission = "write"
+	ModelRunActionsAccess UserAccessPermission = "run-actions"
+	ModelDebugAccess      UserAccessPermission = "debug"
+	ModelDeployAccess     UserAccessPermission = "deploy"
 )
@@ -281,6 +281,11 @@ type ApplicationSetCharm struct {
 // ApplicationExpose holds the parameters for making the application Expose call.
 type ApplicationExpose struct {
 	ApplicationName string `json:"application"`
+
+	// ExposedCIDRs contains the source CIDRs from which the
+	// application's opened ports may be accessed. If empty,
+	// the ports may be accessed from anywhere.
+	ExposedCIDRs []string `json:"exposed-cidrs,omitempty"`
 }
 
 // ApplicationSet holds the parameters for an application Set
@@ -3,7 +3,11 @@
 
 package params
 
-import "github.com/juju/juju/storage"
+import (
+	"time"
+
+	"github.com/juju/juju/storage"
+)
 
 // MachineBlockDevices holds a machine tag and the block devices present
 // on that machine.
@@ -94,6 +98,10 @@ type StorageAttachment struct {
 	Kind     StorageKind `json:"kind"`
 	Location string      `json:"location"`
 	Life     Life        `json:"life"`
+
+	// Size is the size of the attached block device or
+	// filesystem in MiB, or zero if it is not known.
+	Size uint64 `json:"size,omitempty"`
 }
 
 // StorageAttachmentId identifies a storage attachment by the tags of the
@@ -210,6 +218,7 @@ type VolumeParams struct {
 	Attributes map[string]interface{}  `json:"attributes,omitempty"`
 	Tags       map[string]string       `json:"tags,omitempty"`
 	Attachment *VolumeAttachmentParams `json:"attachment,omitempty"`
+	SnapshotId string                  `json:"snapshot-id,omitempty"`
 }
 
 // VolumeAttachmentParams holds the parameters for creating a volume
@@ -270,6 +279,27 @@ type VolumeParamsResults struct {
 	Results []VolumeParamsResult `json:"results,omitempty"`
 }
 
+// VolumeResizeParams holds the parameters for growing a provisioned
+// volume.
+type VolumeResizeParams struct {
+	VolumeTag string `json:"volume-tag"`
+	VolumeId  string `json:"volume-id"`
+	Provider  string `json:"provider"`
+	// Size is the size, in MiB, that the volume is to be grown to.
+	Size uint64 `json:"size"`
+}
+
+// VolumeResizeParamsResult holds resize parameters for a volume.
+type VolumeResizeParamsResult struct {
+	Result VolumeResizeParams `json:"result"`
+	Error  *Error             `json:"error,omitempty"`
+}
+
+// VolumeResizeParamsResults holds resize parameters for multiple volumes.
+type VolumeResizeParamsResults struct {
+	Results []VolumeResizeParamsResult `json:"results,omitempty"`
+}
+
 // VolumeAttachmentParamsResults holds provisioning parameters for a volume
 // attachment.
 type VolumeAttachmentParamsResult struct {
@@ -329,6 +359,7 @@ type FilesystemParams struct {
 	Attributes    map[string]interface{}      `json:"attributes,omitempty"`
 	Tags          map[string]string           `json:"tags,omitempty"`
 	Attachment    *FilesystemAttachmentParams `json:"attachment,omitempty"`
+	SnapshotId    string                      `json:"snapshot-id,omitempty"`
 }
 
 // FilesystemAttachmentParams holds the parameters for creating a filesystem
@@ -501,6 +532,21 @@ type StoragePoolsResults struct {
 	Results []StoragePoolsResult `json:"results,omitempty"`
 }
 
+// StoragePoolArgs holds a collection of storage pools.
+type StoragePoolArgs struct {
+	Pools []StoragePool `json:"pools"`
+}
+
+// StoragePoolDeleteArgs holds a collection of storage pools to remove.
+type StoragePoolDeleteArgs struct {
+	Pools []StoragePoolDeleteArg `json:"pools"`
+}
+
+// StoragePoolDeleteArg holds the name of a storage pool to remove.
+type StoragePoolDeleteArg struct {
+	Name string `json:"name"`
+}
+
 // VolumeFilter holds a filter for volume list API call.
 type VolumeFilter struct {
 	// Machines are machine tags to filter on.
@@ -662,9 +708,96 @@ type StorageAddParams struct {
 
 	// Constraints are specified storage constraints.
 	Constraints StorageConstraints `json:"storage"`
+
+	// FromSnapshot, if non-empty, is the provider ID of the snapshot
+	// from which the storage instance's volume is to be restored.
+	FromSnapshot string `json:"from-snapshot,omitempty"`
 }
 
 // StoragesAddParams holds storage details to add to units dynamically.
 type StoragesAddParams struct {
 	Storages []StorageAddParams `json:"storages"`
 }
+
+// RemoveStorage holds the parameters for removing storage from the model.
+type RemoveStorage struct {
+	Storage []RemoveStorageInstance `json:"storage"`
+}
+
+// RemoveStorageInstance holds the parameters for removing a storage
+// instance from the model.
+type RemoveStorageInstance struct {
+	// Tag is the tag of the storage instance to be removed.
+	Tag string `json:"tag"`
+
+	// Release, if true, removes the storage instance from the model
+	// without destroying its cloud storage. Otherwise the cloud
+	// storage is destroyed.
+	Release bool `json:"release,omitempty"`
+}
+
+// Snapshot describes a point-in-time snapshot of the volume of a
+// storage instance.
+type Snapshot struct {
+	// SnapshotId is the provider ID of the snapshot.
+	SnapshotId string `json:"snapshot-id"`
+
+	// StorageTag is the tag of the storage instance whose volume
+	// the snapshot was taken from.
+	StorageTag string `json:"storage-tag"`
+
+	// VolumeTag is the tag of the volume the snapshot was taken from.
+	VolumeTag string `json:"volume-tag"`
+
+	// VolumeId is the provider ID of the volume the snapshot was
+	// taken from.
+	VolumeId string `json:"volume-id"`
+
+	// Size is the size of the volume the snapshot was taken from,
+	// in MiB.
+	Size uint64 `json:"size"`
+
+	// Created is the time at which the snapshot was started.
+	Created time.Time `json:"created"`
+
+	// Status is the provider-specific status of the snapshot.
+	Status string `json:"status,omitempty"`
+}
+
+// SnapshotResult holds a snapshot or an error.
+type SnapshotResult struct {
+	Result *Snapshot `json:"result,omitempty"`
+	Error  *Error    `json:"error,omitempty"`
+}
+
+// SnapshotResults holds the results of creating snapshots.
+type SnapshotResults struct {
+	Results []SnapshotResult `json:"results"`
+}
+
+// SnapshotListResult holds a collection of snapshots or an error.
+type SnapshotListResult struct {
+	Result []Snapshot `json:"result,omitempty"`
+	Error  *Error     `json:"error,omitempty"`
+}
+
+// SnapshotListResults holds the results of listing snapshots.
+type SnapshotListResults struct {
+	Results []SnapshotListResult `json:"results"`
+}
+
+// ResizeStorage holds the parameters for growing storage instances.
+type ResizeStorage struct {
+	Storage []ResizeStorageInstance `json:"storage"`
+}
+
+// ResizeStorageInstance holds the parameters for growing the volume
+// backing a storage instance.
+type ResizeStorageInstance struct {
+	// Tag is the tag of the storage instance to be resized.
+	Tag string `json:"tag"`
+
+	// Size is the size, in MiB, that the storage instance's
+	// volume is to be grown to.
+	Size uint64 `json:"size"`
+}
@@ -36,12 +36,17 @@ func New(backend Backend, _ facade.Resources, authorizer facade.Authorizer) (*Fa
 	return &Facade{backend: backend, authorizer: authorizer}, nil
 }
 
-func (facade *Facade) checkIsModelAdmin() error {
-	isModelAdmin, err := facade.authorizer.HasPermission(permission.AdminAccess, facade.backend.ModelTag())
+// checkCanSSH checks that the user may connect to the model's machines,
+// which requires admin access or the debug capability.
+func (facade *Facade) checkCanSSH() error {
+	canSSH, err := common.HasAnyPermission(
+		facade.authorizer, facade.backend.ModelTag(),
+		permission.AdminAccess, permission.DebugAccess,
+	)
 	if err != nil {
 		return errors.Trace(err)
 	}
-	if !isModelAdmin {
+	if !canSSH {
 		return common.ErrPerm
 	}
 	return nil
@@ -50,7 +55,7 @@ func (facade *Facade) checkIsModelAdmin() error {
 // PublicAddress reports the preferred public network address for one
 // or more entities. Machines and units are suppored.
 func (facade *Facade) PublicAddress(args params.Entities) (params.SSHAddressResults, error) {
-	if err := facade.checkIsModelAdmin(); err != nil {
+	if err := facade.checkCanSSH(); err != nil {
 		return params.SSHAddressResults{}, errors.Trace(err)
 	}
 
@@ -61,7 +66,7 @@ func (facade *Facade) PublicAddress(args params.Entities) (params.SSHAddressResu
 // PrivateAddress reports the preferred private network address for one or
 // more entities. Machines and units are supported.
 func (facade *Facade) PrivateAddress(args params.Entities) (params.SSHAddressResults, error) {
-	if err := facade.checkIsModelAdmin(); err != nil {
+	if err := facade.checkCanSSH(); err != nil {
 		return params.SSHAddressResults{}, errors.Trace(err)
 	}
 
@@ -73,7 +78,7 @@ func (facade *Facade) PrivateAddress(args params.Entities) (params.SSHAddressRes
 // args. Machines and units are supported as entity types. Since the returned
 // addresses are gathered from multiple sources, results may include duplicates.
 func (facade *Facade) AllAddresses(args params.Entities) (params.SSHAddressesResults, error) {
-	if err := facade.checkIsModelAdmin(); err != nil {
+	if err := facade.checkCanSSH(); err != nil {
 		return params.SSHAddressesResults{}, errors.Trace(err)
 	}
 
@@ -150,7 +155,7 @@ func (facade *Facade) getAddressPerEntity(args params.Entities, addressGetter fu
 // PublicKeys returns the public SSH hosts for one or more
 // entities. Machines and units are supported.
 func (facade *Facade) PublicKeys(args params.Entities) (params.SSHPublicKeysResults, error) {
-	if err := facade.checkIsModelAdmin(); err != nil {
+	if err := facade.checkCanSSH(); err != nil {
 		return params.SSHPublicKeysResults{}, errors.Trace(err)
 	}
 
@@ -176,7 +181,7 @@ func (facade *Facade) PublicKeys(args params.Entities) (params.SSHPublicKeysResu
 // Proxy returns whether SSH connections should be proxied through the
 // controller hosts for the model associated with the API connection.
 func (facade *Facade) Proxy() (params.SSHProxyResult, error) {
-	if err := facade.checkIsModelAdmin(); err != nil {
+	if err := facade.checkCanSSH(); err != nil {
 		return params.SSHProxyResult{}, errors.Trace(err)
 	}
 	config, err := facade.backend.ModelConfig()
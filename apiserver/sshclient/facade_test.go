@@ -61,6 +61,29 @@ func (s *facadeSuite) TestUnitAuthNotAllowed(c *gc.C) {
 	c.Assert(err, gc.Equals, common.ErrPerm)
 }
 
+func (s *facadeSuite) TestDebugAccessAllowed(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("debug")
+	s.authorizer.AdminTag = names.UserTag{}
+	args := params.Entities{
+		Entities: []params.Entity{{s.m0}},
+	}
+	results, err := s.facade.PublicAddress(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.Results, gc.DeepEquals, []params.SSHAddressResult{
+		{Address: "1.1.1.1"},
+	})
+}
+
+func (s *facadeSuite) TestWriteAccessNotAllowed(c *gc.C) {
+	s.authorizer.Tag = names.NewUserTag("write")
+	s.authorizer.AdminTag = names.UserTag{}
+	args := params.Entities{
+		Entities: []params.Entity{{s.m0}},
+	}
+	_, err := s.facade.PublicAddress(args)
+	c.Assert(errors.Cause(err), gc.Equals, common.ErrPerm)
+}
+
 func (s *facadeSuite) TestPublicAddress(c *gc.C) {
 	args := params.Entities{
 		Entities: []params.Entity{{s.m0}, {s.uFoo}, {s.uOther}},
@@ -84,7 +84,14 @@ const (
 	filesystemAttachmentsCall               = "filesystemAttachments"
 	allFilesystemsCall                      = "allFilesystems"
 	addStorageForUnitCall                   = "addStorageForUnit"
+	addStorageForUnitFromSnapshotCall       = "addStorageForUnitFromSnapshot"
+	attachStorageCall                       = "attachStorage"
+	detachStorageCall                       = "detachStorage"
+	destroyStorageInstanceCall              = "destroyStorageInstance"
+	releaseStorageInstanceCall              = "releaseStorageInstance"
+	resizeStorageInstanceCall               = "resizeStorageInstance"
 	getBlockForTypeCall                     = "getBlockForType"
+	removeStoragePoolCall                   = "removeStoragePool"
 	volumeAttachmentCall                    = "volumeAttachment"
 )
 
@@ -224,11 +231,43 @@ func (s *baseStorageSuite) constructState() *mockState {
 			s.calls = append(s.calls, addStorageForUnitCall)
 			return nil
 		},
+		addStorageForUnitFromSnapshot: func(u names.UnitTag, name string, cons state.StorageConstraints, snapshotId string) error {
+			s.calls = append(s.calls, addStorageForUnitFromSnapshotCall)
+			return nil
+		},
+		attachStorage: func(storage names.StorageTag, unit names.UnitTag) error {
+			s.calls = append(s.calls, attachStorageCall)
+			return nil
+		},
+		detachStorage: func(storage names.StorageTag, unit names.UnitTag) error {
+			s.calls = append(s.calls, detachStorageCall)
+			return nil
+		},
+		destroyStorageInstance: func(tag names.StorageTag) error {
+			s.calls = append(s.calls, destroyStorageInstanceCall)
+			return nil
+		},
+		releaseStorageInstance: func(tag names.StorageTag) error {
+			s.calls = append(s.calls, releaseStorageInstanceCall)
+			return nil
+		},
+		resizeStorageInstance: func(tag names.StorageTag, size uint64) error {
+			s.calls = append(s.calls, resizeStorageInstanceCall)
+			return nil
+		},
 		getBlockForType: func(t state.BlockType) (state.Block, bool, error) {
 			s.calls = append(s.calls, getBlockForTypeCall)
 			val, found := s.blocks[t]
 			return val, found, nil
 		},
+		removeStoragePool: func(name string) error {
+			s.calls = append(s.calls, removeStoragePoolCall)
+			if _, ok := s.pools[name]; !ok {
+				return errors.NotFoundf("pool %q", name)
+			}
+			delete(s.pools, name)
+			return nil
+		},
 	}
 }
 
@@ -273,6 +312,15 @@ func (s *baseStorageSuite) constructPoolManager() *mockPoolManager {
 			delete(s.pools, name)
 			return nil
 		},
+		replacePool: func(name string, attrs map[string]interface{}) (*jujustorage.Config, error) {
+			existing, ok := s.pools[name]
+			if !ok {
+				return nil, errors.NotFoundf("mock pool manager: replace pool %v", name)
+			}
+			pool, err := jujustorage.NewConfig(name, existing.Provider(), attrs)
+			s.pools[name] = pool
+			return pool, err
+		},
 		listPools: func() ([]*jujustorage.Config, error) {
 			result := make([]*jujustorage.Config, len(s.pools))
 			i := 0
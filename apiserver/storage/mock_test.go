@@ -8,16 +8,18 @@ import (
 	"gopkg.in/juju/charm.v6-unstable"
 	"gopkg.in/juju/names.v2"
 
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
 	jujustorage "github.com/juju/juju/storage"
 )
 
 type mockPoolManager struct {
-	getPool    func(name string) (*jujustorage.Config, error)
-	createPool func(name string, providerType jujustorage.ProviderType, attrs map[string]interface{}) (*jujustorage.Config, error)
-	deletePool func(name string) error
-	listPools  func() ([]*jujustorage.Config, error)
+	getPool     func(name string) (*jujustorage.Config, error)
+	createPool  func(name string, providerType jujustorage.ProviderType, attrs map[string]interface{}) (*jujustorage.Config, error)
+	deletePool  func(name string) error
+	replacePool func(name string, attrs map[string]interface{}) (*jujustorage.Config, error)
+	listPools   func() ([]*jujustorage.Config, error)
 }
 
 func (m *mockPoolManager) Get(name string) (*jujustorage.Config, error) {
@@ -32,6 +34,10 @@ func (m *mockPoolManager) Delete(name string) error {
 	return m.deletePool(name)
 }
 
+func (m *mockPoolManager) Replace(name string, attrs map[string]interface{}) (*jujustorage.Config, error) {
+	return m.replacePool(name, attrs)
+}
+
 func (m *mockPoolManager) List() ([]*jujustorage.Config, error) {
 	return m.listPools()
 }
@@ -47,10 +53,14 @@ type mockState struct {
 	storageInstanceFilesystemAttachment func(m names.MachineTag, f names.FilesystemTag) (state.FilesystemAttachment, error)
 	watchStorageAttachment              func(names.StorageTag, names.UnitTag) state.NotifyWatcher
 	watchFilesystemAttachment           func(names.MachineTag, names.FilesystemTag) state.NotifyWatcher
+	watchFilesystem                     func(names.FilesystemTag) state.NotifyWatcher
 	watchVolumeAttachment               func(names.MachineTag, names.VolumeTag) state.NotifyWatcher
 	watchBlockDevices                   func(names.MachineTag) state.NotifyWatcher
 	modelName                           string
+	removeStoragePool                   func(name string) error
 	modelTag                            names.ModelTag
+	controllerTag                       names.ControllerTag
+	modelConfig                         func() (*config.Config, error)
 	volume                              func(tag names.VolumeTag) (state.Volume, error)
 	machineVolumeAttachments            func(machine names.MachineTag) ([]state.VolumeAttachment, error)
 	volumeAttachments                   func(volume names.VolumeTag) ([]state.VolumeAttachment, error)
@@ -60,6 +70,12 @@ type mockState struct {
 	filesystemAttachments               func(filesystem names.FilesystemTag) ([]state.FilesystemAttachment, error)
 	allFilesystems                      func() ([]state.Filesystem, error)
 	addStorageForUnit                   func(u names.UnitTag, name string, cons state.StorageConstraints) error
+	addStorageForUnitFromSnapshot       func(u names.UnitTag, name string, cons state.StorageConstraints, snapshotId string) error
+	attachStorage                       func(names.StorageTag, names.UnitTag) error
+	detachStorage                       func(names.StorageTag, names.UnitTag) error
+	destroyStorageInstance              func(names.StorageTag) error
+	releaseStorageInstance              func(names.StorageTag) error
+	resizeStorageInstance               func(names.StorageTag, uint64) error
 	getBlockForType                     func(t state.BlockType) (state.Block, bool, error)
 	blockDevices                        func(names.MachineTag) ([]state.BlockDeviceInfo, error)
 }
@@ -104,6 +120,10 @@ func (st *mockState) WatchFilesystemAttachment(mtag names.MachineTag, f names.Fi
 	return st.watchFilesystemAttachment(mtag, f)
 }
 
+func (st *mockState) WatchFilesystem(f names.FilesystemTag) state.NotifyWatcher {
+	return st.watchFilesystem(f)
+}
+
 func (st *mockState) WatchVolumeAttachment(mtag names.MachineTag, v names.VolumeTag) state.NotifyWatcher {
 	return st.watchVolumeAttachment(mtag, v)
 }
@@ -116,10 +136,22 @@ func (st *mockState) ModelName() (string, error) {
 	return st.modelName, nil
 }
 
+func (st *mockState) RemoveStoragePool(name string) error {
+	return st.removeStoragePool(name)
+}
+
 func (st *mockState) ModelTag() names.ModelTag {
 	return st.modelTag
 }
 
+func (st *mockState) ControllerTag() names.ControllerTag {
+	return st.controllerTag
+}
+
+func (st *mockState) ModelConfig() (*config.Config, error) {
+	return st.modelConfig()
+}
+
 func (st *mockState) AllVolumes() ([]state.Volume, error) {
 	return st.allVolumes()
 }
@@ -156,6 +188,30 @@ func (st *mockState) AddStorageForUnit(u names.UnitTag, name string, cons state.
 	return st.addStorageForUnit(u, name, cons)
 }
 
+func (st *mockState) AddStorageForUnitFromSnapshot(u names.UnitTag, name string, cons state.StorageConstraints, snapshotId string) error {
+	return st.addStorageForUnitFromSnapshot(u, name, cons, snapshotId)
+}
+
+func (st *mockState) AttachStorage(storage names.StorageTag, unit names.UnitTag) error {
+	return st.attachStorage(storage, unit)
+}
+
+func (st *mockState) DetachStorage(storage names.StorageTag, unit names.UnitTag) error {
+	return st.detachStorage(storage, unit)
+}
+
+func (st *mockState) DestroyStorageInstance(tag names.StorageTag) error {
+	return st.destroyStorageInstance(tag)
+}
+
+func (st *mockState) ReleaseStorageInstance(tag names.StorageTag) error {
+	return st.releaseStorageInstance(tag)
+}
+
+func (st *mockState) ResizeStorageInstance(tag names.StorageTag, size uint64) error {
+	return st.resizeStorageInstance(tag, size)
+}
+
 func (st *mockState) GetBlockForType(t state.BlockType) (state.Block, bool, error) {
 	return st.getBlockForType(t)
 }
@@ -282,8 +338,8 @@ func (m *mockStorageInstance) Kind() state.StorageKind {
 	return m.kind
 }
 
-func (m *mockStorageInstance) Owner() names.Tag {
-	return m.owner
+func (m *mockStorageInstance) Owner() (names.Tag, bool) {
+	return m.owner, m.owner != nil
 }
 
 func (m *mockStorageInstance) Tag() names.Tag {
@@ -308,7 +364,7 @@ func (m *mockStorageAttachment) StorageInstance() names.StorageTag {
 }
 
 func (m *mockStorageAttachment) Unit() names.UnitTag {
-	return m.storage.Owner().(names.UnitTag)
+	return m.storage.owner.(names.UnitTag)
 }
 
 type mockVolumeAttachment struct {
@@ -353,3 +409,48 @@ func (b mockBlock) Type() state.BlockType {
 func (b mockBlock) Message() string {
 	return b.msg
 }
+
+type mockStorageProvider struct {
+	jujustorage.Provider
+	scope        jujustorage.Scope
+	volumeSource jujustorage.VolumeSource
+	sourceConfig *jujustorage.Config
+}
+
+func (p *mockStorageProvider) Scope() jujustorage.Scope {
+	return p.scope
+}
+
+func (p *mockStorageProvider) VolumeSource(cfg *jujustorage.Config) (jujustorage.VolumeSource, error) {
+	p.sourceConfig = cfg
+	return p.volumeSource, nil
+}
+
+type mockVolumeSnapshotter struct {
+	jujustorage.VolumeSource
+	jujustorage.VolumeSnapshotter
+	createSnapshots func([]jujustorage.SnapshotParams) ([]jujustorage.CreateSnapshotsResult, error)
+	listSnapshots   func([]string) ([]jujustorage.ListSnapshotsResult, error)
+}
+
+func (m *mockVolumeSnapshotter) CreateSnapshots(params []jujustorage.SnapshotParams) ([]jujustorage.CreateSnapshotsResult, error) {
+	return m.createSnapshots(params)
+}
+
+func (m *mockVolumeSnapshotter) ListSnapshots(volIds []string) ([]jujustorage.ListSnapshotsResult, error) {
+	return m.listSnapshots(volIds)
+}
+
+type mockVolumeSource struct {
+	jujustorage.VolumeSource
+}
+
+type mockVolumeResizer struct {
+	jujustorage.VolumeSource
+	jujustorage.VolumeResizer
+	resizesAttached bool
+}
+
+func (m *mockVolumeResizer) ResizesAttachedVolumes() bool {
+	return m.resizesAttached
+}
@@ -0,0 +1,68 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
+)
+
+type poolRemoveSuite struct {
+	baseStorageSuite
+}
+
+var _ = gc.Suite(&poolRemoveSuite{})
+
+func (s *poolRemoveSuite) TestRemovePool(c *gc.C) {
+	s.pools["unused"], _ = jujustorage.NewConfig("unused", provider.LoopProviderType, nil)
+	s.pools["used"], _ = jujustorage.NewConfig("used", provider.LoopProviderType, nil)
+	s.state.removeStoragePool = func(name string) error {
+		s.calls = append(s.calls, removeStoragePoolCall)
+		switch name {
+		case "used":
+			return errors.Errorf("removing storage pool %q: storage pool %q is in use", name, name)
+		case "notfound":
+			return errors.NotFoundf("pool %q", name)
+		}
+		delete(s.pools, name)
+		return nil
+	}
+
+	results, err := s.api.RemovePool(params.StoragePoolDeleteArgs{[]params.StoragePoolDeleteArg{
+		{Name: "unused"},
+		{Name: "used"},
+		{Name: "notfound"},
+		{Name: "#invalid"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: `removing storage pool "used": storage pool "used" is in use`}},
+		{Error: &params.Error{Code: params.CodeNotFound, Message: `pool "notfound" not found`}},
+		{Error: &params.Error{Message: `pool name "#invalid" not valid`}},
+	}})
+	_, ok := s.pools["unused"]
+	c.Assert(ok, jc.IsFalse)
+	_, ok = s.pools["used"]
+	c.Assert(ok, jc.IsTrue)
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		removeStoragePoolCall,
+		removeStoragePoolCall,
+		removeStoragePoolCall,
+	})
+}
+
+func (s *poolRemoveSuite) TestRemovePoolBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestRemovePoolBlocked")
+	_, err := s.api.RemovePool(params.StoragePoolDeleteArgs{[]params.StoragePoolDeleteArg{
+		{Name: "pname"},
+	}})
+	s.assertBlocked(c, err, "TestRemovePoolBlocked")
+}
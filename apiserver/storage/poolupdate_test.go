@@ -0,0 +1,63 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	jujustorage "github.com/juju/juju/storage"
+	"github.com/juju/juju/storage/provider"
+)
+
+type poolUpdateSuite struct {
+	baseStorageSuite
+}
+
+var _ = gc.Suite(&poolUpdateSuite{})
+
+func (s *poolUpdateSuite) TestUpdatePool(c *gc.C) {
+	s.pools["pname"], _ = jujustorage.NewConfig("pname", provider.LoopProviderType, map[string]interface{}{
+		"volume-type": "typo",
+	})
+	expected, _ := jujustorage.NewConfig("pname", provider.LoopProviderType, map[string]interface{}{
+		"volume-type": "ssd",
+	})
+
+	results, err := s.api.UpdatePool(params.StoragePoolArgs{[]params.StoragePool{{
+		Name:  "pname",
+		Attrs: map[string]interface{}{"volume-type": "ssd"},
+	}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{}}})
+	c.Assert(s.pools["pname"], jc.DeepEquals, expected)
+}
+
+func (s *poolUpdateSuite) TestUpdatePoolErrors(c *gc.C) {
+	s.pools["pname"], _ = jujustorage.NewConfig("pname", provider.LoopProviderType, nil)
+
+	results, err := s.api.UpdatePool(params.StoragePoolArgs{[]params.StoragePool{{
+		Name: "notfound",
+	}, {
+		Name: "#invalid",
+	}, {
+		Name:     "pname",
+		Provider: "ebs",
+	}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{Error: &params.Error{Code: params.CodeNotFound, Message: "mock pool manager: replace pool notfound not found"}},
+		{Error: &params.Error{Message: `pool name "#invalid" not valid`}},
+		{Error: &params.Error{Message: `changing provider of pool "pname" from "loop" to "ebs" not valid`}},
+	}})
+}
+
+func (s *poolUpdateSuite) TestUpdatePoolBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestUpdatePoolBlocked")
+	_, err := s.api.UpdatePool(params.StoragePoolArgs{[]params.StoragePool{{
+		Name: "pname",
+	}}})
+	s.assertBlocked(c, err, "TestUpdatePoolBlocked")
+}
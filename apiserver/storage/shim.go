@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/state/stateenvirons"
 	"github.com/juju/juju/storage/poolmanager"
@@ -22,6 +23,10 @@ import (
 
 func init() {
 	common.RegisterStandardFacade("Storage", 3, newAPI)
+	// Facade version 4 adds Detach(), Attach(), Remove(),
+	// CreateSnapshots(), ListSnapshots(), Resize(),
+	// UpdatePool() and RemovePool().
+	common.RegisterStandardFacade("Storage", 4, newAPI)
 }
 
 func newAPI(
@@ -69,6 +74,9 @@ type storageAccess interface {
 	// WatchFilesystemAttachment is required for storage functionality.
 	WatchFilesystemAttachment(names.MachineTag, names.FilesystemTag) state.NotifyWatcher
 
+	// WatchFilesystem is required for storage functionality.
+	WatchFilesystem(names.FilesystemTag) state.NotifyWatcher
+
 	// WatchVolumeAttachment is required for storage functionality.
 	WatchVolumeAttachment(names.MachineTag, names.VolumeTag) state.NotifyWatcher
 
@@ -81,9 +89,18 @@ type storageAccess interface {
 	// ModelName is required for pool functionality.
 	ModelName() (string, error)
 
+	// RemoveStoragePool is required for pool functionality.
+	RemoveStoragePool(name string) error
+
 	// ModelTag is required for model permission checking.
 	ModelTag() names.ModelTag
 
+	// ControllerTag is required for snapshot functionality.
+	ControllerTag() names.ControllerTag
+
+	// ModelConfig is required for snapshot functionality.
+	ModelConfig() (*config.Config, error)
+
 	// AllVolumes is required for volume functionality.
 	AllVolumes() ([]state.Volume, error)
 
@@ -111,6 +128,24 @@ type storageAccess interface {
 	// AddStorageForUnit is required for storage add functionality.
 	AddStorageForUnit(tag names.UnitTag, name string, cons state.StorageConstraints) error
 
+	// AddStorageForUnitFromSnapshot is required for storage add functionality.
+	AddStorageForUnitFromSnapshot(tag names.UnitTag, name string, cons state.StorageConstraints, snapshotId string) error
+
+	// AttachStorage is required for storage attach functionality.
+	AttachStorage(names.StorageTag, names.UnitTag) error
+
+	// DetachStorage is required for storage detach functionality.
+	DetachStorage(names.StorageTag, names.UnitTag) error
+
+	// DestroyStorageInstance is required for storage remove functionality.
+	DestroyStorageInstance(names.StorageTag) error
+
+	// ReleaseStorageInstance is required for storage remove functionality.
+	ReleaseStorageInstance(names.StorageTag) error
+
+	// ResizeStorageInstance is required for storage resize functionality.
+	ResizeStorageInstance(names.StorageTag, uint64) error
+
 	// GetBlockForType is required to block operations.
 	GetBlockForType(t state.BlockType) (state.Block, bool, error)
 }
@@ -0,0 +1,174 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/state"
+	jujustorage "github.com/juju/juju/storage"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type snapshotSuite struct {
+	baseStorageSuite
+	provider    *mockStorageProvider
+	snapshotter *mockVolumeSnapshotter
+	created     time.Time
+	params      []jujustorage.SnapshotParams
+}
+
+var _ = gc.Suite(&snapshotSuite{})
+
+func (s *snapshotSuite) SetUpTest(c *gc.C) {
+	s.baseStorageSuite.SetUpTest(c)
+	s.created = time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.params = nil
+	s.state.modelTag = coretesting.ModelTag
+	s.state.controllerTag = coretesting.ControllerTag
+	s.state.modelConfig = func() (*config.Config, error) {
+		return coretesting.ModelConfig(c), nil
+	}
+	s.snapshotter = &mockVolumeSnapshotter{
+		createSnapshots: func(args []jujustorage.SnapshotParams) ([]jujustorage.CreateSnapshotsResult, error) {
+			s.params = append(s.params, args...)
+			results := make([]jujustorage.CreateSnapshotsResult, len(args))
+			for i, arg := range args {
+				results[i].Snapshot = &jujustorage.Snapshot{
+					SnapshotId: "snap-" + arg.VolumeId,
+					VolumeId:   arg.VolumeId,
+					Size:       1024,
+					Created:    s.created,
+					Status:     "pending",
+				}
+			}
+			return results, nil
+		},
+		listSnapshots: func(volIds []string) ([]jujustorage.ListSnapshotsResult, error) {
+			results := make([]jujustorage.ListSnapshotsResult, len(volIds))
+			for i, volId := range volIds {
+				results[i].Snapshots = []jujustorage.Snapshot{{
+					SnapshotId: "snap-" + volId,
+					VolumeId:   volId,
+					Size:       1024,
+					Created:    s.created,
+					Status:     "completed",
+				}}
+			}
+			return results, nil
+		},
+	}
+	s.provider = &mockStorageProvider{
+		scope:        jujustorage.ScopeEnviron,
+		volumeSource: s.snapshotter,
+	}
+	s.registry.Providers["mock"] = s.provider
+	s.volume.info = &state.VolumeInfo{Pool: "mock", VolumeId: "vol-1", Size: 1024}
+}
+
+func (s *snapshotSuite) TestCreateSnapshots(c *gc.C) {
+	results, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{
+		{Tag: "storage-data-0"},
+		{Tag: "volume-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.SnapshotResults{Results: []params.SnapshotResult{{
+		Result: &params.Snapshot{
+			SnapshotId: "snap-vol-1",
+			StorageTag: "storage-data-0",
+			VolumeTag:  "volume-22",
+			VolumeId:   "vol-1",
+			Size:       1024,
+			Created:    s.created,
+			Status:     "pending",
+		},
+	}, {
+		Error: &params.Error{Message: `"volume-0" is not a valid storage tag`},
+	}}})
+	s.assertCalls(c, []string{getBlockForTypeCall, storageInstanceVolumeCall})
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsResourceTags(c *gc.C) {
+	_, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.params, jc.DeepEquals, []jujustorage.SnapshotParams{{
+		Volume:   s.volumeTag,
+		VolumeId: "vol-1",
+		ResourceTags: map[string]string{
+			tags.JujuModel:      coretesting.ModelTag.Id(),
+			tags.JujuController: coretesting.ControllerTag.Id(),
+		},
+	}})
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsPoolConfig(c *gc.C) {
+	pool, err := jujustorage.NewConfig("fast", "mock", map[string]interface{}{"speed": "fast"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.pools["fast"] = pool
+	s.volume.info.Pool = "fast"
+
+	_, err = s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.provider.sourceConfig.Name(), gc.Equals, "fast")
+	c.Assert(s.provider.sourceConfig.Attrs(), jc.DeepEquals, pool.Attrs())
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestCreateSnapshotsBlocked")
+	_, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	s.assertBlocked(c, err, "TestCreateSnapshotsBlocked")
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsUnprovisioned(c *gc.C) {
+	s.volume.info = nil
+	results, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "volume-22 not provisioned")
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsMachineScoped(c *gc.C) {
+	s.provider.scope = jujustorage.ScopeMachine
+	results, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, jc.Satisfies, params.IsCodeNotSupported)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "snapshots of machine-scoped mock volumes not supported")
+}
+
+func (s *snapshotSuite) TestCreateSnapshotsNotSupported(c *gc.C) {
+	s.provider.volumeSource = &mockVolumeSource{}
+	results, err := s.api.CreateSnapshots(params.Entities{[]params.Entity{{Tag: "storage-data-0"}}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, jc.Satisfies, params.IsCodeNotSupported)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "snapshots of mock volumes not supported")
+}
+
+func (s *snapshotSuite) TestListSnapshots(c *gc.C) {
+	results, err := s.api.ListSnapshots(params.Entities{[]params.Entity{
+		{Tag: "storage-data-0"},
+		{Tag: "storage-data-1"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.SnapshotListResults{Results: []params.SnapshotListResult{{
+		Result: []params.Snapshot{{
+			SnapshotId: "snap-vol-1",
+			StorageTag: "storage-data-0",
+			VolumeTag:  "volume-22",
+			VolumeId:   "vol-1",
+			Size:       1024,
+			Created:    s.created,
+			Status:     "completed",
+		}},
+	}, {
+		Error: &params.Error{Code: params.CodeNotFound, Message: "storage data/1 not found"},
+	}}})
+}
@@ -6,6 +6,8 @@
 package storage
 
 import (
+	"fmt"
+
 	"github.com/juju/errors"
 	"github.com/juju/utils/set"
 	"gopkg.in/juju/names.v2"
@@ -14,6 +16,7 @@ import (
 	"github.com/juju/juju/apiserver/common/storagecommon"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/permission"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/status"
@@ -195,9 +198,14 @@ func createStorageDetails(st storageAccess, si state.StorageInstance) (*params.S
 		}
 	}
 
+	var ownerTag string
+	if owner, ok := si.Owner(); ok {
+		ownerTag = owner.String()
+	}
+
 	return &params.StorageDetails{
 		StorageTag:  si.Tag().String(),
-		OwnerTag:    si.Owner().String(),
+		OwnerTag:    ownerTag,
 		Kind:        params.StorageKind(si.Kind()),
 		Status:      common.EntityStatusFromState(status),
 		Persistent:  persistent,
@@ -364,6 +372,76 @@ func (a *API) CreatePool(p params.StoragePool) error {
 	return err
 }
 
+// UpdatePool replaces the configuration attributes of existing pools.
+// The attributes are validated by the pools' storage providers. A
+// pool's provider cannot be changed. A "CHANGE" block can block this
+// operation.
+func (a *API) UpdatePool(args params.StoragePoolArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Pools))
+	for i, pool := range args.Pools {
+		if err := a.updatePool(pool); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *API) updatePool(pool params.StoragePool) error {
+	if !storage.IsValidPoolName(pool.Name) {
+		return errors.NotValidf("pool name %q", pool.Name)
+	}
+	if pool.Provider != "" {
+		existing, err := a.poolManager.Get(pool.Name)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if existing.Provider() != storage.ProviderType(pool.Provider) {
+			return errors.NotValidf(
+				"changing provider of pool %q from %q to %q",
+				pool.Name, existing.Provider(), pool.Provider,
+			)
+		}
+	}
+	_, err := a.poolManager.Replace(pool.Name, pool.Attrs)
+	return errors.Trace(err)
+}
+
+// RemovePool removes pools that are not referenced by any storage
+// constraints, volumes or filesystems. A "CHANGE" block can block
+// this operation.
+func (a *API) RemovePool(args params.StoragePoolDeleteArgs) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Pools))
+	for i, pool := range args.Pools {
+		if err := a.removePool(pool.Name); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *API) removePool(name string) error {
+	if !storage.IsValidPoolName(name) {
+		return errors.NotValidf("pool name %q", name)
+	}
+	return errors.Trace(a.storage.RemoveStoragePool(name))
+}
+
 // ListVolumes lists volumes with the given filters. Each filter produces
 // an independent list of volumes, or an error if the filter is invalid
 // or the volumes could not be listed.
@@ -702,10 +780,387 @@ func (a *API) AddToUnit(args params.StoragesAddParams) (params.ErrorResults, err
 			continue
 		}
 
-		err = a.storage.AddStorageForUnit(u, one.StorageName, paramsToState(one.Constraints))
+		if one.FromSnapshot != "" {
+			err = a.storage.AddStorageForUnitFromSnapshot(
+				u, one.StorageName, paramsToState(one.Constraints), one.FromSnapshot,
+			)
+		} else {
+			err = a.storage.AddStorageForUnit(u, one.StorageName, paramsToState(one.Constraints))
+		}
 		if err != nil {
 			result[i] = params.ErrorResult{Error: common.ServerError(err)}
 		}
 	}
 	return params.ErrorResults{Results: result}, nil
 }
+
+// Detach detaches storage instances from units, leaving them in the
+// model to be attached to other units. If the unit tag of an ID is
+// empty, the storage instance is detached from all of its units.
+// A "CHANGE" block can block this operation.
+func (a *API) Detach(args params.StorageAttachmentIds) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Ids))
+	for i, id := range args.Ids {
+		if err := a.detachStorage(id); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *API) detachStorage(id params.StorageAttachmentId) error {
+	storageTag, err := names.ParseStorageTag(id.StorageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if id.UnitTag != "" {
+		unitTag, err := names.ParseUnitTag(id.UnitTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return a.storage.DetachStorage(storageTag, unitTag)
+	}
+	attachments, err := a.storage.StorageAttachments(storageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, attachment := range attachments {
+		if err := a.storage.DetachStorage(storageTag, attachment.Unit()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// Attach attaches existing, detached storage instances to units.
+// A "CHANGE" block can block this operation.
+func (a *API) Attach(args params.StorageAttachmentIds) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Ids))
+	for i, id := range args.Ids {
+		if err := a.attachStorage(id); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+func (a *API) attachStorage(id params.StorageAttachmentId) error {
+	storageTag, err := names.ParseStorageTag(id.StorageTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	unitTag, err := names.ParseUnitTag(id.UnitTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return a.storage.AttachStorage(storageTag, unitTag)
+}
+
+// Remove removes storage instances from the model. Each storage
+// instance's cloud storage is either destroyed, or released so that
+// it is left in the cloud but no longer managed by Juju.
+// A "REMOVE" block can block this operation.
+func (a *API) Remove(args params.RemoveStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.RemoveAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			result[i].Error = common.ServerError(err)
+			continue
+		}
+		if arg.Release {
+			err = a.storage.ReleaseStorageInstance(tag)
+		} else {
+			err = a.storage.DestroyStorageInstance(tag)
+		}
+		if err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// Resize requests that the volumes of the specified storage instances
+// be grown to the given sizes. The storage provisioner responsible for
+// each volume resizes it, and any filesystem on it is grown to match.
+// A "CHANGE" block can block this operation.
+func (a *API) Resize(args params.ResizeStorage) (params.ErrorResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.ErrorResults{}, errors.Trace(err)
+	}
+
+	result := make([]params.ErrorResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		tag, err := names.ParseStorageTag(arg.Tag)
+		if err != nil {
+			result[i].Error = common.ServerError(err)
+			continue
+		}
+		if err := a.checkVolumeResizable(tag); err != nil {
+			result[i].Error = common.ServerError(err)
+			continue
+		}
+		if err := a.storage.ResizeStorageInstance(tag, arg.Size); err != nil {
+			result[i].Error = common.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: result}, nil
+}
+
+// checkVolumeResizable returns an error if the volume of the specified
+// storage instance is managed by the controller's storage provisioner,
+// and that cannot resize it as it is. Machine-scoped volumes are left
+// to the machine storage provisioners.
+func (a *API) checkVolumeResizable(tag names.StorageTag) error {
+	volume, info, err := a.storageVolumeInfo(tag)
+	if errors.IsNotFound(err) || errors.IsNotProvisioned(err) {
+		// ResizeStorageInstance reports why
+		// the storage cannot be resized.
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	source, providerType, err := a.environVolumeSource(info.Pool)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if source == nil {
+		return nil
+	}
+	resizer, ok := source.(storage.VolumeResizer)
+	if !ok {
+		return errors.NotSupportedf("resizing %s volumes", providerType)
+	}
+	if resizer.ResizesAttachedVolumes() {
+		return nil
+	}
+	attachments, err := a.storage.VolumeAttachments(volume.VolumeTag())
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(attachments) > 0 {
+		return errors.NewNotSupported(nil, fmt.Sprintf(
+			"cannot resize attached %s volume for storage %s; detach the storage first",
+			providerType, tag.Id(),
+		))
+	}
+	return nil
+}
+
+// CreateSnapshots creates snapshots of the volumes of the specified
+// storage instances. Only storage whose volumes are managed by an
+// environ-scoped storage provider that supports snapshots can be
+// snapshotted. A "CHANGE" block can block this operation.
+func (a *API) CreateSnapshots(args params.Entities) (params.SnapshotResults, error) {
+	if err := a.checkCanWrite(); err != nil {
+		return params.SnapshotResults{}, errors.Trace(err)
+	}
+	blockChecker := common.NewBlockChecker(a.storage)
+	if err := blockChecker.ChangeAllowed(); err != nil {
+		return params.SnapshotResults{}, errors.Trace(err)
+	}
+
+	results := make([]params.SnapshotResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		snapshot, err := a.createSnapshot(entity.Tag)
+		if err != nil {
+			results[i].Error = common.ServerError(err)
+			continue
+		}
+		results[i].Result = snapshot
+	}
+	return params.SnapshotResults{Results: results}, nil
+}
+
+func (a *API) createSnapshot(tag string) (*params.Snapshot, error) {
+	storageTag, err := names.ParseStorageTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	volume, info, err := a.storageVolumeInfo(storageTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotter, err := a.volumeSnapshotter(info.Pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	modelConfig, err := a.storage.ModelConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resourceTags := tags.ResourceTags(
+		a.storage.ModelTag(),
+		a.storage.ControllerTag(),
+		modelConfig,
+	)
+	results, err := snapshotter.CreateSnapshots([]storage.SnapshotParams{{
+		Volume:       volume.VolumeTag(),
+		VolumeId:     info.VolumeId,
+		ResourceTags: resourceTags,
+	}})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return nil, errors.Trace(results[0].Error)
+	}
+	snapshot := snapshotFromStorage(storageTag, volume.VolumeTag(), *results[0].Snapshot)
+	return &snapshot, nil
+}
+
+// ListSnapshots lists the snapshots of the volumes of the specified
+// storage instances.
+func (a *API) ListSnapshots(args params.Entities) (params.SnapshotListResults, error) {
+	if err := a.checkCanRead(); err != nil {
+		return params.SnapshotListResults{}, errors.Trace(err)
+	}
+	results := make([]params.SnapshotListResult, len(args.Entities))
+	for i, entity := range args.Entities {
+		snapshots, err := a.listSnapshots(entity.Tag)
+		if err != nil {
+			results[i].Error = common.ServerError(err)
+			continue
+		}
+		results[i].Result = snapshots
+	}
+	return params.SnapshotListResults{Results: results}, nil
+}
+
+func (a *API) listSnapshots(tag string) ([]params.Snapshot, error) {
+	storageTag, err := names.ParseStorageTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	volume, info, err := a.storageVolumeInfo(storageTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotter, err := a.volumeSnapshotter(info.Pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results, err := snapshotter.ListSnapshots([]string{info.VolumeId})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results))
+	}
+	if results[0].Error != nil {
+		return nil, errors.Trace(results[0].Error)
+	}
+	snapshots := make([]params.Snapshot, len(results[0].Snapshots))
+	for i, snapshot := range results[0].Snapshots {
+		snapshots[i] = snapshotFromStorage(storageTag, volume.VolumeTag(), snapshot)
+	}
+	return snapshots, nil
+}
+
+// storageVolumeInfo returns the volume of the storage instance, and
+// its provisioning information.
+func (a *API) storageVolumeInfo(tag names.StorageTag) (state.Volume, state.VolumeInfo, error) {
+	volume, err := a.storage.StorageInstanceVolume(tag)
+	if err != nil {
+		return nil, state.VolumeInfo{}, errors.Trace(err)
+	}
+	info, err := volume.Info()
+	if err != nil {
+		return nil, state.VolumeInfo{}, errors.Trace(err)
+	}
+	return volume, info, nil
+}
+
+// volumeSnapshotter returns the VolumeSnapshotter for the source of
+// volumes in the named storage pool.
+func (a *API) volumeSnapshotter(pool string) (storage.VolumeSnapshotter, error) {
+	source, providerType, err := a.environVolumeSource(pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if source == nil {
+		// Machine-scoped volumes can only be managed by
+		// the machine's storage provisioner.
+		return nil, errors.NotSupportedf("snapshots of machine-scoped %s volumes", providerType)
+	}
+	snapshotter, ok := source.(storage.VolumeSnapshotter)
+	if !ok {
+		return nil, errors.NotSupportedf("snapshots of %s volumes", providerType)
+	}
+	return snapshotter, nil
+}
+
+// environVolumeSource returns the source of volumes in the named
+// storage pool, and the type of the pool's storage provider. If the
+// provider is not environ-scoped, a nil source is returned.
+func (a *API) environVolumeSource(pool string) (storage.VolumeSource, storage.ProviderType, error) {
+	providerType, poolConfig, err := storagecommon.StoragePoolConfig(pool, a.poolManager, a.registry)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	provider, err := a.registry.StorageProvider(providerType)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	if provider.Scope() != storage.ScopeEnviron {
+		return nil, providerType, nil
+	}
+	// The volume source is configured with the pool's attributes,
+	// which the storage provisioner passes along with each volume.
+	attrs := poolConfig.Attrs()
+	if attrs == nil {
+		attrs = make(map[string]interface{})
+	}
+	sourceConfig, err := storage.NewConfig(pool, providerType, attrs)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	source, err := provider.VolumeSource(sourceConfig)
+	if err != nil {
+		return nil, "", errors.Trace(err)
+	}
+	return source, providerType, nil
+}
+
+func snapshotFromStorage(storageTag names.StorageTag, volumeTag names.VolumeTag, s storage.Snapshot) params.Snapshot {
+	return params.Snapshot{
+		SnapshotId: s.SnapshotId,
+		StorageTag: storageTag.String(),
+		VolumeTag:  volumeTag.String(),
+		VolumeId:   s.VolumeId,
+		Size:       s.Size,
+		Created:    s.Created,
+		Status:     s.Status,
+	}
+}
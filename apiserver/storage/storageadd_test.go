@@ -50,6 +50,23 @@ func (s *storageAddSuite) TestStorageAddUnit(c *gc.C) {
 	s.assertCalls(c, []string{getBlockForTypeCall, addStorageForUnitCall})
 }
 
+func (s *storageAddSuite) TestStorageAddUnitFromSnapshot(c *gc.C) {
+	var snapshotIds []string
+	s.state.addStorageForUnitFromSnapshot = func(u names.UnitTag, name string, cons state.StorageConstraints, snapshotId string) error {
+		s.calls = append(s.calls, addStorageForUnitFromSnapshotCall)
+		snapshotIds = append(snapshotIds, snapshotId)
+		return nil
+	}
+	args := params.StorageAddParams{
+		UnitTag:      s.unitTag.String(),
+		StorageName:  "data",
+		FromSnapshot: "snap-1",
+	}
+	s.assertStorageAddedNoErrors(c, args)
+	s.assertCalls(c, []string{getBlockForTypeCall, addStorageForUnitFromSnapshotCall})
+	c.Assert(snapshotIds, jc.DeepEquals, []string{"snap-1"})
+}
+
 func (s *storageAddSuite) TestStorageAddUnitBlocked(c *gc.C) {
 	s.blockAllChanges(c, "TestStorageAddUnitBlocked")
 
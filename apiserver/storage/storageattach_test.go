@@ -0,0 +1,230 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+	jujustorage "github.com/juju/juju/storage"
+)
+
+type storageAttachSuite struct {
+	baseStorageSuite
+}
+
+var _ = gc.Suite(&storageAttachSuite{})
+
+func (s *storageAttachSuite) TestDetach(c *gc.C) {
+	var detached []string
+	s.state.detachStorage = func(storage names.StorageTag, unit names.UnitTag) error {
+		s.calls = append(s.calls, detachStorageCall)
+		detached = append(detached, storage.Id()+":"+unit.Id())
+		return nil
+	}
+	results, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: "storage-data-0", UnitTag: "unit-mysql-1"},
+		{StorageTag: "storage-data-0"},
+		{StorageTag: "volume-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{},
+		{Error: &params.Error{Message: `"volume-0" is not a valid storage tag`}},
+	}})
+	c.Assert(detached, jc.DeepEquals, []string{"data/0:mysql/1", "data/0:mysql/0"})
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		detachStorageCall,
+		storageInstanceAttachmentsCall,
+		detachStorageCall,
+	})
+}
+
+func (s *storageAttachSuite) TestDetachError(c *gc.C) {
+	s.state.detachStorage = func(storage names.StorageTag, unit names.UnitTag) error {
+		return errors.New("boom")
+	}
+	results, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: "storage-data-0", UnitTag: "unit-mysql-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 1)
+	c.Assert(results.Results[0].Error, gc.ErrorMatches, "boom")
+}
+
+func (s *storageAttachSuite) TestDetachBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestDetachBlocked")
+	_, err := s.api.Detach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: "storage-data-0"},
+	}})
+	s.assertBlocked(c, err, "TestDetachBlocked")
+}
+
+func (s *storageAttachSuite) TestAttach(c *gc.C) {
+	var attached []string
+	s.state.attachStorage = func(storage names.StorageTag, unit names.UnitTag) error {
+		s.calls = append(s.calls, attachStorageCall)
+		attached = append(attached, storage.Id()+":"+unit.Id())
+		return nil
+	}
+	results, err := s.api.Attach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: "storage-data-0", UnitTag: "unit-mysql-1"},
+		{StorageTag: "storage-data-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: `"" is not a valid unit tag`}},
+	}})
+	c.Assert(attached, jc.DeepEquals, []string{"data/0:mysql/1"})
+	s.assertCalls(c, []string{getBlockForTypeCall, attachStorageCall})
+}
+
+func (s *storageAttachSuite) TestAttachBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestAttachBlocked")
+	_, err := s.api.Attach(params.StorageAttachmentIds{[]params.StorageAttachmentId{
+		{StorageTag: "storage-data-0", UnitTag: "unit-mysql-1"},
+	}})
+	s.assertBlocked(c, err, "TestAttachBlocked")
+}
+
+func (s *storageAttachSuite) TestRemove(c *gc.C) {
+	results, err := s.api.Remove(params.RemoveStorage{[]params.RemoveStorageInstance{
+		{Tag: "storage-data-0"},
+		{Tag: "storage-data-1", Release: true},
+		{Tag: "unit-mysql-0"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{},
+		{Error: &params.Error{Message: `"unit-mysql-0" is not a valid storage tag`}},
+	}})
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		destroyStorageInstanceCall,
+		releaseStorageInstanceCall,
+	})
+}
+
+func (s *storageAttachSuite) TestRemoveBlocked(c *gc.C) {
+	s.blockRemoveObject(c, "TestRemoveBlocked")
+	_, err := s.api.Remove(params.RemoveStorage{[]params.RemoveStorageInstance{
+		{Tag: "storage-data-0"},
+	}})
+	s.assertBlocked(c, err, "TestRemoveBlocked")
+}
+
+func (s *storageAttachSuite) TestResize(c *gc.C) {
+	var resized []string
+	s.state.resizeStorageInstance = func(tag names.StorageTag, size uint64) error {
+		s.calls = append(s.calls, resizeStorageInstanceCall)
+		if tag.Id() == "data/1" {
+			return errors.NotValidf("size %dMiB", size)
+		}
+		resized = append(resized, tag.Id())
+		return nil
+	}
+	results, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+		{Tag: "storage-data-1", Size: 1},
+		{Tag: "unit-mysql-0", Size: 2048},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: `size 1MiB not valid`}},
+		{Error: &params.Error{Message: `"unit-mysql-0" is not a valid storage tag`}},
+	}})
+	c.Assert(resized, jc.DeepEquals, []string{"data/0"})
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		storageInstanceVolumeCall,
+		resizeStorageInstanceCall,
+		storageInstanceVolumeCall,
+		resizeStorageInstanceCall,
+	})
+}
+
+func (s *storageAttachSuite) setUpEnvironVolume(c *gc.C, source jujustorage.VolumeSource) {
+	s.registry.Providers["mock"] = &mockStorageProvider{
+		scope:        jujustorage.ScopeEnviron,
+		volumeSource: source,
+	}
+	s.volume.info = &state.VolumeInfo{Pool: "mock", VolumeId: "vol-1", Size: 1024}
+	s.state.resizeStorageInstance = func(tag names.StorageTag, size uint64) error {
+		s.calls = append(s.calls, resizeStorageInstanceCall)
+		return nil
+	}
+}
+
+func (s *storageAttachSuite) TestResizeNotSupported(c *gc.C) {
+	s.setUpEnvironVolume(c, &mockVolumeSource{})
+	results, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{
+		{Error: &params.Error{Code: params.CodeNotSupported, Message: "resizing mock volumes not supported"}},
+	}})
+	s.assertCalls(c, []string{getBlockForTypeCall, storageInstanceVolumeCall})
+}
+
+func (s *storageAttachSuite) TestResizeAttachedNotSupported(c *gc.C) {
+	s.setUpEnvironVolume(c, &mockVolumeResizer{})
+	results, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{
+		Error: &params.Error{
+			Code:    params.CodeNotSupported,
+			Message: "cannot resize attached mock volume for storage data/0; detach the storage first",
+		},
+	}}})
+	s.assertCalls(c, []string{getBlockForTypeCall, storageInstanceVolumeCall, volumeAttachmentsCall})
+}
+
+func (s *storageAttachSuite) TestResizeDetached(c *gc.C) {
+	s.setUpEnvironVolume(c, &mockVolumeResizer{})
+	s.state.volumeAttachments = func(names.VolumeTag) ([]state.VolumeAttachment, error) {
+		s.calls = append(s.calls, volumeAttachmentsCall)
+		return nil, nil
+	}
+	results, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{}}})
+	s.assertCalls(c, []string{
+		getBlockForTypeCall,
+		storageInstanceVolumeCall,
+		volumeAttachmentsCall,
+		resizeStorageInstanceCall,
+	})
+}
+
+func (s *storageAttachSuite) TestResizeAttached(c *gc.C) {
+	s.setUpEnvironVolume(c, &mockVolumeResizer{resizesAttached: true})
+	results, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{Results: []params.ErrorResult{{}}})
+	s.assertCalls(c, []string{getBlockForTypeCall, storageInstanceVolumeCall, resizeStorageInstanceCall})
+}
+
+func (s *storageAttachSuite) TestResizeBlocked(c *gc.C) {
+	s.blockAllChanges(c, "TestResizeBlocked")
+	_, err := s.api.Resize(params.ResizeStorage{[]params.ResizeStorageInstance{
+		{Tag: "storage-data-0", Size: 2048},
+	}})
+	s.assertBlocked(c, err, "TestResizeBlocked")
+}
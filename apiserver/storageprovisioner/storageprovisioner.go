@@ -91,7 +91,18 @@ func NewStorageProvisionerAPI(
 		case names.FilesystemTag:
 			machineTag, ok := names.FilesystemMachine(tag)
 			if ok {
-				return canAccessStorageMachine(machineTag, false)
+				if canAccessStorageMachine(machineTag, false) {
+					return true
+				}
+				// A volume-backed filesystem that was detached
+				// from the machine it is scoped to may now be
+				// attached to the authenticated machine.
+				authTag, ok := authorizer.GetAuthTag().(names.MachineTag)
+				if !ok {
+					return false
+				}
+				_, err := st.FilesystemAttachment(authTag, tag)
+				return err == nil
 			}
 			return authorizer.AuthModelManager()
 		case names.MachineTag:
@@ -254,6 +265,13 @@ func (s *StorageProvisionerAPI) WatchVolumes(args params.Entities) (params.Strin
 	return s.watchStorageEntities(args, s.st.WatchModelVolumes, s.st.WatchMachineVolumes)
 }
 
+// WatchVolumeResizes watches for changes to volumes scoped to the
+// entity with the tag passed to NewState, so that pending resizes
+// may be actioned.
+func (s *StorageProvisionerAPI) WatchVolumeResizes(args params.Entities) (params.StringsWatchResults, error) {
+	return s.watchStorageEntities(args, s.st.WatchModelVolumeResizes, s.st.WatchMachineVolumeResizes)
+}
+
 // WatchFilesystems watches for changes to filesystems scoped
 // to the entity with the tag passed to NewState.
 func (s *StorageProvisionerAPI) WatchFilesystems(args params.Entities) (params.StringsWatchResults, error) {
@@ -622,6 +640,64 @@ func (s *StorageProvisionerAPI) VolumeParams(args params.Entities) (params.Volum
 	return results, nil
 }
 
+// VolumeResizeParams returns the parameters for growing the volumes
+// with the specified tags. If a volume has no pending resize, a
+// NotFound error is returned for it.
+func (s *StorageProvisionerAPI) VolumeResizeParams(args params.Entities) (params.VolumeResizeParamsResults, error) {
+	canAccess, err := s.getStorageEntityAuthFunc()
+	if err != nil {
+		return params.VolumeResizeParamsResults{}, err
+	}
+	results := params.VolumeResizeParamsResults{
+		Results: make([]params.VolumeResizeParamsResult, len(args.Entities)),
+	}
+	one := func(arg params.Entity) (params.VolumeResizeParams, error) {
+		tag, err := names.ParseVolumeTag(arg.Tag)
+		if err != nil || !canAccess(tag) {
+			return params.VolumeResizeParams{}, common.ErrPerm
+		}
+		volume, err := s.st.Volume(tag)
+		if errors.IsNotFound(err) {
+			return params.VolumeResizeParams{}, common.ErrPerm
+		} else if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		size, ok := volume.PendingSize()
+		if !ok {
+			return params.VolumeResizeParams{}, errors.NotFoundf(
+				"pending resize of volume %q", tag.Id(),
+			)
+		}
+		volumeInfo, err := volume.Info()
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		providerType, _, err := storagecommon.StoragePoolConfig(
+			volumeInfo.Pool, s.poolManager, s.registry,
+		)
+		if err != nil {
+			return params.VolumeResizeParams{}, err
+		}
+		return params.VolumeResizeParams{
+			VolumeTag: tag.String(),
+			VolumeId:  volumeInfo.VolumeId,
+			Provider:  string(providerType),
+			Size:      size,
+		}, nil
+	}
+	for i, arg := range args.Entities {
+		var result params.VolumeResizeParamsResult
+		resizeParams, err := one(arg)
+		if err != nil {
+			result.Error = common.ServerError(err)
+		} else {
+			result.Result = resizeParams
+		}
+		results.Results[i] = result
+	}
+	return results, nil
+}
+
 // FilesystemParams returns the parameters for creating the filesystems
 // with the specified tags.
 func (s *StorageProvisionerAPI) FilesystemParams(args params.Entities) (params.FilesystemParamsResults, error) {
@@ -943,6 +1019,14 @@ func (s *StorageProvisionerAPI) SetVolumeInfo(args params.Volumes) (params.Error
 		} else if !canAccessVolume(volumeTag) {
 			return common.ErrPerm
 		}
+		// The pool is not known to the provisioner. When updating the
+		// info of an already-provisioned volume, e.g. after resizing,
+		// carry over the existing pool so that it is left unchanged.
+		if volume, err := s.st.Volume(volumeTag); err == nil {
+			if oldInfo, err := volume.Info(); err == nil {
+				volumeInfo.Pool = oldInfo.Pool
+			}
+		}
 		err = s.st.SetVolumeInfo(volumeTag, volumeInfo)
 		if errors.IsNotFound(err) {
 			return common.ErrPerm
@@ -972,6 +1056,13 @@ func (s *StorageProvisionerAPI) SetFilesystemInfo(args params.Filesystems) (para
 		} else if !canAccessFilesystem(filesystemTag) {
 			return common.ErrPerm
 		}
+		// As with volumes, carry over the pool of an
+		// already-provisioned filesystem.
+		if filesystem, err := s.st.Filesystem(filesystemTag); err == nil {
+			if oldInfo, err := filesystem.Info(); err == nil {
+				filesystemInfo.Pool = oldInfo.Pool
+			}
+		}
 		err = s.st.SetFilesystemInfo(filesystemTag, filesystemInfo)
 		if errors.IsNotFound(err) {
 			return common.ErrPerm
@@ -409,6 +409,69 @@ func (s *provisionerSuite) TestVolumeParamsEmptyArgs(c *gc.C) {
 	c.Assert(results.Results, gc.HasLen, 0)
 }
 
+func (s *provisionerSuite) TestVolumeResizeParams(c *gc.C) {
+	s.setupVolumes(c)
+	err := s.State.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := s.api.VolumeResizeParams(params.Entities{
+		Entities: []params.Entity{
+			{"volume-0-0"},
+			{"volume-2"},
+			{"volume-42"},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.VolumeResizeParamsResults{
+		Results: []params.VolumeResizeParamsResult{
+			{Error: &params.Error{Message: `pending resize of volume "0/0" not found`, Code: "not found"}},
+			{Result: params.VolumeResizeParams{
+				VolumeTag: "volume-2",
+				VolumeId:  "def",
+				Provider:  "environscoped",
+				Size:      8192,
+			}},
+			{Error: &params.Error{Message: "permission denied", Code: "unauthorized access"}},
+		},
+	})
+}
+
+func (s *provisionerSuite) TestSetVolumeInfoResized(c *gc.C) {
+	s.setupVolumes(c)
+	err := s.State.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Updating the info of a provisioned volume leaves its pool
+	// intact, and completes any pending resize.
+	results, err := s.api.SetVolumeInfo(params.Volumes{
+		Volumes: []params.Volume{{
+			VolumeTag: "volume-2",
+			Info: params.VolumeInfo{
+				HardwareId: "456",
+				VolumeId:   "def",
+				Size:       8192,
+			},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+
+	volume, err := s.State.Volume(names.NewVolumeTag("2"))
+	c.Assert(err, jc.ErrorIsNil)
+	info, err := volume.Info()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(info, jc.DeepEquals, state.VolumeInfo{
+		HardwareId: "456",
+		VolumeId:   "def",
+		Size:       8192,
+		Pool:       "environscoped",
+	})
+	_, ok := volume.PendingSize()
+	c.Assert(ok, jc.IsFalse)
+}
+
 func (s *provisionerSuite) TestFilesystemParams(c *gc.C) {
 	s.setupFilesystems(c)
 	results, err := s.api.FilesystemParams(params.Entities{
@@ -711,6 +774,45 @@ func (s *provisionerSuite) TestWatchVolumes(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *provisionerSuite) TestWatchVolumeResizes(c *gc.C) {
+	s.setupVolumes(c)
+	c.Assert(s.resources.Count(), gc.Equals, 0)
+
+	args := params.Entities{Entities: []params.Entity{
+		{"machine-0"},
+		{s.State.ModelTag().String()},
+		{"machine-42"}},
+	}
+	result, err := s.api.WatchVolumeResizes(args)
+	c.Assert(err, jc.ErrorIsNil)
+	sort.Strings(result.Results[1].Changes)
+	c.Assert(result, jc.DeepEquals, params.StringsWatchResults{
+		Results: []params.StringsWatchResult{
+			{StringsWatcherId: "1", Changes: []string{"0/0"}},
+			{StringsWatcherId: "2", Changes: []string{"1", "2", "3", "4"}},
+			{Error: apiservertesting.ErrUnauthorized},
+		},
+	})
+
+	// Verify the resources were registered and stop them when done.
+	c.Assert(s.resources.Count(), gc.Equals, 2)
+	v0Watcher := s.resources.Get("1")
+	defer statetesting.AssertStop(c, v0Watcher)
+	v1Watcher := s.resources.Get("2")
+	defer statetesting.AssertStop(c, v1Watcher)
+
+	wc0 := statetesting.NewStringsWatcherC(c, s.State, v0Watcher.(state.StringsWatcher))
+	wc0.AssertNoChange()
+	wc1 := statetesting.NewStringsWatcherC(c, s.State, v1Watcher.(state.StringsWatcher))
+	wc1.AssertNoChange()
+
+	err = s.State.ResizeVolume(names.NewVolumeTag("2"), 8192)
+	c.Assert(err, jc.ErrorIsNil)
+	wc0.AssertNoChange()
+	wc1.AssertChangeInSingleEvent("2")
+	wc1.AssertNoChange()
+}
+
 func (s *provisionerSuite) TestWatchVolumeAttachments(c *gc.C) {
 	s.setupVolumes(c)
 	s.factory.MakeMachine(c, nil)
@@ -75,6 +75,8 @@ func (fa FakeAuthorizer) HasPermission(operation permission.Access, target names
 // setting permissionname as the name that user will always have the given permission.
 // setting permissionnamemodeltagstring as the name will make that user have the given
 // permission only in that model.
+// setting permissionnameapplicationname as the name will make that user have
+// the given permission only on that application.
 func nameBasedHasPermission(name string, operation permission.Access, target names.Tag) bool {
 	var perm permission.Access
 	switch {
@@ -84,6 +86,12 @@ func nameBasedHasPermission(name string, operation permission.Access, target nam
 		return operation == permission.AddModelAccess
 	case strings.HasPrefix(name, string(permission.LoginAccess)):
 		return operation == permission.LoginAccess
+	case strings.HasPrefix(name, string(permission.RunActionsAccess)):
+		perm = permission.RunActionsAccess
+	case strings.HasPrefix(name, string(permission.DebugAccess)):
+		perm = permission.DebugAccess
+	case strings.HasPrefix(name, string(permission.DeployAccess)):
+		perm = permission.DeployAccess
 	case strings.HasPrefix(name, string(permission.AdminAccess)):
 		perm = permission.AdminAccess
 	case strings.HasPrefix(name, string(permission.WriteAccess)):
@@ -100,6 +108,9 @@ func nameBasedHasPermission(name string, operation permission.Access, target nam
 	if len(name) == 0 {
 		return operation == perm
 	}
+	if target.Kind() == names.ApplicationTagKind {
+		return operation == perm && name == target.Id()
+	}
 	if target.Kind() != names.ModelTagKind {
 		return false
 	}
@@ -0,0 +1,157 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package uniter
+
+import (
+	"github.com/juju/errors"
+	"gopkg.in/juju/charm.v6-unstable"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/common"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/state"
+)
+
+// GoalStates returns the goal state of each given unit: the units
+// expected for its application, and the applications and units
+// expected at the other end of each of its relations. Units that are
+// still being provisioned or are dying are included.
+func (u *UniterAPIV3) GoalStates(args params.Entities) (params.GoalStateResults, error) {
+	result := params.GoalStateResults{
+		Results: make([]params.GoalStateResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.GoalStateResults{}, err
+	}
+	for i, entity := range args.Entities {
+		resultItem := &result.Results[i]
+		tag, err := names.ParseUnitTag(entity.Tag)
+		if err != nil {
+			resultItem.Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		if !canAccess(tag) {
+			resultItem.Error = common.ServerError(common.ErrPerm)
+			continue
+		}
+		unit, err := u.getUnit(tag)
+		if err != nil {
+			resultItem.Error = common.ServerError(err)
+			continue
+		}
+		resultItem.Result, err = u.goalState(unit)
+		if err != nil {
+			resultItem.Error = common.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+func (u *UniterAPIV3) goalState(unit *state.Unit) (*params.GoalState, error) {
+	app, err := unit.Application()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// Subordinate units are only expected alongside the principal
+	// unit the local unit belongs to.
+	principalName := unit.Name()
+	if principal, ok := unit.PrincipalName(); ok {
+		principalName = principal
+	}
+
+	units, err := goalStateUnits(app, principalName)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	goalState := &params.GoalState{
+		Units:     units,
+		Relations: make(map[string]params.UnitsGoalState),
+	}
+
+	relations, err := app.Relations()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, rel := range relations {
+		local, err := rel.Endpoint(app.Name())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		// The units of peer relations are those of the application.
+		if local.Role == charm.RolePeer {
+			continue
+		}
+		related, err := rel.RelatedEndpoints(app.Name())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		relationState, ok := goalState.Relations[local.Name]
+		if !ok {
+			relationState = make(params.UnitsGoalState)
+			goalState.Relations[local.Name] = relationState
+		}
+		for _, ep := range related {
+			relationState[ep.ApplicationName] = params.GoalStateStatus{
+				Status: relationGoalStatus(rel),
+			}
+			relatedApp, err := u.st.Application(ep.ApplicationName)
+			if errors.IsNotFound(err) {
+				// The units of remote applications aren't known.
+				continue
+			} else if err != nil {
+				return nil, errors.Trace(err)
+			}
+			relatedUnits, err := goalStateUnits(relatedApp, principalName)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			for name, unitState := range relatedUnits {
+				relationState[name] = unitState
+			}
+		}
+	}
+	return goalState, nil
+}
+
+// goalStateUnits returns the goal state of the units of the
+// application, leaving out dead units and the subordinates of
+// principals other than the named one.
+func goalStateUnits(app *state.Application, principalName string) (params.UnitsGoalState, error) {
+	units, err := app.AllUnits()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make(params.UnitsGoalState)
+	for _, unit := range units {
+		if principal, ok := unit.PrincipalName(); ok && principal != principalName {
+			continue
+		}
+		life := unit.Life()
+		if life == state.Dead {
+			continue
+		}
+		statusInfo, err := unit.Status()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		unitState := params.GoalStateStatus{
+			Status: statusInfo.Status.String(),
+			Since:  statusInfo.Since,
+		}
+		if life == state.Dying {
+			unitState.Status = life.String()
+		}
+		result[unit.Name()] = unitState
+	}
+	return result, nil
+}
+
+// relationGoalStatus returns the goal state status of the relation.
+func relationGoalStatus(rel *state.Relation) string {
+	if life := rel.Life(); life != state.Alive {
+		return life.String()
+	}
+	return "joined"
+}
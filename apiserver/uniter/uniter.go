@@ -29,6 +29,10 @@ var logger = loggo.GetLogger("juju.apiserver.uniter")
 
 func init() {
 	common.RegisterStandardFacade("Uniter", 4, NewUniterAPIV4)
+	// Facade version 5 adds ActionStatus() and WatchActionStatus(),
+	// so that units can stop aborted actions, LogActionsMessages()
+	// and GoalStates().
+	common.RegisterStandardFacade("Uniter", 5, NewUniterAPIV4)
 }
 
 // UniterAPIV3 implements the API version 3, used by the uniter worker.
@@ -930,6 +934,63 @@ func (u *UniterAPIV3) Actions(args params.Entities) (params.ActionResults, error
 	return common.Actions(args, actionFn), nil
 }
 
+// ActionStatus returns the current status of the Actions by Tags passed,
+// so that the Unit running them can tell when they are to be aborted.
+func (u *UniterAPIV3) ActionStatus(args params.Entities) (params.StringResults, error) {
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.StringResults{}, err
+	}
+
+	actionFn := common.AuthAndActionFromTagFn(canAccess, u.st.ActionByTag)
+	return common.ActionStatus(args, actionFn), nil
+}
+
+// WatchActionStatus returns a NotifyWatcher for observing changes to
+// each of the Actions by Tags passed, so that the Unit running them
+// can tell when they are to be aborted.
+func (u *UniterAPIV3) WatchActionStatus(args params.Entities) (params.NotifyWatchResults, error) {
+	result := params.NotifyWatchResults{
+		Results: make([]params.NotifyWatchResult, len(args.Entities)),
+	}
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.NotifyWatchResults{}, err
+	}
+	actionFn := common.AuthAndActionFromTagFn(canAccess, u.st.ActionByTag)
+	for i, entity := range args.Entities {
+		action, err := actionFn(entity.Tag)
+		if err != nil {
+			result.Results[i].Error = common.ServerError(err)
+			continue
+		}
+		watch := action.Watch()
+		// Consume the initial event. Technically, API
+		// calls to Watch 'transmit' the initial event
+		// in the Watch response. But NotifyWatchers
+		// have no state to transmit.
+		if _, ok := <-watch.Changes(); ok {
+			result.Results[i].NotifyWatcherId = u.resources.Register(watch)
+		} else {
+			err = watcher.EnsureErr(watch)
+			result.Results[i].Error = common.ServerError(err)
+		}
+	}
+	return result, nil
+}
+
+// LogActionsMessages records the progress messages logged by running
+// Actions.
+func (u *UniterAPIV3) LogActionsMessages(args params.ActionMessageParams) (params.ErrorResults, error) {
+	canAccess, err := u.accessUnit()
+	if err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	actionFn := common.AuthAndActionFromTagFn(canAccess, u.st.ActionByTag)
+	return common.LogActionsMessages(args, actionFn), nil
+}
+
 // BeginActions marks the actions represented by the passed in Tags as running.
 func (u *UniterAPIV3) BeginActions(args params.Entities) (params.ErrorResults, error) {
 	canAccess, err := u.accessUnit()
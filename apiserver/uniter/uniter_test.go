@@ -807,6 +807,69 @@ func (s *uniterSuite) TestWorkloadVersion(c *gc.C) {
 	})
 }
 
+func (s *uniterSuite) TestGoalStates(c *gc.C) {
+	s.addRelation(c, "wordpress", "mysql")
+	wordpressUnit1 := s.Factory.MakeUnit(c, &jujuFactory.UnitParams{
+		Application: s.wordpress,
+	})
+	mysqlUnit1 := s.Factory.MakeUnit(c, &jujuFactory.UnitParams{
+		Application: s.mysql,
+	})
+
+	now := time.Now()
+	for unit, unitStatus := range map[*state.Unit]status.Status{
+		s.wordpressUnit: status.Active,
+		wordpressUnit1:  status.Waiting,
+		s.mysqlUnit:     status.Active,
+		mysqlUnit1:      status.Active,
+	} {
+		err := unit.SetStatus(status.StatusInfo{Status: unitStatus, Since: &now})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	// Make the unit dying rather than removing it straight away.
+	err := mysqlUnit1.SetAgentStatus(status.StatusInfo{Status: status.Idle, Since: &now})
+	c.Assert(err, jc.ErrorIsNil)
+	err = mysqlUnit1.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: "unit-wordpress-0"},
+		{Tag: "unit-mysql-0"},
+		{Tag: "application-wordpress"},
+	}}
+	result, err := s.uniter.GoalStates(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+
+	goalState := result.Results[0].Result
+	c.Assert(result.Results[0].Error, gc.IsNil)
+	c.Assert(goalState, gc.NotNil)
+	for _, units := range append([]params.UnitsGoalState{goalState.Units}, goalState.Relations["db"]) {
+		for name, unitState := range units {
+			if names.IsValidUnit(name) {
+				c.Check(unitState.Since, gc.NotNil)
+			}
+			unitState.Since = nil
+			units[name] = unitState
+		}
+	}
+	c.Check(goalState, jc.DeepEquals, &params.GoalState{
+		Units: params.UnitsGoalState{
+			"wordpress/0": {Status: "active"},
+			"wordpress/1": {Status: "waiting"},
+		},
+		Relations: map[string]params.UnitsGoalState{
+			"db": {
+				"mysql":   {Status: "joined"},
+				"mysql/0": {Status: "active"},
+				"mysql/1": {Status: "dying"},
+			},
+		},
+	})
+	c.Check(result.Results[1], jc.DeepEquals, params.GoalStateResult{Error: apiservertesting.ErrUnauthorized})
+	c.Check(result.Results[2], jc.DeepEquals, params.GoalStateResult{Error: apiservertesting.ErrUnauthorized})
+}
+
 func (s *uniterSuite) TestSetWorkloadVersion(c *gc.C) {
 	currentVersion, err := s.wordpressUnit.WorkloadVersion()
 	c.Assert(err, jc.ErrorIsNil)
@@ -945,6 +1008,41 @@ func (s *uniterSuite) TestWatchConfigSettings(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *uniterSuite) TestWatchActionStatus(c *gc.C) {
+	a, err := s.wordpressUnit.AddAction("fakeaction", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	a, err = a.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.resources.Count(), gc.Equals, 0)
+
+	args := params.Entities{Entities: []params.Entity{
+		{Tag: a.ActionTag().String()},
+		{Tag: "action-f47ac10b-58cc-4372-a567-0e02b2c3d479"},
+		{Tag: "unit-wordpress-0"},
+	}}
+	result, err := s.uniter.WatchActionStatus(args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 3)
+	c.Assert(result.Results[0], gc.DeepEquals, params.NotifyWatchResult{NotifyWatcherId: "1"})
+	c.Assert(result.Results[1].Error, gc.NotNil)
+	c.Assert(result.Results[2].Error, gc.NotNil)
+
+	// Verify the resource was registered and stop when done
+	c.Assert(s.resources.Count(), gc.Equals, 1)
+	resource := s.resources.Get("1")
+	defer statetesting.AssertStop(c, resource)
+
+	// Check that the Watch has consumed the initial event ("returned" in
+	// the Watch call)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, resource.(state.NotifyWatcher))
+	wc.AssertNoChange()
+
+	_, err = a.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
+
 func (s *uniterSuite) TestWatchActionNotifications(c *gc.C) {
 	err := s.wordpressUnit.SetCharmURL(s.wpCharm.URL())
 	c.Assert(err, jc.ErrorIsNil)
@@ -16,31 +16,32 @@ import (
 // AuditEntry to a backing store and return an error upon failure.
 type AuditEntrySinkFn func(AuditEntry) error
 
-// AuditEntry represents an auditted event.
+// AuditEntry represents an auditted event. It is serialized as a
+// single JSON object when written to a log file or forwarded.
 type AuditEntry struct {
 	// JujuServerVersion is the version of the jujud that recorded
 	// this AuditEntry.
-	JujuServerVersion version.Number
+	JujuServerVersion version.Number `json:"juju-server-version" yaml:"juju-server-version"`
 	// ModelUUID is the ID of the model the audit entry was written
 	// on.
-	ModelUUID string
+	ModelUUID string `json:"model-uuid" yaml:"model-uuid"`
 	// Timestamp is when the audit entry was generated. It must be
 	// stored with the UTC locale.
-	Timestamp time.Time
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
 	// RemoteAddress is the IP of the machine from which the
 	// audit-event was triggered.
-	RemoteAddress string
+	RemoteAddress string `json:"remote-address" yaml:"remote-address"`
 	// OriginType is the type of entity (e.g. model, user, action)
 	// which triggered the audit event.
-	OriginType string
+	OriginType string `json:"origin-type" yaml:"origin-type"`
 	// OriginName is the name of the origin which triggered the
 	// audit-event.
-	OriginName string
+	OriginName string `json:"origin-name" yaml:"origin-name"`
 	// Operation is the operation that was performed that triggered
 	// the audit event.
-	Operation string
+	Operation string `json:"operation" yaml:"operation"`
 	// Data is a catch-all for storing random data.
-	Data map[string]interface{}
+	Data map[string]interface{} `json:"data,omitempty" yaml:"data,omitempty"`
 }
 
 // Validate ensures that the entry considers itself to be in a
@@ -4,13 +4,11 @@
 package audit
 
 import (
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
-	"strings"
-	"time"
 
-	"fmt"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
 	"github.com/juju/utils"
@@ -20,7 +18,8 @@ import (
 var logger = loggo.GetLogger("juju.audit")
 
 // NewLogFileSink returns an audit entry sink which writes
-// to an audit.log file in the specified directory.
+// to an audit.log file in the specified directory. Each entry
+// is written as a single line of JSON.
 func NewLogFileSink(logDir string) AuditEntrySinkFn {
 	logPath := filepath.Join(logDir, "audit.log")
 	if err := primeLogFile(logPath); err != nil {
@@ -58,14 +57,11 @@ type auditLogFileSink struct {
 }
 
 func (a *auditLogFileSink) handle(entry AuditEntry) error {
-	_, err := a.fileLogger.Write([]byte(strings.Join([]string{
-		entry.Timestamp.In(time.UTC).Format("2006-01-02 15:04:05"),
-		entry.ModelUUID,
-		entry.RemoteAddress,
-		entry.OriginName,
-		entry.OriginType,
-		entry.Operation,
-		fmt.Sprintf("%v", entry.Data),
-	}, ",") + "\n"))
-	return err
+	entry.Timestamp = entry.Timestamp.UTC()
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = a.fileLogger.Write(append(line, '\n'))
+	return errors.Trace(err)
 }
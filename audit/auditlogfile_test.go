@@ -4,6 +4,9 @@
 package audit_test
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -16,6 +19,7 @@ import (
 	"github.com/juju/juju/audit"
 	coretesting "github.com/juju/juju/testing"
 	"github.com/juju/testing"
+	"github.com/juju/version"
 )
 
 type auditLogFileSuite struct {
@@ -56,8 +60,8 @@ func (s *auditLogFileSuite) TestLogging(c *gc.C) {
 	logPath := filepath.Join(dir, "audit.log")
 	logContents, err := ioutil.ReadFile(logPath)
 	c.Assert(err, jc.ErrorIsNil)
-	line0 := "2015-06-01 23:02:01," + modelUUID + ",10.0.0.1,user-admin,API,deploy,map[foo:bar]\n"
-	line1 := "2015-06-01 23:02:02," + modelUUID + ",10.0.0.2,user-admin,API,status,map[]\n"
+	line0 := `{"juju-server-version":"0.0.0","model-uuid":"` + modelUUID + `","timestamp":"2015-06-01T23:02:01Z","remote-address":"10.0.0.1","origin-type":"API","origin-name":"user-admin","operation":"deploy","data":{"foo":"bar"}}` + "\n"
+	line1 := `{"juju-server-version":"0.0.0","model-uuid":"` + modelUUID + `","timestamp":"2015-06-01T23:02:02Z","remote-address":"10.0.0.2","origin-type":"API","origin-name":"user-admin","operation":"status"}` + "\n"
 	c.Assert(string(logContents), gc.Equals, line0+line1)
 
 	// Check the file mode is as expected. This doesn't work on
@@ -69,3 +73,31 @@ func (s *auditLogFileSuite) TestLogging(c *gc.C) {
 		c.Assert(info.Mode(), gc.Equals, os.FileMode(0600))
 	}
 }
+
+func (s *auditLogFileSuite) TestLoggedEntriesRoundTrip(c *gc.C) {
+	dir := c.MkDir()
+	sink := audit.NewLogFileSink(dir)
+
+	entry := audit.AuditEntry{
+		JujuServerVersion: version.MustParse("2.1.0"),
+		ModelUUID:         coretesting.ModelTag.Id(),
+		Timestamp:         time.Date(2015, time.June, 1, 23, 2, 1, 0, time.UTC),
+		RemoteAddress:     "10.0.0.1",
+		OriginType:        "API request",
+		OriginName:        "user-admin",
+		Operation:         "Application:v3 - Deploy",
+		Data:              map[string]interface{}{"a,b": "c\nd"},
+	}
+	err := sink(entry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	logContents, err := ioutil.ReadFile(filepath.Join(dir, "audit.log"))
+	c.Assert(err, jc.ErrorIsNil)
+	scanner := bufio.NewScanner(bytes.NewReader(logContents))
+	c.Assert(scanner.Scan(), jc.IsTrue)
+	var read audit.AuditEntry
+	err = json.Unmarshal(scanner.Bytes(), &read)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(read, jc.DeepEquals, entry)
+	c.Assert(scanner.Scan(), jc.IsFalse)
+}
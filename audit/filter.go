@@ -0,0 +1,70 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Filter describes the audit entries which should be returned
+// when querying an audit log. Zero-valued fields match everything.
+type Filter struct {
+	// ModelUUID restricts the results to entries recorded on the
+	// specified model.
+	ModelUUID string
+
+	// OriginName restricts the results to entries triggered by the
+	// specified origin (e.g. "user-admin").
+	OriginName string
+
+	// Operation restricts the results to entries whose operation
+	// matches exactly.
+	Operation string
+
+	// After restricts the results to entries recorded at or after
+	// this time.
+	After time.Time
+
+	// Before restricts the results to entries recorded before this
+	// time.
+	Before time.Time
+
+	// Limit is the maximum number of entries to return. A value of
+	// zero means no limit.
+	Limit int
+}
+
+// Validate ensures that the filter is internally consistent.
+func (f Filter) Validate() error {
+	if f.Limit < 0 {
+		return errors.NotValidf("negative Limit")
+	}
+	if !f.After.IsZero() && !f.Before.IsZero() && !f.Before.After(f.After) {
+		return errors.NotValidf("Before not after After")
+	}
+	return nil
+}
+
+// Match reports whether the given entry satisfies the filter. The
+// Limit field is not considered.
+func (f Filter) Match(entry AuditEntry) bool {
+	if f.ModelUUID != "" && entry.ModelUUID != f.ModelUUID {
+		return false
+	}
+	if f.OriginName != "" && entry.OriginName != f.OriginName {
+		return false
+	}
+	if f.Operation != "" && entry.Operation != f.Operation {
+		return false
+	}
+	if !f.After.IsZero() && entry.Timestamp.Before(f.After) {
+		return false
+	}
+	if !f.Before.IsZero() && !entry.Timestamp.Before(f.Before) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,62 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/audit"
+)
+
+type filterSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&filterSuite{})
+
+func (s *filterSuite) TestZeroFilterMatchesEverything(c *gc.C) {
+	c.Assert(audit.Filter{}.Match(validEntry()), jc.IsTrue)
+}
+
+func (s *filterSuite) TestMatch(c *gc.C) {
+	entry := validEntry()
+	entry.OriginName = "user-bob"
+	entry.Operation = "Application:v3 - Deploy"
+	entry.Timestamp = time.Date(2016, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	for i, test := range []struct {
+		filter audit.Filter
+		match  bool
+	}{
+		{audit.Filter{ModelUUID: entry.ModelUUID}, true},
+		{audit.Filter{ModelUUID: "other"}, false},
+		{audit.Filter{OriginName: "user-bob"}, true},
+		{audit.Filter{OriginName: "user-mary"}, false},
+		{audit.Filter{Operation: "Application:v3 - Deploy"}, true},
+		{audit.Filter{Operation: "Application:v3 - Destroy"}, false},
+		{audit.Filter{After: entry.Timestamp}, true},
+		{audit.Filter{After: entry.Timestamp.Add(time.Second)}, false},
+		{audit.Filter{Before: entry.Timestamp.Add(time.Second)}, true},
+		{audit.Filter{Before: entry.Timestamp}, false},
+	} {
+		c.Logf("test %d: %+v", i, test.filter)
+		c.Check(test.filter.Match(entry), gc.Equals, test.match)
+	}
+}
+
+func (s *filterSuite) TestValidate(c *gc.C) {
+	now := time.Now()
+	c.Assert(audit.Filter{}.Validate(), jc.ErrorIsNil)
+	c.Assert(audit.Filter{After: now, Before: now.Add(time.Hour)}.Validate(), jc.ErrorIsNil)
+
+	err := audit.Filter{Limit: -1}.Validate()
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	err = audit.Filter{After: now, Before: now}.Validate()
+	c.Assert(err, gc.ErrorMatches, "Before not after After not valid")
+}
@@ -0,0 +1,58 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/logfwd"
+)
+
+// RecordSender sends log records to a log forwarding target, such as
+// a *syslog.Client.
+type RecordSender interface {
+	Send([]logfwd.Record) error
+}
+
+// NewForwardingSink returns an audit entry sink which forwards each
+// entry as a log record through the supplied sender. The record's
+// message is the entry serialized as JSON, and its origin is derived
+// from the given origin and the entry itself.
+func NewForwardingSink(sender RecordSender, origin logfwd.Origin) AuditEntrySinkFn {
+	return func(entry AuditEntry) error {
+		rec, err := recordFromEntry(entry, origin)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		return errors.Trace(sender.Send([]logfwd.Record{rec}))
+	}
+}
+
+func recordFromEntry(entry AuditEntry, origin logfwd.Origin) (logfwd.Record, error) {
+	msg, err := json.Marshal(entry)
+	if err != nil {
+		return logfwd.Record{}, errors.Trace(err)
+	}
+	origin.ModelUUID = entry.ModelUUID
+	origin.Type = logfwd.OriginTypeUnknown
+	origin.Name = ""
+	if tag, err := names.ParseUserTag(entry.OriginName); err == nil {
+		origin.Type = logfwd.OriginTypeUser
+		origin.Name = tag.Id()
+	}
+	return logfwd.Record{
+		Origin:    origin,
+		Timestamp: entry.Timestamp,
+		Level:     loggo.INFO,
+		Location: logfwd.SourceLocation{
+			Module: "juju.audit",
+			Line:   -1,
+		},
+		Message: string(msg),
+	}, nil
+}
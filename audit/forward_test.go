@@ -0,0 +1,84 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit_test
+
+import (
+	"encoding/json"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/logfwd"
+)
+
+type forwardSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&forwardSuite{})
+
+type fakeSender struct {
+	records []logfwd.Record
+	err     error
+}
+
+func (s *fakeSender) Send(records []logfwd.Record) error {
+	s.records = append(s.records, records...)
+	return s.err
+}
+
+func (s *forwardSuite) TestForwardsEntryAsJSON(c *gc.C) {
+	sender := &fakeSender{}
+	origin := logfwd.Origin{
+		ControllerUUID: "9f484882-2f18-4fd2-967d-db9663db7bea",
+		Hostname:       "controller-0",
+	}
+	sink := audit.NewForwardingSink(sender, origin)
+
+	entry := validEntry()
+	entry.OriginName = "user-bob"
+	err := sink(entry)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(sender.records, gc.HasLen, 1)
+	rec := sender.records[0]
+	c.Check(rec.Origin.ControllerUUID, gc.Equals, origin.ControllerUUID)
+	c.Check(rec.Origin.Hostname, gc.Equals, "controller-0")
+	c.Check(rec.Origin.ModelUUID, gc.Equals, entry.ModelUUID)
+	c.Check(rec.Origin.Type, gc.Equals, logfwd.OriginTypeUser)
+	c.Check(rec.Origin.Name, gc.Equals, "bob")
+	c.Check(rec.Timestamp, gc.Equals, entry.Timestamp)
+	c.Check(rec.Level, gc.Equals, loggo.INFO)
+	c.Check(rec.Location.Module, gc.Equals, "juju.audit")
+
+	var forwarded audit.AuditEntry
+	err = json.Unmarshal([]byte(rec.Message), &forwarded)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(forwarded.ModelUUID, gc.Equals, entry.ModelUUID)
+	c.Check(forwarded.OriginName, gc.Equals, "user-bob")
+	c.Check(forwarded.Operation, gc.Equals, entry.Operation)
+}
+
+func (s *forwardSuite) TestNonUserOrigin(c *gc.C) {
+	sender := &fakeSender{}
+	sink := audit.NewForwardingSink(sender, logfwd.Origin{})
+
+	err := sink(validEntry())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sender.records, gc.HasLen, 1)
+	c.Check(sender.records[0].Origin.Type, gc.Equals, logfwd.OriginTypeUnknown)
+	c.Check(sender.records[0].Origin.Name, gc.Equals, "")
+}
+
+func (s *forwardSuite) TestSendError(c *gc.C) {
+	sender := &fakeSender{err: errors.New("connection refused")}
+	sink := audit.NewForwardingSink(sender, logfwd.Origin{})
+
+	err := sink(validEntry())
+	c.Assert(err, gc.ErrorMatches, "connection refused")
+}
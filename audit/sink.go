@@ -0,0 +1,100 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+)
+
+// SinkRegistry holds the named audit entry sinks which are available
+// to a controller. The set of sinks which actually receive entries is
+// chosen from the registry by name, typically from controller config.
+type SinkRegistry struct {
+	mu    sync.Mutex
+	sinks map[string]AuditEntrySinkFn
+}
+
+// NewSinkRegistry returns a new, empty SinkRegistry.
+func NewSinkRegistry() *SinkRegistry {
+	return &SinkRegistry{
+		sinks: make(map[string]AuditEntrySinkFn),
+	}
+}
+
+// Register records the sink under the given name. It is an error to
+// register two sinks with the same name.
+func (r *SinkRegistry) Register(name string, sink AuditEntrySinkFn) error {
+	if name == "" {
+		return errors.NotValidf("empty sink name")
+	}
+	if sink == nil {
+		return errors.NotValidf("nil sink %q", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.sinks[name]; ok {
+		return errors.AlreadyExistsf("audit sink %q", name)
+	}
+	r.sinks[name] = sink
+	return nil
+}
+
+// Names returns the sorted names of all registered sinks.
+func (r *SinkRegistry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.sinks))
+	for name := range r.sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Sink returns a single sink which sends each entry to all of the
+// named sinks. An error satisfying errors.IsNotFound is returned if
+// any of the names has not been registered.
+func (r *SinkRegistry) Sink(names ...string) (AuditEntrySinkFn, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sinks := make(map[string]AuditEntrySinkFn, len(names))
+	for _, name := range names {
+		sink, ok := r.sinks[name]
+		if !ok {
+			return nil, errors.NotFoundf("audit sink %q", name)
+		}
+		sinks[name] = sink
+	}
+	return newMultiSink(sinks), nil
+}
+
+// newMultiSink returns a sink which sends each entry to every one of
+// the supplied sinks, even if some of them fail. The returned error
+// names every sink that failed.
+func newMultiSink(sinks map[string]AuditEntrySinkFn) AuditEntrySinkFn {
+	names := make([]string, 0, len(sinks))
+	for name := range sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return func(entry AuditEntry) error {
+		var failed []string
+		var lastErr error
+		for _, name := range names {
+			if err := sinks[name](entry); err != nil {
+				logger.Errorf("cannot write audit entry to %q sink: %v", name, err)
+				failed = append(failed, name)
+				lastErr = err
+			}
+		}
+		if lastErr != nil {
+			return errors.Annotatef(lastErr, "cannot save audit record to %s", strings.Join(failed, ", "))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package audit_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/audit"
+)
+
+type sinkRegistrySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&sinkRegistrySuite{})
+
+func recordingSink(name string, calls *[]string, err error) audit.AuditEntrySinkFn {
+	return func(audit.AuditEntry) error {
+		*calls = append(*calls, name)
+		return err
+	}
+}
+
+func (s *sinkRegistrySuite) TestRegisterAndNames(c *gc.C) {
+	var calls []string
+	r := audit.NewSinkRegistry()
+	c.Assert(r.Register("mongo", recordingSink("mongo", &calls, nil)), jc.ErrorIsNil)
+	c.Assert(r.Register("file", recordingSink("file", &calls, nil)), jc.ErrorIsNil)
+	c.Assert(r.Names(), jc.DeepEquals, []string{"file", "mongo"})
+}
+
+func (s *sinkRegistrySuite) TestRegisterDuplicate(c *gc.C) {
+	var calls []string
+	r := audit.NewSinkRegistry()
+	c.Assert(r.Register("file", recordingSink("file", &calls, nil)), jc.ErrorIsNil)
+	err := r.Register("file", recordingSink("file", &calls, nil))
+	c.Assert(err, jc.Satisfies, errors.IsAlreadyExists)
+	c.Assert(err, gc.ErrorMatches, `audit sink "file" already exists`)
+}
+
+func (s *sinkRegistrySuite) TestRegisterInvalid(c *gc.C) {
+	r := audit.NewSinkRegistry()
+	err := r.Register("", func(audit.AuditEntry) error { return nil })
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	err = r.Register("file", nil)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+}
+
+func (s *sinkRegistrySuite) TestSinkUnknownName(c *gc.C) {
+	r := audit.NewSinkRegistry()
+	_, err := r.Sink("file")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `audit sink "file" not found`)
+}
+
+func (s *sinkRegistrySuite) TestSinkSendsToSelected(c *gc.C) {
+	var calls []string
+	r := audit.NewSinkRegistry()
+	c.Assert(r.Register("file", recordingSink("file", &calls, nil)), jc.ErrorIsNil)
+	c.Assert(r.Register("mongo", recordingSink("mongo", &calls, nil)), jc.ErrorIsNil)
+	c.Assert(r.Register("logfwd", recordingSink("logfwd", &calls, nil)), jc.ErrorIsNil)
+
+	sink, err := r.Sink("mongo", "file")
+	c.Assert(err, jc.ErrorIsNil)
+	err = sink(validEntry())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(calls, jc.DeepEquals, []string{"file", "mongo"})
+}
+
+func (s *sinkRegistrySuite) TestSinkContinuesAfterFailure(c *gc.C) {
+	var calls []string
+	r := audit.NewSinkRegistry()
+	c.Assert(r.Register("file", recordingSink("file", &calls, errors.New("disk full"))), jc.ErrorIsNil)
+	c.Assert(r.Register("mongo", recordingSink("mongo", &calls, nil)), jc.ErrorIsNil)
+
+	sink, err := r.Sink("file", "mongo")
+	c.Assert(err, jc.ErrorIsNil)
+	err = sink(validEntry())
+	c.Assert(err, gc.ErrorMatches, "cannot save audit record to file: disk full")
+	c.Assert(calls, jc.DeepEquals, []string{"file", "mongo"})
+}
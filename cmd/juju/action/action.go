@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/api/action"
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/watcher"
 )
 
 // type APIClient represents the action API functionality.
@@ -39,7 +40,11 @@ type APIClient interface {
 	ListCompleted(params.Entities) (params.ActionsByReceivers, error)
 
 	// Cancel attempts to cancel a queued up Action from running.
-	Cancel(params.Actions) (params.ActionResults, error)
+	Cancel(params.Entities) (params.ActionResults, error)
+
+	// Abort cancels queued up Actions, and asks the units running
+	// Actions to stop them.
+	Abort(params.Entities) (params.ActionResults, error)
 
 	// ApplicationCharmActions is a single query which uses ApplicationsCharmsActions to
 	// get the charm.Actions for a single Service by tag.
@@ -53,9 +58,23 @@ type APIClient interface {
 	// corresponding ActionTags that match that prefix.
 	FindActionTagsByPrefix(params.FindTags) (params.FindTagsResults, error)
 
+	// WatchActionProgress returns a watcher that reports the progress
+	// messages logged by an action, encoded as JSON.
+	WatchActionProgress(actionId string) (watcher.StringsWatcher, error)
+
 	// FindActionsByNames takes a list of names and finds a corresponding list of
 	// Actions for every name.
 	FindActionsByNames(params.FindActionsByNames) (params.ActionsByNames, error)
+
+	// AddSchedules schedules actions to be enqueued at a future time,
+	// once or on a recurring schedule.
+	AddSchedules(params.ActionSchedules) (params.ActionScheduleResults, error)
+
+	// ListSchedules returns all the action schedules in the model.
+	ListSchedules() (params.ActionSchedules, error)
+
+	// RemoveSchedules removes the action schedules with the given ids.
+	RemoveSchedules(params.ActionScheduleIds) (params.ErrorResults, error)
 }
 
 // ActionCommandBase is the base type for action sub-commands.
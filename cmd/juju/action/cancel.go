@@ -0,0 +1,120 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+)
+
+func NewCancelCommand() cmd.Command {
+	return modelcmd.Wrap(&cancelCommand{})
+}
+
+// cancelCommand cancels queued actions, and aborts running ones when
+// forced.
+type cancelCommand struct {
+	ActionCommandBase
+	out          cmd.Output
+	requestedIds []string
+	force        bool
+}
+
+const cancelDoc = `
+Cancel actions matching the given IDs or partial ID prefixes, so that
+they are not run.
+
+Actions that are already running are only stopped if --force is given.
+The unit running the action terminates the action's processes, killing
+them if they do not exit within a grace period, and records the action
+as aborted.
+
+Examples:
+
+$ juju cancel-action 9f5a3e1d
+$ juju cancel-action --force 9f5a3e1d
+
+See also:
+    run-action
+    show-action-status
+`
+
+// SetFlags sets up the output and the --force flag.
+func (c *cancelCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ActionCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
+	f.BoolVar(&c.force, "force", false, "Abort actions that are already running")
+}
+
+func (c *cancelCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "cancel-action",
+		Args:    "<action ID>|<action ID prefix> [...]",
+		Purpose: "Cancel pending or running actions.",
+		Doc:     cancelDoc,
+	}
+}
+
+// Init gets the ids of the actions to cancel.
+func (c *cancelCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no action ID specified")
+	}
+	c.requestedIds = args
+	return nil
+}
+
+func (c *cancelCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	var entities []params.Entity
+	for _, id := range c.requestedIds {
+		tag, err := getActionTagByPrefix(api, id)
+		if err != nil {
+			return err
+		}
+		entities = append(entities, params.Entity{Tag: tag.String()})
+	}
+
+	cancel := api.Cancel
+	if c.force {
+		cancel = api.Abort
+	}
+	results, err := cancel(params.Entities{Entities: entities})
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != len(entities) {
+		return errors.New("illegal number of results returned")
+	}
+
+	var cancelled []params.ActionResult
+	failed := false
+	for i, result := range results.Results {
+		if result.Error != nil {
+			ctx.Infof("cannot cancel action %s: %v", c.requestedIds[i], result.Error)
+			failed = true
+			continue
+		}
+		cancelled = append(cancelled, result)
+	}
+	if len(cancelled) > 0 {
+		if err := c.out.Write(ctx, resultsToMap(cancelled)); err != nil {
+			return err
+		}
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"errors"
+
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/action"
+	"github.com/juju/juju/testing"
+)
+
+type CancelSuite struct {
+	BaseActionSuite
+}
+
+var _ = gc.Suite(&CancelSuite{})
+
+func (s *CancelSuite) TestInit(c *gc.C) {
+	wrappedCommand, _ := action.NewCancelCommandForTest(s.store)
+	err := testing.InitCommand(wrappedCommand, []string{"-m", "admin"})
+	c.Check(err, gc.ErrorMatches, "no action ID specified")
+
+	wrappedCommand, command := action.NewCancelCommandForTest(s.store)
+	err = testing.InitCommand(wrappedCommand, []string{"-m", "admin", "--force", "deadbeef", "feedface"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(command.RequestedIds(), jc.DeepEquals, []string{"deadbeef", "feedface"})
+	c.Check(command.Force(), jc.IsTrue)
+}
+
+func (s *CancelSuite) TestCancel(c *gc.C) {
+	fakeClient := &fakeAPIClient{
+		actionTagMatches: tagsForIdPrefix("deadbeef", validActionTagString),
+		actionResults: []params.ActionResult{{
+			Action: &params.Action{Tag: validActionTagString, Receiver: "unit-mysql-0"},
+			Status: params.ActionCancelled,
+		}},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	command, _ := action.NewCancelCommandForTest(s.store)
+	ctx, err := testing.RunCommand(c, command, "-m", "admin", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fakeClient.cancelled, jc.DeepEquals, params.Entities{
+		Entities: []params.Entity{{Tag: validActionTagString}},
+	})
+	c.Check(fakeClient.aborted.Entities, gc.HasLen, 0)
+	c.Check(testing.Stdout(ctx), gc.Equals, ""+
+		"actions:\n"+
+		"- id: "+validActionId+"\n"+
+		"  status: cancelled\n"+
+		"  unit: mysql/0\n")
+}
+
+func (s *CancelSuite) TestCancelForce(c *gc.C) {
+	fakeClient := &fakeAPIClient{
+		actionTagMatches: tagsForIdPrefix("deadbeef", validActionTagString),
+		actionResults: []params.ActionResult{{
+			Action: &params.Action{Tag: validActionTagString, Receiver: "unit-mysql-0"},
+			Status: params.ActionAborting,
+		}},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	command, _ := action.NewCancelCommandForTest(s.store)
+	_, err := testing.RunCommand(c, command, "-m", "admin", "--force", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fakeClient.aborted, jc.DeepEquals, params.Entities{
+		Entities: []params.Entity{{Tag: validActionTagString}},
+	})
+	c.Check(fakeClient.cancelled.Entities, gc.HasLen, 0)
+}
+
+func (s *CancelSuite) TestCancelError(c *gc.C) {
+	fakeClient := &fakeAPIClient{
+		actionTagMatches: tagsForIdPrefix("deadbeef", validActionTagString),
+		actionResults: []params.ActionResult{{
+			Error: &params.Error{Message: `cannot abort action "deadbeef" with status "completed"`},
+		}},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	command, _ := action.NewCancelCommandForTest(s.store)
+	ctx, err := testing.RunCommand(c, command, "-m", "admin", "--force", "deadbeef")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Check(testing.Stderr(ctx), gc.Equals,
+		`cannot cancel action deadbeef: cannot abort action "deadbeef" with status "completed"`+"\n")
+}
+
+func (s *CancelSuite) TestCancelNotFound(c *gc.C) {
+	restore := s.patchAPIClient(&fakeAPIClient{})
+	defer restore()
+
+	command, _ := action.NewCancelCommandForTest(s.store)
+	_, err := testing.RunCommand(c, command, "-m", "admin", "deadbeef")
+	c.Assert(err, gc.ErrorMatches, `actions for identifier "deadbeef" not found`)
+}
+
+func (s *CancelSuite) TestCancelAPIError(c *gc.C) {
+	restore := s.patchAPIClient(&fakeAPIClient{
+		actionTagMatches: tagsForIdPrefix("deadbeef", validActionTagString),
+		apiErr:           errors.New("boom"),
+	})
+	defer restore()
+
+	command, _ := action.NewCancelCommandForTest(s.store)
+	_, err := testing.RunCommand(c, command, "-m", "admin", "deadbeef")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
@@ -4,6 +4,8 @@
 package action
 
 import (
+	"time"
+
 	"github.com/juju/cmd"
 	"gopkg.in/juju/names.v2"
 
@@ -88,3 +90,63 @@ func NewRunCommandForTest(store jujuclient.ClientStore) (cmd.Command, *RunComman
 func ActionResultsToMap(results []params.ActionResult) map[string]interface{} {
 	return resultsToMap(results)
 }
+
+type ScheduleCommand struct {
+	*scheduleCommand
+}
+
+func (c *ScheduleCommand) Receivers() []names.Tag {
+	return c.receivers
+}
+
+func (c *ScheduleCommand) ActionName() string {
+	return c.actionName
+}
+
+func (c *ScheduleCommand) Start() time.Time {
+	return c.start
+}
+
+func (c *ScheduleCommand) Recurrence() string {
+	return c.recurrence
+}
+
+func (c *ScheduleCommand) Args() [][]string {
+	return c.args
+}
+
+func NewScheduleCommandForTest(store jujuclient.ClientStore) (cmd.Command, *ScheduleCommand) {
+	c := &scheduleCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel), &ScheduleCommand{c}
+}
+
+func NewListSchedulesCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &listSchedulesCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel)
+}
+
+func NewRemoveScheduleCommandForTest(store jujuclient.ClientStore) cmd.Command {
+	c := &removeScheduleCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c, modelcmd.WrapSkipDefaultModel)
+}
+
+type CancelCommand struct {
+	*cancelCommand
+}
+
+func (c *CancelCommand) RequestedIds() []string {
+	return c.requestedIds
+}
+
+func (c *CancelCommand) Force() bool {
+	return c.force
+}
+
+func NewCancelCommandForTest(store jujuclient.ClientStore) (cmd.Command, *CancelCommand) {
+	c := &cancelCommand{}
+	c.SetClientStore(store)
+	return modelcmd.Wrap(c), &CancelCommand{c}
+}
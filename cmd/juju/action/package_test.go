@@ -5,6 +5,7 @@ package action_test
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"testing"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/jujuclient/jujuclienttesting"
 	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/watcher"
 )
 
 const (
@@ -127,6 +129,14 @@ type fakeAPIClient struct {
 	actionTagMatches   params.FindTagsResults
 	actionsByNames     params.ActionsByNames
 	charmActions       map[string]params.ActionSpec
+	addedSchedules     params.ActionSchedules
+	schedules          params.ActionSchedules
+	scheduleErrors     []*params.Error
+	removedSchedules   params.ActionScheduleIds
+	cancelled          params.Entities
+	aborted            params.Entities
+	progress           []string
+	watchedActionId    string
 	apiErr             error
 }
 
@@ -165,12 +175,46 @@ func (c *fakeAPIClient) ListCompleted(args params.Entities) (params.ActionsByRec
 	}, c.apiErr
 }
 
-func (c *fakeAPIClient) Cancel(args params.Actions) (params.ActionResults, error) {
+func (c *fakeAPIClient) Cancel(args params.Entities) (params.ActionResults, error) {
+	c.cancelled = args
 	return params.ActionResults{
 		Results: c.actionResults,
 	}, c.apiErr
 }
 
+func (c *fakeAPIClient) Abort(args params.Entities) (params.ActionResults, error) {
+	c.aborted = args
+	return params.ActionResults{
+		Results: c.actionResults,
+	}, c.apiErr
+}
+
+func (c *fakeAPIClient) WatchActionProgress(actionId string) (watcher.StringsWatcher, error) {
+	c.watchedActionId = actionId
+	if c.apiErr != nil {
+		return nil, c.apiErr
+	}
+	changes := make(chan []string, 1)
+	changes <- c.progress
+	return &fakeProgressWatcher{changes: changes}, nil
+}
+
+// fakeProgressWatcher is a watcher.StringsWatcher which delivers a
+// single event.
+type fakeProgressWatcher struct {
+	changes chan []string
+}
+
+func (w *fakeProgressWatcher) Changes() watcher.StringsChannel {
+	return w.changes
+}
+
+func (w *fakeProgressWatcher) Kill() {}
+
+func (w *fakeProgressWatcher) Wait() error {
+	return nil
+}
+
 func (c *fakeAPIClient) ApplicationCharmActions(params.Entity) (map[string]params.ActionSpec, error) {
 	return c.charmActions, c.apiErr
 }
@@ -209,3 +253,37 @@ func (c *fakeAPIClient) FindActionTagsByPrefix(arg params.FindTags) (params.Find
 func (c *fakeAPIClient) FindActionsByNames(args params.FindActionsByNames) (params.ActionsByNames, error) {
 	return c.actionsByNames, c.apiErr
 }
+
+func (c *fakeAPIClient) AddSchedules(args params.ActionSchedules) (params.ActionScheduleResults, error) {
+	c.addedSchedules = args
+	results := params.ActionScheduleResults{
+		Results: make([]params.ActionScheduleResult, len(args.Schedules)),
+	}
+	for i, schedule := range args.Schedules {
+		if i < len(c.scheduleErrors) && c.scheduleErrors[i] != nil {
+			results.Results[i].Error = c.scheduleErrors[i]
+			continue
+		}
+		schedule := schedule
+		schedule.Id = fmt.Sprint(i + 1)
+		results.Results[i].Schedule = &schedule
+	}
+	return results, c.apiErr
+}
+
+func (c *fakeAPIClient) ListSchedules() (params.ActionSchedules, error) {
+	return c.schedules, c.apiErr
+}
+
+func (c *fakeAPIClient) RemoveSchedules(args params.ActionScheduleIds) (params.ErrorResults, error) {
+	c.removedSchedules = args
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Ids)),
+	}
+	for i := range args.Ids {
+		if i < len(c.scheduleErrors) {
+			results.Results[i].Error = c.scheduleErrors[i]
+		}
+	}
+	return results, c.apiErr
+}
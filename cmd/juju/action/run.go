@@ -149,24 +149,32 @@ func (c *runCommand) Init(args []string) error {
 			return nil
 		}
 		// Parse CLI key-value args if they exist.
-		c.args = make([][]string, 0)
-		for _, arg := range args[2:] {
-			thisArg := strings.SplitN(arg, "=", 2)
-			if len(thisArg) != 2 {
-				return errors.Errorf("argument %q must be of the form key...=value", arg)
-			}
-			keySlice := strings.Split(thisArg[0], ".")
-			// check each key for validity
-			for _, key := range keySlice {
-				if valid := keyRule.MatchString(key); !valid {
-					return errors.Errorf("key %q must start and end with lowercase alphanumeric, and contain only lowercase alphanumeric and hyphens", key)
-				}
+		var err error
+		c.args, err = parseKeyValueArgs(args[2:])
+		return err
+	}
+}
+
+// parseKeyValueArgs parses key.key.key...=value arguments, returning
+// for each a slice of its keys followed by its value.
+func parseKeyValueArgs(args []string) ([][]string, error) {
+	result := make([][]string, 0)
+	for _, arg := range args {
+		thisArg := strings.SplitN(arg, "=", 2)
+		if len(thisArg) != 2 {
+			return nil, errors.Errorf("argument %q must be of the form key...=value", arg)
+		}
+		keySlice := strings.Split(thisArg[0], ".")
+		// check each key for validity
+		for _, key := range keySlice {
+			if valid := keyRule.MatchString(key); !valid {
+				return nil, errors.Errorf("key %q must start and end with lowercase alphanumeric, and contain only lowercase alphanumeric and hyphens", key)
 			}
-			// c.args={..., [key, key, key, key, value]}
-			c.args = append(c.args, append(keySlice, thisArg[1]))
 		}
-		return nil
+		// result={..., [key, key, key, key, value]}
+		result = append(result, append(keySlice, thisArg[1]))
 	}
+	return result, nil
 }
 
 func (c *runCommand) Run(ctx *cmd.Context) error {
@@ -176,27 +184,70 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 	}
 	defer api.Close()
 
+	actionParams, err := buildActionParams(ctx, c.paramsYAML, c.args, c.parseStrings)
+	if err != nil {
+		return err
+	}
+
+	actionParam := params.Actions{
+		Actions: []params.Action{{
+			Receiver:   c.unitTag.String(),
+			Name:       c.actionName,
+			Parameters: actionParams,
+		}},
+	}
+
+	results, err := api.Enqueue(actionParam)
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != 1 {
+		return errors.New("illegal number of results returned")
+	}
+
+	result := results.Results[0]
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.Action == nil {
+		return errors.New("action failed to enqueue")
+	}
+
+	tag, err := names.ParseActionTag(result.Action.Tag)
+	if err != nil {
+		return err
+	}
+
+	output := map[string]string{"Action queued with id": tag.Id()}
+	return c.out.Write(ctx, output)
+}
+
+// buildActionParams merges the contents of the params file, if any, with
+// the parsed key.key.key...=value arguments, which take precedence.
+func buildActionParams(ctx *cmd.Context, paramsYAML cmd.FileVar, args [][]string, parseStrings bool) (map[string]interface{}, error) {
 	actionParams := map[string]interface{}{}
 
-	if c.paramsYAML.Path != "" {
-		b, err := c.paramsYAML.Read(ctx)
+	if paramsYAML.Path != "" {
+		b, err := paramsYAML.Read(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		err = yaml.Unmarshal(b, &actionParams)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		conformantParams, err := common.ConformYAML(actionParams)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		betterParams, ok := conformantParams.(map[string]interface{})
 		if !ok {
-			return errors.New("params must contain a YAML map with string keys")
+			return nil, errors.New("params must contain a YAML map with string keys")
 		}
 
 		actionParams = betterParams
@@ -204,15 +255,15 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 
 	// If we had explicit args {..., [key, key, key, key, value], ...}
 	// then iterate and set params ..., key.key.key.key=value, ...
-	for _, argSlice := range c.args {
+	for _, argSlice := range args {
 		valueIndex := len(argSlice) - 1
 		keys := argSlice[:valueIndex]
 		value := argSlice[valueIndex]
 		cleansedValue := interface{}(value)
-		if !c.parseStrings {
+		if !parseStrings {
 			err := yaml.Unmarshal([]byte(value), &cleansedValue)
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 		// Insert the value in the map.
@@ -221,45 +272,12 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 
 	conformantParams, err := common.ConformYAML(actionParams)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	typedConformantParams, ok := conformantParams.(map[string]interface{})
 	if !ok {
-		return errors.Errorf("params must be a map, got %T", typedConformantParams)
-	}
-
-	actionParam := params.Actions{
-		Actions: []params.Action{{
-			Receiver:   c.unitTag.String(),
-			Name:       c.actionName,
-			Parameters: actionParams,
-		}},
-	}
-
-	results, err := api.Enqueue(actionParam)
-	if err != nil {
-		return err
-	}
-	if len(results.Results) != 1 {
-		return errors.New("illegal number of results returned")
-	}
-
-	result := results.Results[0]
-
-	if result.Error != nil {
-		return result.Error
-	}
-
-	if result.Action == nil {
-		return errors.New("action failed to enqueue")
-	}
-
-	tag, err := names.ParseActionTag(result.Action.Tag)
-	if err != nil {
-		return err
+		return nil, errors.Errorf("params must be a map, got %T", typedConformantParams)
 	}
-
-	output := map[string]string{"Action queued with id": tag.Id()}
-	return c.out.Write(ctx, output)
+	return actionParams, nil
 }
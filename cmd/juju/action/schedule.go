@@ -0,0 +1,407 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/core/cron"
+)
+
+func NewScheduleCommand() cmd.Command {
+	return modelcmd.Wrap(&scheduleCommand{})
+}
+
+// scheduleCommand schedules an Action to be enqueued on the given units
+// or applications at a future time, once or on a recurring schedule.
+type scheduleCommand struct {
+	ActionCommandBase
+	receivers    []names.Tag
+	actionName   string
+	at           string
+	start        time.Time
+	recurrence   string
+	paramsYAML   cmd.FileVar
+	parseStrings bool
+	out          cmd.Output
+	args         [][]string
+}
+
+const scheduleDoc = `
+Schedule an Action to be queued for execution at a future time, either once
+or on a recurring schedule. The receiver may be a unit, or an application, in
+which case the Action is queued on every unit the application has when the
+schedule fires. Several receivers may be given, separated by commas.
+
+The time at which the Action is first queued is given with --at, either as
+an RFC 3339 timestamp such as 2017-03-01T02:00:00Z, or as a duration from now
+such as 90m.
+
+The recurrence is given with --recurrence as a cron-style specification of
+five fields (minute, hour, day of month, month and day of week), or as one of
+@yearly, @monthly, @weekly, @daily or @hourly. Recurrences are evaluated in
+UTC. If --at is not also given, the Action is first queued at the next time
+matching the recurrence.
+
+Params are given as for 'juju run-action', and are validated according to
+the charm when the Action is queued.
+
+Examples:
+
+$ juju schedule-action mysql backup --recurrence "0 2 * * *"
+id: "1"
+
+$ juju schedule-action mysql/0,mysql/1 backup --at 2017-03-01T02:00:00Z
+
+$ juju schedule-action mysql backup --recurrence @weekly --at 1h out=weekly.tar.bz2
+
+See also:
+    list-schedules
+    remove-schedule
+    run-action
+`
+
+// SetFlags offers an option for YAML output.
+func (c *scheduleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ActionCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
+	f.StringVar(&c.at, "at", "", "When to first queue the action (RFC 3339 time, or duration from now)")
+	f.StringVar(&c.recurrence, "recurrence", "", "Cron-style specification of when the action recurs")
+	f.Var(&c.paramsYAML, "params", "Path to yaml-formatted params file")
+	f.BoolVar(&c.parseStrings, "string-args", false, "Use raw string values of CLI args")
+}
+
+func (c *scheduleCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "schedule-action",
+		Args:    "<unit or application>[,...] <action name> [key.key.key...=value]",
+		Purpose: "Schedule an action for execution at a future time.",
+		Doc:     scheduleDoc,
+	}
+}
+
+// Init gets the receiver tags, and checks for other correct args.
+func (c *scheduleCommand) Init(args []string) error {
+	switch len(args) {
+	case 0:
+		return errors.New("no unit or application specified")
+	case 1:
+		return errors.New("no action specified")
+	}
+	for _, receiver := range strings.Split(args[0], ",") {
+		switch {
+		case names.IsValidUnit(receiver):
+			c.receivers = append(c.receivers, names.NewUnitTag(receiver))
+		case names.IsValidApplication(receiver):
+			c.receivers = append(c.receivers, names.NewApplicationTag(receiver))
+		default:
+			return errors.Errorf("invalid unit or application name %q", receiver)
+		}
+	}
+	c.actionName = args[1]
+	if valid := ActionNameRule.MatchString(c.actionName); !valid {
+		return errors.Errorf("invalid action name %q", c.actionName)
+	}
+
+	if c.at == "" && c.recurrence == "" {
+		return errors.New("--at or --recurrence must be specified")
+	}
+	if c.at != "" {
+		start, err := parseStartTime(c.at, time.Now())
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.start = start
+	}
+	if c.recurrence != "" {
+		if _, err := cron.Parse(c.recurrence); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if len(args) == 2 {
+		return nil
+	}
+	var err error
+	c.args, err = parseKeyValueArgs(args[2:])
+	return err
+}
+
+// parseStartTime parses an RFC 3339 time, or a duration relative to
+// the given time.
+func parseStartTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		if d < 0 {
+			return time.Time{}, errors.NotValidf("negative --at duration %q", value)
+		}
+		return now.Add(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.NotValidf("--at value %q (expected RFC 3339 time or duration)", value)
+	}
+	return t, nil
+}
+
+func (c *scheduleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	actionParams, err := buildActionParams(ctx, c.paramsYAML, c.args, c.parseStrings)
+	if err != nil {
+		return err
+	}
+
+	schedule := params.ActionSchedule{
+		Receivers:  make([]string, len(c.receivers)),
+		Name:       c.actionName,
+		Parameters: actionParams,
+		Recurrence: c.recurrence,
+	}
+	for i, receiver := range c.receivers {
+		schedule.Receivers[i] = receiver.String()
+	}
+	if !c.start.IsZero() {
+		start := c.start.UTC()
+		schedule.Start = &start
+	}
+
+	results, err := api.AddSchedules(params.ActionSchedules{
+		Schedules: []params.ActionSchedule{schedule},
+	})
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != 1 {
+		return errors.New("illegal number of results returned")
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.Schedule == nil {
+		return errors.New("action failed to schedule")
+	}
+
+	output := map[string]string{"id": result.Schedule.Id}
+	if result.Schedule.Next != nil {
+		output["next"] = common.FormatTime(result.Schedule.Next, true)
+	}
+	return c.out.Write(ctx, output)
+}
+
+func NewListSchedulesCommand() cmd.Command {
+	return modelcmd.Wrap(&listSchedulesCommand{})
+}
+
+// listSchedulesCommand lists the action schedules in the model.
+type listSchedulesCommand struct {
+	ActionCommandBase
+	out cmd.Output
+}
+
+const listSchedulesDoc = `
+List the scheduled actions in the model, with when each is next queued.
+Times are shown in UTC.
+
+See also:
+    schedule-action
+    remove-schedule
+`
+
+// scheduleOutput holds the details of an action schedule for output.
+type scheduleOutput struct {
+	Id         string                 `yaml:"id" json:"id"`
+	Receivers  []string               `yaml:"receivers" json:"receivers"`
+	Action     string                 `yaml:"action" json:"action"`
+	Parameters map[string]interface{} `yaml:"parameters,omitempty" json:"parameters,omitempty"`
+	Recurrence string                 `yaml:"recurrence,omitempty" json:"recurrence,omitempty"`
+	Next       string                 `yaml:"next,omitempty" json:"next,omitempty"`
+	LastFired  string                 `yaml:"last-fired,omitempty" json:"last-fired,omitempty"`
+	CreatedBy  string                 `yaml:"created-by" json:"created-by"`
+}
+
+// SetFlags offers tabular, YAML and JSON output.
+func (c *listSchedulesCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ActionCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": printSchedulesTabular,
+	})
+}
+
+func (c *listSchedulesCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "list-schedules",
+		Purpose: "List scheduled actions.",
+		Doc:     listSchedulesDoc,
+		Aliases: []string{"schedules"},
+	}
+}
+
+// Init checks that no arguments were given.
+func (c *listSchedulesCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+func (c *listSchedulesCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	schedules, err := api.ListSchedules()
+	if err != nil {
+		return err
+	}
+	if len(schedules.Schedules) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No scheduled actions.")
+		return nil
+	}
+	result := make([]scheduleOutput, len(schedules.Schedules))
+	for i, schedule := range schedules.Schedules {
+		result[i] = makeScheduleOutput(schedule)
+	}
+	return c.out.Write(ctx, result)
+}
+
+func makeScheduleOutput(schedule params.ActionSchedule) scheduleOutput {
+	result := scheduleOutput{
+		Id:         schedule.Id,
+		Receivers:  make([]string, len(schedule.Receivers)),
+		Action:     schedule.Name,
+		Parameters: schedule.Parameters,
+		Recurrence: schedule.Recurrence,
+	}
+	for i, receiver := range schedule.Receivers {
+		result.Receivers[i] = receiver
+		if tag, err := names.ParseTag(receiver); err == nil {
+			result.Receivers[i] = tag.Id()
+		}
+	}
+	if tag, err := names.ParseUserTag(schedule.CreatedBy); err == nil {
+		result.CreatedBy = tag.Id()
+	}
+	if schedule.Next != nil {
+		result.Next = common.FormatTime(schedule.Next, true)
+	}
+	if schedule.LastFired != nil {
+		result.LastFired = common.FormatTime(schedule.LastFired, true)
+	}
+	return result
+}
+
+// printSchedulesTabular prints the list of action schedules in tabular
+// format.
+func printSchedulesTabular(writer io.Writer, value interface{}) error {
+	list, ok := value.([]scheduleOutput)
+	if !ok {
+		return errors.New("unexpected value")
+	}
+
+	tw := output.TabWriter(writer)
+	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", "ID", "Action", "Receivers", "Recurrence", "Next", "Last fired")
+	for _, schedule := range list {
+		recurrence := schedule.Recurrence
+		if recurrence == "" {
+			recurrence = "once"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			schedule.Id,
+			schedule.Action,
+			strings.Join(schedule.Receivers, ","),
+			recurrence,
+			schedule.Next,
+			schedule.LastFired,
+		)
+	}
+	tw.Flush()
+	return nil
+}
+
+func NewRemoveScheduleCommand() cmd.Command {
+	return modelcmd.Wrap(&removeScheduleCommand{})
+}
+
+// removeScheduleCommand removes action schedules.
+type removeScheduleCommand struct {
+	ActionCommandBase
+	ids []string
+}
+
+const removeScheduleDoc = `
+Remove one or more action schedules, given their ids as shown by
+'juju list-schedules'. Actions already queued by the schedules are not
+affected.
+
+Examples:
+
+$ juju remove-schedule 3
+
+See also:
+    schedule-action
+    list-schedules
+`
+
+func (c *removeScheduleCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "remove-schedule",
+		Args:    "<schedule id> [...]",
+		Purpose: "Remove scheduled actions.",
+		Doc:     removeScheduleDoc,
+	}
+}
+
+// Init gets the ids of the schedules to remove.
+func (c *removeScheduleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no schedule id specified")
+	}
+	c.ids = args
+	return nil
+}
+
+func (c *removeScheduleCommand) Run(ctx *cmd.Context) error {
+	api, err := c.NewActionAPIClient()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	results, err := api.RemoveSchedules(params.ActionScheduleIds{Ids: c.ids})
+	if err != nil {
+		return err
+	}
+	if len(results.Results) != len(c.ids) {
+		return errors.New("illegal number of results returned")
+	}
+	failed := false
+	for i, result := range results.Results {
+		if result.Error != nil {
+			ctx.Infof("cannot remove schedule %s: %v", c.ids[i], result.Error)
+			failed = true
+		}
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
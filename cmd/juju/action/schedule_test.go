@@ -0,0 +1,231 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package action_test
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/action"
+	"github.com/juju/juju/testing"
+)
+
+type ScheduleSuite struct {
+	BaseActionSuite
+}
+
+var _ = gc.Suite(&ScheduleSuite{})
+
+func (s *ScheduleSuite) TestInit(c *gc.C) {
+	tests := []struct {
+		should           string
+		args             []string
+		expectReceivers  []names.Tag
+		expectAction     string
+		expectStart      time.Time
+		expectRecurrence string
+		expectKVArgs     [][]string
+		expectError      string
+	}{{
+		should:      "fail with missing args",
+		args:        []string{},
+		expectError: "no unit or application specified",
+	}, {
+		should:      "fail with no action specified",
+		args:        []string{validUnitId},
+		expectError: "no action specified",
+	}, {
+		should:      "fail with invalid receiver",
+		args:        []string{invalidUnitId, "backup", "--at", "1h"},
+		expectError: `invalid unit or application name "something-strange-"`,
+	}, {
+		should:      "fail with invalid action name",
+		args:        []string{validUnitId, "BadName", "--at", "1h"},
+		expectError: `invalid action name "BadName"`,
+	}, {
+		should:      "fail with no time",
+		args:        []string{validUnitId, "backup"},
+		expectError: "--at or --recurrence must be specified",
+	}, {
+		should:      "fail with invalid time",
+		args:        []string{validUnitId, "backup", "--at", "tomorrow"},
+		expectError: `--at value "tomorrow" \(expected RFC 3339 time or duration\) not valid`,
+	}, {
+		should:      "fail with invalid recurrence",
+		args:        []string{validUnitId, "backup", "--recurrence", "0 25 * * *"},
+		expectError: `recurrence "0 25 \* \* \*": hour "25" not valid`,
+	}, {
+		should:      "fail with wrong formatting of k-v args",
+		args:        []string{validUnitId, "backup", "--at", "1h", "uh"},
+		expectError: `argument "uh" must be of the form key...=value`,
+	}, {
+		should:           "schedule a recurring action on an application",
+		args:             []string{validServiceId, "backup", "--recurrence", "0 2 * * *"},
+		expectReceivers:  []names.Tag{names.NewApplicationTag(validServiceId)},
+		expectAction:     "backup",
+		expectRecurrence: "0 2 * * *",
+	}, {
+		should: "schedule an action once on several units",
+		args:   []string{"mysql/0,mysql/1", "backup", "--at", "2017-03-01T02:00:00Z", "out=x"},
+		expectReceivers: []names.Tag{
+			names.NewUnitTag("mysql/0"),
+			names.NewUnitTag("mysql/1"),
+		},
+		expectAction: "backup",
+		expectStart:  time.Date(2017, 3, 1, 2, 0, 0, 0, time.UTC),
+		expectKVArgs: [][]string{{"out", "x"}},
+	}}
+
+	for i, t := range tests {
+		wrappedCommand, command := action.NewScheduleCommandForTest(s.store)
+		c.Logf("test %d: should %s:\n$ juju schedule-action %s\n", i,
+			t.should, strings.Join(t.args, " "))
+		args := append([]string{"-m", "admin"}, t.args...)
+		err := testing.InitCommand(wrappedCommand, args)
+		if t.expectError != "" {
+			c.Check(err, gc.ErrorMatches, t.expectError)
+			continue
+		}
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(command.Receivers(), jc.DeepEquals, t.expectReceivers)
+		c.Check(command.ActionName(), gc.Equals, t.expectAction)
+		c.Check(command.Start().Equal(t.expectStart), jc.IsTrue)
+		c.Check(command.Recurrence(), gc.Equals, t.expectRecurrence)
+		c.Check(command.Args(), jc.DeepEquals, t.expectKVArgs)
+	}
+}
+
+func (s *ScheduleSuite) TestInitRelativeStart(c *gc.C) {
+	wrappedCommand, command := action.NewScheduleCommandForTest(s.store)
+	before := time.Now()
+	err := testing.InitCommand(wrappedCommand, []string{"-m", "admin", validUnitId, "backup", "--at", "90m"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(command.Start().Before(before.Add(90*time.Minute)), jc.IsFalse)
+	c.Check(command.Start().After(time.Now().Add(90*time.Minute)), jc.IsFalse)
+}
+
+func (s *ScheduleSuite) TestRun(c *gc.C) {
+	next := time.Date(2017, 3, 1, 2, 0, 0, 0, time.UTC)
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	wrappedCommand, _ := action.NewScheduleCommandForTest(s.store)
+	ctx, err := testing.RunCommand(c, wrappedCommand,
+		"-m", "admin", validServiceId, "backup",
+		"--at", "2017-03-01T02:00:00Z", "--recurrence", "@daily",
+		"out=x", "level=3",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fakeClient.addedSchedules, jc.DeepEquals, params.ActionSchedules{
+		Schedules: []params.ActionSchedule{{
+			Receivers:  []string{"application-mysql"},
+			Name:       "backup",
+			Parameters: map[string]interface{}{"out": "x", "level": 3},
+			Start:      &next,
+			Recurrence: "@daily",
+		}},
+	})
+	c.Check(testing.Stdout(ctx), gc.Equals, "id: \"1\"\n")
+}
+
+func (s *ScheduleSuite) TestRunError(c *gc.C) {
+	fakeClient := &fakeAPIClient{
+		scheduleErrors: []*params.Error{{Message: `unit "mysql/0" not found`}},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	wrappedCommand, _ := action.NewScheduleCommandForTest(s.store)
+	_, err := testing.RunCommand(c, wrappedCommand, "-m", "admin", validUnitId, "backup", "--at", "1h")
+	c.Assert(err, gc.ErrorMatches, `unit "mysql/0" not found`)
+}
+
+func (s *ScheduleSuite) TestListSchedules(c *gc.C) {
+	next := time.Date(2017, 3, 2, 2, 0, 0, 0, time.UTC)
+	lastFired := time.Date(2017, 3, 1, 2, 0, 0, 0, time.UTC)
+	fakeClient := &fakeAPIClient{
+		schedules: params.ActionSchedules{
+			Schedules: []params.ActionSchedule{{
+				Id:         "1",
+				Receivers:  []string{"application-mysql"},
+				Name:       "backup",
+				Recurrence: "0 2 * * *",
+				Next:       &next,
+				LastFired:  &lastFired,
+				CreatedBy:  "user-admin",
+			}, {
+				Id:        "2",
+				Receivers: []string{"unit-mysql-0", "unit-mysql-1"},
+				Name:      "snapshot",
+				Next:      &next,
+				CreatedBy: "user-bob",
+			}},
+		},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := testing.RunCommand(c, action.NewListSchedulesCommandForTest(s.store), "-m", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(testing.Stdout(ctx), gc.Equals, ""+
+		"ID  Action    Receivers        Recurrence  Next                  Last fired\n"+
+		"1   backup    mysql            0 2 * * *   2017-03-02 02:00:00Z  2017-03-01 02:00:00Z\n"+
+		"2   snapshot  mysql/0,mysql/1  once        2017-03-02 02:00:00Z  \n")
+
+	ctx, err = testing.RunCommand(c, action.NewListSchedulesCommandForTest(s.store), "-m", "admin", "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(testing.Stdout(ctx), gc.Equals, ""+
+		`[{"id":"1","receivers":["mysql"],"action":"backup","recurrence":"0 2 * * *",`+
+		`"next":"2017-03-02 02:00:00Z","last-fired":"2017-03-01 02:00:00Z","created-by":"admin"},`+
+		`{"id":"2","receivers":["mysql/0","mysql/1"],"action":"snapshot",`+
+		`"next":"2017-03-02 02:00:00Z","created-by":"bob"}]`+"\n")
+}
+
+func (s *ScheduleSuite) TestListSchedulesNone(c *gc.C) {
+	restore := s.patchAPIClient(&fakeAPIClient{})
+	defer restore()
+
+	ctx, err := testing.RunCommand(c, action.NewListSchedulesCommandForTest(s.store), "-m", "admin")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(testing.Stdout(ctx), gc.Equals, "")
+	c.Check(testing.Stderr(ctx), gc.Equals, "No scheduled actions.\n")
+}
+
+func (s *ScheduleSuite) TestRemoveSchedule(c *gc.C) {
+	fakeClient := &fakeAPIClient{}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	_, err := testing.RunCommand(c, action.NewRemoveScheduleCommandForTest(s.store), "-m", "admin", "1", "3")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fakeClient.removedSchedules, jc.DeepEquals, params.ActionScheduleIds{Ids: []string{"1", "3"}})
+}
+
+func (s *ScheduleSuite) TestRemoveScheduleError(c *gc.C) {
+	fakeClient := &fakeAPIClient{
+		scheduleErrors: []*params.Error{nil, {Message: `action schedule "3" not found`}},
+	}
+	restore := s.patchAPIClient(fakeClient)
+	defer restore()
+
+	ctx, err := testing.RunCommand(c, action.NewRemoveScheduleCommandForTest(s.store), "-m", "admin", "1", "3")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Check(testing.Stderr(ctx), gc.Equals, "cannot remove schedule 3: action schedule \"3\" not found\n")
+}
+
+func (s *ScheduleSuite) TestRemoveScheduleAPIError(c *gc.C) {
+	restore := s.patchAPIClient(&fakeAPIClient{apiErr: errors.New("boom")})
+	defer restore()
+
+	_, err := testing.RunCommand(c, action.NewRemoveScheduleCommandForTest(s.store), "-m", "admin", "1")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
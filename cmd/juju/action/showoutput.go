@@ -4,6 +4,8 @@
 package action
 
 import (
+	"encoding/json"
+	"fmt"
 	"regexp"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/worker"
 )
 
 func NewShowOutputCommand() cmd.Command {
@@ -27,6 +30,7 @@ type showOutputCommand struct {
 	requestedId string
 	fullSchema  bool
 	wait        string
+	watch       bool
 }
 
 const showOutputDoc = `
@@ -38,6 +42,11 @@ to wait indefinitely.  If units are left off, seconds are assumed.
 The default behavior without --wait is to immediately check and return; if
 the results are "pending" then only the available information will be
 displayed.  This is also the behavior when any negative time is given.
+
+To follow a running action, use the --watch flag.  The progress messages
+logged by the action with action-log are shown as they arrive, followed by
+the results once the action has finished.  With --watch, the command waits
+indefinitely unless a --wait duration is also given.
 `
 
 // Set up the output.
@@ -45,6 +54,7 @@ func (c *showOutputCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ActionCommandBase.SetFlags(f)
 	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
 	f.StringVar(&c.wait, "wait", "-1s", "Wait for results")
+	f.BoolVar(&c.watch, "watch", false, "Show progress messages until the action finishes")
 }
 
 func (c *showOutputCommand) Info() *cmd.Info {
@@ -83,6 +93,10 @@ func (c *showOutputCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.watch && waitDur < 0 {
+		// Watching only makes sense until the action finishes.
+		waitDur = 0
+	}
 
 	api, err := c.NewActionAPIClient()
 	if err != nil {
@@ -104,7 +118,12 @@ func (c *showOutputCommand) Run(ctx *cmd.Context) error {
 		wait = time.NewTimer(waitDur)
 	}
 
-	result, err := GetActionResult(api, c.requestedId, wait)
+	var result params.ActionResult
+	if c.watch {
+		result, err = watchActionResult(ctx, api, c.requestedId, wait)
+	} else {
+		result, err = GetActionResult(api, c.requestedId, wait)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -112,6 +131,80 @@ func (c *showOutputCommand) Run(ctx *cmd.Context) error {
 	return c.out.Write(ctx, FormatActionResult(result))
 }
 
+// watchActionResult writes the progress messages logged by the action
+// to the context's stdout as they arrive, until the action finishes or
+// "wait" times out. It returns the latest action result, without the
+// messages already written.
+func watchActionResult(ctx *cmd.Context, api APIClient, requestedId string, wait *time.Timer) (params.ActionResult, error) {
+	none := params.ActionResult{}
+
+	actionTag, err := getActionTagByPrefix(api, requestedId)
+	if err != nil {
+		return none, err
+	}
+	w, err := api.WatchActionProgress(actionTag.Id())
+	if err != nil {
+		return none, errors.Trace(err)
+	}
+	defer worker.Stop(w)
+
+	seen := 0
+	finish := func(result params.ActionResult) params.ActionResult {
+		if seen < len(result.Log) {
+			for _, msg := range result.Log[seen:] {
+				writeActionMessage(ctx, msg)
+			}
+		}
+		result.Log = nil
+		return result
+	}
+
+	tick := time.NewTimer(0)
+	for {
+		select {
+		case changes, ok := <-w.Changes():
+			if !ok {
+				if err := w.Wait(); err != nil {
+					return none, errors.Trace(err)
+				}
+				return none, errors.New("action progress watcher stopped")
+			}
+			for _, change := range changes {
+				var msg params.ActionMessage
+				if err := json.Unmarshal([]byte(change), &msg); err != nil {
+					return none, errors.Annotate(err, "cannot decode action progress message")
+				}
+				writeActionMessage(ctx, msg)
+				seen++
+			}
+
+		case <-tick.C:
+			result, err := fetchResult(api, requestedId)
+			if err != nil {
+				return none, err
+			}
+			switch result.Status {
+			case params.ActionRunning, params.ActionPending, params.ActionAborting:
+				tick.Reset(2 * time.Second)
+			default:
+				return finish(result), nil
+			}
+
+		case <-wait.C:
+			result, err := fetchResult(api, requestedId)
+			if err != nil {
+				return none, err
+			}
+			return finish(result), nil
+		}
+	}
+}
+
+// writeActionMessage writes a progress message logged by an action.
+func writeActionMessage(ctx *cmd.Context, msg params.ActionMessage) {
+	fmt.Fprintf(ctx.Stdout, "%s %s\n", msg.Timestamp.UTC().Format(time.RFC3339), msg.Message)
+}
+
 // GetActionResult tries to repeatedly fetch an action until it is
 // in a completed state and then it returns it.
 // It waits for a maximum of "wait" before returning with the latest action status.
@@ -144,7 +237,7 @@ func timerLoop(api APIClient, requestedId string, wait, tick *time.Timer) (param
 		// Whether or not we're waiting for a result, if a completed
 		// result arrives, we're done.
 		switch result.Status {
-		case params.ActionRunning, params.ActionPending:
+		case params.ActionRunning, params.ActionPending, params.ActionAborting:
 		default:
 			return result, nil
 		}
@@ -204,6 +297,13 @@ func FormatActionResult(result params.ActionResult) map[string]interface{} {
 	if len(result.Output) != 0 {
 		response["results"] = result.Output
 	}
+	if len(result.Log) != 0 {
+		log := make([]string, len(result.Log))
+		for i, msg := range result.Log {
+			log[i] = fmt.Sprintf("%s %s", msg.Timestamp.UTC().Format(time.RFC3339), msg.Message)
+		}
+		response["log"] = log
+	}
 
 	if result.Enqueued.IsZero() && result.Started.IsZero() && result.Completed.IsZero() {
 		return response
@@ -239,6 +239,28 @@ status: pending
 timing:
   completed: 2015-02-14 08:15:30 +0000 UTC
   enqueued: 2015-02-14 08:13:00 +0000 UTC
+`[1:],
+	}, {
+		should:            "show the progress messages logged by the action",
+		withClientQueryID: validActionId,
+		withAPITimeout:    10 * time.Second,
+		withTags:          tagsForIdPrefix(validActionId, validActionTagString),
+		withAPIResponse: []params.ActionResult{{
+			Status: "running",
+			Log: []params.ActionMessage{{
+				Timestamp: time.Date(2015, time.February, 14, 8, 14, 0, 0, time.UTC),
+				Message:   "copying tables",
+			}},
+			Enqueued: time.Date(2015, time.February, 14, 8, 13, 0, 0, time.UTC),
+			Started:  time.Date(2015, time.February, 14, 8, 13, 30, 0, time.UTC),
+		}},
+		expectedOutput: `
+log:
+- 2015-02-14T08:14:00Z copying tables
+status: running
+timing:
+  enqueued: 2015-02-14 08:13:00 +0000 UTC
+  started: 2015-02-14 08:13:30 +0000 UTC
 `[1:],
 	}, {
 		should:            "set an appropriate timer and wait, get a result",
@@ -290,6 +312,54 @@ timing:
 	}
 }
 
+func (s *ShowOutputSuite) TestRunWatch(c *gc.C) {
+	client := makeFakeClient(
+		0,
+		10*time.Second,
+		tagsForIdPrefix(validActionId, validActionTagString),
+		[]params.ActionResult{{
+			Status: "completed",
+			Output: map[string]interface{}{"tables": "5"},
+			Log: []params.ActionMessage{{
+				Timestamp: time.Date(2015, time.February, 14, 8, 14, 0, 0, time.UTC),
+				Message:   "copying tables",
+			}, {
+				Timestamp: time.Date(2015, time.February, 14, 8, 15, 0, 0, time.UTC),
+				Message:   "copied 3 of 5 tables",
+			}, {
+				Timestamp: time.Date(2015, time.February, 14, 8, 15, 20, 0, time.UTC),
+				Message:   "copied 5 of 5 tables",
+			}},
+			Enqueued:  time.Date(2015, time.February, 14, 8, 13, 0, 0, time.UTC),
+			Completed: time.Date(2015, time.February, 14, 8, 15, 30, 0, time.UTC),
+		}},
+		params.ActionsByNames{},
+		"",
+	)
+	client.progress = []string{
+		`{"timestamp":"2015-02-14T08:14:00Z","message":"copying tables"}`,
+		`{"timestamp":"2015-02-14T08:15:00Z","message":"copied 3 of 5 tables"}`,
+	}
+	restore := s.patchAPIClient(client)
+	defer restore()
+
+	cmd, _ := action.NewShowOutputCommandForTest(s.store)
+	ctx, err := testing.RunCommand(c, cmd, "-m", "admin", "--watch", validActionId)
+	c.Assert(err, gc.IsNil)
+	c.Check(client.watchedActionId, gc.Equals, validActionId)
+	c.Check(ctx.Stdout.(*bytes.Buffer).String(), gc.Equals, `
+2015-02-14T08:14:00Z copying tables
+2015-02-14T08:15:00Z copied 3 of 5 tables
+2015-02-14T08:15:20Z copied 5 of 5 tables
+results:
+  tables: "5"
+status: completed
+timing:
+  completed: 2015-02-14 08:15:30 +0000 UTC
+  enqueued: 2015-02-14 08:13:00 +0000 UTC
+`[1:])
+}
+
 func testRunHelper(c *gc.C, s *ShowOutputSuite, client *fakeAPIClient, expectedErr, expectedOutput, wait, query, modelFlag string) {
 	unpatch := s.BaseActionSuite.patchAPIClient(client)
 	defer unpatch()
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -60,54 +61,45 @@ func deployBundle(
 	log deploymentLogger,
 	bundleStorage map[string]map[string]storage.Constraints,
 ) (map[*charm.URL]*macaroon.Macaroon, error) {
-	verifyConstraints := func(s string) error {
-		_, err := constraints.Parse(s)
-		return err
-	}
-	verifyStorage := func(s string) error {
-		_, err := storage.ParseConstraints(s)
-		return err
-	}
-	var verifyError error
-	if bundleFilePath == "" {
-		verifyError = data.Verify(verifyConstraints, verifyStorage)
-	} else {
-		verifyError = data.VerifyLocal(bundleFilePath, verifyConstraints, verifyStorage)
-	}
-	if verifyError != nil {
-		if verr, ok := verifyError.(*charm.VerificationError); ok {
-			errs := make([]string, len(verr.Errors))
-			for i, err := range verr.Errors {
-				errs[i] = err.Error()
-			}
-			return nil, errors.New("the provided bundle has the following errors:\n" + strings.Join(errs, "\n"))
-		}
-		return nil, errors.Annotate(verifyError, "cannot deploy bundle")
+	csMacs, _, err := handleBundle(bundleFilePath, data, channel, apiRoot, log, bundleStorage, false)
+	return csMacs, err
+}
+
+// dryRunBundle returns descriptions of the changes that deploying the
+// given bundle data would make to the current model, in the order they
+// would be applied, leaving the model untouched.
+func dryRunBundle(
+	bundleFilePath string,
+	data *charm.BundleData,
+	channel csparams.Channel,
+	apiRoot DeployAPI,
+	log deploymentLogger,
+	bundleStorage map[string]map[string]storage.Constraints,
+) ([]string, error) {
+	_, changes, err := handleBundle(bundleFilePath, data, channel, apiRoot, log, bundleStorage, true)
+	return changes, err
+}
+
+// handleBundle applies the changes required to deploy the given bundle
+// data. When dryRun is true, the changes that would modify the model
+// are recorded instead of applied, and returned.
+func handleBundle(
+	bundleFilePath string,
+	data *charm.BundleData,
+	channel csparams.Channel,
+	apiRoot DeployAPI,
+	log deploymentLogger,
+	bundleStorage map[string]map[string]storage.Constraints,
+	dryRun bool,
+) (map[*charm.URL]*macaroon.Macaroon, []string, error) {
+	if err := verifyBundle(bundleFilePath, data); err != nil {
+		return nil, nil, errors.Trace(err)
 	}
 
 	// Retrieve bundle changes.
 	changes := bundlechanges.FromData(data)
 	numChanges := len(changes)
 
-	// Initialize the unit status.
-	status, err := apiRoot.Status(nil)
-	if err != nil {
-		return nil, errors.Annotate(err, "cannot get model status")
-	}
-	unitStatus := make(map[string]string, numChanges)
-	for _, serviceData := range status.Applications {
-		for unit, unitData := range serviceData.Units {
-			unitStatus[unit] = unitData.Machine
-		}
-	}
-
-	// Instantiate a watcher used to follow the deployment progress.
-	watcher, err := apiRoot.WatchAll()
-	if err != nil {
-		return nil, errors.Annotate(err, "cannot watch model")
-	}
-	defer watcher.Stop()
-
 	// Instantiate the bundle handler.
 	h := &bundleHandler{
 		bundleDir:       bundleFilePath,
@@ -118,13 +110,44 @@ func deployBundle(
 		bundleStorage:   bundleStorage,
 		log:             log,
 		data:            data,
-		unitStatus:      unitStatus,
 		ignoredMachines: make(map[string]bool, len(data.Applications)),
 		ignoredUnits:    make(map[string]bool, len(data.Applications)),
-		watcher:         watcher,
+		dryRun:          dryRun,
+	}
+
+	if dryRun {
+		// Read the parts of the model that are compared with the
+		// bundle, instead of following the deployment progress.
+		model, err := readBundleModel(apiRoot)
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		h.model = model
+		h.unitStatus = model.unitStatus
+	} else {
+		// Initialize the unit status.
+		status, err := apiRoot.Status(nil)
+		if err != nil {
+			return nil, nil, errors.Annotate(err, "cannot get model status")
+		}
+		h.unitStatus = make(map[string]string, numChanges)
+		for _, serviceData := range status.Applications {
+			for unit, unitData := range serviceData.Units {
+				h.unitStatus[unit] = unitData.Machine
+			}
+		}
+
+		// Instantiate a watcher used to follow the deployment progress.
+		watcher, err := apiRoot.WatchAll()
+		if err != nil {
+			return nil, nil, errors.Annotate(err, "cannot watch model")
+		}
+		defer watcher.Stop()
+		h.watcher = watcher
 	}
 
 	// Deploy the bundle.
+	var err error
 	csMacs := make(map[*charm.URL]*macaroon.Macaroon)
 	channels := make(map[*charm.URL]csparams.Channel)
 	for _, change := range changes {
@@ -158,13 +181,43 @@ func deployBundle(
 		case *bundlechanges.SetAnnotationsChange:
 			err = h.setAnnotations(change.Id(), change.Params)
 		default:
-			return nil, errors.Errorf("unknown change type: %T", change)
+			return nil, nil, errors.Errorf("unknown change type: %T", change)
 		}
 		if err != nil {
-			return nil, errors.Annotate(err, "cannot deploy bundle")
+			return nil, nil, errors.Annotate(err, "cannot deploy bundle")
 		}
 	}
-	return csMacs, nil
+	return csMacs, h.dryRunChanges, nil
+}
+
+// verifyBundle checks that the given bundle data, read from the given
+// bundle directory for local bundles, can be deployed.
+func verifyBundle(bundleFilePath string, data *charm.BundleData) error {
+	verifyConstraints := func(s string) error {
+		_, err := constraints.Parse(s)
+		return err
+	}
+	verifyStorage := func(s string) error {
+		_, err := storage.ParseConstraints(s)
+		return err
+	}
+	var verifyError error
+	if bundleFilePath == "" {
+		verifyError = data.Verify(verifyConstraints, verifyStorage)
+	} else {
+		verifyError = data.VerifyLocal(bundleFilePath, verifyConstraints, verifyStorage)
+	}
+	if verifyError != nil {
+		if verr, ok := verifyError.(*charm.VerificationError); ok {
+			errs := make([]string, len(verr.Errors))
+			for i, err := range verr.Errors {
+				errs[i] = err.Error()
+			}
+			return errors.New("the provided bundle has the following errors:\n" + strings.Join(errs, "\n"))
+		}
+		return errors.Annotate(verifyError, "cannot deploy bundle")
+	}
+	return nil
 }
 
 // bundleHandler provides helpers and the state required to deploy a bundle.
@@ -226,6 +279,22 @@ type bundleHandler struct {
 	// LXD.  This flag keeps us from writing the warning more than once per
 	// bundle.
 	warnedLXC bool
+
+	// dryRun indicates that the changes that would modify the environment
+	// are only recorded in dryRunChanges. In that case, model holds the
+	// parts of the environment that the bundle is compared with, and the
+	// results of adding machines and units describe the new machines and
+	// units rather than identifying them.
+	dryRun        bool
+	dryRunChanges []string
+	model         *bundleModel
+	newMachines   int
+}
+
+// describe records a change that would be made to the environment
+// during a dry run.
+func (h *bundleHandler) describe(format string, args ...interface{}) {
+	h.dryRunChanges = append(h.dryRunChanges, fmt.Sprintf(format, args...))
 }
 
 // addCharm adds a charm to the environment.
@@ -246,6 +315,11 @@ func (h *bundleHandler) addCharm(id string, p bundlechanges.AddCharmParams) (*ch
 		if err != nil && !os.IsNotExist(err) {
 			return nil, noChannel, nil, errors.Annotatef(err, "cannot deploy local charm at %q", charmPath)
 		}
+		if err == nil && h.dryRun {
+			h.describe("upload local charm at %s", charmPath)
+			h.results[id] = curl.String()
+			return curl, noChannel, nil, nil
+		}
 		if err == nil {
 			if curl, err = h.api.AddLocalCharm(curl, ch); err != nil {
 				return nil, noChannel, nil, err
@@ -273,6 +347,13 @@ func (h *bundleHandler) addCharm(id string, p bundlechanges.AddCharmParams) (*ch
 	if url.Series == "bundle" {
 		return nil, channel, nil, errors.Errorf("expected charm URL, got bundle URL %q", p.Charm)
 	}
+	if h.dryRun {
+		if !h.model.hasCharm(url.String()) {
+			h.describe("upload charm %s", url)
+		}
+		h.results[id] = url.String()
+		return url, channel, nil, nil
+	}
 	var csMac *macaroon.Macaroon
 	url, csMac, err = addCharmFromURL(h.api, url, channel)
 	if err != nil {
@@ -294,6 +375,10 @@ func (h *bundleHandler) addService(
 ) error {
 	h.results[id] = p.Application
 	ch := chID.URL.String()
+	if h.dryRun {
+		h.describeService(p, ch)
+		return nil
+	}
 	// Handle application configuration.
 	configYAML := ""
 	if len(p.Options) > 0 {
@@ -485,6 +570,20 @@ func (h *bundleHandler) addMachine(id string, p bundlechanges.AddMachineParams)
 			}
 		}
 	}
+	if h.dryRun {
+		h.newMachines++
+		machine = fmt.Sprintf("new machine #%d", h.newMachines)
+		if ct := machineParams.ContainerType; ct != "" {
+			parent := "new machine"
+			if machineParams.ParentId != "" {
+				parent = describeMachine(machineParams.ParentId)
+			}
+			machine = fmt.Sprintf("new %s container #%d on %s", ct, h.newMachines, parent)
+		}
+		h.describe("add %s", machine)
+		h.results[id] = machine
+		return nil
+	}
 	r, err := h.api.AddMachines([]params.AddMachineParams{machineParams})
 	if err != nil {
 		return errors.Annotatef(err, "cannot create machine for holding %s", msg)
@@ -508,6 +607,12 @@ func (h *bundleHandler) addMachine(id string, p bundlechanges.AddMachineParams)
 func (h *bundleHandler) addRelation(id string, p bundlechanges.AddRelationParams) error {
 	ep1 := resolveRelation(p.Endpoint1, h.results)
 	ep2 := resolveRelation(p.Endpoint2, h.results)
+	if h.dryRun {
+		if !h.model.hasRelation(ep1, ep2) {
+			h.describe("add relation %s - %s", ep1, ep2)
+		}
+		return nil
+	}
 	_, err := h.api.AddRelation(ep1, ep2)
 	if err == nil {
 		// A new relation has been established.
@@ -551,6 +656,12 @@ func (h *bundleHandler) addUnit(id string, p bundlechanges.AddUnitParams) error
 			// Should never happen.
 			return errors.Annotatef(err, "cannot retrieve placement for %q unit", application)
 		}
+	}
+	if h.dryRun {
+		h.describeUnit(id, application, machineSpec)
+		return nil
+	}
+	if machineSpec != "" {
 		placement, err := parsePlacement(machineSpec)
 		if err != nil {
 			return errors.Errorf("invalid --to parameter %q", machineSpec)
@@ -582,6 +693,12 @@ func (h *bundleHandler) addUnit(id string, p bundlechanges.AddUnitParams) error
 // exposeService exposes an application.
 func (h *bundleHandler) exposeService(id string, p bundlechanges.ExposeParams) error {
 	application := resolve(p.Application, h.results)
+	if h.dryRun {
+		if app, ok := h.model.applications[application]; !ok || !app.exposed {
+			h.describe("expose %s", application)
+		}
+		return nil
+	}
 	if err := h.api.Expose(application); err != nil {
 		return errors.Annotatef(err, "cannot expose application %s", application)
 	}
@@ -592,6 +709,9 @@ func (h *bundleHandler) exposeService(id string, p bundlechanges.ExposeParams) e
 // setAnnotations sets annotations for an application or a machine.
 func (h *bundleHandler) setAnnotations(id string, p bundlechanges.SetAnnotationsParams) error {
 	eid := resolve(p.Id, h.results)
+	if h.dryRun {
+		return h.describeAnnotations(eid, p)
+	}
 	var tag string
 	switch p.EntityType {
 	case bundlechanges.MachineType:
@@ -764,6 +884,10 @@ func (h *bundleHandler) resolveMachine(placeholder string) (string, error) {
 		return machineOrUnit, nil
 	}
 	for h.unitStatus[machineOrUnit] == "" {
+		if h.dryRun {
+			// The unit is not assigned to a machine yet.
+			return "machine of " + machineOrUnit, nil
+		}
 		if err := h.updateUnitStatus(); err != nil {
 			return "", errors.Annotate(err, "cannot resolve machine")
 		}
@@ -878,3 +1002,85 @@ func isErrRelationExists(err error) bool {
 	// the string when a specific cause is available.
 	return params.IsCodeAlreadyExists(err) || strings.HasSuffix(err.Error(), "relation already exists")
 }
+
+// describeService records the deployment of a new application, or the
+// charm upgrade, configuration and constraints changes of an existing
+// one, during a dry run.
+func (h *bundleHandler) describeService(p bundlechanges.AddApplicationParams, ch string) {
+	app, ok := h.model.applications[p.Application]
+	if !ok {
+		h.describe("deploy application %s using %s", p.Application, ch)
+		return
+	}
+	if ch != app.charm {
+		h.describe("upgrade %s from %s to %s", p.Application, app.charm, ch)
+	}
+	var options []string
+	for name, value := range p.Options {
+		if !sameOptionValue(value, app.options[name]) {
+			options = append(options, fmt.Sprintf("%s=%v", name, value))
+		}
+	}
+	if len(options) > 0 {
+		sort.Strings(options)
+		h.describe("set application options for %s: %s", p.Application, strings.Join(options, ", "))
+	}
+	if p.Constraints != "" && !sameConstraints(p.Constraints, app.constraints) {
+		h.describe("set constraints for %s to %q", p.Application, p.Constraints)
+	}
+}
+
+// describeUnit records the addition of a unit of the given application
+// to the given machine, or to a new machine if machineSpec is empty,
+// during a dry run. The unit is named after the units already known.
+func (h *bundleHandler) describeUnit(id, application, machineSpec string) {
+	number := 0
+	for unit := range h.unitStatus {
+		if svc, err := names.UnitApplication(unit); err != nil || svc != application {
+			continue
+		}
+		if n, err := strconv.Atoi(unit[len(application)+1:]); err == nil && n >= number {
+			number = n + 1
+		}
+	}
+	unit := fmt.Sprintf("%s/%d", application, number)
+	if machineSpec == "" {
+		h.describe("add unit %s to new machine", unit)
+		h.results[id] = unit
+		h.unitStatus[unit] = "new machine for " + unit
+		return
+	}
+	h.describe("add unit %s to %s", unit, describeMachine(machineSpec))
+	h.results[id] = machineSpec
+	h.unitStatus[unit] = machineSpec
+}
+
+// describeAnnotations records the annotations set for an application or
+// a machine during a dry run.
+func (h *bundleHandler) describeAnnotations(eid string, p bundlechanges.SetAnnotationsParams) error {
+	entity := eid
+	switch p.EntityType {
+	case bundlechanges.MachineType:
+		entity = describeMachine(eid)
+	case bundlechanges.ApplicationType:
+	default:
+		return errors.Errorf("unexpected annotation entity type %q", p.EntityType)
+	}
+	keys := make([]string, 0, len(p.Annotations))
+	for key := range p.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	h.describe("set annotations for %s: %s", entity, strings.Join(keys, ", "))
+	return nil
+}
+
+// describeMachine returns a description of the given machine, which is
+// either the id of an existing machine or the description of a machine
+// that a dry run would create.
+func describeMachine(machine string) string {
+	if names.IsValidMachine(machine) {
+		return "machine " + machine
+	}
+	return machine
+}
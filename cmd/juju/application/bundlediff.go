@@ -0,0 +1,467 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/charm.v6-unstable"
+	"gopkg.in/juju/charmrepo.v2-unstable"
+
+	"github.com/juju/juju/api"
+	"github.com/juju/juju/api/application"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+	"github.com/juju/juju/constraints"
+)
+
+// bundleModelAPI defines the API methods used to read the parts of the
+// model that a bundle is compared with.
+type bundleModelAPI interface {
+	Status(patterns []string) (*params.FullStatus, error)
+	ApplicationGet(application string) (*params.ApplicationGetResults, error)
+}
+
+// bundleModel holds the parts of the current model that a bundle is
+// compared with.
+type bundleModel struct {
+	applications map[string]*bundleModelApplication
+	relations    []params.RelationStatus
+	// unitStatus maps unit names to the ids of the machines hosting
+	// them, as used by bundleHandler.
+	unitStatus map[string]string
+}
+
+// bundleModelApplication holds the details of an application in the
+// current model.
+type bundleModelApplication struct {
+	charm       string
+	series      string
+	exposed     bool
+	numUnits    int
+	options     map[string]interface{}
+	constraints constraints.Value
+}
+
+// readBundleModel reads the parts of the current model that a bundle
+// is compared with.
+func readBundleModel(api bundleModelAPI) (*bundleModel, error) {
+	status, err := api.Status(nil)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot get model status")
+	}
+	model := &bundleModel{
+		applications: make(map[string]*bundleModelApplication),
+		relations:    status.Relations,
+		unitStatus:   make(map[string]string),
+	}
+	for name, appStatus := range status.Applications {
+		app := &bundleModelApplication{
+			charm:    appStatus.Charm,
+			series:   appStatus.Series,
+			exposed:  appStatus.Exposed,
+			numUnits: len(appStatus.Units),
+			options:  make(map[string]interface{}),
+		}
+		for unit, unitStatus := range appStatus.Units {
+			model.unitStatus[unit] = unitStatus.Machine
+		}
+		result, err := api.ApplicationGet(name)
+		if err != nil {
+			return nil, errors.Annotatef(err, "cannot get application %q", name)
+		}
+		app.constraints = result.Constraints
+		for option, info := range result.Config {
+			info, ok := info.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if value, ok := info["value"]; ok {
+				app.options[option] = value
+			}
+		}
+		model.applications[name] = app
+	}
+	return model, nil
+}
+
+// hasCharm reports whether an application in the model uses the given
+// charm.
+func (m *bundleModel) hasCharm(curl string) bool {
+	for _, app := range m.applications {
+		if app.charm == curl {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRelation reports whether the model holds a relation between the
+// given endpoints, in the "application[:relation]" form. Endpoints
+// without a relation name match any relation of the application.
+func (m *bundleModel) hasRelation(endpoint1, endpoint2 string) bool {
+	matches := func(endpoint string, ep params.EndpointStatus) bool {
+		parts := strings.SplitN(endpoint, ":", 2)
+		if parts[0] != ep.ApplicationName {
+			return false
+		}
+		return len(parts) == 1 || ep.Name == "" || parts[1] == ep.Name
+	}
+	for _, rel := range m.relations {
+		if len(rel.Endpoints) != 2 {
+			continue
+		}
+		ep1, ep2 := rel.Endpoints[0], rel.Endpoints[1]
+		if matches(endpoint1, ep1) && matches(endpoint2, ep2) ||
+			matches(endpoint1, ep2) && matches(endpoint2, ep1) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameOptionValue reports whether the option value in the bundle is
+// the same as the one in the model. Values read from the API are
+// decoded from JSON, so they are compared by their representation.
+func sameOptionValue(bundleValue, modelValue interface{}) bool {
+	return fmt.Sprint(bundleValue) == fmt.Sprint(modelValue)
+}
+
+// sameConstraints reports whether the constraints in the bundle are
+// the same as the ones in the model.
+func sameConstraints(bundleCons string, modelCons constraints.Value) bool {
+	cons, err := constraints.Parse(bundleCons)
+	if err != nil {
+		return false
+	}
+	return cons.String() == modelCons.String()
+}
+
+// sameCharm reports whether the charm URL in the bundle refers to the
+// charm used in the model. A bundle charm without a series or revision
+// matches any series or revision of the same charm.
+func sameCharm(bundleCharm, modelCharm string) bool {
+	if bundleCharm == modelCharm {
+		return true
+	}
+	bundleURL, err := charm.ParseURL(bundleCharm)
+	if err != nil {
+		return false
+	}
+	modelURL, err := charm.ParseURL(modelCharm)
+	if err != nil {
+		return false
+	}
+	if bundleURL.Series == "" {
+		modelURL = modelURL.WithSeries("")
+	}
+	if bundleURL.Revision == -1 {
+		modelURL = modelURL.WithRevision(-1)
+	}
+	return bundleURL.String() == modelURL.String()
+}
+
+// bundleDiff holds the differences between a bundle and the current
+// model.
+type bundleDiff struct {
+	Applications map[string]*applicationDiff `yaml:"applications,omitempty" json:"applications,omitempty"`
+	Relations    *relationsDiff              `yaml:"relations,omitempty" json:"relations,omitempty"`
+}
+
+// applicationDiff holds the differences between an application in the
+// bundle and in the model. Missing is set to "bundle" or "model" when
+// the application is only present in the other one.
+type applicationDiff struct {
+	Missing     string                `yaml:"missing,omitempty" json:"missing,omitempty"`
+	Charm       *stringDiff           `yaml:"charm,omitempty" json:"charm,omitempty"`
+	Series      *stringDiff           `yaml:"series,omitempty" json:"series,omitempty"`
+	NumUnits    *intDiff              `yaml:"num_units,omitempty" json:"num_units,omitempty"`
+	Expose      *boolDiff             `yaml:"expose,omitempty" json:"expose,omitempty"`
+	Options     map[string]optionDiff `yaml:"options,omitempty" json:"options,omitempty"`
+	Constraints *stringDiff           `yaml:"constraints,omitempty" json:"constraints,omitempty"`
+}
+
+func (d *applicationDiff) empty() bool {
+	return d.Missing == "" && d.Charm == nil && d.Series == nil && d.NumUnits == nil &&
+		d.Expose == nil && len(d.Options) == 0 && d.Constraints == nil
+}
+
+// relationsDiff holds the relations that are only present in the
+// bundle or in the model.
+type relationsDiff struct {
+	BundleAdditions [][]string `yaml:"bundle-additions,omitempty" json:"bundle-additions,omitempty"`
+	ModelAdditions  [][]string `yaml:"model-additions,omitempty" json:"model-additions,omitempty"`
+}
+
+type stringDiff struct {
+	Bundle string `yaml:"bundle" json:"bundle"`
+	Model  string `yaml:"model" json:"model"`
+}
+
+type intDiff struct {
+	Bundle int `yaml:"bundle" json:"bundle"`
+	Model  int `yaml:"model" json:"model"`
+}
+
+type boolDiff struct {
+	Bundle bool `yaml:"bundle" json:"bundle"`
+	Model  bool `yaml:"model" json:"model"`
+}
+
+type optionDiff struct {
+	Bundle interface{} `yaml:"bundle" json:"bundle"`
+	Model  interface{} `yaml:"model" json:"model"`
+}
+
+// computeBundleDiff returns the differences between the bundle data and
+// the model.
+func computeBundleDiff(data *charm.BundleData, model *bundleModel) *bundleDiff {
+	diff := &bundleDiff{
+		Applications: make(map[string]*applicationDiff),
+	}
+	for name, spec := range data.Applications {
+		app, ok := model.applications[name]
+		if !ok {
+			diff.Applications[name] = &applicationDiff{Missing: "model"}
+			continue
+		}
+		appDiff := diffApplication(data, spec, app)
+		if !appDiff.empty() {
+			diff.Applications[name] = appDiff
+		}
+	}
+	for name := range model.applications {
+		if _, ok := data.Applications[name]; !ok {
+			diff.Applications[name] = &applicationDiff{Missing: "bundle"}
+		}
+	}
+
+	relations := &relationsDiff{}
+	bundle := &bundleModel{relations: bundleRelations(data)}
+	for _, relation := range data.Relations {
+		if len(relation) == 2 && !model.hasRelation(relation[0], relation[1]) {
+			relations.BundleAdditions = append(relations.BundleAdditions, relation)
+		}
+	}
+	for _, rel := range model.relations {
+		if len(rel.Endpoints) != 2 {
+			continue
+		}
+		ep1, ep2 := rel.Endpoints[0].String(), rel.Endpoints[1].String()
+		if !bundle.hasRelation(ep1, ep2) {
+			relations.ModelAdditions = append(relations.ModelAdditions, []string{ep1, ep2})
+		}
+	}
+	if len(relations.BundleAdditions) > 0 || len(relations.ModelAdditions) > 0 {
+		sort.Sort(relationsByEndpoints(relations.BundleAdditions))
+		sort.Sort(relationsByEndpoints(relations.ModelAdditions))
+		diff.Relations = relations
+	}
+	return diff
+}
+
+func diffApplication(data *charm.BundleData, spec *charm.ApplicationSpec, app *bundleModelApplication) *applicationDiff {
+	appDiff := &applicationDiff{}
+	if !sameCharm(spec.Charm, app.charm) {
+		appDiff.Charm = &stringDiff{Bundle: spec.Charm, Model: app.charm}
+	}
+	series := spec.Series
+	if series == "" {
+		if curl, err := charm.ParseURL(spec.Charm); err == nil && curl.Series != "" {
+			series = curl.Series
+		} else {
+			series = data.Series
+		}
+	}
+	if series != "" && series != app.series {
+		appDiff.Series = &stringDiff{Bundle: series, Model: app.series}
+	}
+	if spec.NumUnits != app.numUnits {
+		appDiff.NumUnits = &intDiff{Bundle: spec.NumUnits, Model: app.numUnits}
+	}
+	if spec.Expose != app.exposed {
+		appDiff.Expose = &boolDiff{Bundle: spec.Expose, Model: app.exposed}
+	}
+	for name, value := range spec.Options {
+		if modelValue := app.options[name]; !sameOptionValue(value, modelValue) {
+			if appDiff.Options == nil {
+				appDiff.Options = make(map[string]optionDiff)
+			}
+			appDiff.Options[name] = optionDiff{Bundle: value, Model: modelValue}
+		}
+	}
+	if !sameConstraints(spec.Constraints, app.constraints) {
+		appDiff.Constraints = &stringDiff{Bundle: spec.Constraints, Model: app.constraints.String()}
+	}
+	return appDiff
+}
+
+// bundleRelations returns the relations in the bundle data in the form
+// used by the model, so that model relations can be looked up in it.
+func bundleRelations(data *charm.BundleData) []params.RelationStatus {
+	var relations []params.RelationStatus
+	for _, relation := range data.Relations {
+		var rel params.RelationStatus
+		for _, endpoint := range relation {
+			parts := strings.SplitN(endpoint, ":", 2)
+			ep := params.EndpointStatus{ApplicationName: parts[0]}
+			if len(parts) == 2 {
+				ep.Name = parts[1]
+			}
+			rel.Endpoints = append(rel.Endpoints, ep)
+		}
+		relations = append(relations, rel)
+	}
+	return relations
+}
+
+type relationsByEndpoints [][]string
+
+func (r relationsByEndpoints) Len() int      { return len(r) }
+func (r relationsByEndpoints) Swap(i, j int) { r[i], r[j] = r[j], r[i] }
+func (r relationsByEndpoints) Less(i, j int) bool {
+	return strings.Join(r[i], " ") < strings.Join(r[j], " ")
+}
+
+// NewDiffBundleCommand returns a command that compares a bundle with
+// the current model.
+func NewDiffBundleCommand() cmd.Command {
+	return modelcmd.Wrap(&diffBundleCommand{})
+}
+
+// diffBundleCommand reports the differences between a bundle and the
+// current model.
+type diffBundleCommand struct {
+	modelcmd.ModelCommandBase
+	out        cmd.Output
+	bundleFile string
+	newAPI     func() (DiffBundleAPI, error)
+}
+
+const diffBundleDoc = `
+Compares a local bundle with the current model, and reports the
+differences between them.
+
+For each application, the differences in charm, series, number of
+units, exposure, options and constraints are reported, as well as
+applications and relations that are only present in the bundle or in
+the model. An application reported as missing from the model is added
+when the bundle is deployed. Machines are not compared, as the machine
+ids in a bundle are placeholders rather than the ids of model machines.
+
+Examples:
+
+    juju diff-bundle ./bundle.yaml
+    juju diff-bundle --format json ./mediawiki
+
+See also:
+    deploy
+    export-bundle
+`
+
+// Info implements Command.
+func (c *diffBundleCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "diff-bundle",
+		Args:    "<bundle file or directory>",
+		Purpose: "Compares a bundle with the current model.",
+		Doc:     diffBundleDoc,
+	}
+}
+
+// SetFlags implements Command.
+func (c *diffBundleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", output.DefaultFormatters)
+}
+
+// Init implements Command.
+func (c *diffBundleCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no bundle specified")
+	}
+	c.bundleFile = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// DiffBundleAPI defines the API methods used by the diff-bundle
+// command.
+type DiffBundleAPI interface {
+	bundleModelAPI
+	Close() error
+}
+
+// diffBundleAPIAdapter implements DiffBundleAPI with the client and
+// application facades.
+type diffBundleAPIAdapter struct {
+	root        api.Connection
+	application *application.Client
+}
+
+// Status is part of the DiffBundleAPI interface.
+func (a *diffBundleAPIAdapter) Status(patterns []string) (*params.FullStatus, error) {
+	return a.root.Client().Status(patterns)
+}
+
+// ApplicationGet is part of the DiffBundleAPI interface.
+func (a *diffBundleAPIAdapter) ApplicationGet(application string) (*params.ApplicationGetResults, error) {
+	return a.application.Get(application)
+}
+
+// Close is part of the DiffBundleAPI interface.
+func (a *diffBundleAPIAdapter) Close() error {
+	return a.root.Close()
+}
+
+func (c *diffBundleCommand) getAPI() (DiffBundleAPI, error) {
+	if c.newAPI != nil {
+		return c.newAPI()
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &diffBundleAPIAdapter{
+		root:        root,
+		application: application.NewClient(root),
+	}, nil
+}
+
+// Run implements Command.
+func (c *diffBundleCommand) Run(ctx *cmd.Context) error {
+	data, err := readLocalBundle(ctx.AbsPath(c.bundleFile))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	api, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+	model, err := readBundleModel(api)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return c.out.Write(ctx, computeBundleDiff(data, model))
+}
+
+// readLocalBundle reads the bundle data from a bundle file, archive or
+// directory.
+func readLocalBundle(path string) (*charm.BundleData, error) {
+	data, err := charmrepo.ReadBundleFile(path)
+	if err == nil {
+		return data, nil
+	}
+	bundle, _, pathErr := charmrepo.NewBundleAtPath(path)
+	if pathErr != nil {
+		return nil, errors.Annotatef(err, "cannot read bundle %q", path)
+	}
+	return bundle.Data(), nil
+}
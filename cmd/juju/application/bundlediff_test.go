@@ -0,0 +1,168 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/modelcmd"
+	coretesting "github.com/juju/juju/testing"
+)
+
+type diffBundleSuite struct {
+	jujutesting.IsolationSuite
+	api *fakeDiffBundleAPI
+}
+
+var _ = gc.Suite(&diffBundleSuite{})
+
+func (s *diffBundleSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.api = &fakeDiffBundleAPI{
+		status: &params.FullStatus{
+			Machines: map[string]params.MachineStatus{
+				"0": {},
+				"1": {},
+			},
+			Applications: map[string]params.ApplicationStatus{
+				"wordpress": {
+					Charm:  "cs:quantal/wordpress-3",
+					Series: "quantal",
+					Units: map[string]params.UnitStatus{
+						"wordpress/0": {Machine: "0"},
+					},
+				},
+				"varnish": {
+					Charm:  "cs:quantal/varnish-1",
+					Series: "quantal",
+				},
+			},
+			Relations: []params.RelationStatus{{
+				Endpoints: []params.EndpointStatus{
+					{ApplicationName: "wordpress", Name: "cache"},
+					{ApplicationName: "varnish", Name: "webcache"},
+				},
+			}},
+		},
+		applications: map[string]*params.ApplicationGetResults{
+			"wordpress": {
+				Config: map[string]interface{}{
+					"blog-title": map[string]interface{}{"value": "My Title", "default": true},
+				},
+			},
+			"varnish": {},
+		},
+	}
+}
+
+func (s *diffBundleSuite) runDiffBundle(c *gc.C, bundle string, args ...string) (string, error) {
+	bundlePath := filepath.Join(c.MkDir(), "bundle.yaml")
+	err := ioutil.WriteFile(bundlePath, []byte(bundle), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	command := modelcmd.Wrap(&diffBundleCommand{
+		newAPI: func() (DiffBundleAPI, error) {
+			return s.api, nil
+		},
+	})
+	ctx, err := coretesting.RunCommand(c, command, append(args, bundlePath)...)
+	return coretesting.Stdout(ctx), err
+}
+
+func (s *diffBundleSuite) TestDiffBundle(c *gc.C) {
+	out, err := s.runDiffBundle(c, `
+applications:
+    wordpress:
+        charm: cs:wordpress
+        num_units: 2
+        expose: true
+        options:
+            blog-title: Juju
+    mysql:
+        charm: cs:mysql
+        num_units: 1
+machines:
+    "0": {}
+relations:
+    - ["wordpress:db", "mysql:server"]
+`)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(out, gc.Equals, `
+applications:
+  mysql:
+    missing: model
+  varnish:
+    missing: bundle
+  wordpress:
+    num_units:
+      bundle: 2
+      model: 1
+    expose:
+      bundle: true
+      model: false
+    options:
+      blog-title:
+        bundle: Juju
+        model: My Title
+relations:
+  bundle-additions:
+  - - wordpress:db
+    - mysql:server
+  model-additions:
+  - - wordpress:cache
+    - varnish:webcache
+`[1:])
+	c.Check(s.api.closed, jc.IsTrue)
+}
+
+func (s *diffBundleSuite) TestDiffBundleNoDifferences(c *gc.C) {
+	out, err := s.runDiffBundle(c, `
+applications:
+    wordpress:
+        charm: cs:quantal/wordpress-3
+        num_units: 1
+        options:
+            blog-title: My Title
+    varnish:
+        charm: cs:varnish
+machines:
+    "0": {}
+relations:
+    - ["wordpress", "varnish:webcache"]
+`, "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(out, gc.Equals, "{}\n")
+}
+
+func (s *diffBundleSuite) TestDiffBundleNoBundle(c *gc.C) {
+	command := modelcmd.Wrap(&diffBundleCommand{})
+	_, err := coretesting.RunCommand(c, command)
+	c.Assert(err, gc.ErrorMatches, "no bundle specified")
+}
+
+// fakeDiffBundleAPI is a fake DiffBundleAPI returning the configured
+// model.
+type fakeDiffBundleAPI struct {
+	status       *params.FullStatus
+	applications map[string]*params.ApplicationGetResults
+	closed       bool
+}
+
+func (f *fakeDiffBundleAPI) Status(patterns []string) (*params.FullStatus, error) {
+	return f.status, nil
+}
+
+func (f *fakeDiffBundleAPI) ApplicationGet(application string) (*params.ApplicationGetResults, error) {
+	return f.applications[application], nil
+}
+
+func (f *fakeDiffBundleAPI) Close() error {
+	f.closed = true
+	return nil
+}
@@ -5,6 +5,7 @@ package application
 
 import (
 	"archive/zip"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -52,6 +53,7 @@ type ApplicationAPI interface {
 	AddUnits(application string, numUnits int, placement []*instance.Placement) ([]string, error)
 	Expose(application string) error
 	GetCharmURL(serviceName string) (*charm.URL, error)
+	ApplicationGet(application string) (*apiparams.ApplicationGetResults, error)
 	SetAnnotation(annotations map[string]map[string]string) ([]apiparams.ErrorResult, error)
 	SetCharm(application.SetCharmConfig) error
 	SetConstraints(application string, constraints constraints.Value) error
@@ -178,6 +180,10 @@ func (a *deployAPIAdapter) Get(url *charm.URL) (charm.Charm, error) {
 	return a.charmRepoClient.Get(url)
 }
 
+func (a *deployAPIAdapter) ApplicationGet(application string) (*apiparams.ApplicationGetResults, error) {
+	return a.applicationClient.Get(application)
+}
+
 func (a *deployAPIAdapter) SetAnnotation(annotations map[string]map[string]string) ([]apiparams.ErrorResult, error) {
 	return a.annotationsClient.Set(annotations)
 }
@@ -273,6 +279,10 @@ type DeployCommand struct {
 	Bindings map[string]string
 	Steps    []DeployStep
 
+	// DryRun is true if the changes required to deploy a bundle are
+	// only to be shown, rather than applied.
+	DryRun bool
+
 	// NewAPIRoot stores a function which returns a new API root.
 	NewAPIRoot NewAPIRootFn
 
@@ -318,6 +328,14 @@ For example:
 
   juju deploy /path/to/bundle/openstack/bundle.yaml
 
+When '--dry-run' is given with a bundle, the changes that deploying the
+bundle would make to the current model are shown, in the order they would
+be applied, but the model is left unchanged. These include the charm
+upgrades and the configuration and constraints changes of applications
+that are already deployed.
+
+  juju deploy --dry-run /path/to/bundle/openstack/bundle.yaml
+
 If an 'application name' is not provided, the application name used is the
 'charm or bundle' name.
 
@@ -372,6 +390,7 @@ Examples:
     'cmd' or the 'database' spaces)
 
 See also:
+    diff-bundle
     spaces
     constraints
     add-unit
@@ -417,7 +436,7 @@ var (
 	// charmOnlyFlags and bundleOnlyFlags are used to validate flags based on
 	// whether we are deploying a charm or a bundle.
 	charmOnlyFlags        = []string{"bind", "config", "constraints", "force", "n", "num-units", "series", "to", "resource"}
-	bundleOnlyFlags       = []string{}
+	bundleOnlyFlags       = []string{"dry-run"}
 	modelCommandBaseFlags = []string{"B", "no-browser-login"}
 )
 
@@ -435,6 +454,7 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(storageFlag{&c.Storage, &c.BundleStorage}, "storage", "Charm storage constraints")
 	f.Var(stringMap{&c.Resources}, "resource", "Resource to be uploaded to the controller")
 	f.StringVar(&c.BindToSpaces, "bind", "", "Configure application endpoint bindings to spaces")
+	f.BoolVar(&c.DryRun, "dry-run", false, "Just show the changes a bundle deployment would make to the model")
 
 	for _, step := range c.Steps {
 		step.SetFlags(f)
@@ -489,6 +509,21 @@ func (c *DeployCommand) deployBundle(
 	apiRoot DeployAPI,
 	bundleStorage map[string]map[string]storage.Constraints,
 ) error {
+	if c.DryRun {
+		changes, err := dryRunBundle(filePath, data, channel, apiRoot, ctx, bundleStorage)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if len(changes) == 0 {
+			ctx.Infof("No changes to apply.")
+			return nil
+		}
+		fmt.Fprintln(ctx.Stdout, "Changes to deploy bundle:")
+		for _, change := range changes {
+			fmt.Fprintln(ctx.Stdout, "- "+change)
+		}
+		return nil
+	}
 	// TODO(ericsnow) Do something with the CS macaroons that were returned?
 	if _, err := deployBundle(
 		filePath,
@@ -713,6 +748,9 @@ func (c *DeployCommand) maybePredeployedLocalCharm() (deployFn, error) {
 		logger.Debugf("cannot interpret as a redeployment of a local charm from the controller")
 		return nil, nil
 	}
+	if err := c.validateCharmFlags(); err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	return func(ctx *cmd.Context, api DeployAPI) error {
 		formattedCharmURL := userCharmURL.String()
@@ -817,6 +855,9 @@ func (c *DeployCommand) maybeReadLocalCharm() (deployFn, error) {
 		logger.Debugf("cannot interpret as local charm: %v", err)
 		return nil, nil
 	}
+	if err := c.validateCharmFlags(); err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	return func(ctx *cmd.Context, apiRoot DeployAPI) error {
 		if curl, err = apiRoot.AddLocalCharm(curl, ch); err != nil {
@@ -1387,6 +1387,96 @@ func (s *DeployUnitTestSuite) TestDeployBundle_OutputsCorrectMessage(c *gc.C) {
 	)
 }
 
+func (s *DeployUnitTestSuite) TestDeployBundleDryRun(c *gc.C) {
+	bundlePath := filepath.Join(c.MkDir(), "bundle.yaml")
+	err := ioutil.WriteFile(bundlePath, []byte(`
+applications:
+    wordpress:
+        charm: cs:wordpress
+        num_units: 2
+        expose: true
+        options:
+            blog-title: Juju
+    mysql:
+        charm: cs:mysql
+        num_units: 1
+relations:
+    - ["wordpress:db", "mysql:server"]
+`), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfgAttrs := map[string]interface{}{
+		"name": "name",
+		"uuid": "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		"type": "foo",
+	}
+	fakeAPI := vanillaFakeModelAPI(cfgAttrs)
+	cfg, err := config.New(config.NoDefaults, cfgAttrs)
+	c.Assert(err, jc.ErrorIsNil)
+	withCharmRepoResolvable(fakeAPI, charm.MustParseURL("cs:mysql"), cfg)
+	fakeAPI.Call("Resolve", cfg, charm.MustParseURL("cs:wordpress")).Returns(
+		charm.MustParseURL("cs:quantal/wordpress-5"),
+		csclientparams.Channel(""),
+		[]string{"quantal"},
+		error(nil),
+	)
+	fakeAPI.Call("Status", []string(nil)).Returns(&params.FullStatus{
+		Machines: map[string]params.MachineStatus{"0": {}},
+		Applications: map[string]params.ApplicationStatus{
+			"wordpress": {
+				Charm:  "cs:quantal/wordpress-3",
+				Series: "quantal",
+				Units: map[string]params.UnitStatus{
+					"wordpress/0": {Machine: "0"},
+				},
+			},
+		},
+	}, error(nil))
+	fakeAPI.Call("ApplicationGet", "wordpress").Returns(&params.ApplicationGetResults{
+		Config: map[string]interface{}{
+			"blog-title": map[string]interface{}{"value": "My Title", "default": true},
+		},
+	}, error(nil))
+
+	deployCmd := NewDeployCommand(func() (DeployAPI, error) {
+		return fakeAPI, nil
+	}, nil)
+	context, err := jtesting.RunCommand(c, deployCmd, "--dry-run", bundlePath)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(jtesting.Stdout(context), gc.Equals, ""+
+		"Changes to deploy bundle:\n"+
+		"- upload charm cs:mysql\n"+
+		"- deploy application mysql using cs:mysql\n"+
+		"- upload charm cs:quantal/wordpress-5\n"+
+		"- upgrade wordpress from cs:quantal/wordpress-3 to cs:quantal/wordpress-5\n"+
+		"- set application options for wordpress: blog-title=Juju\n"+
+		"- expose wordpress\n"+
+		"- add relation wordpress:db - mysql:server\n"+
+		"- add unit mysql/0 to new machine\n"+
+		"- add unit wordpress/1 to new machine\n",
+	)
+	for _, call := range fakeAPI.Calls() {
+		switch call.FuncName {
+		case "AddCharm", "Deploy", "SetCharm", "Update", "Expose", "AddRelation", "AddUnits":
+			c.Errorf("unexpected call to %s during a dry run", call.FuncName)
+		}
+	}
+}
+
+func (s *DeployUnitTestSuite) TestDeployCharmDryRun(c *gc.C) {
+	fakeAPI := vanillaFakeModelAPI(map[string]interface{}{
+		"name": "name",
+		"uuid": "deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		"type": "foo",
+	})
+	deployCmd := NewDeployCommand(func() (DeployAPI, error) {
+		return fakeAPI, nil
+	}, nil)
+	_, err := jtesting.RunCommand(c, deployCmd, "--dry-run", "local:trusty/dummy-0")
+	c.Assert(err, gc.ErrorMatches, "Flags provided but not supported when deploying a charm: dry-run.")
+}
+
 // fakeDeployAPI is a mock of the API used by the deploy command. It's
 // a little muddled at the moment, but as the DeployAPI interface is
 // sharpened, this will become so as well.
@@ -1518,6 +1608,11 @@ func (f *fakeDeployAPI) GetCharmURL(serviceName string) (*charm.URL, error) {
 	return results[0].(*charm.URL), jujutesting.TypeAssertError(results[1])
 }
 
+func (f *fakeDeployAPI) ApplicationGet(application string) (*params.ApplicationGetResults, error) {
+	results := f.MethodCall(f, "ApplicationGet", application)
+	return results[0].(*params.ApplicationGetResults), jujutesting.TypeAssertError(results[1])
+}
+
 func (f *fakeDeployAPI) SetCharm(cfg application.SetCharmConfig) error {
 	results := f.MethodCall(f, "SetCharm", cfg)
 	return jujutesting.TypeAssertError(results[0])
@@ -4,12 +4,16 @@
 package application
 
 import (
+	"strings"
+
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
 	"github.com/juju/juju/api/application"
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/network"
 )
 
 var usageExposeSummary = `
@@ -19,8 +23,15 @@ var usageExposeDetails = `
 Adjusts the firewall rules and any relevant security mechanisms of the
 cloud to allow public access to the application.
 
+Access may be restricted to a set of source CIDRs with --to-cidrs;
+only traffic from those CIDRs is then allowed to reach the ports
+opened by the application's units. Exposing an application again
+replaces any previously specified source CIDRs. --to-cidrs is rejected
+on clouds whose firewalls cannot restrict traffic by source.
+
 Examples:
     juju expose wordpress
+    juju expose wordpress --to-cidrs 10.0.0.0/8,192.168.1.0/24
 
 See also: 
     unexpose`[1:]
@@ -34,6 +45,8 @@ func NewExposeCommand() cmd.Command {
 type exposeCommand struct {
 	modelcmd.ModelCommandBase
 	ApplicationName string
+	SourceCIDRs     []string
+	toCIDRs         string
 }
 
 func (c *exposeCommand) Info() *cmd.Info {
@@ -45,17 +58,31 @@ func (c *exposeCommand) Info() *cmd.Info {
 	}
 }
 
+func (c *exposeCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.toCIDRs, "to-cidrs", "", "Comma-separated list of source CIDRs allowed to access the application")
+}
+
 func (c *exposeCommand) Init(args []string) error {
 	if len(args) == 0 {
 		return errors.New("no application name specified")
 	}
 	c.ApplicationName = args[0]
+	if c.toCIDRs != "" {
+		for _, cidr := range strings.Split(c.toCIDRs, ",") {
+			c.SourceCIDRs = append(c.SourceCIDRs, strings.TrimSpace(cidr))
+		}
+		if err := network.ValidateSourceCIDRs(c.SourceCIDRs); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return cmd.CheckEmpty(args[1:])
 }
 
 type serviceExposeAPI interface {
 	Close() error
 	Expose(serviceName string) error
+	ExposeToCIDRs(serviceName string, cidrs []string) error
 	Unexpose(serviceName string) error
 }
 
@@ -75,5 +102,10 @@ func (c *exposeCommand) Run(_ *cmd.Context) error {
 		return err
 	}
 	defer client.Close()
-	return block.ProcessBlockedError(client.Expose(c.ApplicationName), block.BlockChange)
+	if len(c.SourceCIDRs) > 0 {
+		err = client.ExposeToCIDRs(c.ApplicationName, c.SourceCIDRs)
+	} else {
+		err = client.Expose(c.ApplicationName)
+	}
+	return block.ProcessBlockedError(err, block.BlockChange)
 }
@@ -59,6 +59,24 @@ func (s *ExposeSuite) TestExpose(c *gc.C) {
 	})
 }
 
+func (s *ExposeSuite) TestExposeToCIDRs(c *gc.C) {
+	ch := testcharms.Repo.CharmArchivePath(s.CharmsPath, "dummy")
+	err := runDeploy(c, ch, "some-application-name", "--series", "trusty")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = runExpose(c, "some-application-name", "--to-cidrs", "192.168.1.0/24, 10.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertExposed(c, "some-application-name")
+	svc, err := s.State.Application("some-application-name")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(svc.ExposedCIDRs(), jc.DeepEquals, []string{"10.0.0.0/8", "192.168.1.0/24"})
+}
+
+func (s *ExposeSuite) TestExposeToInvalidCIDRs(c *gc.C) {
+	err := runExpose(c, "some-application-name", "--to-cidrs", "10.0.0.0/8,10.0.0.1")
+	c.Assert(err, gc.ErrorMatches, `source CIDR "10.0.0.1" not valid`)
+}
+
 func (s *ExposeSuite) TestBlockExpose(c *gc.C) {
 	ch := testcharms.Repo.CharmArchivePath(s.CharmsPath, "dummy")
 	err := runDeploy(c, ch, "some-application-name", "--series", "trusty")
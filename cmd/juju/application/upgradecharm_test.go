@@ -38,12 +38,14 @@ import (
 	jujutesting "github.com/juju/juju/juju/testing"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/permission"
 	"github.com/juju/juju/resource/resourceadapters"
 	"github.com/juju/juju/rpc"
 	"github.com/juju/juju/state"
 	"github.com/juju/juju/storage"
 	"github.com/juju/juju/testcharms"
 	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/testing/factory"
 )
 
 type UpgradeCharmSuite struct {
@@ -628,6 +630,34 @@ func (s *UpgradeCharmCharmStoreStateSuite) TestSwitch(c *gc.C) {
 	c.Assert(curl.String(), gc.Equals, "cs:~other/trusty/anotherriak-42")
 }
 
+func (s *UpgradeCharmCharmStoreStateSuite) TestUpgradeCharmApplicationWriteUser(c *gc.C) {
+	testcharms.UploadCharm(c, s.client, "cs:~other/trusty/riak-0", "riak")
+	testcharms.UploadCharm(c, s.client, "cs:~other/trusty/riak-7", "riak")
+	err := runDeploy(c, "cs:~other/trusty/riak-0")
+	c.Assert(err, jc.ErrorIsNil)
+	riak, err := s.State.Application("riak")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Log in as a user who may only read the model, but
+	// has been granted write access to the riak application.
+	user := s.Factory.MakeUser(c, &factory.UserParams{
+		Name:     "bob",
+		Password: "hunter2",
+		Access:   permission.ReadAccess,
+	})
+	_, err = s.State.SetUserAccess(user.UserTag(), riak.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.ControllerStore.UpdateAccount(jujutesting.ControllerName, jujuclient.AccountDetails{
+		User:     "bob",
+		Password: "hunter2",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = runUpgradeCharm(c, "riak")
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertUpgraded(c, riak, 7, false)
+}
+
 func (s *UpgradeCharmCharmStoreStateSuite) TestUpgradeCharmWithChannel(c *gc.C) {
 	id, ch := testcharms.UploadCharm(c, s.client, "cs:~client-username/trusty/wordpress-0", "wordpress")
 	err := runDeploy(c, "cs:~client-username/trusty/wordpress-0")
@@ -6,6 +6,7 @@ package backups
 import (
 	"fmt"
 	"io"
+	"net/url"
 	"os"
 
 	"github.com/juju/cmd"
@@ -25,16 +26,25 @@ type APIClient interface {
 	io.Closer
 	// Create sends an RPC request to create a new backup.
 	Create(notes string) (*params.BackupsMetadataResult, error)
+	// CreateWithArgs sends an RPC request to create a new backup,
+	// with a target or encryption key.
+	CreateWithArgs(args params.BackupsCreateArgs) (*params.BackupsMetadataResult, error)
 	// Info gets the backup's metadata.
 	Info(id string) (*params.BackupsMetadataResult, error)
 	// List gets all stored metadata.
 	List() (*params.BackupsListResult, error)
 	// Download pulls the backup archive file.
 	Download(id string) (io.ReadCloser, error)
+	// DownloadWithArgs pulls the backup archive file, from a target
+	// if it is stored there.
+	DownloadWithArgs(args params.BackupsDownloadArgs) (io.ReadCloser, error)
 	// Upload pushes a backup archive to storage.
 	Upload(ar io.ReadSeeker, meta params.BackupsMetadataResult) (string, error)
 	// Remove removes the stored backup.
 	Remove(id string) error
+	// RemoveWithArgs removes the stored backup, from a target if its
+	// archive is stored there.
+	RemoveWithArgs(args params.BackupsRemoveArgs) error
 	// Restore will restore a backup with the given id into the controller.
 	Restore(string, backups.ClientConnection) error
 	// RestoreReader will restore a backup file into the controller.
@@ -80,6 +90,9 @@ func (c *CommandBase) dumpMetadata(ctx *cmd.Context, result *params.BackupsMetad
 	fmt.Fprintf(ctx.Stdout, "started:         %v\n", result.Started)
 	fmt.Fprintf(ctx.Stdout, "finished:        %v\n", result.Finished)
 	fmt.Fprintf(ctx.Stdout, "notes:           %q\n", result.Notes)
+	fmt.Fprintf(ctx.Stdout, "scheduled:       %v\n", result.Scheduled)
+	fmt.Fprintf(ctx.Stdout, "target:          %q\n", result.Target)
+	fmt.Fprintf(ctx.Stdout, "key fingerprint: %q\n", result.KeyFingerprint)
 
 	fmt.Fprintf(ctx.Stdout, "model ID:        %q\n", result.Model)
 	fmt.Fprintf(ctx.Stdout, "machine ID:      %q\n", result.Machine)
@@ -87,6 +100,29 @@ func (c *CommandBase) dumpMetadata(ctx *cmd.Context, result *params.BackupsMetad
 	fmt.Fprintf(ctx.Stdout, "juju version:    %v\n", result.Version)
 }
 
+// parseTarget returns the backup target with the given URL. The
+// credentials for S3-compatible object storage are taken from the
+// AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+func parseTarget(targetURL string) (params.BackupTarget, error) {
+	u, err := url.Parse(targetURL)
+	if err != nil {
+		return params.BackupTarget{}, errors.NotValidf("backup target %q", targetURL)
+	}
+	target := params.BackupTarget{URL: targetURL}
+	switch u.Scheme {
+	case "file":
+	case "s3":
+		target.AccessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		target.SecretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		if target.AccessKey == "" || target.SecretKey == "" {
+			return params.BackupTarget{}, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set for an s3 backup target")
+		}
+	default:
+		return params.BackupTarget{}, errors.Errorf("backup target %q must be a file:// or s3:// URL", targetURL)
+	}
+	return target, nil
+}
+
 // ArchiveReader can read a backup archive.
 type ArchiveReader interface {
 	io.ReadSeeker
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/state/backups"
 )
@@ -44,6 +45,29 @@ Therefore, you should use the --download or --filename options, or use:
 to get a local copy of the backup archive.
 This local copy can then be used to restore an model even if that
 model was already destroyed or is otherwise unavailable.
+
+The --target option stores the backup archive outside the controller,
+either in a directory on the controller machine (such as an NFS mount),
+or in S3-compatible object storage:
+
+    juju create-backup --target file:///mnt/backups
+    juju create-backup --target s3://bucket/prefix
+    juju create-backup --target "s3://bucket?endpoint=https://minio.example.com:9000&region=us-east-1"
+
+Credentials for object storage are taken from the AWS_ACCESS_KEY_ID and
+AWS_SECRET_ACCESS_KEY environment variables. An archive stored in a
+target is not downloaded.
+
+The --encrypt-key-file option encrypts the backup archive with the key
+in the given file, which must hold 32 bytes encoded as hex, such as is
+generated by:
+
+    openssl rand -hex 32 > backup.key
+
+Without it, the archive is encrypted with the controller's own key if
+the backup-encryption-key-file controller setting is configured. The
+fingerprint of the key is recorded with the backup. Keep the key safe;
+an encrypted backup cannot be restored without it.
 `
 
 // NewCreateCommand returns a command used to create backups.
@@ -60,6 +84,14 @@ type createCommand struct {
 	Filename string
 	// Notes is the custom message to associated with the new backup.
 	Notes string
+	// Target is the URL of the backup target in which the archive
+	// should be stored, if not the controller.
+	Target string
+	// EncryptKeyFile is the file holding the key with which the
+	// archive should be encrypted.
+	EncryptKeyFile string
+
+	target params.BackupTarget
 }
 
 // Info implements Command.Info.
@@ -77,6 +109,8 @@ func (c *createCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.CommandBase.SetFlags(f)
 	f.BoolVar(&c.NoDownload, "no-download", false, "Do not download the archive")
 	f.StringVar(&c.Filename, "filename", notset, "Download to this file")
+	f.StringVar(&c.Target, "target", "", "Store the archive in this backup target (file:// or s3:// URL)")
+	f.StringVar(&c.EncryptKeyFile, "encrypt-key-file", "", "Encrypt the archive with the hex-encoded key in this file")
 }
 
 // Init implements Command.Init.
@@ -93,6 +127,17 @@ func (c *createCommand) Init(args []string) error {
 	if c.Filename == "" {
 		return errors.Errorf("missing filename")
 	}
+	if c.Target != "" {
+		if c.Filename != notset {
+			return errors.Errorf("cannot mix --target and --filename")
+		}
+		c.target, err = parseTarget(c.Target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		// Archives stored in a target cannot be downloaded.
+		c.NoDownload = true
+	}
 
 	return nil
 }
@@ -110,13 +155,29 @@ func (c *createCommand) Run(ctx *cmd.Context) error {
 	}
 	defer client.Close()
 
-	result, err := client.Create(c.Notes)
+	args := params.BackupsCreateArgs{Notes: c.Notes}
+	if c.Target != "" {
+		args.Target = &c.target
+	}
+	if c.EncryptKeyFile != "" {
+		args.EncryptionKey, err = backups.ReadEncryptionKey(c.EncryptKeyFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	var result *params.BackupsMetadataResult
+	if args.Target != nil || args.EncryptionKey != nil {
+		result, err = client.CreateWithArgs(args)
+	} else {
+		result, err = client.Create(c.Notes)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	if c.Log != nil && !c.Log.Quiet {
-		if c.NoDownload {
+		if c.NoDownload && c.Target == "" {
 			fmt.Fprintln(ctx.Stderr, downloadWarning)
 		}
 		c.dumpMetadata(ctx, result)
@@ -5,6 +5,8 @@ package backups_test
 
 import (
 	"bytes"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 
 	"github.com/juju/cmd"
@@ -12,6 +14,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/testing"
 )
@@ -133,9 +136,82 @@ func (s *createSuite) TestFilenameAndNoDownload(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, "cannot mix --no-download and --filename")
 }
 
+func (s *createSuite) TestTarget(c *gc.C) {
+	client := s.setSuccess()
+	ctx, err := testing.RunCommand(c, s.wrappedCommand, "--target", "file:///mnt/backups")
+	c.Assert(err, jc.ErrorIsNil)
+
+	client.Check(c, "", "", "CreateWithArgs")
+	c.Check(client.target, jc.DeepEquals, &params.BackupTarget{URL: "file:///mnt/backups"})
+	s.checkStd(c, ctx, MetaResultString+s.metaresult.ID+"\n", "")
+}
+
+func (s *createSuite) TestTargetS3Credentials(c *gc.C) {
+	s.PatchEnvironment("AWS_ACCESS_KEY_ID", "access")
+	s.PatchEnvironment("AWS_SECRET_ACCESS_KEY", "secret")
+	client := s.setSuccess()
+	_, err := testing.RunCommand(c, s.wrappedCommand, "--target", "s3://juju-backups", "--quiet")
+	c.Assert(err, jc.ErrorIsNil)
+
+	client.Check(c, "", "", "CreateWithArgs")
+	c.Check(client.target, jc.DeepEquals, &params.BackupTarget{
+		URL:       "s3://juju-backups",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+}
+
+func (s *createSuite) TestTargetErrors(c *gc.C) {
+	s.PatchEnvironment("AWS_ACCESS_KEY_ID", "")
+	s.setSuccess()
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"--target", "file:///mnt/backups", "--filename", "backup.tgz"},
+		err:  "cannot mix --target and --filename",
+	}, {
+		args: []string{"--target", "ftp://example.com/backups"},
+		err:  `backup target "ftp://example.com/backups" must be a file:// or s3:// URL`,
+	}, {
+		args: []string{"--target", "s3://juju-backups"},
+		err:  "AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set for an s3 backup target",
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		s.wrappedCommand, s.command = backups.NewCreateCommandForTest()
+		_, err := testing.RunCommand(c, s.wrappedCommand, test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
 func (s *createSuite) TestError(c *gc.C) {
 	s.setFailure("failed!")
 	_, err := testing.RunCommand(c, s.wrappedCommand)
 
 	c.Check(errors.Cause(err), gc.ErrorMatches, "failed!")
 }
+
+func (s *createSuite) TestEncryptKeyFile(c *gc.C) {
+	keyFile := filepath.Join(c.MkDir(), "backup.key")
+	err := ioutil.WriteFile(keyFile, []byte(strings.Repeat("42", 32)), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	client := s.setSuccess()
+	_, err = testing.RunCommand(c, s.wrappedCommand, "--encrypt-key-file", keyFile, "--no-download", "--quiet")
+	c.Assert(err, jc.ErrorIsNil)
+
+	client.Check(c, "", "", "CreateWithArgs")
+	c.Check(client.keyArg, jc.DeepEquals, bytes.Repeat([]byte{0x42}, 32))
+	c.Check(client.target, gc.IsNil)
+}
+
+func (s *createSuite) TestEncryptKeyFileInvalid(c *gc.C) {
+	keyFile := filepath.Join(c.MkDir(), "backup.key")
+	err := ioutil.WriteFile(keyFile, []byte("sekrit"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+
+	client := s.setSuccess()
+	_, err = testing.RunCommand(c, s.wrappedCommand, "--encrypt-key-file", keyFile)
+	c.Check(err, gc.ErrorMatches, `backup encryption key in ".*" \(expected 32 hex-encoded bytes\) not valid`)
+	c.Check(client.calls, gc.HasLen, 0)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/state/backups"
 )
@@ -21,6 +22,9 @@ download-backup retrieves a backup archive file.
 
 If --filename is not used, the archive is downloaded to a temporary
 location and the filename is printed to stdout.
+
+An archive stored in a backup target with "juju create-backup --target"
+is downloaded by passing the same target with --target.
 `
 
 // NewDownloadCommand returns a commant used to download backups.
@@ -35,6 +39,10 @@ type downloadCommand struct {
 	Filename string
 	// ID is the backup ID to download.
 	ID string
+	// Target is the URL of the backup target holding the archive.
+	Target string
+
+	target params.BackupTarget
 }
 
 // Info implements Command.Info.
@@ -51,6 +59,7 @@ func (c *downloadCommand) Info() *cmd.Info {
 func (c *downloadCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.CommandBase.SetFlags(f)
 	f.StringVar(&c.Filename, "filename", "", "Download target")
+	f.StringVar(&c.Target, "target", "", "Download the archive from this backup target (file:// or s3:// URL)")
 }
 
 // Init implements Command.Init.
@@ -63,6 +72,13 @@ func (c *downloadCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 	c.ID = id
+	if c.Target != "" {
+		target, err := parseTarget(c.Target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.target = target
+	}
 	return nil
 }
 
@@ -80,7 +96,15 @@ func (c *downloadCommand) Run(ctx *cmd.Context) error {
 	defer client.Close()
 
 	// Download the archive.
-	resultArchive, err := client.Download(c.ID)
+	var resultArchive io.ReadCloser
+	if c.Target != "" {
+		resultArchive, err = client.DownloadWithArgs(params.BackupsDownloadArgs{
+			ID:     c.ID,
+			Target: &c.target,
+		})
+	} else {
+		resultArchive, err = client.Download(c.ID)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
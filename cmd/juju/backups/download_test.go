@@ -9,6 +9,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/testing"
 )
@@ -66,6 +67,17 @@ func (s *downloadSuite) TestFilename(c *gc.C) {
 	s.checkArchive(c)
 }
 
+func (s *downloadSuite) TestTarget(c *gc.C) {
+	client := s.setSuccess()
+	_, err := testing.RunCommand(c, s.wrappedCommand, s.metaresult.ID, "--target", "file:///mnt/backups")
+	c.Check(err, jc.ErrorIsNil)
+
+	client.Check(c, s.metaresult.ID, "", "DownloadWithArgs")
+	c.Check(client.target, jc.DeepEquals, &params.BackupTarget{URL: "file:///mnt/backups"})
+	s.filename = "juju-backup-" + s.metaresult.ID + ".tar.gz"
+	s.checkArchive(c)
+}
+
 func (s *downloadSuite) TestError(c *gc.C) {
 	s.setFailure("failed!")
 	_, err := testing.RunCommand(c, s.wrappedCommand, s.metaresult.ID)
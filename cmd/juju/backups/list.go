@@ -9,11 +9,14 @@ import (
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
 )
 
 const listDoc = `
 backups provides the metadata associated with all backups.
+Backups taken by the controller's backup scheduler are marked
+as scheduled.
 `
 
 // NewListCommand returns a command used to list metadata for backups.
@@ -72,15 +75,25 @@ func (c *listCommand) Run(ctx *cmd.Context) error {
 	if verbose {
 		c.dumpMetadata(ctx, &result.List[0])
 	} else {
-		fmt.Fprintln(ctx.Stdout, result.List[0].ID)
+		dumpID(ctx, &result.List[0])
 	}
 	for _, resultItem := range result.List[1:] {
 		if verbose {
 			fmt.Fprintln(ctx.Stdout)
 			c.dumpMetadata(ctx, &resultItem)
 		} else {
-			fmt.Fprintln(ctx.Stdout, resultItem.ID)
+			dumpID(ctx, &resultItem)
 		}
 	}
 	return nil
 }
+
+// dumpID writes the backup ID to stdout, marking backups that were
+// taken by the backup scheduler.
+func dumpID(ctx *cmd.Context, result *params.BackupsMetadataResult) {
+	if result.Scheduled {
+		fmt.Fprintf(ctx.Stdout, "%s (scheduled)\n", result.ID)
+		return
+	}
+	fmt.Fprintln(ctx.Stdout, result.ID)
+}
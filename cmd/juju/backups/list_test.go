@@ -44,6 +44,15 @@ func (s *listSuite) TestBrief(c *gc.C) {
 	s.checkStd(c, ctx, out, "")
 }
 
+func (s *listSuite) TestBriefScheduled(c *gc.C) {
+	s.metaresult.Scheduled = true
+	s.setSuccess()
+	ctx, err := testing.RunCommand(c, s.subcommand)
+	c.Assert(err, jc.ErrorIsNil)
+	out := s.metaresult.ID + " (scheduled)\n"
+	s.checkStd(c, ctx, out, "")
+}
+
 func (s *listSuite) TestError(c *gc.C) {
 	s.setFailure("failed!")
 	_, err := testing.RunCommand(c, s.subcommand)
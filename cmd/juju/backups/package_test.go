@@ -32,6 +32,9 @@ stored:          0001-01-01 00:00:00 +0000 UTC
 started:         0001-01-01 00:00:00 +0000 UTC
 finished:        0001-01-01 00:00:00 +0000 UTC
 notes:           ""
+scheduled:       false
+target:          ""
+key fingerprint: ""
 model ID:        ""
 machine ID:      ""
 created on host: ""
@@ -119,10 +122,12 @@ type fakeAPIClient struct {
 	archive    io.ReadCloser
 	err        error
 
-	calls []string
-	args  []string
-	idArg string
-	notes string
+	calls  []string
+	args   []string
+	idArg  string
+	notes  string
+	target *params.BackupTarget
+	keyArg []byte
 }
 
 func (f *fakeAPIClient) Check(c *gc.C, id, notes string, calls ...string) {
@@ -141,6 +146,18 @@ func (c *fakeAPIClient) Create(notes string) (*params.BackupsMetadataResult, err
 	return c.metaresult, nil
 }
 
+func (c *fakeAPIClient) CreateWithArgs(args params.BackupsCreateArgs) (*params.BackupsMetadataResult, error) {
+	c.calls = append(c.calls, "CreateWithArgs")
+	c.args = append(c.args, "args")
+	c.notes = args.Notes
+	c.target = args.Target
+	c.keyArg = args.EncryptionKey
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.metaresult, nil
+}
+
 func (c *fakeAPIClient) Info(id string) (*params.BackupsMetadataResult, error) {
 	c.calls = append(c.calls, "Info")
 	c.args = append(c.args, "id")
@@ -171,6 +188,17 @@ func (c *fakeAPIClient) Download(id string) (io.ReadCloser, error) {
 	return c.archive, nil
 }
 
+func (c *fakeAPIClient) DownloadWithArgs(args params.BackupsDownloadArgs) (io.ReadCloser, error) {
+	c.calls = append(c.calls, "DownloadWithArgs")
+	c.args = append(c.args, "args")
+	c.idArg = args.ID
+	c.target = args.Target
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.archive, nil
+}
+
 func (c *fakeAPIClient) Upload(ar io.ReadSeeker, meta params.BackupsMetadataResult) (string, error) {
 	c.args = append(c.args, "ar", "meta")
 	if c.err != nil {
@@ -189,6 +217,17 @@ func (c *fakeAPIClient) Remove(id string) error {
 	return nil
 }
 
+func (c *fakeAPIClient) RemoveWithArgs(args params.BackupsRemoveArgs) error {
+	c.calls = append(c.calls, "RemoveWithArgs")
+	c.args = append(c.args, "args")
+	c.idArg = args.ID
+	c.target = args.Target
+	if c.err != nil {
+		return c.err
+	}
+	return nil
+}
+
 func (c *fakeAPIClient) Close() error {
 	return nil
 }
@@ -200,3 +239,7 @@ func (c *fakeAPIClient) RestoreReader(io.ReadSeeker, *params.BackupsMetadataResu
 func (c *fakeAPIClient) Restore(string, apibackups.ClientConnection) error {
 	return nil
 }
+
+func (c *fakeAPIClient) RestoreWithArgs(params.RestoreArgs, apibackups.ClientConnection) error {
+	return nil
+}
@@ -8,12 +8,18 @@ import (
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/modelcmd"
 )
 
 const removeDoc = `
 remove-backup removes a backup from remote storage.
+
+A backup whose archive is stored in a backup target with
+"juju create-backup --target" is removed, along with its archive, by
+passing the same target with --target.
 `
 
 // NewRemoveCommand returns a command used to remove a
@@ -26,6 +32,10 @@ type removeCommand struct {
 	CommandBase
 	// ID refers to the backup to be removed.
 	ID string
+	// Target is the URL of the backup target holding the archive.
+	Target string
+
+	target params.BackupTarget
 }
 
 // Info implements Command.Info.
@@ -38,6 +48,12 @@ func (c *removeCommand) Info() *cmd.Info {
 	}
 }
 
+// SetFlags implements Command.SetFlags.
+func (c *removeCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.CommandBase.SetFlags(f)
+	f.StringVar(&c.Target, "target", "", "Remove the archive from this backup target (file:// or s3:// URL)")
+}
+
 // Init implements Command.Init.
 func (c *removeCommand) Init(args []string) error {
 	if len(args) == 0 {
@@ -48,6 +64,13 @@ func (c *removeCommand) Init(args []string) error {
 		return errors.Trace(err)
 	}
 	c.ID = id
+	if c.Target != "" {
+		target, err := parseTarget(c.Target)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		c.target = target
+	}
 	return nil
 }
 
@@ -64,7 +87,14 @@ func (c *removeCommand) Run(ctx *cmd.Context) error {
 	}
 	defer client.Close()
 
-	err = client.Remove(c.ID)
+	if c.Target != "" {
+		err = client.RemoveWithArgs(params.BackupsRemoveArgs{
+			ID:     c.ID,
+			Target: &c.target,
+		})
+	} else {
+		err = client.Remove(c.ID)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
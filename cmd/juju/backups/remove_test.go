@@ -9,6 +9,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/cmd/juju/backups"
 	"github.com/juju/juju/testing"
 )
@@ -34,6 +35,15 @@ func (s *removeSuite) TestOkay(c *gc.C) {
 	s.checkStd(c, ctx, out, "")
 }
 
+func (s *removeSuite) TestTarget(c *gc.C) {
+	client := s.setSuccess()
+	_, err := testing.RunCommand(c, s.command, "spam", "--target", "file:///mnt/backups")
+	c.Assert(err, jc.ErrorIsNil)
+
+	client.Check(c, "spam", "", "RemoveWithArgs")
+	c.Check(client.target, jc.DeepEquals, &params.BackupTarget{URL: "file:///mnt/backups"})
+}
+
 func (s *removeSuite) TestError(c *gc.C) {
 	s.setFailure("failed!")
 	_, err := testing.RunCommand(c, s.command, "spam")
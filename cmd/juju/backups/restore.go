@@ -7,6 +7,8 @@ import (
 	"crypto/rand"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/environs/sync"
 	"github.com/juju/juju/jujuclient"
+	statebackups "github.com/juju/juju/state/backups"
 	"github.com/juju/juju/version"
 )
 
@@ -52,6 +55,9 @@ type restoreCommand struct {
 	constraintsStr string
 	filename       string
 	backupId       string
+	targetURL      string
+	target         params.BackupTarget
+	encryptKeyFile string
 	bootstrap      bool
 	buildAgent     bool
 
@@ -72,6 +78,9 @@ type RestoreAPI interface {
 
 	// RestoreReader is taken from backups.Client.
 	RestoreReader(r io.ReadSeeker, meta *params.BackupsMetadataResult, newClient backups.ClientConnection) error
+
+	// RestoreWithArgs is taken from backups.Client.
+	RestoreWithArgs(args params.RestoreArgs, newClient backups.ClientConnection) error
 }
 
 var restoreDoc = `
@@ -89,6 +98,20 @@ If the provided state cannot be restored, this command will fail with
 an appropriate message.  For instance, if the existing bootstrap
 instance is already running then the command will fail with a message
 to that effect.
+
+A backup whose archive was stored in a backup target with
+"juju create-backup --target" is restored by also passing the target
+with --target, along with its --id. The backup's metadata is stored
+in the target alongside the archive, so the backup can be restored
+even if the controller no longer holds it.
+
+An encrypted backup is restored by passing the file holding the key
+with which it was encrypted with --encrypt-key-file. An archive given
+with --file is decrypted locally before it is uploaded; otherwise the
+key is passed to the controller, which refuses to restore the backup
+if the key does not match the one recorded with it. If no key is
+given, the controller uses its own backup encryption key, if it has
+one.
 `
 
 var BootstrapFunc = bootstrap.Bootstrap
@@ -110,6 +133,8 @@ func (c *restoreCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.bootstrap, "b", false, "Bootstrap a new state machine")
 	f.StringVar(&c.filename, "file", "", "Provide a file to be used as the backup.")
 	f.StringVar(&c.backupId, "id", "", "Provide the name of the backup to be restored")
+	f.StringVar(&c.targetURL, "target", "", "Provide the backup target holding the archive of the backup to be restored")
+	f.StringVar(&c.encryptKeyFile, "encrypt-key-file", "", "Provide the file holding the key with which the backup was encrypted")
 	f.BoolVar(&c.buildAgent, "build-agent", false, "Build binary agent if bootstraping a new machine")
 }
 
@@ -124,6 +149,16 @@ func (c *restoreCommand) Init(args []string) error {
 	if c.backupId != "" && c.bootstrap {
 		return errors.Errorf("it is not possible to rebootstrap and restore from an id.")
 	}
+	if c.targetURL != "" {
+		if c.backupId == "" {
+			return errors.Errorf("a backup target can only be used to restore from an id.")
+		}
+		var err error
+		c.target, err = parseTarget(c.targetURL)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	var err error
 	if c.filename != "" {
@@ -352,6 +387,56 @@ func (c *restoreCommand) rebootstrap(ctx *cmd.Context, meta *params.BackupsMetad
 	return c.waitForAgentFunc(ctx, &c.ModelCommandBase, c.ControllerName(), "default")
 }
 
+// decryptArchive returns the name of a file holding the backup archive
+// in the named file, decrypted with the key if it was encrypted, along
+// with a function that removes any temporary file it created.
+func decryptArchive(filename string, key []byte) (_ string, cleanup func(), err error) {
+	noop := func() {}
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	defer file.Close()
+	encrypted, err := statebackups.IsEncryptedArchive(file)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	if !encrypted {
+		return filename, noop, nil
+	}
+	if key == nil {
+		return "", nil, errors.Errorf("backup archive %q is encrypted; use --encrypt-key-file to restore it", filename)
+	}
+	if _, err := file.Seek(0, os.SEEK_SET); err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	decrypter, err := statebackups.NewDecryptingReader(file, key)
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+
+	decrypted, err := ioutil.TempFile("", "juju-restore-")
+	if err != nil {
+		return "", nil, errors.Trace(err)
+	}
+	cleanup = func() {
+		os.Remove(decrypted.Name())
+	}
+	defer func() {
+		if err != nil {
+			cleanup()
+		}
+	}()
+	_, err = io.Copy(decrypted, decrypter)
+	if closeErr := decrypted.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", nil, errors.Annotate(err, "cannot decrypt backup archive")
+	}
+	return decrypted.Name(), cleanup, nil
+}
+
 func (c *restoreCommand) newClient() (*backups.Client, error) {
 	client, err := c.NewAPIClient()
 	if err != nil {
@@ -378,6 +463,14 @@ func (c *restoreCommand) Run(ctx *cmd.Context) error {
 		}
 	}
 
+	var key []byte
+	if c.encryptKeyFile != "" {
+		key, err = statebackups.ReadEncryptionKey(c.encryptKeyFile)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	var archive ArchiveReader
 	var meta *params.BackupsMetadataResult
 	target := c.backupId
@@ -386,12 +479,19 @@ func (c *restoreCommand) Run(ctx *cmd.Context) error {
 		// we'll need the info later regardless if
 		// we need it now to rebootstrap.
 		target = c.filename
-		var err error
-		archive, meta, err = c.getArchiveFunc(c.filename)
+		filename, cleanup, err := decryptArchive(c.filename, key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		defer cleanup()
+		archive, meta, err = c.getArchiveFunc(filename)
 		if err != nil {
 			return errors.Trace(err)
 		}
 		defer archive.Close()
+		// The uploaded archive is not encrypted, whatever the
+		// metadata in it says.
+		meta.KeyFingerprint = ""
 
 		if c.bootstrap {
 			if err := c.rebootstrap(ctx, meta); err != nil {
@@ -410,6 +510,15 @@ func (c *restoreCommand) Run(ctx *cmd.Context) error {
 	// to restore the backup.
 	if c.filename != "" {
 		err = client.RestoreReader(archive, meta, c.newClient)
+	} else if c.targetURL != "" || key != nil {
+		args := params.RestoreArgs{
+			BackupId:      c.backupId,
+			EncryptionKey: key,
+		}
+		if c.targetURL != "" {
+			args.Target = &c.target
+		}
+		err = client.RestoreWithArgs(args, c.newClient)
 	} else {
 		err = client.Restore(c.backupId, c.newClient)
 	}
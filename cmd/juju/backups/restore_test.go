@@ -4,8 +4,13 @@
 package backups_test
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -24,6 +29,7 @@ import (
 	"github.com/juju/juju/network"
 	_ "github.com/juju/juju/provider/dummy"
 	_ "github.com/juju/juju/provider/lxd"
+	statebackups "github.com/juju/juju/state/backups"
 	"github.com/juju/juju/testing"
 	"github.com/juju/juju/version"
 )
@@ -104,18 +110,111 @@ func (s *restoreSuite) TestRestoreArgs(c *gc.C) {
 
 	_, err = testing.RunCommand(c, s.command, "restore", "--id", "anid", "-b")
 	c.Assert(err, gc.ErrorMatches, "it is not possible to rebootstrap and restore from an id.")
+
+	_, err = testing.RunCommand(c, s.command, "restore", "--file", "afile", "--target", "file:///mnt/backups")
+	c.Assert(err, gc.ErrorMatches, "a backup target can only be used to restore from an id.")
+
+	_, err = testing.RunCommand(c, s.command, "restore", "--id", "anid", "--target", "ftp://example.com")
+	c.Assert(err, gc.ErrorMatches, `backup target "ftp://example.com" must be a file:// or s3:// URL`)
+}
+
+func (s *restoreSuite) TestRestoreFromTarget(c *gc.C) {
+	client := &mockRestoreAPI{}
+	s.command = backups.NewRestoreCommandForTest(s.store, client, nil, nil, nil)
+	ctx, err := testing.RunCommand(c, s.command, "restore", "--id", "anid", "--target", "file:///mnt/backups")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(client.args, jc.DeepEquals, params.RestoreArgs{
+		BackupId: "anid",
+		Target:   &params.BackupTarget{URL: "file:///mnt/backups"},
+	})
+	c.Check(testing.Stdout(ctx), gc.Equals, "restore from \"anid\" completed\n")
+}
+
+func writeKeyFile(c *gc.C) (string, []byte) {
+	keyFile := filepath.Join(c.MkDir(), "backup.key")
+	err := ioutil.WriteFile(keyFile, []byte(strings.Repeat("42", 32)), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	return keyFile, bytes.Repeat([]byte{0x42}, 32)
+}
+
+func writeEncryptedArchive(c *gc.C, key []byte) string {
+	filename := filepath.Join(c.MkDir(), "juju-backup.tar.gz")
+	file, err := os.Create(filename)
+	c.Assert(err, jc.ErrorIsNil)
+	defer file.Close()
+	w, err := statebackups.NewEncryptingWriter(file, key)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = w.Write([]byte("<archive>"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(w.Close(), jc.ErrorIsNil)
+	return filename
+}
+
+func (s *restoreSuite) TestRestoreEncryptedID(c *gc.C) {
+	keyFile, key := writeKeyFile(c)
+	client := &mockRestoreAPI{}
+	s.command = backups.NewRestoreCommandForTest(s.store, client, nil, nil, nil)
+	_, err := testing.RunCommand(c, s.command, "restore", "--id", "anid", "--encrypt-key-file", keyFile)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(client.args, jc.DeepEquals, params.RestoreArgs{
+		BackupId:      "anid",
+		EncryptionKey: key,
+	})
+}
+
+func (s *restoreSuite) TestRestoreEncryptedFile(c *gc.C) {
+	keyFile, key := writeKeyFile(c)
+	filename := writeEncryptedArchive(c, key)
+	client := &mockRestoreAPI{}
+	var decrypted string
+	s.command = backups.NewRestoreCommandForTest(
+		s.store, client,
+		func(filename string) (backups.ArchiveReader, *params.BackupsMetadataResult, error) {
+			decrypted = filename
+			data, err := ioutil.ReadFile(filename)
+			c.Assert(err, jc.ErrorIsNil)
+			c.Check(string(data), gc.Equals, "<archive>")
+			return &mockArchiveReader{}, &params.BackupsMetadataResult{
+				KeyFingerprint: statebackups.KeyFingerprint(key),
+			}, nil
+		},
+		nil, nil,
+	)
+	_, err := testing.RunCommand(c, s.command, "restore", "--file", filename, "--encrypt-key-file", keyFile)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The decrypted archive was uploaded, and then removed.
+	c.Check(client.meta.KeyFingerprint, gc.Equals, "")
+	c.Check(decrypted, gc.Not(gc.Equals), filename)
+	_, err = os.Stat(decrypted)
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *restoreSuite) TestRestoreEncryptedFileNoKey(c *gc.C) {
+	filename := writeEncryptedArchive(c, bytes.Repeat([]byte{0x42}, 32))
+	s.command = backups.NewRestoreCommandForTest(s.store, &mockRestoreAPI{}, nil, nil, nil)
+	_, err := testing.RunCommand(c, s.command, "restore", "--file", filename)
+	c.Assert(err, gc.ErrorMatches, `backup archive ".*" is encrypted; use --encrypt-key-file to restore it`)
 }
 
 // TODO(wallyworld) - add more api related unit tests
 type mockRestoreAPI struct {
 	backups.RestoreAPI
+	args params.RestoreArgs
+	meta *params.BackupsMetadataResult
 }
 
 func (*mockRestoreAPI) Close() error {
 	return nil
 }
 
-func (*mockRestoreAPI) RestoreReader(io.ReadSeeker, *params.BackupsMetadataResult, apibackups.ClientConnection) error {
+func (m *mockRestoreAPI) RestoreReader(_ io.ReadSeeker, meta *params.BackupsMetadataResult, _ apibackups.ClientConnection) error {
+	m.meta = meta
+	return nil
+}
+
+func (m *mockRestoreAPI) RestoreWithArgs(args params.RestoreArgs, _ apibackups.ClientConnection) error {
+	m.args = args
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"regexp"
 	"time"
 
 	"github.com/juju/ansiterm"
@@ -17,6 +18,7 @@ import (
 	"github.com/mattn/go-isatty"
 
 	"github.com/juju/juju/api/common"
+	"github.com/juju/juju/api/controller"
 	"github.com/juju/juju/cmd/modelcmd"
 )
 
@@ -48,13 +50,37 @@ The '--include-module' and '--exclude-module' options filter by (dotted)
 logging module name. The module name can be truncated such that all loggers
 with the prefix will match.
 
+The '--grep' and '--exclude-grep' options filter by message, using regular
+expressions which may match anywhere within the message.
+
+The '--include-location' and '--exclude-location' options filter by source
+location, in the form <filename>:<line-no>. The line number may be omitted to
+match every line in the file, and '*' matches any sequence of characters.
+
+The '--until' option only shows messages logged before the given time, in
+RFC3339 format. If that time is in the future, new messages are shown as they
+are logged until the time has passed, and then debug-log exits.
+
+All of the filtering is done by the controller, so only matching messages
+are sent to the client.
+
+The '--all-models' option shows the messages of every model in the
+controller, merged in time order, with each line prefixed by the name of the
+model it was logged in. The '--include-model' option restricts this to the
+given models, by name or UUID. Only controller superusers may use these
+options.
+
 The filtering options combine as follows:
 * All --include options are logically ORed together.
 * All --exclude options are logically ORed together.
 * All --include-module options are logically ORed together.
 * All --exclude-module options are logically ORed together.
-* The combined --include, --exclude, --include-module and --exclude-module
-  selections are logically ANDed to form the complete filter.
+* All --grep options are logically ORed together.
+* All --exclude-grep options are logically ORed together.
+* All --include-location options are logically ORed together.
+* All --exclude-location options are logically ORed together.
+* The combined selections of each kind of option are logically ANDed to form
+  the complete filter.
 
 Examples:
 
@@ -93,6 +119,18 @@ new WARNING and ERROR messages as they are logged:
 
     juju debug-log --replay --level WARNING
 
+Show all messages mentioning hooks, other than those from the uniter's
+operation executor, that were logged before 9am UTC on the 1st of March 2017:
+
+    juju debug-log --replay --grep "hook" \
+        --exclude-location "executor.go" \
+        --until 2017-03-01T09:00:00Z
+
+Show all ERROR messages from the "default" and "staging" models and then exit:
+
+    juju debug-log --all-models --include-model default \
+        --include-model staging --level ERROR --replay --no-tail
+
 See also: 
     status
     ssh`
@@ -116,8 +154,10 @@ func newDebugLogCommandTZ(tz *time.Location) cmd.Command {
 type debugLogCommand struct {
 	modelcmd.ModelCommandBase
 
-	level  string
-	params common.DebugLogParams
+	level     string
+	until     string
+	allModels bool
+	params    common.DebugLogParams
 
 	utc      bool
 	location bool
@@ -140,6 +180,13 @@ func (c *debugLogCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeEntity), "exclude", "Do not show log messages for these entities")
 	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeModule), "include-module", "Only show log messages for these logging modules")
 	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeModule), "exclude-module", "Do not show log messages for these logging modules")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeMessage), "grep", "Only show log messages matching these regular expressions")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeMessage), "exclude-grep", "Do not show log messages matching these regular expressions")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeLocation), "include-location", "Only show log messages from these source locations")
+	f.Var(cmd.NewAppendStringsValue(&c.params.ExcludeLocation), "exclude-location", "Do not show log messages from these source locations")
+	f.StringVar(&c.until, "until", "", "Only show log messages logged before this time (RFC3339), and then exit")
+	f.BoolVar(&c.allModels, "all-models", false, "Show log messages from all models in the controller")
+	f.Var(cmd.NewAppendStringsValue(&c.params.IncludeModel), "include-model", "Only show log messages from these models (with --all-models)")
 
 	f.StringVar(&c.level, "l", "", "Log level to show, one of [TRACE, DEBUG, INFO, WARNING, ERROR]")
 	f.StringVar(&c.level, "level", "", "")
@@ -168,9 +215,29 @@ func (c *debugLogCommand) Init(args []string) error {
 		}
 		c.params.Level = level
 	}
+	for _, exprs := range [][]string{c.params.IncludeMessage, c.params.ExcludeMessage} {
+		for _, expr := range exprs {
+			if _, err := regexp.Compile(expr); err != nil {
+				return errors.Errorf("invalid regular expression %q: %v", expr, err)
+			}
+		}
+	}
+	if c.until != "" {
+		until, err := time.Parse(time.RFC3339, c.until)
+		if err != nil {
+			return errors.Errorf("--until value %q is not a valid time in RFC3339 format", c.until)
+		}
+		c.params.EndTime = until
+	}
 	if c.tail && c.notail {
 		return errors.NotValidf("setting --tail and --no-tail")
 	}
+	if c.tail && c.until != "" {
+		return errors.NotValidf("setting --tail and --until")
+	}
+	if len(c.params.IncludeModel) > 0 && !c.allModels {
+		return errors.NotValidf("setting --include-model without --all-models")
+	}
 	if c.utc {
 		c.tz = time.UTC
 	}
@@ -191,6 +258,13 @@ type DebugLogAPI interface {
 }
 
 var getDebugLogAPI = func(c *debugLogCommand) (DebugLogAPI, error) {
+	if c.allModels {
+		root, err := c.NewControllerAPIRoot()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return controller.NewClient(root), nil
+	}
 	return c.NewAPIClient()
 }
 
@@ -252,6 +326,9 @@ var SeverityColor = map[string]*ansiterm.Context{
 
 func (c *debugLogCommand) writeLogRecord(w *ansiterm.Writer, r common.LogMessage) {
 	ts := r.Timestamp.In(c.tz).Format(c.format)
+	if c.allModels {
+		fmt.Fprintf(w, "%s ", r.ModelName)
+	}
 	fmt.Fprintf(w, "%s: %s ", r.Entity, ts)
 	SeverityColor[r.Severity].Fprintf(w, r.Severity)
 	fmt.Fprintf(w, " %s ", r.Module)
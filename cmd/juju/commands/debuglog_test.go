@@ -86,6 +86,44 @@ func (s *DebugLogSuite) TestArgParsing(c *gc.C) {
 				Backlog: 10,
 				Limit:   100,
 			},
+		}, {
+			args: []string{"--grep", "hook", "--grep", "^relation", "--exclude-grep", "failed$"},
+			expected: common.DebugLogParams{
+				IncludeMessage: []string{"hook", "^relation"},
+				ExcludeMessage: []string{"failed$"},
+				Backlog:        10,
+			},
+		}, {
+			args:     []string{"--grep", "foo("},
+			errMatch: `invalid regular expression "foo\(": .*`,
+		}, {
+			args: []string{"--include-location", "uniter.go", "--exclude-location", "op.go:10"},
+			expected: common.DebugLogParams{
+				IncludeLocation: []string{"uniter.go"},
+				ExcludeLocation: []string{"op.go:10"},
+				Backlog:         10,
+			},
+		}, {
+			args: []string{"--until", "2017-03-01T09:00:00Z"},
+			expected: common.DebugLogParams{
+				Backlog: 10,
+				EndTime: time.Date(2017, 3, 1, 9, 0, 0, 0, time.UTC),
+			},
+		}, {
+			args:     []string{"--until", "yesterday"},
+			errMatch: `--until value "yesterday" is not a valid time in RFC3339 format`,
+		}, {
+			args:     []string{"--until", "2017-03-01T09:00:00Z", "--tail"},
+			errMatch: `setting --tail and --until not valid`,
+		}, {
+			args: []string{"--all-models", "--include-model", "default", "--include-model", "staging"},
+			expected: common.DebugLogParams{
+				IncludeModel: []string{"default", "staging"},
+				Backlog:      10,
+			},
+		}, {
+			args:     []string{"--include-model", "default"},
+			errMatch: `setting --include-model without --all-models not valid`,
 		},
 	} {
 		c.Logf("test %v", i)
@@ -135,6 +173,7 @@ func (s *DebugLogSuite) TestLogOutput(c *gc.C) {
 				Module:    "test.module",
 				Location:  "somefile.go:123",
 				Message:   "this is the log output",
+				ModelName: "default",
 			},
 		}}, nil
 	})
@@ -163,6 +202,9 @@ func (s *DebugLogSuite) TestLogOutput(c *gc.C) {
 	checkOutput(
 		"--location",
 		"machine-0: 14:15:23 INFO test.module somefile.go:123 this is the log output\n")
+	checkOutput(
+		"--all-models",
+		"default machine-0: 14:15:23 INFO test.module this is the log output\n")
 }
 
 type fakeDebugLogAPI struct {
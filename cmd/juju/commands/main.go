@@ -332,6 +332,7 @@ func registerCommands(r commandRegistry, ctx *cmd.Context) {
 	r.Register(model.NewGrantCommand())
 	r.Register(model.NewRevokeCommand())
 	r.Register(model.NewShowCommand())
+	r.Register(model.NewExportBundleCommand())
 
 	r.Register(newMigrateCommand())
 	if featureflag.Enabled(feature.DeveloperMode) {
@@ -342,8 +343,12 @@ func registerCommands(r commandRegistry, ctx *cmd.Context) {
 	// Manage and control actions
 	r.Register(action.NewStatusCommand())
 	r.Register(action.NewRunCommand())
+	r.Register(action.NewCancelCommand())
 	r.Register(action.NewShowOutputCommand())
 	r.Register(action.NewListCommand())
+	r.Register(action.NewScheduleCommand())
+	r.Register(action.NewListSchedulesCommand())
+	r.Register(action.NewRemoveScheduleCommand())
 
 	// Manage controller availability
 	r.Register(newEnableHACommand())
@@ -352,6 +357,7 @@ func registerCommands(r commandRegistry, ctx *cmd.Context) {
 	r.Register(application.NewAddUnitCommand())
 	r.Register(application.NewConfigCommand())
 	r.Register(application.NewDefaultDeployCommand())
+	r.Register(application.NewDiffBundleCommand())
 	r.Register(application.NewExposeCommand())
 	r.Register(application.NewUnexposeCommand())
 	r.Register(application.NewServiceGetConstraintsCommand())
@@ -364,9 +370,17 @@ func registerCommands(r commandRegistry, ctx *cmd.Context) {
 
 	// Manage storage
 	r.Register(storage.NewAddCommand())
+	r.Register(storage.NewAttachStorageCommand())
+	r.Register(storage.NewCreateSnapshotCommand())
+	r.Register(storage.NewDetachStorageCommand())
 	r.Register(storage.NewListCommand())
+	r.Register(storage.NewListSnapshotsCommand())
 	r.Register(storage.NewPoolCreateCommand())
 	r.Register(storage.NewPoolListCommand())
+	r.Register(storage.NewPoolRemoveCommand())
+	r.Register(storage.NewPoolUpdateCommand())
+	r.Register(storage.NewRemoveStorageCommand())
+	r.Register(storage.NewResizeStorageCommand())
 	r.Register(storage.NewShowCommand())
 
 	// Manage spaces
@@ -397,6 +411,7 @@ func registerCommands(r commandRegistry, ctx *cmd.Context) {
 	r.Register(controller.NewEnableDestroyControllerCommand())
 	r.Register(controller.NewShowControllerCommand())
 	r.Register(controller.NewGetConfigCommand())
+	r.Register(controller.NewAuditLogCommand())
 
 	// Debug Metrics
 	r.Register(metricsdebug.New())
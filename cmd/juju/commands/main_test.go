@@ -402,11 +402,14 @@ var commandNames = []string{
 	"agree",
 	"agreements",
 	"allocate",
+	"attach-storage",
+	"audit-log",
 	"autoload-credentials",
 	"backups",
 	"bootstrap",
 	"budgets",
 	"cached-images",
+	"cancel-action",
 	"change-user-password",
 	"charm",
 	"clouds",
@@ -416,6 +419,7 @@ var commandNames = []string{
 	"create-backup",
 	"create-budget",
 	"create-storage-pool",
+	"create-storage-snapshot",
 	"credentials",
 	"controller-config",
 	"debug-hooks",
@@ -424,6 +428,8 @@ var commandNames = []string{
 	"deploy",
 	"destroy-controller",
 	"destroy-model",
+	"detach-storage",
+	"diff-bundle",
 	"disable-command",
 	"disable-user",
 	"disabled-commands",
@@ -432,6 +438,7 @@ var commandNames = []string{
 	"enable-command",
 	"enable-destroy-controller",
 	"enable-user",
+	"export-bundle",
 	"expose",
 	"get-constraints",
 	"get-model-constraints",
@@ -454,10 +461,12 @@ var commandNames = []string{
 	"list-models",
 	"list-plans",
 	"list-regions",
+	"list-schedules",
 	"list-ssh-keys",
 	"list-spaces",
 	"list-storage",
 	"list-storage-pools",
+	"list-storage-snapshots",
 	"list-subnets",
 	"list-users",
 	"login",
@@ -479,8 +488,12 @@ var commandNames = []string{
 	"remove-credential",
 	"remove-machine",
 	"remove-relation",
+	"remove-schedule",
 	"remove-ssh-key",
+	"remove-storage",
+	"remove-storage-pool",
 	"remove-unit",
+	"resize-storage",
 	"resolved",
 	"restore-backup",
 	"retry-provisioning",
@@ -488,6 +501,8 @@ var commandNames = []string{
 	"run",
 	"run-action",
 	"scp",
+	"schedule-action",
+	"schedules",
 	"set-budget",
 	"set-constraints",
 	"set-default-credential",
@@ -513,6 +528,7 @@ var commandNames = []string{
 	"status",
 	"storage",
 	"storage-pools",
+	"storage-snapshots",
 	"subnets",
 	"switch",
 	"sync-tools",
@@ -522,6 +538,7 @@ var commandNames = []string{
 	"unregister",
 	"update-clouds",
 	"update-credential",
+	"update-storage-pool",
 	"upgrade-charm",
 	"upgrade-gui",
 	"upgrade-juju",
@@ -4,10 +4,13 @@
 package commands
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/dustin/go-humanize"
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"gopkg.in/macaroon-bakery.v1/httpbakery"
 	"gopkg.in/macaroon.v1"
 
@@ -15,6 +18,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/controller"
 	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/jujuclient"
 )
 
@@ -31,11 +35,13 @@ type migrateCommand struct {
 	api              migrateAPI
 	model            string
 	targetController string
+	dryRun           bool
 }
 
 type migrateAPI interface {
 	AllModels() ([]base.UserModel, error)
 	InitiateMigration(spec controller.MigrationSpec) (string, error)
+	MigrationPrecheckReport(spec controller.MigrationSpec) (coremigration.PrecheckReport, error)
 }
 
 const migrateDoc = `
@@ -61,6 +67,16 @@ This command only starts a model migration - it does not wait for its
 completion. The progress of a migration can be tracked using the
 "status" command and by consulting the logs.
 
+With --dry-run, no migration is started. Instead every check made on
+the model, the source controller and the target controller is run,
+and all the problems that would prevent the migration are reported,
+along with the estimated size of the exported model. Nothing is
+changed on either controller.
+
+Examples:
+    juju migrate mymodel other-controller
+    juju migrate --dry-run mymodel other-controller
+
 See also:
     login
     controllers
@@ -77,6 +93,12 @@ func (c *migrateCommand) Info() *cmd.Info {
 	}
 }
 
+// SetFlags implements cmd.Command.
+func (c *migrateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.BoolVar(&c.dryRun, "dry-run", false, "Report all problems that would prevent the migration, without starting it")
+}
+
 // Init implements cmd.Command.
 func (c *migrateCommand) Init(args []string) error {
 	if len(args) < 1 {
@@ -140,6 +162,13 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.dryRun {
+		report, err := api.MigrationPrecheckReport(*spec)
+		if err != nil {
+			return err
+		}
+		return c.writeReport(ctx, report)
+	}
 	id, err := api.InitiateMigration(*spec)
 	if err != nil {
 		return err
@@ -148,6 +177,28 @@ func (c *migrateCommand) Run(ctx *cmd.Context) error {
 	return nil
 }
 
+// writeReport writes the precheck report of a dry run, returning an
+// error if the migration would fail.
+func (c *migrateCommand) writeReport(ctx *cmd.Context, report coremigration.PrecheckReport) error {
+	writeProblems := func(heading string, problems []coremigration.PrecheckProblem) {
+		fmt.Fprintln(ctx.Stdout, heading)
+		if len(problems) == 0 {
+			fmt.Fprintln(ctx.Stdout, "  - ok")
+		}
+		for _, problem := range problems {
+			fmt.Fprintf(ctx.Stdout, "  - %s\n", problem.Message)
+		}
+	}
+	writeProblems("Source controller checks:", report.Source)
+	writeProblems("Target controller checks:", report.Target)
+	fmt.Fprintf(ctx.Stdout, "Estimated model export size: %s\n", humanize.Bytes(uint64(report.ExportSize)))
+	if !report.OK() {
+		return errors.Errorf("model %q cannot be migrated to controller %q", c.model, c.targetController)
+	}
+	ctx.Infof("Model %q can be migrated to controller %q", c.model, c.targetController)
+	return nil
+}
+
 func (c *migrateCommand) findModelUUID(ctx *cmd.Context, api migrateAPI) (string, error) {
 	models, err := api.AllModels()
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/controller"
 	"github.com/juju/juju/cmd/modelcmd"
+	coremigration "github.com/juju/juju/core/migration"
 	"github.com/juju/juju/jujuclient"
 	"github.com/juju/juju/jujuclient/jujuclienttesting"
 	"github.com/juju/juju/testing"
@@ -210,6 +211,61 @@ func (s *MigrateSuite) TestControllerDoesntExist(c *gc.C) {
 	c.Check(s.api.specSeen, gc.IsNil) // API shouldn't have been called
 }
 
+func (s *MigrateSuite) TestDryRun(c *gc.C) {
+	s.api.report = coremigration.PrecheckReport{
+		ExportSize: 2048,
+	}
+	ctx, err := s.makeAndRun(c, "--dry-run", "model", "target")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(testing.Stdout(ctx), gc.Equals, `
+Source controller checks:
+  - ok
+Target controller checks:
+  - ok
+Estimated model export size: 2.0 kB
+`[1:])
+	c.Check(testing.Stderr(ctx), gc.Equals, "Model \"model\" can be migrated to controller \"target\"\n")
+	c.Check(s.api.specSeen, gc.IsNil) // No migration should be started.
+	c.Check(s.api.dryRunSpec, jc.DeepEquals, &controller.MigrationSpec{
+		ModelUUID:            modelUUID,
+		TargetControllerUUID: targetControllerUUID,
+		TargetAddrs:          []string{"1.2.3.4:5"},
+		TargetCACert:         "cert",
+		TargetUser:           "target",
+		TargetPassword:       "secret",
+	})
+}
+
+func (s *MigrateSuite) TestDryRunProblems(c *gc.C) {
+	s.api.report = coremigration.PrecheckReport{
+		Source: []coremigration.PrecheckProblem{{
+			Entity:  "machine 0",
+			Message: "machine 0 is dying",
+		}, {
+			Entity:  "unit foo/0",
+			Message: "unit foo/0 not idle or executing (lost)",
+		}},
+		Target: []coremigration.PrecheckProblem{{
+			Entity:  "user owner",
+			Message: "model owner owner does not exist in target controller",
+		}},
+		ExportSize: 1500000,
+	}
+	ctx, err := s.makeAndRun(c, "--dry-run", "model", "target")
+	c.Assert(err, gc.ErrorMatches, `model "model" cannot be migrated to controller "target"`)
+
+	c.Check(testing.Stdout(ctx), gc.Equals, `
+Source controller checks:
+  - machine 0 is dying
+  - unit foo/0 not idle or executing (lost)
+Target controller checks:
+  - model owner owner does not exist in target controller
+Estimated model export size: 1.5 MB
+`[1:])
+	c.Check(s.api.specSeen, gc.IsNil) // No migration should be started.
+}
+
 func (s *MigrateSuite) makeAndRun(c *gc.C, args ...string) (*cmd.Context, error) {
 	return s.run(c, s.makeCommand(), args...)
 }
@@ -230,8 +286,10 @@ func (s *MigrateSuite) run(c *gc.C, cmd *migrateCommand, args ...string) (*cmd.C
 }
 
 type fakeMigrateAPI struct {
-	specSeen *controller.MigrationSpec
-	models   []base.UserModel
+	specSeen   *controller.MigrationSpec
+	dryRunSpec *controller.MigrationSpec
+	models     []base.UserModel
+	report     coremigration.PrecheckReport
 }
 
 func (a *fakeMigrateAPI) InitiateMigration(spec controller.MigrationSpec) (string, error) {
@@ -239,6 +297,11 @@ func (a *fakeMigrateAPI) InitiateMigration(spec controller.MigrationSpec) (strin
 	return "uuid:0", nil
 }
 
+func (a *fakeMigrateAPI) MigrationPrecheckReport(spec controller.MigrationSpec) (coremigration.PrecheckReport, error) {
+	a.dryRunSpec = &spec
+	return a.report, nil
+}
+
 func (a *fakeMigrateAPI) AllModels() ([]base.UserModel, error) {
 	return a.models, nil
 }
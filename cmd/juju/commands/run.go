@@ -261,7 +261,7 @@ func (c *runCommand) Run(ctx *cmd.Context) error {
 		for i, result := range actionResults.Results {
 			if result.Error == nil {
 				switch result.Status {
-				case params.ActionRunning, params.ActionPending:
+				case params.ActionRunning, params.ActionPending, params.ActionAborting:
 					newActionsToQuery = append(newActionsToQuery, actionsToQuery[i])
 					continue
 				}
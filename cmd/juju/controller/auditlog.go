@@ -0,0 +1,198 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"io"
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/api/auditlog"
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/cmd/output"
+)
+
+// NewAuditLogCommand returns a command which queries the controller's
+// audit log.
+func NewAuditLogCommand() cmd.Command {
+	return modelcmd.WrapController(&auditLogCommand{})
+}
+
+// auditLogCommand queries the controller's audit log.
+type auditLogCommand struct {
+	modelcmd.ControllerCommandBase
+	api AuditLogAPI
+	out cmd.Output
+
+	modelName string
+	user      string
+	operation string
+	after     string
+	before    string
+	limit     int
+
+	filter audit.Filter
+}
+
+// AuditLogAPI defines the API methods used by the audit-log command.
+type AuditLogAPI interface {
+	Close() error
+	Query(audit.Filter) ([]audit.AuditEntry, error)
+}
+
+const auditLogHelpDoc = `
+Displays the entries recorded in the controller's audit log, oldest
+first. Auditing must be enabled on the controller (auditing-enabled)
+and the "mongo" audit sink must be configured (audit-log-sinks) for
+entries to be available.
+
+Entries can be filtered by model, user, operation and time range.
+Times may be given in RFC3339 format (2016-06-01T10:00:00Z) or as a
+date (2016-06-01), in which case midnight UTC is assumed. Only
+controller administrators may read the audit log.
+
+Examples:
+
+    juju audit-log
+    juju audit-log --model mymodel --user bob
+    juju audit-log --operation "Application:v3 - Deploy" --after 2016-06-01
+    juju audit-log --after 2016-06-01 --before 2016-06-02 --format json
+
+See also:
+    controller-config
+`
+
+// Info implements Command.Info.
+func (c *auditLogCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "audit-log",
+		Purpose: "Displays the controller's audit log.",
+		Doc:     auditLogHelpDoc,
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *auditLogCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.modelName, "model", "", "Only show entries for the named model")
+	f.StringVar(&c.user, "user", "", "Only show entries for the named user")
+	f.StringVar(&c.operation, "operation", "", "Only show entries for the given operation")
+	f.StringVar(&c.after, "after", "", "Only show entries recorded at or after this time")
+	f.StringVar(&c.before, "before", "", "Only show entries recorded before this time")
+	f.IntVar(&c.limit, "n", 0, "Show at most this many entries")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatAuditLogTabular,
+	})
+}
+
+// Init implements Command.Init.
+func (c *auditLogCommand) Init(args []string) error {
+	if err := cmd.CheckEmpty(args); err != nil {
+		return errors.Trace(err)
+	}
+	if c.user != "" {
+		if !names.IsValidUser(c.user) {
+			return errors.NotValidf("user name %q", c.user)
+		}
+		c.filter.OriginName = names.NewUserTag(c.user).String()
+	}
+	if c.limit < 0 {
+		return errors.Errorf("-n must be a positive number")
+	}
+	c.filter.Operation = c.operation
+	c.filter.Limit = c.limit
+	var err error
+	if c.filter.After, err = parseAuditTime(c.after); err != nil {
+		return errors.Annotate(err, "invalid --after value")
+	}
+	if c.filter.Before, err = parseAuditTime(c.before); err != nil {
+		return errors.Annotate(err, "invalid --before value")
+	}
+	return errors.Trace(c.filter.Validate())
+}
+
+func parseAuditTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.Errorf("expected RFC3339 time or YYYY-MM-DD date, got %q", value)
+	}
+	return t.UTC(), nil
+}
+
+func (c *auditLogCommand) getAPI() (AuditLogAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return auditlog.NewClient(root), nil
+}
+
+// Run implements Command.Run.
+func (c *auditLogCommand) Run(ctx *cmd.Context) error {
+	filter := c.filter
+	if c.modelName != "" {
+		uuids, err := c.ModelUUIDs([]string{c.modelName})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		filter.ModelUUID = uuids[0]
+	}
+
+	client, err := c.getAPI()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	entries, err := client.Query(filter)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(entries) == 0 && c.out.Name() == "tabular" {
+		ctx.Infof("No audit log entries found.")
+		return nil
+	}
+	return c.out.Write(ctx, entries)
+}
+
+func formatAuditLogTabular(writer io.Writer, value interface{}) error {
+	entries, ok := value.([]audit.AuditEntry)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", entries, value)
+	}
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{tw}
+	w.Println("Time", "Model", "User", "Address", "Operation")
+	for _, entry := range entries {
+		user := entry.OriginName
+		if tag, err := names.ParseUserTag(user); err == nil {
+			user = tag.Id()
+		}
+		w.Println(
+			entry.Timestamp.UTC().Format(time.RFC3339),
+			entry.ModelUUID,
+			user,
+			entry.RemoteAddress,
+			entry.Operation,
+		)
+	}
+	tw.Flush()
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/audit"
+	"github.com/juju/juju/cmd/juju/controller"
+	"github.com/juju/juju/testing"
+)
+
+type AuditLogSuite struct {
+	baseControllerSuite
+	api *fakeAuditLogAPI
+}
+
+var _ = gc.Suite(&AuditLogSuite{})
+
+func (s *AuditLogSuite) SetUpTest(c *gc.C) {
+	s.baseControllerSuite.SetUpTest(c)
+	s.createTestClientStore(c)
+	s.api = &fakeAuditLogAPI{
+		entries: []audit.AuditEntry{{
+			JujuServerVersion: version.MustParse("2.1.0"),
+			ModelUUID:         "def",
+			Timestamp:         time.Date(2016, time.June, 1, 10, 0, 0, 0, time.UTC),
+			RemoteAddress:     "10.0.0.1:45678",
+			OriginType:        "API request",
+			OriginName:        "user-bob",
+			Operation:         "Application:v3 - Deploy",
+		}},
+	}
+}
+
+func (s *AuditLogSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	command := controller.NewAuditLogCommandForTest(s.api, s.store)
+	return testing.RunCommand(c, command, args...)
+}
+
+func (s *AuditLogSuite) TestInitErrors(c *gc.C) {
+	for i, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"foo"},
+		err:  `unrecognized args: \["foo"\]`,
+	}, {
+		args: []string{"--user", "bob@@"},
+		err:  `user name "bob@@" not valid`,
+	}, {
+		args: []string{"-n", "-1"},
+		err:  `-n must be a positive number`,
+	}, {
+		args: []string{"--after", "yesterday"},
+		err:  `invalid --after value: expected RFC3339 time or YYYY-MM-DD date, got "yesterday"`,
+	}, {
+		args: []string{"--after", "2016-06-02", "--before", "2016-06-01"},
+		err:  `Before not after After not valid`,
+	}} {
+		c.Logf("test %d: %v", i, test.args)
+		err := testing.InitCommand(controller.NewAuditLogCommandForTest(s.api, s.store), test.args)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *AuditLogSuite) TestFilter(c *gc.C) {
+	_, err := s.run(c,
+		"--model", "my-model",
+		"--user", "bob",
+		"--operation", "Application:v3 - Deploy",
+		"--after", "2016-06-01",
+		"--before", "2016-06-01T12:00:00Z",
+		"-n", "10",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.api.filter, jc.DeepEquals, audit.Filter{
+		ModelUUID:  "def",
+		OriginName: "user-bob",
+		Operation:  "Application:v3 - Deploy",
+		After:      time.Date(2016, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Before:     time.Date(2016, time.June, 1, 12, 0, 0, 0, time.UTC),
+		Limit:      10,
+	})
+	c.Assert(s.api.closed, jc.IsTrue)
+}
+
+func (s *AuditLogSuite) TestTabular(c *gc.C) {
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, ""+
+		"Time                  Model  User  Address         Operation\n"+
+		"2016-06-01T10:00:00Z  def    bob   10.0.0.1:45678  Application:v3 - Deploy\n")
+}
+
+func (s *AuditLogSuite) TestJSON(c *gc.C) {
+	ctx, err := s.run(c, "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, `[{"juju-server-version":"2.1.0","model-uuid":"def",`+
+		`"timestamp":"2016-06-01T10:00:00Z","remote-address":"10.0.0.1:45678","origin-type":"API request",`+
+		`"origin-name":"user-bob","operation":"Application:v3 - Deploy"}]`+"\n")
+}
+
+func (s *AuditLogSuite) TestNoEntries(c *gc.C) {
+	s.api.entries = nil
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "No audit log entries found.\n")
+}
+
+func (s *AuditLogSuite) TestAPIError(c *gc.C) {
+	s.api.err = errors.New("permission denied")
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
+type fakeAuditLogAPI struct {
+	entries []audit.AuditEntry
+	filter  audit.Filter
+	err     error
+	closed  bool
+}
+
+func (f *fakeAuditLogAPI) Close() error {
+	f.closed = true
+	return nil
+}
+
+func (f *fakeAuditLogAPI) Query(filter audit.Filter) ([]audit.AuditEntry, error) {
+	f.filter = filter
+	return f.entries, f.err
+}
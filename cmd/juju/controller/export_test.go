@@ -167,3 +167,11 @@ func FmtModelStatus(data ModelData) string {
 func NewData(api destroyControllerAPI, ctrUUID string) (ctrData, []modelData, error) {
 	return newData(api, ctrUUID)
 }
+
+// NewAuditLogCommandForTest returns an audit-log command with the
+// api and client store provided.
+func NewAuditLogCommandForTest(api AuditLogAPI, store jujuclient.ClientStore) cmd.Command {
+	c := &auditLogCommand{api: api}
+	c.SetClientStore(store)
+	return modelcmd.WrapController(c)
+}
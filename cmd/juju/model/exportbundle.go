@@ -0,0 +1,112 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/bundle"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewExportBundleCommand returns a fully constructed export-bundle command.
+func NewExportBundleCommand() cmd.Command {
+	return modelcmd.Wrap(&exportBundleCommand{})
+}
+
+// exportBundleCommand writes a bundle that deploys an equivalent of
+// the current model.
+type exportBundleCommand struct {
+	modelcmd.ModelCommandBase
+	api      ExportBundleAPI
+	filename string
+}
+
+const exportBundleHelpDoc = `
+Exports the applications, machines and relations of the current model
+as a bundle, which can be deployed with "juju deploy" to create an
+equivalent model.
+
+The bundle records the charm of each application, the charm store
+channel it was deployed from when that is not the stable channel, the
+configuration that differs from the charm defaults, constraints,
+storage directives, the placement of units on machines, relations,
+exposure, endpoint bindings and annotations.
+
+The bundle is written to stdout unless --filename is given.
+
+Examples:
+
+    juju export-bundle
+    juju export-bundle --filename mymodel.yaml
+
+See also:
+    deploy
+`
+
+// ExportBundleAPI specifies the used function calls of the Bundle facade.
+type ExportBundleAPI interface {
+	Close() error
+	ExportBundle() (string, error)
+}
+
+// Info implements Command.
+func (c *exportBundleCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "export-bundle",
+		Purpose: "Exports the current model as a bundle.",
+		Doc:     exportBundleHelpDoc,
+	}
+}
+
+// SetFlags implements Command.
+func (c *exportBundleCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.filename, "filename", "", "Write the bundle to the named file")
+}
+
+// Init implements Command.
+func (c *exportBundleCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+func (c *exportBundleCommand) getAPI() (ExportBundleAPI, error) {
+	if c.api != nil {
+		return c.api, nil
+	}
+	root, err := c.NewAPIRoot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return bundle.NewClient(root), nil
+}
+
+// Run implements Command.
+func (c *exportBundleCommand) Run(ctx *cmd.Context) error {
+	client, err := c.getAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	data, err := client.ExportBundle()
+	if err != nil {
+		return err
+	}
+	if c.filename == "" {
+		_, err := fmt.Fprint(ctx.Stdout, data)
+		return err
+	}
+	path := ctx.AbsPath(c.filename)
+	if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+		return errors.Annotate(err, "cannot write bundle")
+	}
+	ctx.Infof("Bundle successfully exported to %s", path)
+	return nil
+}
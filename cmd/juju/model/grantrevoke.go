@@ -6,6 +6,8 @@ package model
 import (
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/cmd/juju/block"
 	"github.com/juju/juju/cmd/modelcmd"
@@ -25,12 +27,26 @@ Valid access levels for models are:
     read
     write
     admin
+    run-actions
+    debug
+    deploy
+
+The run-actions, debug and deploy access levels give read access plus
+a single additional ability, without the rest of write access:
+    run-actions: run actions and commands (` + "`juju run-action`, `juju run`" + `)
+    debug:       connect to machines and units (` + "`juju ssh`, `juju debug-log`" + `)
+    deploy:      deploy, add units to and upgrade applications
 
 Valid access levels for controllers are:
     login
     add-model
     superuser
 
+With --app, write or admin access is granted on a single application in
+the given model, in addition to the user's access to the model. Write
+access to an application allows the user to configure, upgrade and add
+units to it, and to run actions on its units.
+
 Examples:
 Grant user 'joe' 'read' access to model 'mymodel':
 
@@ -40,6 +56,14 @@ Grant user 'jim' 'write' access to model 'mymodel':
 
     juju grant jim write mymodel
 
+Grant user 'ann' 'run-actions' access to model 'mymodel':
+
+    juju grant ann run-actions mymodel
+
+Grant user 'kim' 'write' access to application 'mysql' in model 'mymodel':
+
+    juju grant kim write mymodel --app mysql
+
 Grant user 'sam' 'read' access to models 'model1' and 'model2':
 
     juju grant sam read model1 model2
@@ -60,7 +84,12 @@ By default, the controller is the current controller.
 
 Revoking write access, from a user who has that permission, will leave
 that user with read access. Revoking read access, however, also revokes
-write access.
+write access. Likewise, revoking run-actions, debug or deploy access
+leaves the user with read access.
+
+With --app, access is revoked on a single application in the given
+model. Revoking write access from the application removes all access to
+it, while revoking admin access leaves write access.
 
 Examples:
 Revoke 'read' (and 'write') access from user 'joe' for model 'mymodel':
@@ -71,6 +100,10 @@ Revoke 'write' access from user 'sam' for models 'model1' and 'model2':
 
     juju revoke sam write model1 model2
 
+Revoke 'write' access from user 'kim' for application 'mysql' in model 'mymodel':
+
+    juju revoke kim write mymodel --app mysql
+
 Revoke 'add-model' access from user 'maria' to the controller:
 
     juju revoke maria add-model
@@ -81,9 +114,16 @@ See also:
 type accessCommand struct {
 	modelcmd.ControllerCommandBase
 
-	User       string
-	ModelNames []string
-	Access     string
+	User        string
+	ModelNames  []string
+	Access      string
+	Application string
+}
+
+// SetFlags implements cmd.Command.
+func (c *accessCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ControllerCommandBase.SetFlags(f)
+	f.StringVar(&c.Application, "app", "", "Change access to the named application in the model")
 }
 
 // Init implements cmd.Command.
@@ -103,6 +143,15 @@ func (c *accessCommand) Init(args []string) error {
 	if c.Access == "addmodel" {
 		c.Access = "add-model"
 	}
+	if c.Application != "" {
+		if !names.IsValidApplication(c.Application) {
+			return errors.NotValidf("application name %q", c.Application)
+		}
+		if len(c.ModelNames) != 1 {
+			return errors.New("exactly one model must be specified with --app")
+		}
+		return permission.ValidateApplicationAccess(permission.Access(c.Access))
+	}
 	if len(c.ModelNames) > 0 {
 		if err := permission.ValidateControllerAccess(permission.Access(c.Access)); err == nil {
 			return errors.Errorf("You have specified a controller access permission %q.\n"+
@@ -134,7 +183,7 @@ type grantCommand struct {
 func (c *grantCommand) Info() *cmd.Info {
 	return &cmd.Info{
 		Name:    "grant",
-		Args:    "<user name> <permission> [<model name> ...]",
+		Args:    "<user name> <permission> [<model name> ...] [--app <application>]",
 		Purpose: usageGrantSummary,
 		Doc:     usageGrantDetails,
 	}
@@ -155,6 +204,7 @@ func (c *grantCommand) getControllerAPI() (GrantControllerAPI, error) {
 type GrantModelAPI interface {
 	Close() error
 	GrantModel(user, access string, modelUUIDs ...string) error
+	GrantApplication(user, access, modelUUID, application string) error
 }
 
 // GrantControllerAPI defines the API functions used by the grant command.
@@ -165,6 +215,9 @@ type GrantControllerAPI interface {
 
 // Run implements cmd.Command.
 func (c *grantCommand) Run(ctx *cmd.Context) error {
+	if c.Application != "" {
+		return c.runForApplication()
+	}
 	if len(c.ModelNames) > 0 {
 		return c.runForModel()
 	}
@@ -195,6 +248,20 @@ func (c *grantCommand) runForModel() error {
 	return block.ProcessBlockedError(client.GrantModel(c.User, c.Access, models...), block.BlockChange)
 }
 
+func (c *grantCommand) runForApplication() error {
+	client, err := c.getModelAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	models, err := c.ModelUUIDs(c.ModelNames)
+	if err != nil {
+		return err
+	}
+	return block.ProcessBlockedError(client.GrantApplication(c.User, c.Access, models[0], c.Application), block.BlockChange)
+}
+
 // NewRevokeCommand returns a new revoke command.
 func NewRevokeCommand() cmd.Command {
 	return modelcmd.WrapController(&revokeCommand{})
@@ -210,7 +277,7 @@ type revokeCommand struct {
 func (c *revokeCommand) Info() *cmd.Info {
 	return &cmd.Info{
 		Name:    "revoke",
-		Args:    "<user> <permission> [<model name> ...]",
+		Args:    "<user> <permission> [<model name> ...] [--app <application>]",
 		Purpose: usageRevokeSummary,
 		Doc:     usageRevokeDetails,
 	}
@@ -231,6 +298,7 @@ func (c *revokeCommand) getControllerAPI() (RevokeControllerAPI, error) {
 type RevokeModelAPI interface {
 	Close() error
 	RevokeModel(user, access string, modelUUIDs ...string) error
+	RevokeApplication(user, access, modelUUID, application string) error
 }
 
 // RevokeControllerAPI defines the API functions used by the revoke command.
@@ -241,6 +309,9 @@ type RevokeControllerAPI interface {
 
 // Run implements cmd.Command.
 func (c *revokeCommand) Run(ctx *cmd.Context) error {
+	if c.Application != "" {
+		return c.runForApplication()
+	}
 	if len(c.ModelNames) > 0 {
 		return c.runForModel()
 	}
@@ -270,3 +341,17 @@ func (c *revokeCommand) runForModel() error {
 	}
 	return block.ProcessBlockedError(client.RevokeModel(c.User, c.Access, models...), block.BlockChange)
 }
+
+func (c *revokeCommand) runForApplication() error {
+	client, err := c.getModelAPI()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	models, err := c.ModelUUIDs(c.ModelNames)
+	if err != nil {
+		return err
+	}
+	return block.ProcessBlockedError(client.RevokeApplication(c.User, c.Access, models[0], c.Application), block.BlockChange)
+}
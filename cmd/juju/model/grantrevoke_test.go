@@ -83,6 +83,34 @@ func (s *grantRevokeSuite) TestAccess(c *gc.C) {
 	c.Assert(s.fake.access, gc.Equals, "write")
 }
 
+func (s *grantRevokeSuite) TestCapabilityAccess(c *gc.C) {
+	for _, access := range []string{"run-actions", "debug", "deploy"} {
+		_, err := s.run(c, "sam", access, "model1")
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(s.fake.access, gc.Equals, access)
+	}
+}
+
+func (s *grantRevokeSuite) TestApplicationAccess(c *gc.C) {
+	_, err := s.run(c, "sam", "write", "model1", "--app", "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.user, gc.Equals, "sam")
+	c.Assert(s.fake.modelUUIDs, jc.DeepEquals, []string{model1ModelUUID})
+	c.Assert(s.fake.application, gc.Equals, "mysql")
+	c.Assert(s.fake.access, gc.Equals, "write")
+}
+
+func (s *grantRevokeSuite) TestApplicationAccessInvalid(c *gc.C) {
+	_, err := s.run(c, "sam", "read", "model1", "--app", "mysql")
+	c.Assert(err, gc.ErrorMatches, `"read" application access not valid`)
+
+	_, err = s.run(c, "sam", "write", "model1", "model2", "--app", "mysql")
+	c.Assert(err, gc.ErrorMatches, "exactly one model must be specified with --app")
+
+	_, err = s.run(c, "sam", "write", "--app", "mysql")
+	c.Assert(err, gc.ErrorMatches, "exactly one model must be specified with --app")
+}
+
 func (s *grantRevokeSuite) TestBlockGrant(c *gc.C) {
 	s.fake.err = common.OperationBlockedError("TestBlockGrant")
 	_, err := s.run(c, "sam", "read", "foo")
@@ -191,10 +219,11 @@ func (s *grantSuite) TestControllerAccessForModel(c *gc.C) {
 }
 
 type fakeGrantRevokeAPI struct {
-	err        error
-	user       string
-	access     string
-	modelUUIDs []string
+	err         error
+	user        string
+	access      string
+	modelUUIDs  []string
+	application string
 }
 
 func (f *fakeGrantRevokeAPI) Close() error { return nil }
@@ -207,6 +236,16 @@ func (f *fakeGrantRevokeAPI) RevokeModel(user, access string, modelUUIDs ...stri
 	return f.fake(user, access, modelUUIDs...)
 }
 
+func (f *fakeGrantRevokeAPI) GrantApplication(user, access, modelUUID, application string) error {
+	f.application = application
+	return f.fake(user, access, modelUUID)
+}
+
+func (f *fakeGrantRevokeAPI) RevokeApplication(user, access, modelUUID, application string) error {
+	f.application = application
+	return f.fake(user, access, modelUUID)
+}
+
 func (f *fakeGrantRevokeAPI) fake(user, access string, modelUUIDs ...string) error {
 	f.user = user
 	f.access = access
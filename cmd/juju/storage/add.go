@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/cmd"
 	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
 	"github.com/juju/utils/set"
 	"gopkg.in/juju/names.v2"
 
@@ -73,6 +74,17 @@ Examples:
       juju add-storage u/0 data=1 
     or
       juju add-storage u/0 data 
+
+    # Add 1 ebs storage instance for "data" storage to unit u/0,
+    # restoring its volume from the snapshot snap-0123abcd:
+
+      juju add-storage --from-snapshot snap-0123abcd u/0 data=ebs
+
+The --from-snapshot option restores a single storage instance from a
+snapshot listed by juju storage-snapshots. The storage pool must use
+the same storage provider as the snapshotted volume. Filesystem storage
+can only be restored if the pool's provider manages the filesystem on
+a volume.
 `
 	addCommandAgs = `
 <unit name> <storage directive> ...
@@ -92,6 +104,16 @@ type addCommand struct {
 	// defined in charm storage metadata.
 	storageCons map[string]storage.Constraints
 	newAPIFunc  func() (StorageAddAPI, error)
+
+	// fromSnapshot is the provider ID of the snapshot from which the
+	// storage instance's volume is to be restored, if any.
+	fromSnapshot string
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *addCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.StringVar(&c.fromSnapshot, "from-snapshot", "", "Restore the storage from the snapshot with this ID")
 }
 
 // Init implements Command.Init.
@@ -107,7 +129,20 @@ func (c *addCommand) Init(args []string) (err error) {
 	c.unitTag = names.NewUnitTag(u).String()
 
 	c.storageCons, err = storage.ParseConstraintsMap(args[1:], false)
-	return
+	if err != nil {
+		return err
+	}
+	if c.fromSnapshot != "" {
+		if len(c.storageCons) != 1 {
+			return errors.New("--from-snapshot requires a single storage directive")
+		}
+		for _, cons := range c.storageCons {
+			if cons.Count > 1 {
+				return errors.New("--from-snapshot cannot add more than one storage instance")
+			}
+		}
+	}
+	return nil
 }
 
 // Info implements Command.Info.
@@ -196,6 +231,7 @@ func (c *addCommand) createStorageAddParams() []params.StorageAddParams {
 					&cons.Size,
 					&cons.Count,
 				},
+				FromSnapshot: c.fromSnapshot,
 			})
 	}
 
@@ -76,6 +76,16 @@ var errorTsts = []tstData{
 		expectedErr: `storage "data" specified more than once`,
 		visibleErr:  `storage "data" specified more than once`,
 	},
+	{
+		args:        []string{"--from-snapshot", "snap-1", "tst/123", "data", "logs"},
+		expectedErr: "--from-snapshot requires a single storage directive",
+		visibleErr:  "--from-snapshot requires a single storage directive",
+	},
+	{
+		args:        []string{"--from-snapshot", "snap-1", "tst/123", "data=ebs,2"},
+		expectedErr: "--from-snapshot cannot add more than one storage instance",
+		visibleErr:  "--from-snapshot cannot add more than one storage instance",
+	},
 }
 
 func (s *addSuite) TestAddArgs(c *gc.C) {
@@ -106,6 +116,20 @@ func (s *addSuite) TestAddSuccess(c *gc.C) {
 	}
 }
 
+func (s *addSuite) TestAddFromSnapshot(c *gc.C) {
+	var added []params.StorageAddParams
+	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.ErrorResult, error) {
+		added = storages
+		return make([]params.ErrorResult, len(storages)), nil
+	}
+	s.args = []string{"--from-snapshot", "snap-1", "tst/123", "data=ebs"}
+	s.assertAddOutput(c, "added \"data\"\n", "")
+	c.Assert(added, gc.HasLen, 1)
+	c.Assert(added[0].FromSnapshot, gc.Equals, "snap-1")
+	c.Assert(added[0].Constraints.Pool, gc.Equals, "ebs")
+	c.Assert(*added[0].Constraints.Count, gc.Equals, uint64(1))
+}
+
 func (s *addSuite) TestAddOperationAborted(c *gc.C) {
 	s.args = []string{"tst/123", "data=676"}
 	s.mockAPI.addToUnitFunc = func(storages []params.StorageAddParams) ([]params.ErrorResult, error) {
@@ -0,0 +1,103 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewAttachStorageCommand returns a command used to attach detached
+// storage to a unit.
+func NewAttachStorageCommand() cmd.Command {
+	cmd := &attachStorageCommand{}
+	cmd.newAPIFunc = func() (StorageAttachAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	attachStorageCommandDoc = `
+Attaches existing, detached storage instances to a unit. The unit's
+charm must define storage of the same kind and name as the storage
+instances, and must allow that many more instances of it.
+
+Examples:
+    juju attach-storage postgresql/1 pgdata/0
+`
+	attachStorageCommandArgs = `<unit> <storage> [<storage> ...]`
+)
+
+// attachStorageCommand attaches storage instances to a unit.
+type attachStorageCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (StorageAttachAPI, error)
+	unitId     string
+	storageIds []string
+}
+
+// Init implements Command.Init.
+func (c *attachStorageCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("attach-storage requires a unit and at least one storage ID")
+	}
+	if !names.IsValidUnit(args[0]) {
+		return errors.NotValidf("unit name %q", args[0])
+	}
+	for _, id := range args[1:] {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.unitId = args[0]
+	c.storageIds = args[1:]
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *attachStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "attach-storage",
+		Purpose: "Attaches existing storage to a unit.",
+		Doc:     attachStorageCommandDoc,
+		Args:    attachStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *attachStorageCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	results, err := api.Attach(c.unitId, c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "attach storage")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return reportStorageResults(ctx, c.storageIds, results, attachSuccess, attachFail)
+}
+
+var (
+	attachSuccess = "attaching %s"
+	attachFail    = "failed to attach %s: %v"
+)
+
+// StorageAttachAPI defines the API methods that the attach-storage
+// command uses.
+type StorageAttachAPI interface {
+	Close() error
+	Attach(string, []string) ([]params.ErrorResult, error)
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type AttachStorageSuite struct {
+	SubStorageSuite
+	fake *fakeStorageAttachAPI
+}
+
+var _ = gc.Suite(&AttachStorageSuite{})
+
+func (s *AttachStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.fake = &fakeStorageAttachAPI{}
+}
+
+func (s *AttachStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewAttachStorageCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *AttachStorageSuite) TestInitErrors(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "attach-storage requires a unit and at least one storage ID")
+	_, err = s.run(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "attach-storage requires a unit and at least one storage ID")
+	_, err = s.run(c, "foo", "bar/0")
+	c.Assert(err, gc.ErrorMatches, `unit name "foo" not valid`)
+	_, err = s.run(c, "foo/0", "bar")
+	c.Assert(err, gc.ErrorMatches, `storage ID "bar" not valid`)
+}
+
+func (s *AttachStorageSuite) TestAttach(c *gc.C) {
+	ctx, err := s.run(c, "foo/0", "bar/1", "baz/2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.unit, gc.Equals, "foo/0")
+	c.Assert(s.fake.attached, jc.DeepEquals, []string{"bar/1", "baz/2"})
+	c.Assert(testing.Stdout(ctx), gc.Equals, "attaching bar/1\nattaching baz/2\n")
+}
+
+func (s *AttachStorageSuite) TestAttachFailure(c *gc.C) {
+	s.fake.results = []params.ErrorResult{
+		{Error: &params.Error{Message: "storage is attached to unit-qux-0"}},
+	}
+	ctx, err := s.run(c, "foo/0", "bar/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to attach bar/1: storage is attached to unit-qux-0\n")
+}
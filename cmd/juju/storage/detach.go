@@ -0,0 +1,130 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewDetachStorageCommand returns a command used to detach storage
+// from units.
+func NewDetachStorageCommand() cmd.Command {
+	cmd := &detachStorageCommand{}
+	cmd.newAPIFunc = func() (StorageDetachAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	detachStorageCommandDoc = `
+Detaches storage instances from the units they are attached to.
+Detached storage instances remain in the model, and may be attached
+to another unit with juju attach-storage, or removed with
+juju remove-storage. Storage that the unit's charm requires cannot be
+detached.
+
+Only block storage, and filesystem storage managed on a volume, may be
+detached, and only if the volume can be detached from its machine.
+
+Examples:
+    juju detach-storage pgdata/0
+`
+	detachStorageCommandArgs = `<storage> [<storage> ...]`
+)
+
+// detachStorageCommand detaches storage instances from units.
+type detachStorageCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (StorageDetachAPI, error)
+	storageIds []string
+}
+
+// Init implements Command.Init.
+func (c *detachStorageCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("detach-storage requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *detachStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "detach-storage",
+		Purpose: "Detaches storage from units.",
+		Doc:     detachStorageCommandDoc,
+		Args:    detachStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *detachStorageCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	results, err := api.Detach(c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "detach storage")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return reportStorageResults(ctx, c.storageIds, results, detachSuccess, detachFail)
+}
+
+var (
+	detachSuccess = "detaching %s"
+	detachFail    = "failed to detach %s: %v"
+)
+
+// reportStorageResults writes the successful operations on the
+// storage instances to stdout, and the failures to stderr, using
+// the given formats.
+func reportStorageResults(
+	ctx *cmd.Context,
+	storageIds []string,
+	results []params.ErrorResult,
+	successFormat, failFormat string,
+) error {
+	var failures []string
+	for i, result := range results {
+		if result.Error != nil {
+			failures = append(failures, fmt.Sprintf(failFormat, storageIds[i], result.Error))
+			continue
+		}
+		fmt.Fprintln(ctx.Stdout, fmt.Sprintf(successFormat, storageIds[i]))
+	}
+	if len(failures) > 0 {
+		fmt.Fprintln(ctx.Stderr, strings.Join(failures, newline))
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// StorageDetachAPI defines the API methods that the detach-storage
+// command uses.
+type StorageDetachAPI interface {
+	Close() error
+	Detach([]string) ([]params.ErrorResult, error)
+}
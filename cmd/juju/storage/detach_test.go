@@ -0,0 +1,112 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type DetachStorageSuite struct {
+	SubStorageSuite
+	fake *fakeStorageAttachAPI
+}
+
+var _ = gc.Suite(&DetachStorageSuite{})
+
+func (s *DetachStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.fake = &fakeStorageAttachAPI{}
+}
+
+func (s *DetachStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewDetachStorageCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *DetachStorageSuite) TestInitErrors(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "detach-storage requires at least one storage ID")
+	_, err = s.run(c, "foo")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
+
+func (s *DetachStorageSuite) TestDetach(c *gc.C) {
+	ctx, err := s.run(c, "foo/0", "bar/1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.detached, jc.DeepEquals, []string{"foo/0", "bar/1"})
+	c.Assert(testing.Stdout(ctx), gc.Equals, "detaching foo/0\ndetaching bar/1\n")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "")
+}
+
+func (s *DetachStorageSuite) TestDetachFailure(c *gc.C) {
+	s.fake.results = []params.ErrorResult{
+		{},
+		{Error: &params.Error{Message: "storage is required"}},
+	}
+	ctx, err := s.run(c, "foo/0", "bar/1")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "detaching foo/0\n")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to detach bar/1: storage is required\n")
+}
+
+func (s *DetachStorageSuite) TestDetachError(c *gc.C) {
+	s.fake.err = errors.New("boom")
+	_, err := s.run(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *DetachStorageSuite) TestDetachBlocked(c *gc.C) {
+	s.fake.err = &params.Error{Code: params.CodeOperationBlocked, Message: "nope"}
+	_, err := s.run(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, `(?s).*juju enable-command all.*`)
+}
+
+// fakeStorageAttachAPI is a fake implementation of the APIs used by
+// the detach-storage, attach-storage and remove-storage commands.
+type fakeStorageAttachAPI struct {
+	detached []string
+	attached []string
+	removed  []string
+	unit     string
+	release  bool
+	results  []params.ErrorResult
+	err      error
+}
+
+func (f *fakeStorageAttachAPI) Close() error {
+	return nil
+}
+
+func (f *fakeStorageAttachAPI) Detach(ids []string) ([]params.ErrorResult, error) {
+	f.detached = ids
+	return f.resultsFor(ids)
+}
+
+func (f *fakeStorageAttachAPI) Attach(unit string, ids []string) ([]params.ErrorResult, error) {
+	f.unit = unit
+	f.attached = ids
+	return f.resultsFor(ids)
+}
+
+func (f *fakeStorageAttachAPI) Remove(ids []string, release bool) ([]params.ErrorResult, error) {
+	f.removed = ids
+	f.release = release
+	return f.resultsFor(ids)
+}
+
+func (f *fakeStorageAttachAPI) resultsFor(ids []string) ([]params.ErrorResult, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.results != nil {
+		return f.results, nil
+	}
+	return make([]params.ErrorResult, len(ids)), nil
+}
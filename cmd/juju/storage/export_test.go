@@ -31,6 +31,22 @@ func NewPoolCreateCommandForTest(api PoolCreateAPI, store jujuclient.ClientStore
 	return modelcmd.Wrap(cmd)
 }
 
+func NewPoolUpdateCommandForTest(api PoolUpdateAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &poolUpdateCommand{newAPIFunc: func() (PoolUpdateAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewPoolRemoveCommandForTest(api PoolRemoveAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &poolRemoveCommand{newAPIFunc: func() (PoolRemoveAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
 func NewShowCommandForTest(api StorageShowAPI, store jujuclient.ClientStore) cmd.Command {
 	cmd := &showCommand{newAPIFunc: func() (StorageShowAPI, error) {
 		return api, nil
@@ -54,3 +70,51 @@ func NewAddCommandForTest(api StorageAddAPI, store jujuclient.ClientStore) cmd.C
 	cmd.SetClientStore(store)
 	return modelcmd.Wrap(cmd)
 }
+
+func NewDetachStorageCommandForTest(api StorageDetachAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &detachStorageCommand{newAPIFunc: func() (StorageDetachAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewAttachStorageCommandForTest(api StorageAttachAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &attachStorageCommand{newAPIFunc: func() (StorageAttachAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewRemoveStorageCommandForTest(api StorageRemoveAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &removeStorageCommand{newAPIFunc: func() (StorageRemoveAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewCreateSnapshotCommandForTest(api SnapshotCreateAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &createSnapshotCommand{newAPIFunc: func() (SnapshotCreateAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewListSnapshotsCommandForTest(api SnapshotListAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &listSnapshotsCommand{newAPIFunc: func() (SnapshotListAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+func NewResizeStorageCommandForTest(api StorageResizeAPI, store jujuclient.ClientStore) cmd.Command {
+	cmd := &resizeStorageCommand{newAPIFunc: func() (StorageResizeAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// PoolRemoveAPI defines the API methods that pool remove command uses.
+type PoolRemoveAPI interface {
+	Close() error
+	RemovePool(pname string) error
+}
+
+const poolRemoveCommandDoc = `
+Removes a storage pool. A pool cannot be removed while it is referenced
+by the storage constraints of an application, or by any volume or
+filesystem.
+
+Examples:
+    juju remove-storage-pool ebs-fast
+`
+
+// NewPoolRemoveCommand returns a command that removes a storage pool.
+func NewPoolRemoveCommand() cmd.Command {
+	cmd := &poolRemoveCommand{}
+	cmd.newAPIFunc = func() (PoolRemoveAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// poolRemoveCommand removes a storage pool.
+type poolRemoveCommand struct {
+	PoolCommandBase
+	newAPIFunc func() (PoolRemoveAPI, error)
+	poolName   string
+}
+
+// Init implements Command.Init.
+func (c *poolRemoveCommand) Init(args []string) (err error) {
+	if len(args) < 1 {
+		return errors.New("pool removal requires a pool name")
+	}
+	c.poolName = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Info implements Command.Info.
+func (c *poolRemoveCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "remove-storage-pool",
+		Args:    "<name>",
+		Purpose: "Remove a storage pool.",
+		Doc:     poolRemoveCommandDoc,
+	}
+}
+
+// Run implements Command.Run.
+func (c *poolRemoveCommand) Run(ctx *cmd.Context) (err error) {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+	if err := api.RemovePool(c.poolName); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "remove storage pools")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return nil
+}
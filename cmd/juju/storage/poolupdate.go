@@ -0,0 +1,97 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/utils/keyvalues"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// PoolUpdateAPI defines the API methods that pool update command uses.
+type PoolUpdateAPI interface {
+	Close() error
+	UpdatePool(pname string, pconfig map[string]interface{}) error
+}
+
+const poolUpdateCommandDoc = `
+Replaces the configuration attributes of an existing storage pool.
+All of the pool's attributes are replaced by those specified; any
+attribute that is not specified is removed from the pool. The
+attributes are validated by the pool's storage provider. A pool's
+provider cannot be changed.
+
+Changes to a pool only affect storage provisioned after the change;
+existing storage is left untouched.
+
+Examples:
+    juju update-storage-pool ebs-fast volume-type=provisioned-iops iops=40
+`
+
+// NewPoolUpdateCommand returns a command that updates a storage pool.
+func NewPoolUpdateCommand() cmd.Command {
+	cmd := &poolUpdateCommand{}
+	cmd.newAPIFunc = func() (PoolUpdateAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+// poolUpdateCommand updates a storage pool.
+type poolUpdateCommand struct {
+	PoolCommandBase
+	newAPIFunc func() (PoolUpdateAPI, error)
+	poolName   string
+	attrs      map[string]interface{}
+}
+
+// Init implements Command.Init.
+func (c *poolUpdateCommand) Init(args []string) (err error) {
+	if len(args) < 2 {
+		return errors.New("pool update requires name and attrs for configuration")
+	}
+
+	c.poolName = args[0]
+
+	options, err := keyvalues.Parse(args[1:], false)
+	if err != nil {
+		return err
+	}
+	c.attrs = make(map[string]interface{})
+	for key, value := range options {
+		c.attrs[key] = value
+	}
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *poolUpdateCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "update-storage-pool",
+		Args:    "<name> <key>=<value> [<key>=<value>...]",
+		Purpose: "Update the configuration of a storage pool.",
+		Doc:     poolUpdateCommandDoc,
+	}
+}
+
+// Run implements Command.Run.
+func (c *poolUpdateCommand) Run(ctx *cmd.Context) (err error) {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+	if err := api.UpdatePool(c.poolName, c.attrs); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "update storage pools")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	return nil
+}
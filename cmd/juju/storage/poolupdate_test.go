@@ -0,0 +1,85 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type PoolUpdateSuite struct {
+	SubStorageSuite
+	mockAPI *mockPoolUpdateAPI
+}
+
+var _ = gc.Suite(&PoolUpdateSuite{})
+
+func (s *PoolUpdateSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+
+	s.mockAPI = &mockPoolUpdateAPI{}
+}
+
+func (s *PoolUpdateSuite) runPoolUpdate(c *gc.C, args []string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewPoolUpdateCommandForTest(s.mockAPI, s.store), args...)
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateNoArgs(c *gc.C) {
+	_, err := s.runPoolUpdate(c, nil)
+	c.Check(err, gc.ErrorMatches, "pool update requires name and attrs for configuration")
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateOneArg(c *gc.C) {
+	_, err := s.runPoolUpdate(c, []string{"sunshine"})
+	c.Check(err, gc.ErrorMatches, "pool update requires name and attrs for configuration")
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateAttrMissingKey(c *gc.C) {
+	_, err := s.runPoolUpdate(c, []string{"sunshine", "=too"})
+	c.Check(err, gc.ErrorMatches, `expected "key=value", got "=too"`)
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateManyAttrs(c *gc.C) {
+	_, err := s.runPoolUpdate(c, []string{"sunshine", "something=too", "another=one"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(s.mockAPI.poolName, gc.Equals, "sunshine")
+	c.Check(s.mockAPI.attrs, jc.DeepEquals, map[string]interface{}{
+		"something": "too",
+		"another":   "one",
+	})
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateError(c *gc.C) {
+	s.mockAPI.err = errors.New("boom")
+	_, err := s.runPoolUpdate(c, []string{"sunshine", "something=too"})
+	c.Check(err, gc.ErrorMatches, "boom")
+}
+
+func (s *PoolUpdateSuite) TestPoolUpdateBlocked(c *gc.C) {
+	s.mockAPI.err = &params.Error{Code: params.CodeOperationBlocked, Message: "nope"}
+	_, err := s.runPoolUpdate(c, []string{"sunshine", "something=too"})
+	c.Check(err, gc.ErrorMatches, `(?s).*juju enable-command all.*`)
+}
+
+type mockPoolUpdateAPI struct {
+	poolName string
+	attrs    map[string]interface{}
+	err      error
+}
+
+func (s *mockPoolUpdateAPI) UpdatePool(pname string, pconfig map[string]interface{}) error {
+	s.poolName = pname
+	s.attrs = pconfig
+	return s.err
+}
+
+func (s *mockPoolUpdateAPI) Close() error {
+	return nil
+}
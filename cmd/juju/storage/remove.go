@@ -0,0 +1,117 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewRemoveStorageCommand returns a command used to remove storage
+// from the model.
+func NewRemoveStorageCommand() cmd.Command {
+	cmd := &removeStorageCommand{}
+	cmd.newAPIFunc = func() (StorageRemoveAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	removeStorageCommandDoc = `
+Removes storage instances from the model. By default, or with
+--destroy, the cloud storage backing the storage instances is
+destroyed. With --release, the cloud storage is left in the cloud,
+and is no longer managed by Juju.
+
+Storage that is attached to units is detached before it is destroyed.
+Storage must be detached with juju detach-storage before it can be
+released.
+
+Examples:
+    juju remove-storage pgdata/0
+    juju remove-storage --release pgdata/0
+`
+	removeStorageCommandArgs = `<storage> [<storage> ...]`
+)
+
+// removeStorageCommand removes storage instances from the model.
+type removeStorageCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (StorageRemoveAPI, error)
+	storageIds []string
+	destroy    bool
+	release    bool
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *removeStorageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	f.BoolVar(&c.destroy, "destroy", false, "Destroy the cloud storage (default)")
+	f.BoolVar(&c.release, "release", false, "Release the cloud storage from the model, leaving it in the cloud")
+}
+
+// Init implements Command.Init.
+func (c *removeStorageCommand) Init(args []string) error {
+	if c.destroy && c.release {
+		return errors.New("--destroy and --release cannot be used together")
+	}
+	if len(args) < 1 {
+		return errors.New("remove-storage requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *removeStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "remove-storage",
+		Purpose: "Removes storage from the model.",
+		Doc:     removeStorageCommandDoc,
+		Args:    removeStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *removeStorageCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	results, err := api.Remove(c.storageIds, c.release)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "remove storage")
+		}
+		return block.ProcessBlockedError(err, block.BlockRemove)
+	}
+	return reportStorageResults(ctx, c.storageIds, results, removeSuccess, removeFail)
+}
+
+var (
+	removeSuccess = "removing %s"
+	removeFail    = "failed to remove %s: %v"
+)
+
+// StorageRemoveAPI defines the API methods that the remove-storage
+// command uses.
+type StorageRemoveAPI interface {
+	Close() error
+	Remove(storageIds []string, release bool) ([]params.ErrorResult, error)
+}
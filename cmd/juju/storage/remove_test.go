@@ -0,0 +1,75 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type RemoveStorageSuite struct {
+	SubStorageSuite
+	fake *fakeStorageAttachAPI
+}
+
+var _ = gc.Suite(&RemoveStorageSuite{})
+
+func (s *RemoveStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.fake = &fakeStorageAttachAPI{}
+}
+
+func (s *RemoveStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewRemoveStorageCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *RemoveStorageSuite) TestInitErrors(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "remove-storage requires at least one storage ID")
+	_, err = s.run(c, "foo")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+	_, err = s.run(c, "--destroy", "--release", "foo/0")
+	c.Assert(err, gc.ErrorMatches, "--destroy and --release cannot be used together")
+}
+
+func (s *RemoveStorageSuite) TestRemove(c *gc.C) {
+	ctx, err := s.run(c, "foo/0", "bar/1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.removed, jc.DeepEquals, []string{"foo/0", "bar/1"})
+	c.Assert(s.fake.release, jc.IsFalse)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "removing foo/0\nremoving bar/1\n")
+}
+
+func (s *RemoveStorageSuite) TestRemoveDestroy(c *gc.C) {
+	_, err := s.run(c, "--destroy", "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.release, jc.IsFalse)
+}
+
+func (s *RemoveStorageSuite) TestRemoveRelease(c *gc.C) {
+	_, err := s.run(c, "--release", "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.removed, jc.DeepEquals, []string{"foo/0"})
+	c.Assert(s.fake.release, jc.IsTrue)
+}
+
+func (s *RemoveStorageSuite) TestRemoveFailure(c *gc.C) {
+	s.fake.results = []params.ErrorResult{
+		{Error: &params.Error{Message: "storage is attached; detach it before releasing it"}},
+	}
+	ctx, err := s.run(c, "--release", "foo/0")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to remove foo/0: storage is attached; detach it before releasing it\n")
+}
+
+func (s *RemoveStorageSuite) TestRemoveBlocked(c *gc.C) {
+	s.fake.err = &params.Error{Code: params.CodeOperationBlocked, Message: "nope"}
+	_, err := s.run(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, `(?s).*juju enable-command remove-object.*`)
+}
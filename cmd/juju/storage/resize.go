@@ -0,0 +1,114 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/utils"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewResizeStorageCommand returns a command used to resize storage.
+func NewResizeStorageCommand() cmd.Command {
+	cmd := &resizeStorageCommand{}
+	cmd.newAPIFunc = func() (StorageResizeAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	resizeStorageCommandDoc = `
+Grows the volume of a storage instance to the specified size. The size
+is a number with an optional unit suffix (M, G, T, P or E), defaulting
+to mebibytes. Storage cannot be shrunk.
+
+The volume is resized by the storage provider, after which any
+filesystem on the volume is grown to fill it. The storage-resized hook
+is then run for the units the storage is attached to.
+
+Only storage whose storage provider supports resizing volumes, such as
+ebs, cinder and loop, can be resized. EBS volumes are resized while they
+are attached, except for magnetic volumes, which cannot be resized.
+Cinder volumes must be detached from their machines (see juju
+detach-storage) before they are resized.
+
+Examples:
+    juju resize-storage pgdata/0 100G
+`
+	resizeStorageCommandArgs = `<storage> <size>`
+)
+
+// resizeStorageCommand resizes a storage instance.
+type resizeStorageCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (StorageResizeAPI, error)
+	storageId  string
+	size       uint64
+}
+
+// Init implements Command.Init.
+func (c *resizeStorageCommand) Init(args []string) error {
+	if len(args) < 2 {
+		return errors.New("resize-storage requires a storage ID and a size")
+	}
+	storageId, sizeArg := args[0], args[1]
+	if !names.IsValidStorage(storageId) {
+		return errors.NotValidf("storage ID %q", storageId)
+	}
+	size, err := utils.ParseSize(sizeArg)
+	if err != nil {
+		return errors.Annotatef(err, "cannot parse size %q", sizeArg)
+	}
+	if size == 0 {
+		return errors.NotValidf("size %q", sizeArg)
+	}
+	c.storageId = storageId
+	c.size = size
+	return cmd.CheckEmpty(args[2:])
+}
+
+// Info implements Command.Info.
+func (c *resizeStorageCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "resize-storage",
+		Purpose: "Grows storage to a larger size.",
+		Doc:     resizeStorageCommandDoc,
+		Args:    resizeStorageCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *resizeStorageCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	if err := api.Resize(c.storageId, c.size); err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "resize storage")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	fmt.Fprintf(ctx.Stdout, "resizing %s to %s\n", c.storageId, humanize.IBytes(c.size*humanize.MiByte))
+	return nil
+}
+
+// StorageResizeAPI defines the API methods that the resize-storage
+// command uses.
+type StorageResizeAPI interface {
+	Close() error
+	Resize(storageId string, size uint64) error
+}
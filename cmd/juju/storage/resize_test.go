@@ -0,0 +1,95 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type ResizeStorageSuite struct {
+	SubStorageSuite
+	fake *fakeStorageResizeAPI
+}
+
+var _ = gc.Suite(&ResizeStorageSuite{})
+
+func (s *ResizeStorageSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.fake = &fakeStorageResizeAPI{}
+}
+
+func (s *ResizeStorageSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewResizeStorageCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *ResizeStorageSuite) TestInitErrors(c *gc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: nil,
+		err:  "resize-storage requires a storage ID and a size",
+	}, {
+		args: []string{"foo/0"},
+		err:  "resize-storage requires a storage ID and a size",
+	}, {
+		args: []string{"foo", "10G"},
+		err:  `storage ID "foo" not valid`,
+	}, {
+		args: []string{"foo/0", "big"},
+		err:  `cannot parse size "big": .*`,
+	}, {
+		args: []string{"foo/0", "0"},
+		err:  `size "0" not valid`,
+	}, {
+		args: []string{"foo/0", "10G", "bar"},
+		err:  `unrecognized args: \["bar"\]`,
+	}} {
+		_, err := s.run(c, test.args...)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *ResizeStorageSuite) TestResize(c *gc.C) {
+	ctx, err := s.run(c, "foo/0", "10G")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.storageId, gc.Equals, "foo/0")
+	c.Assert(s.fake.size, gc.Equals, uint64(10*1024))
+	c.Assert(testing.Stdout(ctx), gc.Equals, "resizing foo/0 to 10GiB\n")
+}
+
+func (s *ResizeStorageSuite) TestResizeError(c *gc.C) {
+	s.fake.err = errors.New("boom")
+	_, err := s.run(c, "foo/0", "10G")
+	c.Assert(err, gc.ErrorMatches, "boom")
+}
+
+func (s *ResizeStorageSuite) TestResizeBlocked(c *gc.C) {
+	s.fake.err = &params.Error{Code: params.CodeOperationBlocked, Message: "nope"}
+	_, err := s.run(c, "foo/0", "10G")
+	c.Assert(err, gc.ErrorMatches, `(?s).*juju enable-command all.*`)
+}
+
+type fakeStorageResizeAPI struct {
+	storageId string
+	size      uint64
+	err       error
+}
+
+func (f *fakeStorageResizeAPI) Close() error {
+	return nil
+}
+
+func (f *fakeStorageResizeAPI) Resize(storageId string, size uint64) error {
+	f.storageId = storageId
+	f.size = size
+	return f.err
+}
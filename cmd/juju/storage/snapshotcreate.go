@@ -0,0 +1,112 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewCreateSnapshotCommand returns a command used to create snapshots
+// of storage.
+func NewCreateSnapshotCommand() cmd.Command {
+	cmd := &createSnapshotCommand{}
+	cmd.newAPIFunc = func() (SnapshotCreateAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const (
+	createSnapshotCommandDoc = `
+Creates point-in-time snapshots of the volumes of storage instances.
+Snapshots are created by the cloud, and are listed with
+juju storage-snapshots. A snapshot may be restored to new storage
+with juju add-storage --from-snapshot.
+
+Only storage backed by volumes whose storage provider supports
+snapshots, such as ebs, cinder and gce, can be snapshotted.
+
+Examples:
+    juju create-storage-snapshot pgdata/0
+`
+	createSnapshotCommandArgs = `<storage> [<storage> ...]`
+)
+
+// createSnapshotCommand creates snapshots of storage instances.
+type createSnapshotCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (SnapshotCreateAPI, error)
+	storageIds []string
+}
+
+// Init implements Command.Init.
+func (c *createSnapshotCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("create-storage-snapshot requires at least one storage ID")
+	}
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *createSnapshotCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "create-storage-snapshot",
+		Purpose: "Creates snapshots of storage.",
+		Doc:     createSnapshotCommandDoc,
+		Args:    createSnapshotCommandArgs,
+	}
+}
+
+// Run implements Command.Run.
+func (c *createSnapshotCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	results, err := api.CreateSnapshots(c.storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "create storage snapshots")
+		}
+		return block.ProcessBlockedError(err, block.BlockChange)
+	}
+	var failures []string
+	for i, result := range results {
+		if result.Error != nil {
+			failures = append(failures, fmt.Sprintf("failed to snapshot %s: %v", c.storageIds[i], result.Error))
+			continue
+		}
+		fmt.Fprintf(ctx.Stdout, "created snapshot %s of %s\n", result.Result.SnapshotId, c.storageIds[i])
+	}
+	if len(failures) > 0 {
+		fmt.Fprintln(ctx.Stderr, strings.Join(failures, newline))
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// SnapshotCreateAPI defines the API methods that the
+// create-storage-snapshot command uses.
+type SnapshotCreateAPI interface {
+	Close() error
+	CreateSnapshots([]string) ([]params.SnapshotResult, error)
+}
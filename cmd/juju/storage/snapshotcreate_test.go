@@ -0,0 +1,94 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type CreateSnapshotSuite struct {
+	SubStorageSuite
+	fake *fakeSnapshotAPI
+}
+
+var _ = gc.Suite(&CreateSnapshotSuite{})
+
+func (s *CreateSnapshotSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.fake = &fakeSnapshotAPI{}
+}
+
+func (s *CreateSnapshotSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewCreateSnapshotCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *CreateSnapshotSuite) TestInitErrors(c *gc.C) {
+	_, err := s.run(c)
+	c.Assert(err, gc.ErrorMatches, "create-storage-snapshot requires at least one storage ID")
+	_, err = s.run(c, "foo")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
+
+func (s *CreateSnapshotSuite) TestCreateSnapshot(c *gc.C) {
+	s.fake.createResults = []params.SnapshotResult{
+		{Result: &params.Snapshot{SnapshotId: "snap-0"}},
+		{Result: &params.Snapshot{SnapshotId: "snap-1"}},
+	}
+	ctx, err := s.run(c, "foo/0", "bar/1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.created, jc.DeepEquals, []string{"foo/0", "bar/1"})
+	c.Assert(testing.Stdout(ctx), gc.Equals, `
+created snapshot snap-0 of foo/0
+created snapshot snap-1 of bar/1
+`[1:])
+}
+
+func (s *CreateSnapshotSuite) TestCreateSnapshotFailure(c *gc.C) {
+	s.fake.createResults = []params.SnapshotResult{
+		{Error: &params.Error{Message: "snapshots of loop volumes not supported"}},
+	}
+	ctx, err := s.run(c, "foo/0")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to snapshot foo/0: snapshots of loop volumes not supported\n")
+}
+
+func (s *CreateSnapshotSuite) TestCreateSnapshotBlocked(c *gc.C) {
+	s.fake.err = &params.Error{Code: params.CodeOperationBlocked, Message: "nope"}
+	_, err := s.run(c, "foo/0")
+	c.Assert(err, gc.ErrorMatches, `(?s).*juju enable-command all.*`)
+}
+
+// fakeSnapshotAPI is a fake SnapshotCreateAPI and SnapshotListAPI.
+type fakeSnapshotAPI struct {
+	created       []string
+	listed        []string
+	storage       []params.StorageDetails
+	createResults []params.SnapshotResult
+	listResults   []params.SnapshotListResult
+	err           error
+}
+
+func (f *fakeSnapshotAPI) Close() error {
+	return nil
+}
+
+func (f *fakeSnapshotAPI) CreateSnapshots(ids []string) ([]params.SnapshotResult, error) {
+	f.created = ids
+	return f.createResults, f.err
+}
+
+func (f *fakeSnapshotAPI) ListStorageDetails() ([]params.StorageDetails, error) {
+	return f.storage, f.err
+}
+
+func (f *fakeSnapshotAPI) ListSnapshots(ids []string) ([]params.SnapshotListResult, error) {
+	f.listed = ids
+	return f.listResults, f.err
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/modelcmd"
+)
+
+// NewListSnapshotsCommand returns a command used to list snapshots of
+// storage.
+func NewListSnapshotsCommand() cmd.Command {
+	cmd := &listSnapshotsCommand{}
+	cmd.newAPIFunc = func() (SnapshotListAPI, error) {
+		return cmd.NewStorageAPI()
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+const listSnapshotsCommandDoc = `
+Lists the snapshots of the volumes of storage instances, including
+snapshots that were not created by Juju. If no storage is specified,
+the snapshots of all storage in the model are listed.
+
+Examples:
+    juju storage-snapshots
+    juju storage-snapshots pgdata/0 --format yaml
+`
+
+// listSnapshotsCommand lists snapshots of storage instances.
+type listSnapshotsCommand struct {
+	StorageCommandBase
+	newAPIFunc func() (SnapshotListAPI, error)
+	storageIds []string
+	out        cmd.Output
+}
+
+// Init implements Command.Init.
+func (c *listSnapshotsCommand) Init(args []string) error {
+	for _, id := range args {
+		if !names.IsValidStorage(id) {
+			return errors.NotValidf("storage ID %q", id)
+		}
+	}
+	c.storageIds = args
+	return nil
+}
+
+// Info implements Command.Info.
+func (c *listSnapshotsCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "storage-snapshots",
+		Purpose: "Lists snapshots of storage.",
+		Doc:     listSnapshotsCommandDoc,
+		Args:    "[<storage> ...]",
+		Aliases: []string{"list-storage-snapshots"},
+	}
+}
+
+// SetFlags implements Command.SetFlags.
+func (c *listSnapshotsCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.StorageCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml":    cmd.FormatYaml,
+		"json":    cmd.FormatJson,
+		"tabular": formatSnapshotListTabular,
+	})
+}
+
+// Run implements Command.Run.
+func (c *listSnapshotsCommand) Run(ctx *cmd.Context) error {
+	api, err := c.newAPIFunc()
+	if err != nil {
+		return err
+	}
+	defer api.Close()
+
+	storageIds := c.storageIds
+	if len(storageIds) == 0 {
+		storageIds, err = allStorageIds(api)
+		if err != nil {
+			return err
+		}
+	}
+	if len(storageIds) == 0 {
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	results, err := api.ListSnapshots(storageIds)
+	if err != nil {
+		if params.IsCodeUnauthorized(err) {
+			common.PermissionsMessage(ctx.Stderr, "list storage snapshots")
+		}
+		return err
+	}
+
+	var failed bool
+	output := make(map[string]SnapshotInfo)
+	for i, result := range results {
+		if result.Error != nil {
+			if len(c.storageIds) == 0 && (params.IsCodeNotSupported(result.Error) ||
+				params.IsCodeNotProvisioned(result.Error) ||
+				params.IsCodeNotFound(result.Error)) {
+				// When listing the snapshots of all storage, don't
+				// complain about storage that cannot be snapshotted,
+				// including filesystems that have no volume.
+				continue
+			}
+			fmt.Fprintf(ctx.Stderr, "failed to list snapshots of %s: %v\n", storageIds[i], result.Error)
+			failed = true
+			continue
+		}
+		for _, snapshot := range result.Result {
+			info, err := createSnapshotInfo(snapshot)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			output[snapshot.SnapshotId] = info
+		}
+	}
+	if len(output) == 0 {
+		if failed {
+			return cmd.ErrSilent
+		}
+		ctx.Infof("No storage snapshots to display.")
+		return nil
+	}
+	if err := c.out.Write(ctx, output); err != nil {
+		return err
+	}
+	if failed {
+		return cmd.ErrSilent
+	}
+	return nil
+}
+
+// allStorageIds returns the IDs of all storage in the model.
+func allStorageIds(api SnapshotListAPI) ([]string, error) {
+	storages, err := api.ListStorageDetails()
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, storage := range storages {
+		tag, err := names.ParseStorageTag(storage.StorageTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ids = append(ids, tag.Id())
+	}
+	return ids, nil
+}
+
+// SnapshotInfo defines the serialization behaviour of a storage
+// snapshot.
+type SnapshotInfo struct {
+	// Storage is the ID of the storage instance whose volume the
+	// snapshot was taken from.
+	Storage string `yaml:"storage" json:"storage"`
+
+	// Volume is the ID of the volume the snapshot was taken from.
+	Volume string `yaml:"volume" json:"volume"`
+
+	// ProviderVolumeId is the provider ID of the volume the snapshot
+	// was taken from.
+	ProviderVolumeId string `yaml:"provider-volume-id,omitempty" json:"provider-volume-id,omitempty"`
+
+	// Size is the size of the snapshotted volume, in MiB.
+	Size uint64 `yaml:"size" json:"size"`
+
+	// Created is the time at which the snapshot was started.
+	Created string `yaml:"created,omitempty" json:"created,omitempty"`
+
+	// Status is the provider-specific status of the snapshot.
+	Status string `yaml:"status,omitempty" json:"status,omitempty"`
+}
+
+func createSnapshotInfo(snapshot params.Snapshot) (SnapshotInfo, error) {
+	storageTag, err := names.ParseStorageTag(snapshot.StorageTag)
+	if err != nil {
+		return SnapshotInfo{}, errors.Trace(err)
+	}
+	volumeTag, err := names.ParseVolumeTag(snapshot.VolumeTag)
+	if err != nil {
+		return SnapshotInfo{}, errors.Trace(err)
+	}
+	info := SnapshotInfo{
+		Storage:          storageTag.Id(),
+		Volume:           volumeTag.Id(),
+		ProviderVolumeId: snapshot.VolumeId,
+		Size:             snapshot.Size,
+		Status:           snapshot.Status,
+	}
+	if !snapshot.Created.IsZero() {
+		info.Created = common.FormatTime(&snapshot.Created, false)
+	}
+	return info, nil
+}
+
+// SnapshotListAPI defines the API methods that the storage-snapshots
+// command uses.
+type SnapshotListAPI interface {
+	Close() error
+	ListStorageDetails() ([]params.StorageDetails, error)
+	ListSnapshots([]string) ([]params.SnapshotListResult, error)
+}
@@ -0,0 +1,121 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/cmd/juju/common"
+	"github.com/juju/juju/cmd/juju/storage"
+	"github.com/juju/juju/testing"
+)
+
+type ListSnapshotsSuite struct {
+	SubStorageSuite
+	fake    *fakeSnapshotAPI
+	created time.Time
+}
+
+var _ = gc.Suite(&ListSnapshotsSuite{})
+
+func (s *ListSnapshotsSuite) SetUpTest(c *gc.C) {
+	s.SubStorageSuite.SetUpTest(c)
+	s.created = time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.fake = &fakeSnapshotAPI{
+		storage: []params.StorageDetails{
+			{StorageTag: "storage-data-0", Kind: params.StorageKindBlock},
+			{StorageTag: "storage-data-1", Kind: params.StorageKindBlock},
+			{StorageTag: "storage-logs-0", Kind: params.StorageKindFilesystem},
+		},
+		listResults: []params.SnapshotListResult{{
+			Result: []params.Snapshot{{
+				SnapshotId: "snap-1",
+				StorageTag: "storage-data-0",
+				VolumeTag:  "volume-0",
+				VolumeId:   "vol-0",
+				Size:       1024,
+				Created:    s.created,
+				Status:     "completed",
+			}, {
+				SnapshotId: "snap-0",
+				StorageTag: "storage-data-0",
+				VolumeTag:  "volume-0",
+				VolumeId:   "vol-0",
+				Size:       1024,
+				Created:    s.created,
+				Status:     "pending",
+			}},
+		}, {
+			Error: &params.Error{Code: params.CodeNotSupported, Message: "snapshots of loop volumes not supported"},
+		}},
+	}
+}
+
+func (s *ListSnapshotsSuite) run(c *gc.C, args ...string) (*cmd.Context, error) {
+	return testing.RunCommand(c, storage.NewListSnapshotsCommandForTest(s.fake, s.store), args...)
+}
+
+func (s *ListSnapshotsSuite) TestInitErrors(c *gc.C) {
+	_, err := s.run(c, "foo")
+	c.Assert(err, gc.ErrorMatches, `storage ID "foo" not valid`)
+}
+
+func (s *ListSnapshotsSuite) TestListAllStorage(c *gc.C) {
+	// Creation times are local, so leave them out of the table.
+	for i := range s.fake.listResults[0].Result {
+		s.fake.listResults[0].Result[i].Created = time.Time{}
+	}
+	// logs/0 is a filesystem with no backing volume.
+	s.fake.listResults = append(s.fake.listResults, params.SnapshotListResult{
+		Error: &params.Error{Code: params.CodeNotFound, Message: "volume for storage logs/0 not found"},
+	})
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.listed, jc.DeepEquals, []string{"data/0", "data/1", "logs/0"})
+	c.Assert(testing.Stdout(ctx), gc.Equals, `
+Snapshot  Storage  Volume  Size    Created  Status
+snap-0    data/0   0       1.0GiB           pending
+snap-1    data/0   0       1.0GiB           completed
+
+`[1:])
+	c.Assert(testing.Stderr(ctx), gc.Equals, "")
+}
+
+func (s *ListSnapshotsSuite) TestListStorageYAML(c *gc.C) {
+	ctx, err := s.run(c, "data/0", "data/1", "--format", "yaml")
+	c.Assert(err, gc.Equals, cmd.ErrSilent)
+	c.Assert(s.fake.listed, jc.DeepEquals, []string{"data/0", "data/1"})
+	created := common.FormatTime(&s.created, false)
+	c.Assert(testing.Stdout(ctx), gc.Equals, `
+snap-0:
+  storage: data/0
+  volume: "0"
+  provider-volume-id: vol-0
+  size: 1024
+  created: `+created+`
+  status: pending
+snap-1:
+  storage: data/0
+  volume: "0"
+  provider-volume-id: vol-0
+  size: 1024
+  created: `+created+`
+  status: completed
+`[1:])
+	c.Assert(testing.Stderr(ctx), gc.Equals, "failed to list snapshots of data/1: snapshots of loop volumes not supported\n")
+}
+
+func (s *ListSnapshotsSuite) TestListNoSnapshots(c *gc.C) {
+	s.fake.storage = nil
+	ctx, err := s.run(c)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.fake.listed, gc.IsNil)
+	c.Assert(testing.Stdout(ctx), gc.Equals, "")
+	c.Assert(testing.Stderr(ctx), gc.Equals, "No storage snapshots to display.\n")
+}
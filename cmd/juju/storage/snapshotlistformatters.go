@@ -0,0 +1,67 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/cmd/output"
+)
+
+// formatSnapshotListTabular returns a tabular summary of snapshots or
+// errors out if parameter is not a map of SnapshotInfo.
+func formatSnapshotListTabular(writer io.Writer, value interface{}) error {
+	snapshots, ok := value.(map[string]SnapshotInfo)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", snapshots, value)
+	}
+	tw := output.TabWriter(writer)
+	print := func(values ...string) {
+		fmt.Fprintln(tw, strings.Join(values, "\t"))
+	}
+	print("Snapshot", "Storage", "Volume", "Size", "Created", "Status")
+
+	infos := make(snapshotInfos, 0, len(snapshots))
+	for id, snapshot := range snapshots {
+		infos = append(infos, snapshotInfo{id, snapshot})
+	}
+	sort.Sort(infos)
+	for _, snapshot := range infos {
+		var size string
+		if snapshot.Size > 0 {
+			size = humanize.IBytes(snapshot.Size * humanize.MiByte)
+		}
+		print(snapshot.SnapshotId, snapshot.Storage, snapshot.Volume, size, snapshot.Created, snapshot.Status)
+	}
+	return tw.Flush()
+}
+
+type snapshotInfo struct {
+	SnapshotId string
+	SnapshotInfo
+}
+
+// snapshotInfos sorts snapshots by storage ID, and then by snapshot ID.
+type snapshotInfos []snapshotInfo
+
+func (v snapshotInfos) Len() int {
+	return len(v)
+}
+
+func (v snapshotInfos) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
+func (v snapshotInfos) Less(i, j int) bool {
+	if v[i].Storage != v[j].Storage {
+		return v[i].Storage < v[j].Storage
+	}
+	return v[i].SnapshotId < v[j].SnapshotId
+}
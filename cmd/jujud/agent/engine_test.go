@@ -37,11 +37,14 @@ var (
 		"spaces-imported-gate",
 	}
 	aliveModelWorkers = []string{
+		"action-pruner",
+		"action-scheduler",
 		"charm-revision-updater",
 		"compute-provisioner",
 		"environ-tracker",
 		"firewaller",
 		"instance-poller",
+		"log-forward-sinks",
 		"machine-undertaker",
 		"metric-worker",
 		"migration-fortress",
@@ -6,6 +6,7 @@ package agent
 import (
 	"os"
 	"runtime"
+	"sync"
 
 	names "gopkg.in/juju/names.v2"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/juju/juju/agent"
+	"github.com/juju/juju/state"
 	"github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/dependency"
 	"github.com/juju/juju/worker/introspection"
@@ -33,6 +35,13 @@ type introspectionConfig struct {
 	PrometheusGatherer prometheus.Gatherer
 	NewSocketName      func(names.Tag) string
 	WorkerFunc         func(config introspection.Config) (worker.Worker, error)
+
+	// Leases, Presence, StatePool and PubSub are optional reporters
+	// that are only available on controller machines.
+	Leases    introspection.Reporter
+	Presence  introspection.Reporter
+	StatePool introspection.Reporter
+	PubSub    introspection.Reporter
 }
 
 // startIntrospection creates the introspection worker. It cannot and should
@@ -52,6 +61,10 @@ func startIntrospection(cfg introspectionConfig) error {
 		SocketName:         socketName,
 		Reporter:           cfg.Engine,
 		PrometheusGatherer: cfg.PrometheusGatherer,
+		Leases:             cfg.Leases,
+		Presence:           cfg.Presence,
+		StatePool:          cfg.StatePool,
+		PubSub:             cfg.PubSub,
 	})
 	if err != nil {
 		return errors.Trace(err)
@@ -67,6 +80,52 @@ func startIntrospection(cfg introspectionConfig) error {
 	return nil
 }
 
+// deferredReporter is an introspection.Reporter whose underlying
+// reporter is set once it becomes available, such as when the API
+// server opens its state connection.
+type deferredReporter struct {
+	mu       sync.Mutex
+	reporter introspection.Reporter
+}
+
+// set replaces the underlying reporter; nil clears it.
+func (r *deferredReporter) set(reporter introspection.Reporter) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reporter = reporter
+}
+
+// Report is part of the introspection.Reporter interface.
+func (r *deferredReporter) Report() map[string]interface{} {
+	r.mu.Lock()
+	reporter := r.reporter
+	r.mu.Unlock()
+	if reporter == nil {
+		return map[string]interface{}{"error": "not available"}
+	}
+	return reporter.Report()
+}
+
+// reportFunc adapts a function to the introspection.Reporter interface.
+type reportFunc func() map[string]interface{}
+
+// Report is part of the introspection.Reporter interface.
+func (f reportFunc) Report() map[string]interface{} {
+	return f()
+}
+
+// leaseReporter returns a reporter for the leases held in the
+// given state.
+func leaseReporter(st *state.State) introspection.Reporter {
+	return reportFunc(func() map[string]interface{} {
+		report, err := st.LeaseReport()
+		if err != nil {
+			return map[string]interface{}{"error": err.Error()}
+		}
+		return report
+	})
+}
+
 // newPrometheusRegistry returns a new prometheus.Registry with
 // the Go and process metric collectors registered. This registry
 // is exposed by the introspection abstract domain socket on all
@@ -108,6 +108,25 @@ func (s *introspectionSuite) TestDefaultIntrospectionSocketName(c *gc.C) {
 	c.Assert(name, gc.Equals, "jujud-machine-42")
 }
 
+func (s *introspectionSuite) TestDeferredReporter(c *gc.C) {
+	var r deferredReporter
+	c.Check(r.Report(), jc.DeepEquals, map[string]interface{}{
+		"error": "not available",
+	})
+
+	r.set(reportFunc(func() map[string]interface{} {
+		return map[string]interface{}{"working": true}
+	}))
+	c.Check(r.Report(), jc.DeepEquals, map[string]interface{}{
+		"working": true,
+	})
+
+	r.set(nil)
+	c.Check(r.Report(), jc.DeepEquals, map[string]interface{}{
+		"error": "not available",
+	})
+}
+
 type dummyAgent struct {
 	agent.Agent
 }
@@ -18,7 +18,6 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/gnuflag"
 	"github.com/juju/loggo"
-	"github.com/juju/pubsub"
 	"github.com/juju/replicaset"
 	"github.com/juju/utils"
 	"github.com/juju/utils/clock"
@@ -62,6 +61,8 @@ import (
 	"github.com/juju/juju/instance"
 	jujunames "github.com/juju/juju/juju/names"
 	"github.com/juju/juju/juju/paths"
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/logfwd/syslog"
 	"github.com/juju/juju/mongo"
 	"github.com/juju/juju/mongo/txnmetrics"
 	"github.com/juju/juju/pubsub/centralhub"
@@ -352,7 +353,14 @@ type MachineAgent struct {
 
 	// Only API servers have hubs. This is temporary until the apiserver and
 	// peergrouper have manifolds.
-	centralHub *pubsub.StructuredHub
+	centralHub *centralhub.Hub
+
+	// The lease, presence and state pool reporters are set while
+	// the API server is running, and are reported on by the
+	// introspection worker.
+	leaseReporter     deferredReporter
+	presenceReporter  deferredReporter
+	statePoolReporter deferredReporter
 }
 
 // IsRestorePreparing returns bool representing if we are in restore mode
@@ -522,7 +530,7 @@ func (a *MachineAgent) makeEngineCreator(previousAgentVersion version.Number) fu
 			Clock:                clock.WallClock,
 			ValidateMigration:    a.validateMigration,
 			PrometheusRegisterer: a.prometheusRegistry,
-			CentralHub:           a.centralHub,
+			CentralHub:           a.centralHub.StructuredHub,
 		})
 		if err := dependency.Install(engine, manifolds); err != nil {
 			if err := worker.Stop(engine); err != nil {
@@ -536,6 +544,10 @@ func (a *MachineAgent) makeEngineCreator(previousAgentVersion version.Number) fu
 			NewSocketName:      a.newIntrospectionSocketName,
 			PrometheusGatherer: a.prometheusRegistry,
 			WorkerFunc:         introspection.NewWorker,
+			Leases:             &a.leaseReporter,
+			Presence:           &a.presenceReporter,
+			StatePool:          &a.statePoolReporter,
+			PubSub:             a.centralHub,
 		}); err != nil {
 			// If the introspection worker failed to start, we just log error
 			// but continue. It is very unlikely to happen in the real world
@@ -1092,6 +1104,7 @@ func (a *MachineAgent) startModelWorkers(controllerUUID, modelUUID string) (work
 		StatusHistoryPrunerMaxHistoryTime: 336 * time.Hour, // 2 weeks
 		StatusHistoryPrunerMaxHistoryMB:   5120,            // 5G
 		StatusHistoryPrunerInterval:       5 * time.Minute,
+		ActionPrunerInterval:              5 * time.Minute,
 		SpacesImportedGate:                a.discoverSpacesComplete,
 		NewEnvironFunc:                    newEnvirons,
 		NewMigrationMaster:                migrationmaster.NewWorker,
@@ -1162,12 +1175,21 @@ func (a *MachineAgent) newAPIserverWorker(st *state.State, certChanged chan para
 		return nil, errors.Annotate(err, "cannot fetch the controller config")
 	}
 
+	machineTag, ok := tag.(names.MachineTag)
+	if !ok {
+		return nil, errors.Errorf("expected machine tag, got %v", tag)
+	}
+	auditEntrySink, err := newAuditEntrySink(st, logDir, controllerConfig, machineTag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	newObserver, err := newObserverFn(
 		controllerConfig,
 		clock.WallClock,
 		jujuversion.Current,
 		agentConfig.Model().Id(),
-		newAuditEntrySink(st, logDir),
+		auditEntrySink,
 		auditErrorHandler,
 		a.prometheusRegistry,
 	)
@@ -1175,6 +1197,7 @@ func (a *MachineAgent) newAPIserverWorker(st *state.State, certChanged chan para
 		return nil, errors.Annotate(err, "cannot create RPC observer factory")
 	}
 
+	statePool := state.NewStatePool(st)
 	server, err := apiserver.NewServer(st, listener, apiserver.ServerConfig{
 		Clock:            clock.WallClock,
 		Cert:             cert,
@@ -1189,17 +1212,51 @@ func (a *MachineAgent) newAPIserverWorker(st *state.State, certChanged chan para
 		AutocertDNSName:  controllerConfig.AutocertDNSName(),
 		AllowModelAccess: controllerConfig.AllowModelAccess(),
 		NewObserver:      newObserver,
+		StatePool:        statePool,
 	})
 	if err != nil {
+		statePool.Close()
 		return nil, errors.Annotate(err, "cannot start api server worker")
 	}
 
+	a.leaseReporter.set(leaseReporter(st))
+	a.presenceReporter.set(reportFunc(st.PresenceReport))
+	a.statePoolReporter.set(statePool)
+	go func() {
+		server.Wait()
+		a.leaseReporter.set(nil)
+		a.presenceReporter.set(nil)
+		a.statePoolReporter.set(nil)
+	}()
 	return server, nil
 }
 
-func newAuditEntrySink(st *state.State, logDir string) audit.AuditEntrySinkFn {
-	persistFn := st.PutAuditEntryFn()
-	fileSinkFn := audit.NewLogFileSink(logDir)
+func newAuditEntrySink(
+	st *state.State,
+	logDir string,
+	controllerConfig controller.Config,
+	tag names.MachineTag,
+) (audit.AuditEntrySinkFn, error) {
+	registry := audit.NewSinkRegistry()
+	if err := registry.Register("file", audit.NewLogFileSink(logDir)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := registry.Register("mongo", st.PutAuditEntryFn()); err != nil {
+		return nil, errors.Trace(err)
+	}
+	forwarder := &auditForwarder{
+		st: st,
+		origin: logfwd.OriginForMachineAgent(
+			tag, controllerConfig.ControllerUUID(), st.ModelUUID(), jujuversion.Current,
+		),
+	}
+	if err := registry.Register("logfwd", forwarder.send); err != nil {
+		return nil, errors.Trace(err)
+	}
+	sink, err := registry.Sink(controllerConfig.AuditLogSinks()...)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot configure audit sinks")
+	}
 	return func(entry audit.AuditEntry) error {
 		// We don't care about auditing anything but user actions.
 		if _, err := names.ParseUserTag(entry.OriginName); err != nil {
@@ -1209,16 +1266,45 @@ func newAuditEntrySink(st *state.State, logDir string) audit.AuditEntrySinkFn {
 		if strings.HasPrefix(entry.Operation, "Pinger:") {
 			return nil
 		}
-		persistErr := persistFn(entry)
-		sinkErr := fileSinkFn(entry)
-		if persistErr == nil {
-			return errors.Annotate(sinkErr, "cannot save audit record to file")
+		return sink(entry)
+	}, nil
+}
+
+// auditForwarder forwards audit entries to the syslog target
+// configured on the controller model. The connection is opened on
+// first use, and reopened after a failed send.
+type auditForwarder struct {
+	st     *state.State
+	origin logfwd.Origin
+
+	mu     sync.Mutex
+	client *syslog.Client
+}
+
+func (f *auditForwarder) send(entry audit.AuditEntry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.client == nil {
+		cfg, err := f.st.ModelConfig()
+		if err != nil {
+			return errors.Trace(err)
 		}
-		if sinkErr == nil {
-			return errors.Annotate(persistErr, "cannot save audit record to database")
+		syslogCfg, ok := cfg.LogFwdSyslog()
+		if !ok || !syslogCfg.Enabled {
+			return errors.New("log forwarding not enabled on the controller model")
+		}
+		client, err := syslog.Open(*syslogCfg)
+		if err != nil {
+			return errors.Annotate(err, "cannot open syslog connection")
 		}
-		return errors.Annotate(persistErr, "cannot save audit record to file or database")
+		f.client = client
 	}
+	err := audit.NewForwardingSink(f.client, f.origin)(entry)
+	if err != nil {
+		f.client.Close()
+		f.client = nil
+	}
+	return errors.Trace(err)
 }
 
 func newObserverFn(
@@ -1250,6 +1336,11 @@ func newObserverFn(
 			ctx := &observer.AuditContext{
 				JujuServerVersion: jujuServerVersion,
 				ModelUUID:         modelUUID,
+				Policy: observer.AuditPolicy{
+					CaptureArgs:    controllerConfig.AuditLogCaptureArgs(),
+					ExcludeMethods: set.NewStrings(controllerConfig.AuditLogExcludeMethods()...),
+					RedactKeys:     controllerConfig.AuditLogRedactKeys(),
+				},
 			}
 			return observer.NewAudit(ctx, persistAuditEntry, auditErrorHandler)
 		})
@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/worker/apicaller"
 	"github.com/juju/juju/worker/apiconfigwatcher"
 	"github.com/juju/juju/worker/authenticationworker"
+	"github.com/juju/juju/worker/backupscheduler"
 	"github.com/juju/juju/worker/centralhub"
 	"github.com/juju/juju/worker/dependency"
 	"github.com/juju/juju/worker/deployer"
@@ -478,6 +479,18 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 				OpenFn: sinks.OpenSyslog,
 			}},
 		})),
+
+		// The backup scheduler takes controller backups on the
+		// schedule in the controller config, and removes old
+		// scheduled backups. It depends on the state manifold, so
+		// it only runs on controller machines.
+		backupSchedulerName: ifFullyUpgraded(backupscheduler.Manifold(backupscheduler.ManifoldConfig{
+			AgentName:  agentName,
+			StateName:  stateName,
+			Clock:      config.Clock,
+			NewBackend: backupscheduler.NewBackend,
+			NewWorker:  backupscheduler.NewWorker,
+		})),
 	}
 }
 
@@ -534,4 +547,5 @@ const (
 	machineActionName        = "machine-action-runner"
 	hostKeyReporterName      = "host-key-reporter"
 	logForwarderName         = "log-forwarder"
+	backupSchedulerName      = "backup-scheduler"
 )
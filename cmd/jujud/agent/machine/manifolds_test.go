@@ -46,6 +46,7 @@ func (*ManifoldsSuite) TestManifoldNames(c *gc.C) {
 		"api-address-updater",
 		"api-caller",
 		"api-config-watcher",
+		"backup-scheduler",
 		"central-hub",
 		"disk-manager",
 		"host-key-reporter",
@@ -95,6 +96,7 @@ func (*ManifoldsSuite) TestMigrationGuardsUsed(c *gc.C) {
 		"agent",
 		"api-caller",
 		"api-config-watcher",
+		"backup-scheduler",
 		"central-hub",
 		"log-forwarder",
 		"state",
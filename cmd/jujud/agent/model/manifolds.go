@@ -18,6 +18,8 @@ import (
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/feature"
 	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/actionpruner"
+	"github.com/juju/juju/worker/actionscheduler"
 	"github.com/juju/juju/worker/agent"
 	"github.com/juju/juju/worker/apicaller"
 	"github.com/juju/juju/worker/apiconfigwatcher"
@@ -33,6 +35,8 @@ import (
 	"github.com/juju/juju/worker/gate"
 	"github.com/juju/juju/worker/instancepoller"
 	"github.com/juju/juju/worker/lifeflag"
+	"github.com/juju/juju/worker/logforwarder"
+	"github.com/juju/juju/worker/logforwarder/sinks"
 	"github.com/juju/juju/worker/machineundertaker"
 	"github.com/juju/juju/worker/metricworker"
 	"github.com/juju/juju/worker/migrationflag"
@@ -87,6 +91,10 @@ type ManifoldsConfig struct {
 	StatusHistoryPrunerMaxHistoryMB   uint
 	StatusHistoryPrunerInterval       time.Duration
 
+	// ActionPrunerInterval determines how often completed actions are
+	// pruned, within the limits set by the model's config.
+	ActionPrunerInterval time.Duration
+
 	// SpacesImportedGate will be unlocked when spaces are known to
 	// have been imported.
 	SpacesImportedGate gate.Lock
@@ -281,6 +289,19 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 		stateCleanerName: ifNotMigrating(cleaner.Manifold(cleaner.ManifoldConfig{
 			APICallerName: apiCallerName,
 		})),
+		actionSchedulerName: ifNotMigrating(actionscheduler.Manifold(actionscheduler.ManifoldConfig{
+			APICallerName: apiCallerName,
+			ClockName:     clockName,
+			NewFacade:     actionscheduler.NewFacade,
+			NewWorker:     actionscheduler.NewWorker,
+		})),
+		actionPrunerName: ifNotMigrating(actionpruner.Manifold(actionpruner.ManifoldConfig{
+			APICallerName: apiCallerName,
+			ClockName:     clockName,
+			PruneInterval: config.ActionPrunerInterval,
+			NewFacade:     actionpruner.NewFacade,
+			NewWorker:     actionpruner.NewWorker,
+		})),
 		statusHistoryPrunerName: ifNotMigrating(statushistorypruner.Manifold(statushistorypruner.ManifoldConfig{
 			APICallerName:  apiCallerName,
 			MaxHistoryTime: config.StatusHistoryPrunerMaxHistoryTime,
@@ -294,6 +315,11 @@ func Manifolds(config ManifoldsConfig) dependency.Manifolds {
 			EnvironName:   environTrackerName,
 			NewWorker:     machineundertaker.NewWorker,
 		})),
+		logForwardSinksName: ifNotMigrating(logforwarder.SinksManifold(logforwarder.SinksManifoldConfig{
+			AgentName:          agentName,
+			APICallerName:      apiCallerName,
+			OpenConfiguredSink: sinks.Open,
+		})),
 	}
 	if featureflag.Enabled(feature.CrossModelRelations) {
 		result[remoteRelationsName] = ifNotMigrating(remoterelations.Manifold(remoterelations.ManifoldConfig{
@@ -395,7 +421,10 @@ const (
 	charmRevisionUpdaterName = "charm-revision-updater"
 	metricWorkerName         = "metric-worker"
 	stateCleanerName         = "state-cleaner"
+	actionSchedulerName      = "action-scheduler"
+	actionPrunerName         = "action-pruner"
 	statusHistoryPrunerName  = "status-history-pruner"
 	machineUndertakerName    = "machine-undertaker"
+	logForwardSinksName      = "log-forward-sinks"
 	remoteRelationsName      = "remote-relations"
 )
@@ -32,6 +32,8 @@ func (s *ManifoldsSuite) TestNames(c *gc.C) {
 	// NOTE: if this test failed, the cmd/jujud/agent tests will
 	// also fail. Search for 'ModelWorkers' to find affected vars.
 	c.Check(actual.SortedValues(), jc.DeepEquals, []string{
+		"action-pruner",
+		"action-scheduler",
 		"agent",
 		"api-caller",
 		"api-config-watcher",
@@ -43,6 +45,7 @@ func (s *ManifoldsSuite) TestNames(c *gc.C) {
 		"firewaller",
 		"instance-poller",
 		"is-responsible-flag",
+		"log-forward-sinks",
 		"machine-undertaker",
 		"metric-worker",
 		"migration-fortress",
@@ -141,6 +144,8 @@ func (s *ManifoldsCrossModelSuite) TestNames(c *gc.C) {
 	// NOTE: if this test failed, the cmd/jujud/agent tests will
 	// also fail. Search for 'ModelWorkers' to find affected vars.
 	c.Check(actual.SortedValues(), jc.DeepEquals, []string{
+		"action-pruner",
+		"action-scheduler",
 		"agent",
 		"api-caller",
 		"api-config-watcher",
@@ -152,6 +157,7 @@ func (s *ManifoldsCrossModelSuite) TestNames(c *gc.C) {
 		"firewaller",
 		"instance-poller",
 		"is-responsible-flag",
+		"log-forward-sinks",
 		"machine-undertaker",
 		"metric-worker",
 		"migration-fortress",
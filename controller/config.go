@@ -5,6 +5,9 @@ package controller
 
 import (
 	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -14,6 +17,7 @@ import (
 	"gopkg.in/macaroon-bakery.v1/bakery"
 
 	"github.com/juju/juju/cert"
+	"github.com/juju/juju/core/cron"
 )
 
 var logger = loggo.GetLogger("juju.controller")
@@ -26,6 +30,50 @@ const (
 	// auditing information.
 	AuditingEnabled = "auditing-enabled"
 
+	// AuditLogSinks is a comma-separated list of the names of the
+	// sinks to which audit entries are written. Known sinks are
+	// "file", "mongo" and "logfwd".
+	AuditLogSinks = "audit-log-sinks"
+
+	// AuditLogCaptureArgs determines whether the audit log records
+	// the facade, method, version, redacted arguments and error code
+	// of every audited API call, rather than just the operation.
+	AuditLogCaptureArgs = "audit-log-capture-args"
+
+	// AuditLogExcludeMethods is a comma-separated list of the
+	// "Facade.Method" names of API calls which are not recorded
+	// when AuditLogCaptureArgs is enabled. The special value
+	// "ReadOnlyMethods" excludes all calls known not to change
+	// anything.
+	AuditLogExcludeMethods = "audit-log-exclude-methods"
+
+	// AuditLogRedactKeys is a comma-separated list of argument field
+	// name fragments whose values are redacted from the audit log,
+	// in addition to the built-in list of secret field names.
+	AuditLogRedactKeys = "audit-log-redact-keys"
+
+	// BackupSchedule is the cron-style specification of when the
+	// controller takes scheduled backups, as accepted by cron.Parse.
+	// No scheduled backups are taken if it is empty.
+	BackupSchedule = "backup-schedule"
+
+	// BackupRetentionCount is the number of scheduled backups that
+	// are kept; older scheduled backups are removed. Zero means that
+	// scheduled backups are not removed because of their number.
+	BackupRetentionCount = "backup-retention-count"
+
+	// BackupRetentionAge is how long scheduled backups are kept, as a
+	// duration such as "720h". Zero means that scheduled backups are
+	// not removed because of their age.
+	BackupRetentionAge = "backup-retention-age"
+
+	// BackupEncryptionKeyFile is the absolute path, on each controller
+	// machine, of a file holding the key with which backups are
+	// encrypted when no key is supplied with the request, including
+	// scheduled backups. Backups are not encrypted by default if it is
+	// empty.
+	BackupEncryptionKeyFile = "backup-encryption-key-file"
+
 	// StatePort is the port used for mongo connections.
 	StatePort = "state-port"
 
@@ -68,6 +116,14 @@ const (
 	// AuditingEnabled config value.
 	DefaultAuditingEnabled = false
 
+	// DefaultAuditLogSinks contains the default value for the
+	// AuditLogSinks config value.
+	DefaultAuditLogSinks = "file,mongo"
+
+	// DefaultAuditLogExcludeMethods contains the default value for
+	// the AuditLogExcludeMethods config value.
+	DefaultAuditLogExcludeMethods = "ReadOnlyMethods"
+
 	// DefaultNUMAControlPolicy should not be used by default.
 	// Only use numactl if user specifically requests it
 	DefaultNUMAControlPolicy = false
@@ -84,8 +140,16 @@ const (
 var ControllerOnlyConfigAttributes = []string{
 	AllowModelAccessKey,
 	APIPort,
+	AuditLogCaptureArgs,
+	AuditLogExcludeMethods,
+	AuditLogRedactKeys,
+	AuditLogSinks,
 	AutocertDNSNameKey,
 	AutocertURLKey,
+	BackupEncryptionKeyFile,
+	BackupRetentionAge,
+	BackupRetentionCount,
+	BackupSchedule,
 	CACertKey,
 	ControllerUUIDKey,
 	IdentityPublicKey,
@@ -182,6 +246,101 @@ func (c Config) AuditingEnabled() bool {
 	return false
 }
 
+// AuditLogSinks returns the names of the sinks to which audit entries
+// should be written.
+func (c Config) AuditLogSinks() []string {
+	value, ok := c[AuditLogSinks].(string)
+	if !ok {
+		value = DefaultAuditLogSinks
+	}
+	return splitList(value)
+}
+
+// knownAuditLogSinks holds the names of the audit sinks which every
+// controller registers.
+var knownAuditLogSinks = []string{"file", "logfwd", "mongo"}
+
+func isKnownAuditLogSink(name string) bool {
+	for _, known := range knownAuditLogSinks {
+		if name == known {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditLogCaptureArgs returns whether the audit log should record
+// the redacted arguments and outcome of each audited API call.
+func (c Config) AuditLogCaptureArgs() bool {
+	value, _ := c[AuditLogCaptureArgs].(bool)
+	return value
+}
+
+// AuditLogExcludeMethods returns the names of the API calls which
+// should not be recorded when capturing arguments.
+func (c Config) AuditLogExcludeMethods() []string {
+	value, ok := c[AuditLogExcludeMethods].(string)
+	if !ok {
+		value = DefaultAuditLogExcludeMethods
+	}
+	return splitList(value)
+}
+
+// AuditLogRedactKeys returns the additional argument field name
+// fragments whose values should be redacted from the audit log.
+func (c Config) AuditLogRedactKeys() []string {
+	value, _ := c[AuditLogRedactKeys].(string)
+	return splitList(value)
+}
+
+// BackupSchedule returns the cron-style specification of when the
+// controller takes scheduled backups, or an empty string if it does
+// not take them.
+func (c Config) BackupSchedule() string {
+	return c.asString(BackupSchedule)
+}
+
+// BackupRetentionCount returns the number of scheduled backups that
+// are kept, or zero if there is no limit.
+func (c Config) BackupRetentionCount() int {
+	// Values obtained over the api are encoded as float64.
+	if value, ok := c[BackupRetentionCount].(float64); ok {
+		return int(value)
+	}
+	value, _ := c[BackupRetentionCount].(int)
+	return value
+}
+
+// BackupRetentionAge returns how long scheduled backups are kept, or
+// zero if there is no limit.
+func (c Config) BackupRetentionAge() time.Duration {
+	value := c.asString(BackupRetentionAge)
+	if value == "" {
+		return 0
+	}
+	// The value is checked by Validate.
+	age, _ := time.ParseDuration(value)
+	return age
+}
+
+// BackupEncryptionKeyFile returns the path of the file holding the
+// controller's backup encryption key, or an empty string if backups
+// are not encrypted by default.
+func (c Config) BackupEncryptionKeyFile() string {
+	return c.asString(BackupEncryptionKeyFile)
+}
+
+// splitList splits a comma-separated list, discarding empty items.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // ControllerUUID returns the uuid for the model's controller.
 func (c Config) ControllerUUID() string {
 	return c.mustString(ControllerUUIDKey)
@@ -269,6 +428,37 @@ func Validate(c Config) error {
 		}
 	}
 
+	for _, name := range c.AuditLogSinks() {
+		if !isKnownAuditLogSink(name) {
+			return errors.Errorf("%s: unknown sink %q, expected one of %s",
+				AuditLogSinks, name, strings.Join(knownAuditLogSinks, ", "))
+		}
+	}
+
+	if v, ok := c[BackupSchedule].(string); ok && v != "" {
+		if _, err := cron.Parse(v); err != nil {
+			return errors.Annotatef(err, "invalid %s", BackupSchedule)
+		}
+	}
+
+	if c.BackupRetentionCount() < 0 {
+		return errors.Errorf("%s: expected non-negative number, got %d", BackupRetentionCount, c.BackupRetentionCount())
+	}
+
+	if v, ok := c[BackupRetentionAge].(string); ok && v != "" {
+		age, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotatef(err, "invalid %s", BackupRetentionAge)
+		}
+		if age < 0 {
+			return errors.Errorf("%s: expected non-negative duration, got %q", BackupRetentionAge, v)
+		}
+	}
+
+	if v, ok := c[BackupEncryptionKeyFile].(string); ok && v != "" && !filepath.IsAbs(v) {
+		return errors.Errorf("%s: expected absolute path, got %q", BackupEncryptionKeyFile, v)
+	}
+
 	caCert, caCertOK := c.CACert()
 	if !caCertOK {
 		return errors.Errorf("missing CA certificate")
@@ -292,7 +482,15 @@ func GenerateControllerCertAndKey(caCert, caKey string, hostAddresses []string)
 
 var configChecker = schema.FieldMap(schema.Fields{
 	AuditingEnabled:         schema.Bool(),
+	AuditLogSinks:           schema.String(),
+	AuditLogCaptureArgs:     schema.Bool(),
+	AuditLogExcludeMethods:  schema.String(),
+	AuditLogRedactKeys:      schema.String(),
 	APIPort:                 schema.ForceInt(),
+	BackupSchedule:          schema.String(),
+	BackupRetentionCount:    schema.ForceInt(),
+	BackupRetentionAge:      schema.String(),
+	BackupEncryptionKeyFile: schema.String(),
 	StatePort:               schema.ForceInt(),
 	IdentityURL:             schema.String(),
 	IdentityPublicKey:       schema.String(),
@@ -303,6 +501,14 @@ var configChecker = schema.FieldMap(schema.Fields{
 }, schema.Defaults{
 	APIPort:                 DefaultAPIPort,
 	AuditingEnabled:         DefaultAuditingEnabled,
+	AuditLogSinks:           schema.Omit,
+	AuditLogCaptureArgs:     schema.Omit,
+	AuditLogExcludeMethods:  schema.Omit,
+	AuditLogRedactKeys:      schema.Omit,
+	BackupSchedule:          schema.Omit,
+	BackupRetentionCount:    schema.Omit,
+	BackupRetentionAge:      schema.Omit,
+	BackupEncryptionKeyFile: schema.Omit,
 	StatePort:               DefaultStatePort,
 	IdentityURL:             schema.Omit,
 	IdentityPublicKey:       schema.Omit,
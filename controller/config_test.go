@@ -121,6 +121,55 @@ var validateTests = []struct {
 		controller.CACertKey:         testing.CACert,
 	},
 	expectError: `invalid identity public key: wrong length for base64 key, got 3 want 32`,
+}, {
+	about: "unknown audit log sink",
+	config: controller.Config{
+		controller.AuditLogSinks: "file,syslog",
+		controller.CACertKey:     testing.CACert,
+	},
+	expectError: `audit-log-sinks: unknown sink "syslog", expected one of file, logfwd, mongo`,
+}, {
+	about: "invalid backup schedule",
+	config: controller.Config{
+		controller.BackupSchedule: "0 2 * *",
+		controller.CACertKey:      testing.CACert,
+	},
+	expectError: `invalid backup-schedule: recurrence "0 2 \* \*" \(expected 5 fields, got 4\) not valid`,
+}, {
+	about: "negative backup retention count",
+	config: controller.Config{
+		controller.BackupRetentionCount: -1,
+		controller.CACertKey:            testing.CACert,
+	},
+	expectError: `backup-retention-count: expected non-negative number, got -1`,
+}, {
+	about: "invalid backup retention age",
+	config: controller.Config{
+		controller.BackupRetentionAge: "a week",
+		controller.CACertKey:          testing.CACert,
+	},
+	expectError: `invalid backup-retention-age: time: invalid duration a week`,
+}, {
+	about: "relative backup encryption key file",
+	config: controller.Config{
+		controller.BackupEncryptionKeyFile: "backup.key",
+		controller.CACertKey:               testing.CACert,
+	},
+	expectError: `backup-encryption-key-file: expected absolute path, got "backup.key"`,
+}, {
+	about: "backup policy OK",
+	config: controller.Config{
+		controller.BackupSchedule:       "@daily",
+		controller.BackupRetentionCount: 7,
+		controller.BackupRetentionAge:   "720h",
+		controller.CACertKey:            testing.CACert,
+	},
+}, {
+	about: "backup encryption key file OK",
+	config: controller.Config{
+		controller.BackupEncryptionKeyFile: "/etc/juju/backup.key",
+		controller.CACertKey:               testing.CACert,
+	},
 }}
 
 func (s *ConfigSuite) TestValidate(c *gc.C) {
@@ -134,3 +183,54 @@ func (s *ConfigSuite) TestValidate(c *gc.C) {
 		}
 	}
 }
+
+func (s *ConfigSuite) TestAuditLogSinks(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogSinks(), jc.DeepEquals, []string{"file", "mongo"})
+
+	cfg, err = controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{
+		controller.AuditLogSinks: "mongo, logfwd,",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogSinks(), jc.DeepEquals, []string{"mongo", "logfwd"})
+}
+
+func (s *ConfigSuite) TestAuditLogPolicy(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogCaptureArgs(), jc.IsFalse)
+	c.Assert(cfg.AuditLogExcludeMethods(), jc.DeepEquals, []string{"ReadOnlyMethods"})
+	c.Assert(cfg.AuditLogRedactKeys(), gc.HasLen, 0)
+
+	cfg, err = controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{
+		controller.AuditLogCaptureArgs:    true,
+		controller.AuditLogExcludeMethods: "ReadOnlyMethods, Client.AddMachines",
+		controller.AuditLogRedactKeys:     "db-pass,api-key",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AuditLogCaptureArgs(), jc.IsTrue)
+	c.Assert(cfg.AuditLogExcludeMethods(), jc.DeepEquals, []string{"ReadOnlyMethods", "Client.AddMachines"})
+	c.Assert(cfg.AuditLogRedactKeys(), jc.DeepEquals, []string{"db-pass", "api-key"})
+}
+
+func (s *ConfigSuite) TestBackupPolicy(c *gc.C) {
+	cfg, err := controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.BackupSchedule(), gc.Equals, "")
+	c.Assert(cfg.BackupRetentionCount(), gc.Equals, 0)
+	c.Assert(cfg.BackupRetentionAge(), gc.Equals, time.Duration(0))
+	c.Assert(cfg.BackupEncryptionKeyFile(), gc.Equals, "")
+
+	cfg, err = controller.NewConfig(testing.ControllerTag.Id(), testing.CACert, map[string]interface{}{
+		controller.BackupSchedule:          "0 3 * * *",
+		controller.BackupRetentionCount:    "7",
+		controller.BackupRetentionAge:      "168h",
+		controller.BackupEncryptionKeyFile: "/etc/juju/backup.key",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.BackupEncryptionKeyFile(), gc.Equals, "/etc/juju/backup.key")
+	c.Assert(cfg.BackupSchedule(), gc.Equals, "0 3 * * *")
+	c.Assert(cfg.BackupRetentionCount(), gc.Equals, 7)
+	c.Assert(cfg.BackupRetentionAge(), gc.Equals, 168*time.Hour)
+}
@@ -0,0 +1,192 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package cron parses cron-style recurrence specifications, as used
+// to schedule recurring actions.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Schedule is a parsed recurrence specification.
+type Schedule struct {
+	spec string
+
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar record whether the day of month and day
+	// of week fields were unrestricted. As with cron, when both are
+	// restricted a time matches if either does.
+	domStar, dowStar bool
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField = field{"minute", 0, 59}
+	hourField   = field{"hour", 0, 23}
+	domField    = field{"day of month", 1, 31}
+	monthField  = field{"month", 1, 12}
+	// Both 0 and 7 are Sunday.
+	dowField = field{"day of week", 0, 7}
+)
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// Parse parses a recurrence specification. It accepts the five fields
+// of a crontab entry (minute, hour, day of month, month and day of
+// week), each of which may be "*", a number, a range such as "1-5",
+// any of those with a step such as "*/15", or a comma-separated list
+// of them. The descriptors @yearly, @monthly, @weekly, @daily and
+// @hourly are also accepted. All times are in UTC.
+func Parse(spec string) (Schedule, error) {
+	expanded := strings.TrimSpace(spec)
+	if strings.HasPrefix(expanded, "@") {
+		var ok bool
+		if expanded, ok = descriptors[expanded]; !ok {
+			return Schedule{}, errors.NotValidf("recurrence %q", spec)
+		}
+	}
+	fields := strings.Fields(expanded)
+	if len(fields) != 5 {
+		return Schedule{}, errors.NotValidf("recurrence %q (expected 5 fields, got %d)", spec, len(fields))
+	}
+	s := Schedule{
+		spec:    spec,
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}
+	var err error
+	for i, target := range []struct {
+		bits  *uint64
+		field field
+	}{
+		{&s.minute, minuteField},
+		{&s.hour, hourField},
+		{&s.dom, domField},
+		{&s.month, monthField},
+		{&s.dow, dowField},
+	} {
+		if *target.bits, err = parseField(fields[i], target.field); err != nil {
+			return Schedule{}, errors.Annotatef(err, "recurrence %q", spec)
+		}
+	}
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// String returns the specification the schedule was parsed from.
+func (s Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first time after the given time, truncated to the
+// minute, that matches the schedule. It returns the zero time if there
+// is no such time in the next five years, as for "0 0 30 2 *".
+func (s Schedule) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func has(bits uint64, n int) bool {
+	return bits&(1<<uint(n)) != 0
+}
+
+// parseField parses a comma-separated list of ranges with optional
+// steps, returning the set of values it matches.
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			if step, err = strconv.Atoi(part[i+1:]); err != nil || step <= 0 {
+				return 0, errors.NotValidf("%s step %q", f.name, part[i+1:])
+			}
+		}
+		low, high := f.min, f.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = parseValue(bounds[0], f); err != nil {
+				return 0, errors.Trace(err)
+			}
+			if high, err = parseValue(bounds[1], f); err != nil {
+				return 0, errors.Trace(err)
+			}
+			if low > high {
+				return 0, errors.NotValidf("%s range %q", f.name, rangePart)
+			}
+		default:
+			var err error
+			if low, err = parseValue(rangePart, f); err != nil {
+				return 0, errors.Trace(err)
+			}
+			if step == 1 {
+				high = low
+			}
+		}
+		for n := low; n <= high; n += step {
+			bits |= 1 << uint(n)
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(value string, f field) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, errors.NotValidf("%s %q", f.name, value)
+	}
+	return n, nil
+}
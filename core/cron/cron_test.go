@@ -0,0 +1,107 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cron_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/cron"
+)
+
+type CronSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&CronSuite{})
+
+// start is a Wednesday.
+var start = time.Date(2017, 3, 1, 10, 30, 15, 0, time.UTC)
+
+func (*CronSuite) TestNext(c *gc.C) {
+	for i, test := range []struct {
+		spec     string
+		expected time.Time
+	}{{
+		spec:     "* * * * *",
+		expected: time.Date(2017, 3, 1, 10, 31, 0, 0, time.UTC),
+	}, {
+		spec:     "*/15 * * * *",
+		expected: time.Date(2017, 3, 1, 10, 45, 0, 0, time.UTC),
+	}, {
+		spec:     "0 2 * * *",
+		expected: time.Date(2017, 3, 2, 2, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "@daily",
+		expected: time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "@hourly",
+		expected: time.Date(2017, 3, 1, 11, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "0 0 * * 7",
+		expected: time.Date(2017, 3, 5, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "30 9 * * 1-5",
+		expected: time.Date(2017, 3, 2, 9, 30, 0, 0, time.UTC),
+	}, {
+		spec:     "0 0 1,15 * *",
+		expected: time.Date(2017, 3, 15, 0, 0, 0, 0, time.UTC),
+	}, {
+		// Day of month and day of week match either.
+		spec:     "0 0 13 * 5",
+		expected: time.Date(2017, 3, 3, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "0 0 29 2 *",
+		expected: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC),
+	}, {
+		spec:     "0 0 30 2 *",
+		expected: time.Time{},
+	}} {
+		c.Logf("test %d: %s", i, test.spec)
+		schedule, err := cron.Parse(test.spec)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(schedule.String(), gc.Equals, test.spec)
+		c.Check(schedule.Next(start), gc.Equals, test.expected)
+	}
+}
+
+func (*CronSuite) TestParseInvalid(c *gc.C) {
+	for i, test := range []struct {
+		spec string
+		err  string
+	}{{
+		spec: "",
+		err:  `recurrence "" \(expected 5 fields, got 0\) not valid`,
+	}, {
+		spec: "* * * *",
+		err:  `recurrence "\* \* \* \*" \(expected 5 fields, got 4\) not valid`,
+	}, {
+		spec: "@sometimes",
+		err:  `recurrence "@sometimes" not valid`,
+	}, {
+		spec: "60 * * * *",
+		err:  `recurrence "60 \* \* \* \*": minute "60" not valid`,
+	}, {
+		spec: "* 5-2 * * *",
+		err:  `recurrence "\* 5-2 \* \* \*": hour range "5-2" not valid`,
+	}, {
+		spec: "*/0 * * * *",
+		err:  `recurrence "\*/0 \* \* \* \*": minute step "0" not valid`,
+	}, {
+		spec: "* * 0 * *",
+		err:  `recurrence "\* \* 0 \* \*": day of month "0" not valid`,
+	}, {
+		spec: "* * * jan *",
+		err:  `recurrence "\* \* \* jan \*": month "jan" not valid`,
+	}} {
+		c.Logf("test %d: %q", i, test.spec)
+		_, err := cron.Parse(test.spec)
+		c.Check(err, gc.ErrorMatches, test.err)
+		c.Check(err, jc.Satisfies, errors.IsNotValid)
+	}
+}
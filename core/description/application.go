@@ -29,6 +29,7 @@ type Application interface {
 	CharmModifiedVersion() int
 	ForceCharm() bool
 	Exposed() bool
+	ExposedCIDRs() []string
 	MinUnits() int
 
 	EndpointBindings() map[string]string
@@ -69,6 +70,8 @@ type application struct {
 	Exposed_    bool `yaml:"exposed,omitempty"`
 	MinUnits_   int  `yaml:"min-units,omitempty"`
 
+	ExposedCIDRs_ []string `yaml:"exposed-cidrs,omitempty"`
+
 	Status_        *status `yaml:"status"`
 	StatusHistory_ `yaml:"status-history"`
 
@@ -102,6 +105,7 @@ type ApplicationArgs struct {
 	CharmModifiedVersion int
 	ForceCharm           bool
 	Exposed              bool
+	ExposedCIDRs         []string
 	MinUnits             int
 	EndpointBindings     map[string]string
 	Settings             map[string]interface{}
@@ -122,6 +126,7 @@ func newApplication(args ApplicationArgs) *application {
 		CharmModifiedVersion_: args.CharmModifiedVersion,
 		ForceCharm_:           args.ForceCharm,
 		Exposed_:              args.Exposed,
+		ExposedCIDRs_:         args.ExposedCIDRs,
 		MinUnits_:             args.MinUnits,
 		EndpointBindings_:     args.EndpointBindings,
 		Settings_:             args.Settings,
@@ -186,6 +191,11 @@ func (a *application) Exposed() bool {
 	return a.Exposed_
 }
 
+// ExposedCIDRs implements Application.
+func (a *application) ExposedCIDRs() []string {
+	return a.ExposedCIDRs_
+}
+
 // MinUnits implements Application.
 func (a *application) MinUnits() int {
 	return a.MinUnits_
@@ -382,9 +392,20 @@ type applicationDeserializationFunc func(map[string]interface{}) (*application,
 
 var applicationDeserializationFuncs = map[int]applicationDeserializationFunc{
 	1: importApplicationV1,
+	2: importApplicationV2,
 }
 
 func importApplicationV1(source map[string]interface{}) (*application, error) {
+	fields, defaults := applicationV1Fields()
+	return importApplication(fields, defaults, 1, source)
+}
+
+func importApplicationV2(source map[string]interface{}) (*application, error) {
+	fields, defaults := applicationV2Fields()
+	return importApplication(fields, defaults, 2, source)
+}
+
+func applicationV1Fields() (schema.Fields, schema.Defaults) {
 	fields := schema.Fields{
 		"name":                schema.String(),
 		"series":              schema.String(),
@@ -419,11 +440,24 @@ func importApplicationV1(source map[string]interface{}) (*application, error) {
 	addAnnotationSchema(fields, defaults)
 	addConstraintsSchema(fields, defaults)
 	addStatusHistorySchema(fields)
+	return fields, defaults
+}
+
+// applicationV2Fields adds the source CIDRs to
+// which an exposed application is restricted.
+func applicationV2Fields() (schema.Fields, schema.Defaults) {
+	fields, defaults := applicationV1Fields()
+	fields["exposed-cidrs"] = schema.List(schema.String())
+	defaults["exposed-cidrs"] = schema.Omit
+	return fields, defaults
+}
+
+func importApplication(fields schema.Fields, defaults schema.Defaults, importVersion int, source map[string]interface{}) (*application, error) {
 	checker := schema.FieldMap(fields, defaults)
 
 	coerced, err := checker.Coerce(source, nil)
 	if err != nil {
-		return nil, errors.Annotatef(err, "application v1 schema check failed")
+		return nil, errors.Annotatef(err, "application v%d schema check failed", importVersion)
 	}
 	valid := coerced.(map[string]interface{})
 	// From here we know that the map returned from the schema coercion
@@ -446,6 +480,10 @@ func importApplicationV1(source map[string]interface{}) (*application, error) {
 	}
 	result.importAnnotations(valid)
 
+	if cidrs, ok := valid["exposed-cidrs"]; ok {
+		result.ExposedCIDRs_ = convertToStringSlice(cidrs)
+	}
+
 	if err := result.importStatusHistory(valid); err != nil {
 		return nil, errors.Trace(err)
 	}
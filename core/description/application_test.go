@@ -166,8 +166,12 @@ func (s *ApplicationSerializationSuite) TestMinimalMatches(c *gc.C) {
 }
 
 func (s *ApplicationSerializationSuite) exportImport(c *gc.C, application_ *application) *application {
+	return s.exportImportVersion(c, application_, 2)
+}
+
+func (s *ApplicationSerializationSuite) exportImportVersion(c *gc.C, application_ *application, version int) *application {
 	initial := applications{
-		Version:       1,
+		Version:       version,
 		Applications_: []*application{application_},
 	}
 
@@ -201,6 +205,26 @@ func (s *ApplicationSerializationSuite) TestEndpointBindings(c *gc.C) {
 	c.Assert(application.EndpointBindings(), jc.DeepEquals, args.EndpointBindings)
 }
 
+func (s *ApplicationSerializationSuite) TestExposedCIDRs(c *gc.C) {
+	args := minimalApplicationArgs()
+	args.Exposed = true
+	args.ExposedCIDRs = []string{"10.0.0.0/8", "192.168.1.0/24"}
+	initial := minimalApplication(args)
+	application := s.exportImport(c, initial)
+	c.Assert(application.Exposed(), jc.IsTrue)
+	c.Assert(application.ExposedCIDRs(), jc.DeepEquals, args.ExposedCIDRs)
+}
+
+func (s *ApplicationSerializationSuite) TestV1ParsingIgnoresExposedCIDRs(c *gc.C) {
+	args := minimalApplicationArgs()
+	args.Exposed = true
+	args.ExposedCIDRs = []string{"10.0.0.0/8"}
+	initial := minimalApplication(args)
+	application := s.exportImportVersion(c, initial, 1)
+	c.Assert(application.Exposed(), jc.IsTrue)
+	c.Assert(application.ExposedCIDRs(), gc.HasLen, 0)
+}
+
 func (s *ApplicationSerializationSuite) TestAnnotations(c *gc.C) {
 	initial := minimalApplication()
 	annotations := map[string]string{
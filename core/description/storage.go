@@ -57,7 +57,7 @@ func (s *storage) Kind() string {
 	return s.Kind_
 }
 
-// Owner implements Storage.
+// Owner implements Storage. The owner is nil if the storage is detached.
 func (s *storage) Owner() (names.Tag, error) {
 	if s.Owner_ == "" {
 		return nil, nil
@@ -88,10 +88,8 @@ func (s *storage) Validate() error {
 	if s.ID_ == "" {
 		return errors.NotValidf("storage missing id")
 	}
-	if s.Owner_ == "" {
-		return errors.NotValidf("storage %q missing owner", s.ID_)
-	}
-	// Also check that the owner and attachments are valid.
+	// Storage that has been detached from its unit has no owner, but
+	// an owner that is specified must be valid.
 	if _, err := s.Owner(); err != nil {
 		return errors.Wrap(err, errors.NotValidf("storage %q invalid owner", s.ID_))
 	}
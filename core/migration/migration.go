@@ -72,6 +72,19 @@ type ModelInfo struct {
 	Name                   string
 	AgentVersion           version.Number
 	ControllerAgentVersion version.Number
+
+	// CloudName and CloudCredential are optional. When set, the
+	// target prechecks verify that the model's cloud and credential
+	// are compatible with the target controller.
+	CloudName       string
+	CloudCredential *CredentialInfo
+}
+
+// CredentialInfo describes the cloud credential used by a model,
+// without any of its secret attributes.
+type CredentialInfo struct {
+	Tag      names.CloudCredentialTag
+	AuthType string
 }
 
 func (i *ModelInfo) Validate() error {
@@ -0,0 +1,35 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+// PrecheckProblem describes a condition found by the migration
+// prechecks that would prevent a model migration from succeeding.
+type PrecheckProblem struct {
+	// Entity identifies what the problem relates to, such as
+	// "model", "controller", "machine 0" or "unit mysql/0".
+	Entity string
+
+	// Message describes the problem.
+	Message string
+}
+
+// PrecheckReport holds the results of running every migration
+// precheck for a model, without starting the migration.
+type PrecheckReport struct {
+	// Source holds the problems found in the model and the source
+	// controller.
+	Source []PrecheckProblem
+
+	// Target holds the problems found in the target controller.
+	Target []PrecheckProblem
+
+	// ExportSize holds the size in bytes of the serialized model
+	// description that would be sent to the target controller.
+	ExportSize int64
+}
+
+// OK returns true if the report holds no problems.
+func (r *PrecheckReport) OK() bool {
+	return len(r.Source) == 0 && len(r.Target) == 0
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
@@ -22,6 +23,7 @@ import (
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/juju/osenv"
+	"github.com/juju/juju/logfwd"
 	"github.com/juju/juju/logfwd/syslog"
 )
 
@@ -121,6 +123,10 @@ const (
 	// forwarding.
 	LogFwdSyslogClientKey = "syslog-client-key"
 
+	// LogForwardSinks describes additional log forwarding sinks, in YAML.
+	// The sinks forward the logs of the model on which they are set.
+	LogForwardSinks = "logforward-sinks"
+
 	// AutomaticallyRetryHooks determines whether the uniter will
 	// automatically retry a hook that has failed
 	AutomaticallyRetryHooks = "automatically-retry-hooks"
@@ -129,6 +135,15 @@ const (
 	// metrics collected in this model for anonymized aggregate analytics.
 	TransmitVendorMetricsKey = "transmit-vendor-metrics"
 
+	// MaxActionResultsAge is the maximum age of completed actions
+	// kept in the model, as a duration such as "336h".
+	MaxActionResultsAge = "max-action-results-age"
+
+	// MaxActionResultsSize is the maximum size of the actions
+	// collection, as a size such as "5G", before the oldest completed
+	// actions are removed.
+	MaxActionResultsSize = "max-action-results-size"
+
 	//
 	// Deprecated Settings Attributes
 	//
@@ -277,6 +292,14 @@ func New(withDefaults Defaulting, attrs map[string]interface{}) (*Config, error)
 	return c, nil
 }
 
+const (
+	// DefaultActionResultsAge is the default for MaxActionResultsAge.
+	DefaultActionResultsAge = "336h" // 2 weeks
+
+	// DefaultActionResultsSize is the default for MaxActionResultsSize.
+	DefaultActionResultsSize = "5G"
+)
+
 var defaultConfigValues = map[string]interface{}{
 	// Network.
 	"firewall-mode":              FwInstance,
@@ -295,6 +318,8 @@ var defaultConfigValues = map[string]interface{}{
 	"development":              false,
 	"test-mode":                false,
 	TransmitVendorMetricsKey:   true,
+	MaxActionResultsAge:        DefaultActionResultsAge,
+	MaxActionResultsSize:       DefaultActionResultsSize,
 
 	// Image and agent streams and URLs.
 	"image-stream":       "released",
@@ -442,6 +467,35 @@ func Validate(cfg, old *Config) error {
 		}
 	}
 
+	sinks, err := cfg.LogFwdSinks()
+	if err != nil {
+		return errors.Annotate(err, "invalid log forwarding sinks")
+	}
+	for _, sink := range sinks {
+		if !sink.Type.IsSyslog() {
+			continue
+		}
+		if err := syslog.SinkRawConfig(sink).Validate(); err != nil {
+			return errors.Annotatef(err, "invalid log forwarding sink %q", sink.Name)
+		}
+	}
+
+	if v, ok := cfg.defined[MaxActionResultsAge].(string); ok {
+		age, err := time.ParseDuration(v)
+		if err != nil {
+			return errors.Annotate(err, "invalid max action results age in model configuration")
+		}
+		if age < 0 {
+			return errors.Errorf("invalid max action results age in model configuration: %q is negative", v)
+		}
+	}
+	if v, ok := cfg.defined[MaxActionResultsSize].(string); ok {
+		// ParseSize rejects negative sizes.
+		if _, err := utils.ParseSize(v); err != nil {
+			return errors.Annotate(err, "invalid max action results size in model configuration")
+		}
+	}
+
 	if uuid := cfg.UUID(); !utils.IsValidUUIDString(uuid) {
 		return errors.Errorf("uuid: expected UUID, got string(%q)", uuid)
 	}
@@ -682,6 +736,16 @@ func (c *Config) LogFwdSyslog() (*syslog.RawConfig, bool) {
 	return &lfCfg, true
 }
 
+// LogFwdSinks returns the configuration of the additional log
+// forwarding sinks, ordered by name.
+func (c *Config) LogFwdSinks() ([]logfwd.SinkConfig, error) {
+	s, ok := c.defined[LogForwardSinks].(string)
+	if !ok || s == "" {
+		return nil, nil
+	}
+	return logfwd.ParseSinkConfigs(s)
+}
+
 // FirewallMode returns whether the firewall should
 // manage ports per machine, globally, or not at all.
 // (FwInstance, FwGlobal, or FwNone).
@@ -778,6 +842,22 @@ func (c *Config) TransmitVendorMetrics() bool {
 	}
 }
 
+// MaxActionResultsAge returns the maximum age of the completed actions
+// kept in the model. Zero means that actions are not removed by age.
+func (c *Config) MaxActionResultsAge() time.Duration {
+	// Value has already been validated.
+	val, _ := time.ParseDuration(c.asString(MaxActionResultsAge))
+	return val
+}
+
+// MaxActionResultsSizeMB returns the maximum size in MiB of the actions
+// collection. Zero means that actions are not removed by size.
+func (c *Config) MaxActionResultsSizeMB() uint {
+	// Value has already been validated.
+	val, _ := utils.ParseSize(c.asString(MaxActionResultsSize))
+	return uint(val)
+}
+
 // ProvisionerHarvestMode reports the harvesting methodology the
 // provisioner should take.
 func (c *Config) ProvisionerHarvestMode() HarvestMode {
@@ -939,6 +1019,7 @@ var alwaysOptional = schema.Defaults{
 	LogFwdSyslogCACert:     schema.Omit,
 	LogFwdSyslogClientCert: schema.Omit,
 	LogFwdSyslogClientKey:  schema.Omit,
+	LogForwardSinks:        schema.Omit,
 
 	// Storage related config.
 	// Environ providers will specify their own defaults.
@@ -972,6 +1053,8 @@ var alwaysOptional = schema.Defaults{
 	AutomaticallyRetryHooks:      schema.Omit,
 	"test-mode":                  schema.Omit,
 	TransmitVendorMetricsKey:     schema.Omit,
+	MaxActionResultsAge:          schema.Omit,
+	MaxActionResultsSize:         schema.Omit,
 }
 
 func allowEmpty(attr string) bool {
@@ -1287,6 +1370,11 @@ global or per instance security groups.`,
 		Type:        environschema.Tstring,
 		Group:       environschema.EnvironGroup,
 	},
+	LogForwardSinks: {
+		Description: `Additional log forwarding sinks in YAML, keyed by name. Each sink has a type (syslog-tls, syslog-tcp, syslog-udp, http or file), its target and optional level, include-module and exclude-module filters. The sinks forward the logs of the model on which they are set. The path of a file sink is relative to the model's directory, named for its UUID, within the logforward directory in the controller's log directory.`,
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 	"ssl-hostname-verification": {
 		Description: "Whether SSL hostname verification is enabled (default true)",
 		Type:        environschema.Tbool,
@@ -1327,4 +1415,14 @@ data of the store. (default false)`,
 		Type:        environschema.Tbool,
 		Group:       environschema.EnvironGroup,
 	},
+	MaxActionResultsAge: {
+		Description: "The maximum age for completed actions to be kept, eg \"72h\" (0 to disable)",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
+	MaxActionResultsSize: {
+		Description: "The maximum size for the actions collection, eg \"5G\"; the oldest completed actions are removed beyond it (0 to disable)",
+		Type:        environschema.Tstring,
+		Group:       environschema.EnvironGroup,
+	},
 }
@@ -544,6 +544,61 @@ var configTests = []configTest{
 			"syslog-client-cert": testing.ServerCert,
 			"syslog-client-key":  testing.ServerKey,
 		}),
+	}, {
+		about:       "Valid log forwarding sinks",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-sinks": "udp: {type: syslog-udp, host: localhost}\nlocal: {type: file, path: fwd.log}\n",
+		}),
+	}, {
+		about:       "Invalid log forwarding sink",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-sinks": "local: {type: file}\n",
+		}),
+		err: `invalid log forwarding sinks: sink "local": path "" \(must be relative to the log forwarding directory\) not valid`,
+	}, {
+		about:       "Log forwarding syslog sink with bad TLS config",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"logforward-sinks": "secure: {type: syslog-tls, host: localhost}\n",
+		}),
+		err: `invalid log forwarding sink "secure": validating TLS config: .*`,
+	}, {
+		about:       "Valid action pruning limits",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-action-results-age":  "72h",
+			"max-action-results-size": "500M",
+		}),
+	}, {
+		about:       "Invalid max-action-results-age",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-action-results-age": "a week",
+		}),
+		err: `invalid max action results age in model configuration: time: invalid duration a week`,
+	}, {
+		about:       "Invalid max-action-results-size",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-action-results-size": "lots",
+		}),
+		err: `invalid max action results size in model configuration: .*`,
+	}, {
+		about:       "Negative max-action-results-age",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-action-results-age": "-1h",
+		}),
+		err: `invalid max action results age in model configuration: "-1h" is negative`,
+	}, {
+		about:       "Negative max-action-results-size",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-action-results-size": "-1G",
+		}),
+		err: `invalid max action results size in model configuration: .*`,
 	},
 }
 
@@ -1203,3 +1258,16 @@ var invalidCACert = `
 MIIBOgIBAAJAZabKgKInuOxj5vDWLwHHQtK3/45KB+32D15w94Nt83BmuGxo90lw
 -----END CERTIFICATE-----
 `[1:]
+
+func (s *ConfigSuite) TestMaxActionResults(c *gc.C) {
+	cfg := newTestConfig(c, testing.Attrs{})
+	c.Assert(cfg.MaxActionResultsAge(), gc.Equals, 336*time.Hour)
+	c.Assert(cfg.MaxActionResultsSizeMB(), gc.Equals, uint(5*1024))
+
+	cfg = newTestConfig(c, testing.Attrs{
+		"max-action-results-age":  "0",
+		"max-action-results-size": "200M",
+	})
+	c.Assert(cfg.MaxActionResultsAge(), gc.Equals, time.Duration(0))
+	c.Assert(cfg.MaxActionResultsSizeMB(), gc.Equals, uint(200))
+}
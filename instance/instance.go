@@ -54,6 +54,24 @@ type Instance interface {
 	Ports(machineId string) ([]network.PortRange, error)
 }
 
+// IngressRuleFirewaller is an interface that may be implemented by an
+// Instance whose firewall can restrict the sources from which traffic
+// to opened ports is allowed.
+type IngressRuleFirewaller interface {
+	// OpenIngressRules opens the given ingress rules on the instance,
+	// which should have been started with the given machine id.
+	OpenIngressRules(machineId string, rules []network.IngressRule) error
+
+	// CloseIngressRules closes the given ingress rules on the instance,
+	// which should have been started with the given machine id.
+	CloseIngressRules(machineId string, rules []network.IngressRule) error
+
+	// IngressRules returns the ingress rules opened on the instance,
+	// which should have been started with the given machine id. The
+	// rules are returned as sorted by network.SortIngressRules().
+	IngressRules(machineId string) ([]network.IngressRule, error)
+}
+
 // HardwareCharacteristics represents the characteristics of the instance (if known).
 // Attributes that are nil are unknown or not supported.
 type HardwareCharacteristics struct {
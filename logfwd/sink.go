@@ -0,0 +1,238 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logfwd
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"gopkg.in/yaml.v2"
+)
+
+// SinkType identifies the kind of target to which a log sink
+// forwards records.
+type SinkType string
+
+// These are the supported sink types.
+const (
+	// SinkSyslogTLS forwards records to a syslog host over TLS.
+	SinkSyslogTLS SinkType = "syslog-tls"
+
+	// SinkSyslogTCP forwards records to a syslog host over plain TCP.
+	SinkSyslogTCP SinkType = "syslog-tcp"
+
+	// SinkSyslogUDP forwards records to a syslog host over UDP.
+	SinkSyslogUDP SinkType = "syslog-udp"
+
+	// SinkHTTP posts records as JSON lines to an HTTP endpoint.
+	SinkHTTP SinkType = "http"
+
+	// SinkFile appends records as JSON lines to a local file.
+	SinkFile SinkType = "file"
+)
+
+// IsSyslog returns whether the sink forwards to a syslog host.
+func (t SinkType) IsSyslog() bool {
+	switch t {
+	case SinkSyslogTLS, SinkSyslogTCP, SinkSyslogUDP:
+		return true
+	}
+	return false
+}
+
+var validSinkName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// SinkConfig holds the configuration of a single named log sink.
+type SinkConfig struct {
+	// Name identifies the sink. It is also used to record the last
+	// record sent to the sink, so each sink resumes independently.
+	Name string
+
+	// Type is the kind of target the records are sent to.
+	Type SinkType
+
+	// Host is the host-port of the syslog host, for syslog sinks.
+	Host string
+
+	// CACert, ClientCert and ClientKey hold the TLS configuration
+	// (x.509, PEM-encoded) for syslog-tls sinks.
+	CACert     string
+	ClientCert string
+	ClientKey  string
+
+	// URL is the endpoint records are posted to, for http sinks.
+	URL string
+
+	// BatchSize is the maximum number of records posted in a single
+	// request to an http sink. If zero, a default is used.
+	BatchSize int
+
+	// MaxRetries is the number of times a failed post to an http sink
+	// is retried before giving up. If zero, a default is used.
+	MaxRetries int
+
+	// Path is the file records are appended to, for file sinks. It
+	// is relative to the model's log forwarding directory within the
+	// controller's log directory, and may not leave it.
+	Path string
+
+	// Filter selects the records sent to the sink.
+	Filter Filter
+}
+
+// Validate ensures that the config is valid.
+func (cfg SinkConfig) Validate() error {
+	if !validSinkName.MatchString(cfg.Name) {
+		return errors.NotValidf("sink name %q", cfg.Name)
+	}
+	switch {
+	case cfg.Type.IsSyslog():
+		if cfg.Host == "" {
+			return errors.NotValidf("sink %q: missing host", cfg.Name)
+		}
+	case cfg.Type == SinkHTTP:
+		u, err := url.Parse(cfg.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.NotValidf("sink %q: URL %q", cfg.Name, cfg.URL)
+		}
+	case cfg.Type == SinkFile:
+		if !validFileSinkPath(cfg.Path) {
+			return errors.NotValidf("sink %q: path %q (must be relative to the log forwarding directory)", cfg.Name, cfg.Path)
+		}
+	default:
+		return errors.NotValidf("sink %q: type %q", cfg.Name, cfg.Type)
+	}
+	if cfg.BatchSize < 0 {
+		return errors.NotValidf("sink %q: negative batch-size", cfg.Name)
+	}
+	if cfg.MaxRetries < 0 {
+		return errors.NotValidf("sink %q: negative max-retries", cfg.Name)
+	}
+	return nil
+}
+
+// validFileSinkPath reports whether p is a clean relative path which
+// does not leave the directory it is relative to.
+func validFileSinkPath(p string) bool {
+	if p == "" || path.IsAbs(p) || path.Clean(p) != p {
+		return false
+	}
+	return p != "." && p != ".." && !strings.HasPrefix(p, "../")
+}
+
+// Filter selects log records by level and module.
+type Filter struct {
+	// Level is the minimum level of the records selected. If
+	// unspecified, records of all levels are selected.
+	Level loggo.Level
+
+	// IncludeModule lists the modules whose records are selected. A
+	// module also matches its sub-modules. If empty, records from all
+	// modules are selected.
+	IncludeModule []string
+
+	// ExcludeModule lists the modules whose records are never
+	// selected, even if they match IncludeModule.
+	ExcludeModule []string
+}
+
+// Match returns whether the record is selected by the filter.
+func (f Filter) Match(rec Record) bool {
+	if f.Level != loggo.UNSPECIFIED && rec.Level < f.Level {
+		return false
+	}
+	module := rec.Location.Module
+	if len(f.IncludeModule) > 0 && !matchModule(f.IncludeModule, module) {
+		return false
+	}
+	return !matchModule(f.ExcludeModule, module)
+}
+
+func matchModule(modules []string, module string) bool {
+	for _, m := range modules {
+		if module == m || strings.HasPrefix(module, m+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// sinkConfigDoc is the YAML representation of a SinkConfig.
+type sinkConfigDoc struct {
+	Type          SinkType `yaml:"type"`
+	Host          string   `yaml:"host,omitempty"`
+	CACert        string   `yaml:"ca-cert,omitempty"`
+	ClientCert    string   `yaml:"client-cert,omitempty"`
+	ClientKey     string   `yaml:"client-key,omitempty"`
+	URL           string   `yaml:"url,omitempty"`
+	BatchSize     int      `yaml:"batch-size,omitempty"`
+	MaxRetries    int      `yaml:"max-retries,omitempty"`
+	Path          string   `yaml:"path,omitempty"`
+	Level         string   `yaml:"level,omitempty"`
+	IncludeModule []string `yaml:"include-module,omitempty"`
+	ExcludeModule []string `yaml:"exclude-module,omitempty"`
+}
+
+// ParseSinkConfigs parses the YAML description of a set of log sinks,
+// keyed by sink name, for example:
+//
+//   audit:
+//     type: syslog-tcp
+//     host: logs.example.com:514
+//     level: WARNING
+//   archive:
+//     type: file
+//     path: forwarded.log
+//     exclude-module: [juju.apiserver]
+//
+// The sinks are returned ordered by name.
+func ParseSinkConfigs(data string) ([]SinkConfig, error) {
+	var docs map[string]sinkConfigDoc
+	if err := yaml.Unmarshal([]byte(data), &docs); err != nil {
+		return nil, errors.Annotate(err, "parsing log sinks")
+	}
+	names := make([]string, 0, len(docs))
+	for name := range docs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	configs := make([]SinkConfig, len(names))
+	for i, name := range names {
+		doc := docs[name]
+		cfg := SinkConfig{
+			Name:       name,
+			Type:       doc.Type,
+			Host:       doc.Host,
+			CACert:     doc.CACert,
+			ClientCert: doc.ClientCert,
+			ClientKey:  doc.ClientKey,
+			URL:        doc.URL,
+			BatchSize:  doc.BatchSize,
+			MaxRetries: doc.MaxRetries,
+			Path:       doc.Path,
+			Filter: Filter{
+				IncludeModule: doc.IncludeModule,
+				ExcludeModule: doc.ExcludeModule,
+			},
+		}
+		if doc.Level != "" {
+			level, ok := loggo.ParseLevel(doc.Level)
+			if !ok {
+				return nil, errors.NotValidf("sink %q: level %q", name, doc.Level)
+			}
+			cfg.Filter.Level = level
+		}
+		if err := cfg.Validate(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		configs[i] = cfg
+	}
+	return configs, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logfwd_test
+
+import (
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/logfwd"
+)
+
+type SinkSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&SinkSuite{})
+
+func (s *SinkSuite) TestParseSinkConfigs(c *gc.C) {
+	configs, err := logfwd.ParseSinkConfigs(`
+udp-sink:
+  type: syslog-udp
+  host: logs.example.com
+  level: warning
+collector:
+  type: http
+  url: https://collector.example.com/logs
+  batch-size: 50
+  include-module: [juju.worker]
+  exclude-module: [juju.worker.uniter]
+`)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(configs, jc.DeepEquals, []logfwd.SinkConfig{{
+		Name:      "collector",
+		Type:      logfwd.SinkHTTP,
+		URL:       "https://collector.example.com/logs",
+		BatchSize: 50,
+		Filter: logfwd.Filter{
+			IncludeModule: []string{"juju.worker"},
+			ExcludeModule: []string{"juju.worker.uniter"},
+		},
+	}, {
+		Name: "udp-sink",
+		Type: logfwd.SinkSyslogUDP,
+		Host: "logs.example.com",
+		Filter: logfwd.Filter{
+			Level: loggo.WARNING,
+		},
+	}})
+}
+
+func (s *SinkSuite) TestParseSinkConfigsEmpty(c *gc.C) {
+	configs, err := logfwd.ParseSinkConfigs("")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(configs, gc.HasLen, 0)
+}
+
+func (s *SinkSuite) TestParseSinkConfigsInvalid(c *gc.C) {
+	for i, test := range []struct {
+		yaml string
+		err  string
+	}{{
+		yaml: "Bad_Name: {type: file, path: x.log}",
+		err:  `sink name "Bad_Name" not valid`,
+	}, {
+		yaml: "s: {type: carrier-pigeon}",
+		err:  `sink "s": type "carrier-pigeon" not valid`,
+	}, {
+		yaml: "s: {type: syslog-tcp}",
+		err:  `sink "s": missing host not valid`,
+	}, {
+		yaml: "s: {type: http, url: 'ftp://x'}",
+		err:  `sink "s": URL "ftp://x" not valid`,
+	}, {
+		yaml: "s: {type: file, path: /var/log/x.log}",
+		err:  `sink "s": path "/var/log/x.log" \(must be relative to the log forwarding directory\) not valid`,
+	}, {
+		yaml: "s: {type: file, path: ../../etc/cron.d/x}",
+		err:  `sink "s": path "../../etc/cron.d/x" \(must be relative to the log forwarding directory\) not valid`,
+	}, {
+		yaml: "s: {type: file, path: a/../../x}",
+		err:  `sink "s": path "a/../../x" \(must be relative to the log forwarding directory\) not valid`,
+	}, {
+		yaml: "s: {type: file, path: x.log, level: LOUD}",
+		err:  `sink "s": level "LOUD" not valid`,
+	}, {
+		yaml: "s: [1, 2]",
+		err:  `parsing log sinks: .*`,
+	}} {
+		c.Logf("test %d: %s", i, test.yaml)
+		_, err := logfwd.ParseSinkConfigs(test.yaml)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *SinkSuite) TestFilterMatch(c *gc.C) {
+	filter := logfwd.Filter{
+		Level:         loggo.INFO,
+		IncludeModule: []string{"juju.worker"},
+		ExcludeModule: []string{"juju.worker.uniter"},
+	}
+	for i, test := range []struct {
+		level  loggo.Level
+		module string
+		match  bool
+	}{
+		{loggo.INFO, "juju.worker", true},
+		{loggo.ERROR, "juju.worker.deployer", true},
+		{loggo.DEBUG, "juju.worker", false},
+		{loggo.INFO, "juju.workers", false},
+		{loggo.INFO, "juju.apiserver", false},
+		{loggo.INFO, "juju.worker.uniter", false},
+		{loggo.INFO, "juju.worker.uniter.operation", false},
+	} {
+		c.Logf("test %d: %v %s", i, test.level, test.module)
+		rec := logfwd.Record{
+			Level:    test.level,
+			Location: logfwd.SourceLocation{Module: test.module},
+		}
+		c.Check(filter.Match(rec), gc.Equals, test.match)
+	}
+}
+
+func (s *SinkSuite) TestZeroFilterMatchesAll(c *gc.C) {
+	var filter logfwd.Filter
+	c.Check(filter.Match(logfwd.Record{Level: loggo.TRACE}), jc.IsTrue)
+}
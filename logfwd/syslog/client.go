@@ -7,6 +7,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"net"
 	"time"
 
 	"github.com/juju/errors"
@@ -76,22 +77,37 @@ func OpenForSender(cfg RawConfig, opener SenderOpener) (*Client, error) {
 }
 
 func open(cfg RawConfig, opener SenderOpener) (Sender, error) {
-	tlsCfg, err := cfg.tlsConfig()
-	if err != nil {
-		return nil, errors.Annotate(err, "constructing TLS config")
-	}
-
 	var timeout time.Duration
-	dial, err := opener.DialFunc(tlsCfg, timeout)
-	if err != nil {
-		return nil, errors.Annotate(err, "obtaining dialer")
+	var dial rfc5424.DialFunc
+	if cfg.isTLS() {
+		tlsCfg, err := cfg.tlsConfig()
+		if err != nil {
+			return nil, errors.Annotate(err, "constructing TLS config")
+		}
+		dial, err = opener.DialFunc(tlsCfg, timeout)
+		if err != nil {
+			return nil, errors.Annotate(err, "obtaining dialer")
+		}
+	} else {
+		dial = plainDialFunc(cfg.Network, timeout)
 	}
 
 	var clientCfg rfc5424.ClientConfig
-	client, err := opener.Open(cfg.Host, clientCfg, dial)
+	client, err := opener.Open(cfg.address(), clientCfg, dial)
 	return client, errors.Annotate(err, "opening client connection")
 }
 
+// plainDialFunc returns a dial function that opens an unencrypted
+// connection over the given network, regardless of the network
+// requested by the caller.
+func plainDialFunc(network string, timeout time.Duration) rfc5424.DialFunc {
+	dialer := &net.Dialer{Timeout: timeout}
+	return func(_, address string) (rfc5424.Conn, error) {
+		conn, err := dialer.Dial(network, address)
+		return conn, errors.Trace(err)
+	}
+}
+
 // Close closes the client's connection.
 func (client Client) Close() error {
 	err := client.Sender.Close()
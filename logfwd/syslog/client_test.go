@@ -69,6 +69,30 @@ func (s *ClientSuite) TestOpen(c *gc.C) {
 	c.Check(client.Sender, gc.Equals, s.sender)
 }
 
+func (s *ClientSuite) TestOpenPlain(c *gc.C) {
+	for _, network := range []string{"tcp", "udp"} {
+		c.Logf("network %q", network)
+		s.stub.ResetCalls()
+		cfg := syslog.RawConfig{
+			Enabled: true,
+			Host:    "a.b.c",
+			Network: network,
+		}
+		senderOpener := &stubSenderOpener{
+			stub:       s.stub,
+			ReturnOpen: s.sender,
+		}
+
+		client, err := syslog.OpenForSender(cfg, senderOpener)
+		c.Assert(err, jc.ErrorIsNil)
+
+		// No TLS dialer is requested, and the default port is used.
+		s.stub.CheckCallNames(c, "Open")
+		c.Check(s.stub.Calls()[0].Args[0], gc.Equals, "a.b.c:514")
+		c.Check(client.Sender, gc.Equals, s.sender)
+	}
+}
+
 func (s *ClientSuite) TestClose(c *gc.C) {
 	client := syslog.Client{Sender: s.sender}
 
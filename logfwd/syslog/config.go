@@ -10,6 +10,21 @@ import (
 
 	"github.com/juju/errors"
 	"github.com/juju/utils/cert"
+
+	"github.com/juju/juju/logfwd"
+)
+
+// The networks over which syslog messages may be sent.
+const (
+	// NetworkTLS sends messages over a TLS connection. It is the
+	// default when no network is specified.
+	NetworkTLS = "tls"
+
+	// NetworkTCP sends messages over a plain TCP connection.
+	NetworkTCP = "tcp"
+
+	// NetworkUDP sends messages as UDP datagrams.
+	NetworkUDP = "udp"
 )
 
 // RawConfig holds the raw configuration data for a connection to a
@@ -23,9 +38,14 @@ type RawConfig struct {
 	//   [domain-or-ip-addr] or [domain-or-ip-addr][:port]
 	//
 	// If the port is not set then the default TLS port (6514) will
-	// be used.
+	// be used, or the default syslog port (514) for plain TCP and UDP.
 	Host string
 
+	// Network is the network over which messages are sent. It must be
+	// one of NetworkTLS, NetworkTCP or NetworkUDP. If empty, TLS is
+	// used.
+	Network string
+
 	// CACert is the TLS CA certificate (x.509, PEM-encoded) to use
 	// for validating the server certificate when connecting.
 	CACert string
@@ -45,6 +65,15 @@ func (cfg RawConfig) Validate() error {
 		return errors.Trace(err)
 	}
 
+	switch cfg.Network {
+	case "", NetworkTLS:
+	case NetworkTCP, NetworkUDP:
+		// No TLS config is needed for plain connections.
+		return nil
+	default:
+		return errors.NotValidf("Network %q", cfg.Network)
+	}
+
 	if cfg.Enabled || cfg.ClientKey != "" || cfg.ClientCert != "" || cfg.CACert != "" {
 		if _, err := cfg.tlsConfig(); err != nil {
 			return errors.Annotate(err, "validating TLS config")
@@ -64,6 +93,24 @@ func (cfg RawConfig) validateHost() error {
 	return nil
 }
 
+// isTLS returns whether messages are sent over TLS.
+func (cfg RawConfig) isTLS() bool {
+	return cfg.Network == "" || cfg.Network == NetworkTLS
+}
+
+// address returns the host-port to connect to, adding the default
+// port for the network if none is set.
+func (cfg RawConfig) address() string {
+	if _, _, err := net.SplitHostPort(cfg.Host); err == nil {
+		return cfg.Host
+	}
+	if cfg.isTLS() {
+		// The TLS client supplies its own default port.
+		return cfg.Host
+	}
+	return net.JoinHostPort(cfg.Host, "514")
+}
+
 func (cfg RawConfig) tlsConfig() (*tls.Config, error) {
 	clientCert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
 	if err != nil {
@@ -82,3 +129,24 @@ func (cfg RawConfig) tlsConfig() (*tls.Config, error) {
 		RootCAs:      rootCAs,
 	}, nil
 }
+
+// SinkRawConfig returns the syslog config for the given log sink,
+// which must be one of the syslog sink types.
+func SinkRawConfig(sink logfwd.SinkConfig) RawConfig {
+	cfg := RawConfig{
+		Enabled:    true,
+		Host:       sink.Host,
+		CACert:     sink.CACert,
+		ClientCert: sink.ClientCert,
+		ClientKey:  sink.ClientKey,
+	}
+	switch sink.Type {
+	case logfwd.SinkSyslogTCP:
+		cfg.Network = NetworkTCP
+	case logfwd.SinkSyslogUDP:
+		cfg.Network = NetworkUDP
+	default:
+		cfg.Network = NetworkTLS
+	}
+	return cfg
+}
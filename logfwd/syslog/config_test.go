@@ -45,6 +45,33 @@ func (s *ConfigSuite) TestRawValidateWithoutPort(c *gc.C) {
 	c.Check(err, jc.ErrorIsNil)
 }
 
+func (s *ConfigSuite) TestRawValidatePlainNetworks(c *gc.C) {
+	for _, network := range []string{"tcp", "udp"} {
+		c.Logf("network %q", network)
+		cfg := syslog.RawConfig{
+			Enabled: true,
+			Host:    "a.b.c",
+			Network: network,
+		}
+
+		err := cfg.Validate()
+
+		c.Check(err, jc.ErrorIsNil)
+	}
+}
+
+func (s *ConfigSuite) TestRawValidateBadNetwork(c *gc.C) {
+	cfg := syslog.RawConfig{
+		Enabled: true,
+		Host:    "a.b.c",
+		Network: "sctp",
+	}
+
+	err := cfg.Validate()
+
+	c.Check(err, gc.ErrorMatches, `Network "sctp" not valid`)
+}
+
 func (s *ConfigSuite) TestRawValidateZeroValue(c *gc.C) {
 	var cfg syslog.RawConfig
 	err := cfg.Validate()
@@ -34,6 +34,8 @@ type PrecheckBackend interface {
 	ControllerBackend() (PrecheckBackendCloser, error)
 	CloudCredential(tag names.CloudCredentialTag) (cloud.Credential, error)
 	ListPendingResources(string) ([]resource.Resource, error)
+	ControllerCloudName() (string, error)
+	UserExists(names.UserTag) (bool, error)
 }
 
 // PrecheckBackendCloser adds the Close method to the standard
@@ -92,22 +94,39 @@ type PrecheckUnit interface {
 // sure that the preconditions for model migration are met. The
 // backend provided must be for the model to be migrated.
 func SourcePrecheck(backend PrecheckBackend) error {
-	if err := checkModel(backend); err != nil {
+	return errors.Trace(sourcePrecheck(&prechecker{failFast: true}, backend))
+}
+
+// SourcePrecheckProblems runs every check made by SourcePrecheck and
+// returns all the problems found, rather than stopping at the first
+// one. An error is only returned if the checks could not be run.
+func SourcePrecheckProblems(backend PrecheckBackend) ([]coremigration.PrecheckProblem, error) {
+	p := &prechecker{}
+	if err := sourcePrecheck(p, backend); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return p.problems, nil
+}
+
+func sourcePrecheck(p *prechecker, backend PrecheckBackend) error {
+	if err := p.checkModel(backend); err != nil {
 		return errors.Trace(err)
 	}
 
-	if err := checkMachines(backend); err != nil {
+	if err := p.checkMachines(backend); err != nil {
 		return errors.Trace(err)
 	}
 
-	if err := checkApplications(backend); err != nil {
+	if err := p.checkApplications(backend); err != nil {
 		return errors.Trace(err)
 	}
 
 	if cleanupNeeded, err := backend.NeedsCleanup(); err != nil {
 		return errors.Annotate(err, "checking cleanups")
 	} else if cleanupNeeded {
-		return errors.New("cleanup needed")
+		if err := p.fail("model", "cleanup needed"); err != nil {
+			return errors.Trace(err)
+		}
 	}
 
 	// Check the source controller.
@@ -116,22 +135,80 @@ func SourcePrecheck(backend PrecheckBackend) error {
 		return errors.Trace(err)
 	}
 	defer controllerBackend.Close()
-	if err := checkController(controllerBackend); err != nil {
+	if err := p.withContext("controller").checkController(controllerBackend); err != nil {
 		return errors.Annotate(err, "controller")
 	}
 	return nil
 }
 
-func checkModel(backend PrecheckBackend) error {
+// prechecker runs the migration prechecks. When failFast is set, the
+// first problem found is returned as an error. Otherwise each problem
+// is recorded and the checks carry on.
+type prechecker struct {
+	failFast bool
+
+	// context, if set, is the entity all problems are recorded
+	// against, with the context prefixed to the message.
+	context string
+	parent  *prechecker
+
+	problems []coremigration.PrecheckProblem
+}
+
+// withContext returns a prechecker that records problems in this one
+// against the given context entity.
+func (p *prechecker) withContext(context string) *prechecker {
+	return &prechecker{
+		failFast: p.failFast,
+		context:  context,
+		parent:   p,
+	}
+}
+
+// fail reports a problem with the given entity. It returns an error
+// if checking should stop.
+func (p *prechecker) fail(entity, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if p.failFast {
+		return errors.New(msg)
+	}
+	p.record(entity, msg)
+	return nil
+}
+
+func (p *prechecker) record(entity, msg string) {
+	if p.parent != nil {
+		p.parent.record(p.context, p.context+": "+msg)
+		return
+	}
+	p.problems = append(p.problems, coremigration.PrecheckProblem{
+		Entity:  entity,
+		Message: msg,
+	})
+}
+
+func (p *prechecker) failStatus(entity, format, id string, s status.Status) error {
+	msg := fmt.Sprintf(format, id)
+	if s != status.Empty {
+		msg += fmt.Sprintf(" (%s)", s)
+	}
+	return p.fail(entity, "%s", msg)
+}
+
+func (p *prechecker) checkModel(backend PrecheckBackend) error {
 	model, err := backend.Model()
 	if err != nil {
 		return errors.Annotate(err, "retrieving model")
 	}
 	if model.Life() != state.Alive {
-		return errors.Errorf("model is %s", model.Life())
+		if err := p.fail("model", "model is %s", model.Life()); err != nil {
+			return err
+		}
 	}
 	if model.MigrationMode() == state.MigrationModeImporting {
-		return errors.New("model is being imported as part of another migration")
+		if err := p.fail("model", "model is being imported as part of another migration"); err != nil {
+			return err
+		}
 	}
 	if credTag, found := model.CloudCredential(); found {
 		creds, err := backend.CloudCredential(credTag)
@@ -139,7 +216,9 @@ func checkModel(backend PrecheckBackend) error {
 			return errors.Trace(err)
 		}
 		if creds.Revoked {
-			return errors.New("model has revoked credentials")
+			if err := p.fail("credential "+credTag.Id(), "model has revoked credentials"); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -149,6 +228,21 @@ func checkModel(backend PrecheckBackend) error {
 // sure that the preconditions for model migration are met. The
 // backend provided must be for the target controller.
 func TargetPrecheck(backend PrecheckBackend, modelInfo coremigration.ModelInfo) error {
+	return errors.Trace(targetPrecheck(&prechecker{failFast: true}, backend, modelInfo))
+}
+
+// TargetPrecheckProblems runs every check made by TargetPrecheck and
+// returns all the problems found, rather than stopping at the first
+// one. An error is only returned if the checks could not be run.
+func TargetPrecheckProblems(backend PrecheckBackend, modelInfo coremigration.ModelInfo) ([]coremigration.PrecheckProblem, error) {
+	p := &prechecker{}
+	if err := targetPrecheck(p, backend, modelInfo); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return p.problems, nil
+}
+
+func targetPrecheck(p *prechecker, backend PrecheckBackend, modelInfo coremigration.ModelInfo) error {
 	if err := modelInfo.Validate(); err != nil {
 		return errors.Trace(err)
 	}
@@ -163,7 +257,9 @@ func TargetPrecheck(backend PrecheckBackend, modelInfo coremigration.ModelInfo)
 	if migrating, err := backend.IsMigrationActive(modelInfo.UUID); err != nil {
 		return errors.Annotate(err, "checking for active migration")
 	} else if migrating {
-		return errors.New("model is being migrated out of target controller")
+		if err := p.fail("model", "model is being migrated out of target controller"); err != nil {
+			return err
+		}
 	}
 
 	controllerVersion, err := backend.AgentVersion()
@@ -172,16 +268,20 @@ func TargetPrecheck(backend PrecheckBackend, modelInfo coremigration.ModelInfo)
 	}
 
 	if controllerVersion.Compare(modelInfo.AgentVersion) < 0 {
-		return errors.Errorf("model has higher version than target controller (%s > %s)",
-			modelInfo.AgentVersion, controllerVersion)
+		if err := p.fail("controller", "model has higher version than target controller (%s > %s)",
+			modelInfo.AgentVersion, controllerVersion); err != nil {
+			return err
+		}
 	}
 
 	if !controllerVersionCompatible(modelInfo.ControllerAgentVersion, controllerVersion) {
-		return errors.Errorf("source controller has higher version than target controller (%s > %s)",
-			modelInfo.ControllerAgentVersion, controllerVersion)
+		if err := p.fail("controller", "source controller has higher version than target controller (%s > %s)",
+			modelInfo.ControllerAgentVersion, controllerVersion); err != nil {
+			return err
+		}
 	}
 
-	if err := checkController(backend); err != nil {
+	if err := p.checkController(backend); err != nil {
 		return errors.Trace(err)
 	}
 
@@ -195,13 +295,76 @@ func TargetPrecheck(backend PrecheckBackend, modelInfo coremigration.ModelInfo)
 		// from a previous migration attempt. It will be removed
 		// before the next import.
 		if model.UUID() == modelInfo.UUID && model.MigrationMode() != state.MigrationModeImporting {
-			return errors.Errorf("model with same UUID already exists (%s)", modelInfo.UUID)
+			if err := p.fail("model", "model with same UUID already exists (%s)", modelInfo.UUID); err != nil {
+				return err
+			}
 		}
 		if model.Name() == modelInfo.Name && model.Owner() == modelInfo.Owner {
-			return errors.Errorf("model named %q already exists", model.Name())
+			if err := p.fail("model", "model named %q already exists", model.Name()); err != nil {
+				return err
+			}
 		}
 	}
 
+	// The import of the model checks that its owner, cloud and
+	// credential are usable in the target controller. Those checks
+	// are made here too when reporting every problem up front.
+	if !p.failFast {
+		if err := p.checkModelCompatible(backend, modelInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// checkModelCompatible checks that the model's owner, cloud and cloud
+// credential can be recreated in the target controller.
+func (p *prechecker) checkModelCompatible(backend PrecheckBackend, modelInfo coremigration.ModelInfo) error {
+	if modelInfo.Owner.IsLocal() {
+		if exists, err := backend.UserExists(modelInfo.Owner); err != nil {
+			return errors.Annotatef(err, "retrieving user %s", modelInfo.Owner.Id())
+		} else if !exists {
+			if err := p.fail("user "+modelInfo.Owner.Id(),
+				"model owner %s does not exist in target controller", modelInfo.Owner.Id()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if modelInfo.CloudName != "" {
+		cloudName, err := backend.ControllerCloudName()
+		if err != nil {
+			return errors.Annotate(err, "retrieving controller cloud")
+		}
+		if cloudName != modelInfo.CloudName {
+			if err := p.fail("controller", "model cloud %s does not match target controller cloud %s",
+				modelInfo.CloudName, cloudName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cred := modelInfo.CloudCredential; cred != nil {
+		credID := cred.Tag.Id()
+		existing, err := backend.CloudCredential(cred.Tag)
+		if errors.IsNotFound(err) {
+			// The credential will be added by the import.
+			return nil
+		} else if err != nil {
+			return errors.Annotatef(err, "retrieving credential %s", credID)
+		}
+		if string(existing.AuthType()) != cred.AuthType {
+			if err := p.fail("credential "+credID, "credential %s auth type mismatch: %q != %q",
+				credID, existing.AuthType(), cred.AuthType); err != nil {
+				return err
+			}
+		}
+		if existing.Revoked {
+			if err := p.fail("credential "+credID, "credential %s is revoked in target controller", credID); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -222,26 +385,30 @@ func versionToMajMin(ver version.Number) version.Number {
 	return ver
 }
 
-func checkController(backend PrecheckBackend) error {
+func (p *prechecker) checkController(backend PrecheckBackend) error {
 	model, err := backend.Model()
 	if err != nil {
 		return errors.Annotate(err, "retrieving model")
 	}
 	if model.Life() != state.Alive {
-		return errors.Errorf("model is %s", model.Life())
+		if err := p.fail("controller", "model is %s", model.Life()); err != nil {
+			return err
+		}
 	}
 
 	if upgrading, err := backend.IsUpgrading(); err != nil {
 		return errors.Annotate(err, "checking for upgrades")
 	} else if upgrading {
-		return errors.New("upgrade in progress")
+		if err := p.fail("controller", "upgrade in progress"); err != nil {
+			return err
+		}
 	}
 
-	err = checkMachines(backend)
+	err = p.checkMachines(backend)
 	return errors.Trace(err)
 }
 
-func checkMachines(backend PrecheckBackend) error {
+func (p *prechecker) checkMachines(backend PrecheckBackend) error {
 	modelVersion, err := backend.AgentVersion()
 	if err != nil {
 		return errors.Annotate(err, "retrieving model version")
@@ -252,37 +419,48 @@ func checkMachines(backend PrecheckBackend) error {
 		return errors.Annotate(err, "retrieving machines")
 	}
 	for _, machine := range machines {
-		if machine.Life() != state.Alive {
-			return errors.Errorf("machine %s is %s", machine.Id(), machine.Life())
+		if err := p.checkMachine(machine, modelVersion); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if statusInfo, err := machine.InstanceStatus(); err != nil {
-			return errors.Annotatef(err, "retrieving machine %s instance status", machine.Id())
-		} else if statusInfo.Status != status.Running {
-			return newStatusError("machine %s not running", machine.Id(), statusInfo.Status)
-		}
+func (p *prechecker) checkMachine(machine PrecheckMachine, modelVersion version.Number) error {
+	entity := "machine " + machine.Id()
+	if machine.Life() != state.Alive {
+		return p.fail(entity, "machine %s is %s", machine.Id(), machine.Life())
+	}
 
-		if statusInfo, err := common.MachineStatus(machine); err != nil {
-			return errors.Annotatef(err, "retrieving machine %s status", machine.Id())
-		} else if statusInfo.Status != status.Started {
-			return newStatusError("machine %s agent not functioning at this time",
-				machine.Id(), statusInfo.Status)
+	if statusInfo, err := machine.InstanceStatus(); err != nil {
+		return errors.Annotatef(err, "retrieving machine %s instance status", machine.Id())
+	} else if statusInfo.Status != status.Running {
+		if err := p.failStatus(entity, "machine %s not running", machine.Id(), statusInfo.Status); err != nil {
+			return err
 		}
+	}
 
-		if rebootAction, err := machine.ShouldRebootOrShutdown(); err != nil {
-			return errors.Annotatef(err, "retrieving machine %s reboot status", machine.Id())
-		} else if rebootAction != state.ShouldDoNothing {
-			return errors.Errorf("machine %s is scheduled to %s", machine.Id(), rebootAction)
+	if statusInfo, err := common.MachineStatus(machine); err != nil {
+		return errors.Annotatef(err, "retrieving machine %s status", machine.Id())
+	} else if statusInfo.Status != status.Started {
+		if err := p.failStatus(entity, "machine %s agent not functioning at this time",
+			machine.Id(), statusInfo.Status); err != nil {
+			return err
 		}
+	}
 
-		if err := checkAgentTools(modelVersion, machine, "machine "+machine.Id()); err != nil {
-			return errors.Trace(err)
+	if rebootAction, err := machine.ShouldRebootOrShutdown(); err != nil {
+		return errors.Annotatef(err, "retrieving machine %s reboot status", machine.Id())
+	} else if rebootAction != state.ShouldDoNothing {
+		if err := p.fail(entity, "machine %s is scheduled to %s", machine.Id(), rebootAction); err != nil {
+			return err
 		}
 	}
-	return nil
+
+	return errors.Trace(p.checkAgentTools(modelVersion, machine, entity))
 }
 
-func checkApplications(backend PrecheckBackend) error {
+func (p *prechecker) checkApplications(backend PrecheckBackend) error {
 	modelVersion, err := backend.AgentVersion()
 	if err != nil {
 		return errors.Annotate(err, "retrieving model version")
@@ -292,10 +470,14 @@ func checkApplications(backend PrecheckBackend) error {
 		return errors.Annotate(err, "retrieving applications")
 	}
 	for _, app := range apps {
+		entity := "application " + app.Name()
 		if app.Life() != state.Alive {
-			return errors.Errorf("application %s is %s", app.Name(), app.Life())
+			if err := p.fail(entity, "application %s is %s", app.Name(), app.Life()); err != nil {
+				return err
+			}
+			continue
 		}
-		err := checkUnits(app, modelVersion)
+		err := p.checkUnits(app, modelVersion)
 		if err != nil {
 			return errors.Trace(err)
 		}
@@ -304,47 +486,57 @@ func checkApplications(backend PrecheckBackend) error {
 		if err != nil {
 			return errors.Annotate(err, "checking resources")
 		}
-		if len(resources) > 0 {
-			resName := resources[0].Name
-			return errors.Errorf("resource %q is pending for application %s", resName, app.Name())
+		for _, res := range resources {
+			if err := p.fail(entity, "resource %q is pending for application %s", res.Name, app.Name()); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func checkUnits(app PrecheckApplication, modelVersion version.Number) error {
+func (p *prechecker) checkUnits(app PrecheckApplication, modelVersion version.Number) error {
 	units, err := app.AllUnits()
 	if err != nil {
 		return errors.Annotatef(err, "retrieving units for %s", app.Name())
 	}
 	if len(units) < app.MinUnits() {
-		return errors.Errorf("application %s is below its minimum units threshold", app.Name())
+		if err := p.fail("application "+app.Name(),
+			"application %s is below its minimum units threshold", app.Name()); err != nil {
+			return err
+		}
 	}
 
 	appCharmURL, _ := app.CharmURL()
 
 	for _, unit := range units {
+		entity := "unit " + unit.Name()
 		if unit.Life() != state.Alive {
-			return errors.Errorf("unit %s is %s", unit.Name(), unit.Life())
+			if err := p.fail(entity, "unit %s is %s", unit.Name(), unit.Life()); err != nil {
+				return err
+			}
+			continue
 		}
 
-		if err := checkUnitAgentStatus(unit); err != nil {
+		if err := p.checkUnitAgentStatus(unit); err != nil {
 			return errors.Trace(err)
 		}
 
-		if err := checkAgentTools(modelVersion, unit, "unit "+unit.Name()); err != nil {
+		if err := p.checkAgentTools(modelVersion, unit, entity); err != nil {
 			return errors.Trace(err)
 		}
 
 		unitCharmURL, _ := unit.CharmURL()
 		if appCharmURL.String() != unitCharmURL.String() {
-			return errors.Errorf("unit %s is upgrading", unit.Name())
+			if err := p.fail(entity, "unit %s is upgrading", unit.Name()); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
-func checkUnitAgentStatus(unit PrecheckUnit) error {
+func (p *prechecker) checkUnitAgentStatus(unit PrecheckUnit) error {
 	statusData, _ := common.UnitStatus(unit)
 	if statusData.Err != nil {
 		return errors.Annotatef(statusData.Err, "retrieving unit %s status", unit.Name())
@@ -354,19 +546,21 @@ func checkUnitAgentStatus(unit PrecheckUnit) error {
 	case status.Idle, status.Executing:
 		// These two are fine.
 	default:
-		return newStatusError("unit %s not idle or executing", unit.Name(), agentStatus)
+		return p.failStatus("unit "+unit.Name(), "unit %s not idle or executing", unit.Name(), agentStatus)
 	}
 	return nil
 }
 
-func checkAgentTools(modelVersion version.Number, agent agentToolsGetter, agentLabel string) error {
+func (p *prechecker) checkAgentTools(modelVersion version.Number, agent agentToolsGetter, agentLabel string) error {
 	tools, err := agent.AgentTools()
-	if err != nil {
+	if errors.IsNotFound(err) {
+		return p.fail(agentLabel, "%s has no agent tools", agentLabel)
+	} else if err != nil {
 		return errors.Annotatef(err, "retrieving tools for %s", agentLabel)
 	}
 	agentVersion := tools.Version.Number
 	if agentVersion != modelVersion {
-		return errors.Errorf("%s tools don't match model (%s != %s)",
+		return p.fail(agentLabel, "%s tools don't match model (%s != %s)",
 			agentLabel, agentVersion, modelVersion)
 	}
 	return nil
@@ -375,11 +569,3 @@ func checkAgentTools(modelVersion version.Number, agent agentToolsGetter, agentL
 type agentToolsGetter interface {
 	AgentTools() (*tools.Tools, error)
 }
-
-func newStatusError(format, id string, s status.Status) error {
-	msg := fmt.Sprintf(format, id)
-	if s != status.Empty {
-		msg += fmt.Sprintf(" (%s)", s)
-	}
-	return errors.New(msg)
-}
@@ -6,6 +6,7 @@ package migration
 import (
 	"github.com/juju/errors"
 	"github.com/juju/version"
+	"gopkg.in/juju/names.v2"
 
 	"github.com/juju/juju/resource"
 	"github.com/juju/juju/state"
@@ -105,6 +106,26 @@ func (s *precheckShim) ListPendingResources(app string) ([]resource.Resource, er
 	return resources, nil
 }
 
+// ControllerCloudName implements PrecheckBackend.
+func (s *precheckShim) ControllerCloudName() (string, error) {
+	info, err := s.State.ControllerInfo()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return info.CloudName, nil
+}
+
+// UserExists implements PrecheckBackend.
+func (s *precheckShim) UserExists(tag names.UserTag) (bool, error) {
+	_, err := s.State.User(tag)
+	if errors.IsNotFound(err) || errors.IsUserNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return true, nil
+}
+
 // ControllerBackend implements PrecheckBackend.
 func (s *precheckShim) ControllerBackend() (PrecheckBackendCloser, error) {
 	model, err := s.State.ControllerModel()
@@ -316,6 +316,62 @@ func (s *SourcePrecheckSuite) TestProvisioningControllerMachine(c *gc.C) {
 	c.Assert(err.Error(), gc.Equals, "controller: machine 0 not running (allocating)")
 }
 
+func (s *SourcePrecheckSuite) TestProblemsSuccess(c *gc.C) {
+	backend := newHappyBackend()
+	backend.controllerBackend = newHappyBackend()
+	problems, err := migration.SourcePrecheckProblems(backend)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 0)
+}
+
+func (s *SourcePrecheckSuite) TestProblemsReportsAll(c *gc.C) {
+	backend := &fakeBackend{
+		cleanupNeeded: true,
+		machines: []migration.PrecheckMachine{
+			&fakeMachine{id: "0", life: state.Dying},
+			&fakeMachine{id: "1", version: version.MustParseBinary("1.3.1-xenial-amd64")},
+		},
+		apps: []migration.PrecheckApplication{
+			&fakeApp{
+				name:     "spanner",
+				charmURL: "cs:spanner-3",
+				units: []migration.PrecheckUnit{
+					&fakeUnit{name: "spanner/0", charmURL: "cs:spanner-2", lost: true},
+				},
+			},
+		},
+		controllerBackend: &fakeBackend{isUpgrading: true},
+	}
+	problems, err := migration.SourcePrecheckProblems(backend)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, jc.DeepEquals, []coremigration.PrecheckProblem{{
+		Entity:  "machine 0",
+		Message: "machine 0 is dying",
+	}, {
+		Entity:  "machine 1",
+		Message: "machine 1 tools don't match model (1.3.1 != 1.2.3)",
+	}, {
+		Entity:  "unit spanner/0",
+		Message: "unit spanner/0 not idle or executing (lost)",
+	}, {
+		Entity:  "unit spanner/0",
+		Message: "unit spanner/0 is upgrading",
+	}, {
+		Entity:  "model",
+		Message: "cleanup needed",
+	}, {
+		Entity:  "controller",
+		Message: "controller: upgrade in progress",
+	}})
+}
+
+func (s *SourcePrecheckSuite) TestProblemsError(c *gc.C) {
+	backend := newFakeBackend()
+	backend.allMachinesErr = errors.New("boom")
+	_, err := migration.SourcePrecheckProblems(backend)
+	c.Assert(err, gc.ErrorMatches, "retrieving machines: boom")
+}
+
 type TargetPrecheckSuite struct {
 	precheckBaseSuite
 	modelInfo coremigration.ModelInfo
@@ -523,6 +579,69 @@ func (s *TargetPrecheckSuite) TestUUIDAlreadyExistsButImporting(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *TargetPrecheckSuite) TestProblemsSuccess(c *gc.C) {
+	problems, err := migration.TargetPrecheckProblems(newHappyBackend(), s.modelInfo)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 0)
+}
+
+func (s *TargetPrecheckSuite) TestProblemsReportsAll(c *gc.C) {
+	backend := newBackendWithDyingMachine()
+	backend.models = []migration.PrecheckModel{
+		&fakeModel{name: modelName, owner: modelOwner},
+	}
+	backend.controllerCloud = "aws"
+	backend.missingUsers = []names.UserTag{modelOwner}
+	backend.credentials = cloud.NewCredential(cloud.AccessKeyAuthType, nil)
+
+	s.modelInfo.AgentVersion = version.MustParse("1.2.4")
+	s.modelInfo.CloudName = "dummy"
+	s.modelInfo.CloudCredential = &coremigration.CredentialInfo{
+		Tag:      names.NewCloudCredentialTag("dummy/owner/default"),
+		AuthType: string(cloud.UserPassAuthType),
+	}
+	problems, err := migration.TargetPrecheckProblems(backend, s.modelInfo)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, jc.DeepEquals, []coremigration.PrecheckProblem{{
+		Entity:  "controller",
+		Message: "model has higher version than target controller (1.2.4 > 1.2.3)",
+	}, {
+		Entity:  "machine 0",
+		Message: "machine 0 is dying",
+	}, {
+		Entity:  "model",
+		Message: `model named "model-name" already exists`,
+	}, {
+		Entity:  "user owner",
+		Message: "model owner owner does not exist in target controller",
+	}, {
+		Entity:  "controller",
+		Message: "model cloud dummy does not match target controller cloud aws",
+	}, {
+		Entity:  "credential dummy/owner/default",
+		Message: `credential dummy/owner/default auth type mismatch: "access-key" != "userpass"`,
+	}})
+}
+
+func (s *TargetPrecheckSuite) TestProblemsIgnoresMissingCredential(c *gc.C) {
+	backend := newHappyBackend()
+	backend.credentialsErr = errors.NotFoundf("credential")
+	s.modelInfo.CloudCredential = &coremigration.CredentialInfo{
+		Tag:      names.NewCloudCredentialTag("dummy/owner/default"),
+		AuthType: string(cloud.UserPassAuthType),
+	}
+	problems, err := migration.TargetPrecheckProblems(backend, s.modelInfo)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(problems, gc.HasLen, 0)
+}
+
+func (s *TargetPrecheckSuite) TestMissingOwnerNotCheckedUpFront(c *gc.C) {
+	backend := newHappyBackend()
+	backend.missingUsers = []names.UserTag{modelOwner}
+	err := migration.TargetPrecheck(backend, s.modelInfo)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 type precheckRunner func(migration.PrecheckBackend) error
 
 type precheckBaseSuite struct {
@@ -655,6 +774,9 @@ type fakeBackend struct {
 	pendingResources    []resource.Resource
 	pendingResourcesErr error
 
+	controllerCloud string
+	missingUsers    []names.UserTag
+
 	controllerBackend *fakeBackend
 }
 
@@ -703,6 +825,19 @@ func (b *fakeBackend) ListPendingResources(app string) ([]resource.Resource, err
 	return b.pendingResources, b.pendingResourcesErr
 }
 
+func (b *fakeBackend) ControllerCloudName() (string, error) {
+	return b.controllerCloud, nil
+}
+
+func (b *fakeBackend) UserExists(tag names.UserTag) (bool, error) {
+	for _, missing := range b.missingUsers {
+		if missing == tag {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (b *fakeBackend) ControllerBackend() (migration.PrecheckBackendCloser, error) {
 	if b.controllerBackend == nil {
 		return b, nil
@@ -0,0 +1,122 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/set"
+)
+
+// DefaultSourceCIDR is the source CIDR of an ingress rule that
+// allows traffic from anywhere.
+const DefaultSourceCIDR = "0.0.0.0/0"
+
+// IngressRule represents a range of ports, and the source CIDRs from
+// which traffic to those ports is allowed.
+type IngressRule struct {
+	PortRange
+
+	// SourceCIDRs contains the CIDRs from which traffic is allowed.
+	SourceCIDRs []string
+}
+
+// NewIngressRule returns an IngressRule allowing traffic to the given
+// port range from the specified source CIDRs. If no source CIDRs are
+// specified, traffic is allowed from anywhere. The source CIDRs of the
+// returned rule are sorted, and contain no duplicates.
+func NewIngressRule(portRange PortRange, sourceCIDRs ...string) (IngressRule, error) {
+	rule := IngressRule{PortRange: portRange}
+	if len(sourceCIDRs) == 0 {
+		rule.SourceCIDRs = []string{DefaultSourceCIDR}
+	} else {
+		rule.SourceCIDRs = set.NewStrings(sourceCIDRs...).SortedValues()
+	}
+	if err := rule.Validate(); err != nil {
+		return IngressRule{}, errors.Trace(err)
+	}
+	return rule, nil
+}
+
+// NewOpenIngressRule returns an IngressRule allowing traffic to the
+// given port range from anywhere.
+func NewOpenIngressRule(portRange PortRange) IngressRule {
+	return IngressRule{
+		PortRange:   portRange,
+		SourceCIDRs: []string{DefaultSourceCIDR},
+	}
+}
+
+// Validate determines if the ingress rule is valid.
+func (r IngressRule) Validate() error {
+	if err := r.PortRange.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	return ValidateSourceCIDRs(r.SourceCIDRs)
+}
+
+// IsOpen reports whether the ingress rule allows traffic from anywhere.
+func (r IngressRule) IsOpen() bool {
+	return r.sourceCIDRSet().Contains(DefaultSourceCIDR)
+}
+
+// EqualTo reports whether the two ingress rules allow traffic to the
+// same port range from the same source CIDRs.
+func (r IngressRule) EqualTo(other IngressRule) bool {
+	if r.PortRange != other.PortRange {
+		return false
+	}
+	a, b := r.sourceCIDRSet(), other.sourceCIDRSet()
+	return a.Difference(b).IsEmpty() && b.Difference(a).IsEmpty()
+}
+
+func (r IngressRule) sourceCIDRSet() set.Strings {
+	if len(r.SourceCIDRs) == 0 {
+		return set.NewStrings(DefaultSourceCIDR)
+	}
+	return set.NewStrings(r.SourceCIDRs...)
+}
+
+func (r IngressRule) String() string {
+	if r.IsOpen() {
+		return r.PortRange.String()
+	}
+	return fmt.Sprintf("%s from %s", r.PortRange, strings.Join(r.SourceCIDRs, ","))
+}
+
+func (r IngressRule) GoString() string {
+	return r.String()
+}
+
+type ingressRuleSlice []IngressRule
+
+func (p ingressRuleSlice) Len() int      { return len(p) }
+func (p ingressRuleSlice) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+func (p ingressRuleSlice) Less(i, j int) bool {
+	if p[i].PortRange != p[j].PortRange {
+		return portRangeSlice{p[i].PortRange, p[j].PortRange}.Less(0, 1)
+	}
+	return strings.Join(p[i].SourceCIDRs, ",") < strings.Join(p[j].SourceCIDRs, ",")
+}
+
+// SortIngressRules sorts the given rules, first by port range, then by
+// source CIDRs.
+func SortIngressRules(rules []IngressRule) {
+	sort.Sort(ingressRuleSlice(rules))
+}
+
+// ValidateSourceCIDRs returns an error if any of the given source
+// CIDRs is not a valid CIDR.
+func ValidateSourceCIDRs(sourceCIDRs []string) error {
+	for _, cidr := range sourceCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.NotValidf("source CIDR %q", cidr)
+		}
+	}
+	return nil
+}
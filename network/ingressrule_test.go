@@ -0,0 +1,76 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/network"
+	"github.com/juju/juju/testing"
+)
+
+type IngressRuleSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&IngressRuleSuite{})
+
+func (*IngressRuleSuite) TestNewIngressRule(c *gc.C) {
+	rule, err := network.NewIngressRule(
+		network.MustParsePortRange("80/tcp"),
+		"192.168.1.0/24", "10.0.0.0/8", "192.168.1.0/24",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rule, jc.DeepEquals, network.IngressRule{
+		PortRange:   network.PortRange{80, 80, "tcp"},
+		SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+	})
+	c.Assert(rule.IsOpen(), jc.IsFalse)
+	c.Assert(rule.String(), gc.Equals, "80/tcp from 10.0.0.0/8,192.168.1.0/24")
+}
+
+func (*IngressRuleSuite) TestNewIngressRuleDefaultSource(c *gc.C) {
+	rule, err := network.NewIngressRule(network.MustParsePortRange("8000-8099/udp"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rule, jc.DeepEquals, network.NewOpenIngressRule(network.PortRange{8000, 8099, "udp"}))
+	c.Assert(rule.IsOpen(), jc.IsTrue)
+	c.Assert(rule.String(), gc.Equals, "8000-8099/udp")
+}
+
+func (*IngressRuleSuite) TestNewIngressRuleInvalid(c *gc.C) {
+	_, err := network.NewIngressRule(network.MustParsePortRange("80/tcp"), "10.0.0.0")
+	c.Assert(err, gc.ErrorMatches, `source CIDR "10.0.0.0" not valid`)
+
+	_, err = network.NewIngressRule(network.PortRange{80, 80, "icmp"})
+	c.Assert(err, gc.ErrorMatches, `invalid protocol "icmp", expected "tcp" or "udp"`)
+}
+
+func (*IngressRuleSuite) TestEqualTo(c *gc.C) {
+	portRange := network.MustParsePortRange("80/tcp")
+	open := network.NewOpenIngressRule(portRange)
+	c.Assert(open.EqualTo(network.IngressRule{PortRange: portRange}), jc.IsTrue)
+	c.Assert(open.EqualTo(network.NewOpenIngressRule(network.MustParsePortRange("81/tcp"))), jc.IsFalse)
+
+	restricted := network.IngressRule{portRange, []string{"10.0.0.0/8", "192.168.1.0/24"}}
+	c.Assert(restricted.EqualTo(open), jc.IsFalse)
+	c.Assert(restricted.EqualTo(network.IngressRule{portRange, []string{"192.168.1.0/24", "10.0.0.0/8"}}), jc.IsTrue)
+	c.Assert(restricted.EqualTo(network.IngressRule{portRange, []string{"10.0.0.0/8"}}), jc.IsFalse)
+}
+
+func (*IngressRuleSuite) TestSortIngressRules(c *gc.C) {
+	rules := []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"192.168.1.0/24"}},
+		{network.PortRange{53, 53, "udp"}, []string{network.DefaultSourceCIDR}},
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8"}},
+		{network.PortRange{22, 22, "tcp"}, []string{network.DefaultSourceCIDR}},
+	}
+	network.SortIngressRules(rules)
+	c.Assert(rules, jc.DeepEquals, []network.IngressRule{
+		{network.PortRange{22, 22, "tcp"}, []string{network.DefaultSourceCIDR}},
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8"}},
+		{network.PortRange{80, 80, "tcp"}, []string{"192.168.1.0/24"}},
+		{network.PortRange{53, 53, "udp"}, []string{network.DefaultSourceCIDR}},
+	})
+}
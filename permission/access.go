@@ -27,6 +27,20 @@ const (
 	// AdminAccess allows a user full control over the subject.
 	AdminAccess Access = "admin"
 
+	// RunActionsAccess allows a user to read information about a model
+	// and to run actions and commands on its units and machines, without
+	// being able to change its configuration or topology.
+	RunActionsAccess Access = "run-actions"
+
+	// DebugAccess allows a user to read information about a model and to
+	// debug its machines and units with juju ssh and juju debug-log.
+	DebugAccess Access = "debug"
+
+	// DeployAccess allows a user to read information about a model and
+	// to deploy, add units to and upgrade applications, but not to
+	// remove anything from it.
+	DeployAccess Access = "deploy"
+
 	// Controller permissions
 
 	// LoginAccess allows a user to log-ing into the subject.
@@ -43,6 +57,7 @@ const (
 func (a Access) Validate() error {
 	switch a {
 	case NoAccess, AdminAccess, ReadAccess, WriteAccess,
+		RunActionsAccess, DebugAccess, DeployAccess,
 		LoginAccess, AddModelAccess, SuperuserAccess:
 		return nil
 	}
@@ -53,12 +68,23 @@ func (a Access) Validate() error {
 // model access level.
 func ValidateModelAccess(access Access) error {
 	switch access {
-	case ReadAccess, WriteAccess, AdminAccess:
+	case ReadAccess, WriteAccess, AdminAccess,
+		RunActionsAccess, DebugAccess, DeployAccess:
 		return nil
 	}
 	return errors.NotValidf("%q model access", access)
 }
 
+// ValidateApplicationAccess returns error if the passed access is not a
+// valid application access level.
+func ValidateApplicationAccess(access Access) error {
+	switch access {
+	case WriteAccess, AdminAccess:
+		return nil
+	}
+	return errors.NotValidf("%q application access", access)
+}
+
 //ValidateControllerAccess returns error if the passed access is not a valid
 // controller access level.
 func ValidateControllerAccess(access Access) error {
@@ -99,9 +125,28 @@ func (a Access) modelValue() int {
 	}
 }
 
+// IsCapabilityModelAccess returns true if the access is one of the
+// model access levels that add a single capability to read access.
+// These are neither greater nor less than write access: a user with
+// write access does not implicitly have them, and only admin access
+// includes them.
+func (a Access) IsCapabilityModelAccess() bool {
+	switch a {
+	case RunActionsAccess, DebugAccess, DeployAccess:
+		return true
+	}
+	return false
+}
+
 // EqualOrGreaterModelAccessThan returns true if the current access is equal
 // or greater than the passed in access level.
 func (a Access) EqualOrGreaterModelAccessThan(access Access) bool {
+	if access.IsCapabilityModelAccess() {
+		return a == access || a == AdminAccess
+	}
+	if a.IsCapabilityModelAccess() {
+		a = ReadAccess
+	}
 	v1, v2 := a.modelValue(), access.modelValue()
 	if v1 < 0 || v2 < 0 {
 		return false
@@ -112,11 +157,7 @@ func (a Access) EqualOrGreaterModelAccessThan(access Access) bool {
 // GreaterModelAccessThan returns true if the current access is greater than
 // the passed in access level.
 func (a Access) GreaterModelAccessThan(access Access) bool {
-	v1, v2 := a.modelValue(), access.modelValue()
-	if v1 < 0 || v2 < 0 {
-		return false
-	}
-	return v1 > v2
+	return a != access && a.EqualOrGreaterModelAccessThan(access)
 }
 
 // EqualOrGreaterControllerAccessThan returns true if the current access is
@@ -199,3 +199,51 @@ func (*accessSuite) TestGreaterControllerAccessThan(c *gc.C) {
 	c.Check(superuser.GreaterControllerAccessThan(addmodel), jc.IsTrue)
 	c.Check(superuser.GreaterControllerAccessThan(superuser), jc.IsFalse)
 }
+
+func (*accessSuite) TestCapabilityModelAccess(c *gc.C) {
+	var (
+		undefined  = permission.NoAccess
+		read       = permission.ReadAccess
+		write      = permission.WriteAccess
+		admin      = permission.AdminAccess
+		runActions = permission.RunActionsAccess
+		debug      = permission.DebugAccess
+		deploy     = permission.DeployAccess
+	)
+	for _, value := range []permission.Access{runActions, debug, deploy} {
+		c.Check(value.IsCapabilityModelAccess(), jc.IsTrue)
+		c.Check(permission.ValidateModelAccess(value), jc.ErrorIsNil)
+
+		// Capabilities include read access, but not write access.
+		c.Check(value.EqualOrGreaterModelAccessThan(undefined), jc.IsTrue)
+		c.Check(value.EqualOrGreaterModelAccessThan(read), jc.IsTrue)
+		c.Check(value.EqualOrGreaterModelAccessThan(value), jc.IsTrue)
+		c.Check(value.EqualOrGreaterModelAccessThan(write), jc.IsFalse)
+		c.Check(value.EqualOrGreaterModelAccessThan(admin), jc.IsFalse)
+		c.Check(value.GreaterModelAccessThan(read), jc.IsTrue)
+		c.Check(value.GreaterModelAccessThan(value), jc.IsFalse)
+
+		// Only admin access includes the capabilities.
+		c.Check(read.EqualOrGreaterModelAccessThan(value), jc.IsFalse)
+		c.Check(write.EqualOrGreaterModelAccessThan(value), jc.IsFalse)
+		c.Check(admin.EqualOrGreaterModelAccessThan(value), jc.IsTrue)
+		c.Check(admin.GreaterModelAccessThan(value), jc.IsTrue)
+	}
+	c.Check(runActions.EqualOrGreaterModelAccessThan(debug), jc.IsFalse)
+	c.Check(debug.EqualOrGreaterModelAccessThan(deploy), jc.IsFalse)
+	c.Check(deploy.EqualOrGreaterModelAccessThan(runActions), jc.IsFalse)
+	for _, value := range []permission.Access{undefined, read, write, admin} {
+		c.Check(value.IsCapabilityModelAccess(), jc.IsFalse)
+	}
+}
+
+func (*accessSuite) TestValidateApplicationAccess(c *gc.C) {
+	for _, value := range []permission.Access{permission.WriteAccess, permission.AdminAccess} {
+		c.Check(permission.ValidateApplicationAccess(value), jc.ErrorIsNil)
+	}
+	for _, value := range []permission.Access{
+		permission.NoAccess, permission.ReadAccess, permission.RunActionsAccess, permission.SuperuserAccess,
+	} {
+		c.Check(permission.ValidateApplicationAccess(value), gc.ErrorMatches, `".*" application access not valid`)
+	}
+}
@@ -1421,6 +1421,27 @@ func (env *azureEnviron) Ports() ([]jujunetwork.PortRange, error) {
 	return nil, errNoFwGlobal
 }
 
+var _ environs.IngressRuleFirewaller = (*azureEnviron)(nil)
+
+// OpenIngressRules is specified in environs.IngressRuleFirewaller. However,
+// Azure does not support the global firewall mode; ingress rules are opened
+// on instances instead.
+func (env *azureEnviron) OpenIngressRules(rules []jujunetwork.IngressRule) error {
+	return errNoFwGlobal
+}
+
+// CloseIngressRules is specified in environs.IngressRuleFirewaller. However,
+// Azure does not support the global firewall mode; ingress rules are closed
+// on instances instead.
+func (env *azureEnviron) CloseIngressRules(rules []jujunetwork.IngressRule) error {
+	return errNoFwGlobal
+}
+
+// IngressRules is specified in environs.IngressRuleFirewaller.
+func (env *azureEnviron) IngressRules() ([]jujunetwork.IngressRule, error) {
+	return nil, errNoFwGlobal
+}
+
 // Provider is specified in the Environ interface.
 func (env *azureEnviron) Provider() environs.EnvironProvider {
 	return env.provider
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/instance"
 	jujunetwork "github.com/juju/juju/network"
 	"github.com/juju/juju/status"
+	"github.com/juju/utils/set"
 	"gopkg.in/juju/names.v2"
 )
 
@@ -211,6 +212,32 @@ func (inst *azureInstance) primaryNetworkAddress() (jujunetwork.Address, error)
 
 // OpenPorts is specified in the Instance interface.
 func (inst *azureInstance) OpenPorts(machineId string, ports []jujunetwork.PortRange) error {
+	return inst.OpenIngressRules(machineId, portRangesToIngressRules(ports))
+}
+
+// ClosePorts is specified in the Instance interface.
+func (inst *azureInstance) ClosePorts(machineId string, ports []jujunetwork.PortRange) error {
+	return inst.CloseIngressRules(machineId, portRangesToIngressRules(ports))
+}
+
+// Ports is specified in the Instance interface.
+func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRange, err error) {
+	rules, err := inst.securityRuleIngressRules(machineId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, rule := range rules {
+		if rule.IsOpen() {
+			ports = append(ports, rule.PortRange)
+		}
+	}
+	return ports, nil
+}
+
+var _ instance.IngressRuleFirewaller = (*azureInstance)(nil)
+
+// OpenIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *azureInstance) OpenIngressRules(machineId string, rules []jujunetwork.IngressRule) error {
 	nsgClient := network.SecurityGroupsClient{inst.env.network}
 	securityRuleClient := network.SecurityRulesClient{inst.env.network}
 	primaryNetworkAddress, err := inst.primaryNetworkAddress()
@@ -238,12 +265,17 @@ func (inst *azureInstance) OpenPorts(machineId string, ports []jujunetwork.PortR
 	// Create rules one at a time; this is necessary to avoid trampling
 	// on changes made by the provisioner. We still record rules in the
 	// NSG in memory, so we can easily tell which priorities are available.
+	// A security rule allows traffic from a single source address
+	// prefix, so there is one for each source CIDR of an ingress rule.
 	vmName := resourceName(names.NewMachineTag(machineId))
 	prefix := instanceNetworkSecurityRulePrefix(instance.Id(vmName))
-	for _, ports := range ports {
-		ruleName := securityRuleName(prefix, ports)
+	for _, ingressRule := range singleSourceIngressRules(rules) {
+		ports := ingressRule.PortRange
+		sourceCIDR := ingressRule.SourceCIDRs[0]
+		ruleName := securityRuleName(prefix, ports, sourceCIDR)
 
-		// Check if the rule already exists; OpenPorts must be idempotent.
+		// Check if the rule already exists; OpenIngressRules must be
+		// idempotent.
 		var found bool
 		for _, rule := range securityRules {
 			if to.String(rule.Name) == ruleName {
@@ -259,7 +291,7 @@ func (inst *azureInstance) OpenPorts(machineId string, ports []jujunetwork.PortR
 
 		priority, err := nextSecurityRulePriority(nsg, securityRuleInternalMax+1, securityRuleMax)
 		if err != nil {
-			return errors.Annotatef(err, "getting security rule priority for %s", ports)
+			return errors.Annotatef(err, "getting security rule priority for %s", ingressRule)
 		}
 
 		var protocol network.SecurityRuleProtocol
@@ -279,13 +311,18 @@ func (inst *azureInstance) OpenPorts(machineId string, ports []jujunetwork.PortR
 			portRange = fmt.Sprint(ports.FromPort)
 		}
 
+		sourceAddressPrefix := sourceCIDR
+		if sourceCIDR == jujunetwork.DefaultSourceCIDR {
+			sourceAddressPrefix = "*"
+		}
+
 		rule := network.SecurityRule{
 			Properties: &network.SecurityRulePropertiesFormat{
-				Description:              to.StringPtr(ports.String()),
+				Description:              to.StringPtr(ingressRule.String()),
 				Protocol:                 protocol,
 				SourcePortRange:          to.StringPtr("*"),
 				DestinationPortRange:     to.StringPtr(portRange),
-				SourceAddressPrefix:      to.StringPtr("*"),
+				SourceAddressPrefix:      to.StringPtr(sourceAddressPrefix),
 				DestinationAddressPrefix: to.StringPtr(primaryNetworkAddress.Value),
 				Access:    network.Allow,
 				Priority:  to.Int32Ptr(priority),
@@ -298,15 +335,15 @@ func (inst *azureInstance) OpenPorts(machineId string, ports []jujunetwork.PortR
 				nil, // abort channel
 			)
 		}); err != nil {
-			return errors.Annotatef(err, "creating security rule for %s", ports)
+			return errors.Annotatef(err, "creating security rule for %s", ingressRule)
 		}
 		securityRules = append(securityRules, rule)
 	}
 	return nil
 }
 
-// ClosePorts is specified in the Instance interface.
-func (inst *azureInstance) ClosePorts(machineId string, ports []jujunetwork.PortRange) error {
+// CloseIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *azureInstance) CloseIngressRules(machineId string, rules []jujunetwork.IngressRule) error {
 	securityRuleClient := network.SecurityRulesClient{inst.env.network}
 	securityGroupName := internalSecurityGroupName
 
@@ -314,8 +351,8 @@ func (inst *azureInstance) ClosePorts(machineId string, ports []jujunetwork.Port
 	// on changes made by the provisioner.
 	vmName := resourceName(names.NewMachineTag(machineId))
 	prefix := instanceNetworkSecurityRulePrefix(instance.Id(vmName))
-	for _, ports := range ports {
-		ruleName := securityRuleName(prefix, ports)
+	for _, ingressRule := range singleSourceIngressRules(rules) {
+		ruleName := securityRuleName(prefix, ingressRule.PortRange, ingressRule.SourceCIDRs[0])
 		logger.Debugf("deleting security rule %q", ruleName)
 		var result autorest.Response
 		if err := inst.env.callAPI(func() (autorest.Response, error) {
@@ -334,8 +371,37 @@ func (inst *azureInstance) ClosePorts(machineId string, ports []jujunetwork.Port
 	return nil
 }
 
-// Ports is specified in the Instance interface.
-func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRange, err error) {
+// IngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *azureInstance) IngressRules(machineId string) ([]jujunetwork.IngressRule, error) {
+	rules, err := inst.securityRuleIngressRules(machineId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var portRanges []jujunetwork.PortRange
+	sources := make(map[jujunetwork.PortRange][]string)
+	for _, rule := range rules {
+		if _, ok := sources[rule.PortRange]; !ok {
+			portRanges = append(portRanges, rule.PortRange)
+		}
+		sources[rule.PortRange] = append(sources[rule.PortRange], rule.SourceCIDRs...)
+	}
+	result := make([]jujunetwork.IngressRule, len(portRanges))
+	for i, portRange := range portRanges {
+		result[i] = jujunetwork.IngressRule{
+			PortRange:   portRange,
+			SourceCIDRs: set.NewStrings(sources[portRange]...).SortedValues(),
+		}
+	}
+	jujunetwork.SortIngressRules(result)
+	return result, nil
+}
+
+// securityRuleIngressRules returns an ingress rule for each protocol of
+// each security rule in the internal network security group allowing
+// inbound traffic to the specified machine, in the order of the
+// security rules. Each ingress rule has the single source CIDR of its
+// security rule.
+func (inst *azureInstance) securityRuleIngressRules(machineId string) ([]jujunetwork.IngressRule, error) {
 	nsgClient := network.SecurityGroupsClient{inst.env.network}
 	securityGroupName := internalSecurityGroupName
 	var nsg network.SecurityGroup
@@ -352,6 +418,7 @@ func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRang
 
 	vmName := resourceName(names.NewMachineTag(machineId))
 	prefix := instanceNetworkSecurityRulePrefix(instance.Id(vmName))
+	var rules []jujunetwork.IngressRule
 	for _, rule := range *nsg.Properties.SecurityRules {
 		if rule.Properties.Direction != network.Inbound {
 			continue
@@ -371,6 +438,7 @@ func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRang
 			portRange.FromPort = 0
 			portRange.ToPort = 65535
 		} else {
+			var err error
 			portRange, err = jujunetwork.ParsePortRange(
 				*rule.Properties.DestinationPortRange,
 			)
@@ -382,6 +450,11 @@ func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRang
 			}
 		}
 
+		sourceCIDR := to.String(rule.Properties.SourceAddressPrefix)
+		if sourceCIDR == "" || sourceCIDR == "*" {
+			sourceCIDR = jujunetwork.DefaultSourceCIDR
+		}
+
 		var protocols []string
 		switch rule.Properties.Protocol {
 		case network.TCP:
@@ -393,10 +466,42 @@ func (inst *azureInstance) Ports(machineId string) (ports []jujunetwork.PortRang
 		}
 		for _, protocol := range protocols {
 			portRange.Protocol = protocol
-			ports = append(ports, portRange)
+			rules = append(rules, jujunetwork.IngressRule{
+				PortRange:   portRange,
+				SourceCIDRs: []string{sourceCIDR},
+			})
 		}
 	}
-	return ports, nil
+	return rules, nil
+}
+
+// portRangesToIngressRules returns ingress rules allowing traffic to
+// the given port ranges from anywhere.
+func portRangesToIngressRules(ports []jujunetwork.PortRange) []jujunetwork.IngressRule {
+	rules := make([]jujunetwork.IngressRule, len(ports))
+	for i, portRange := range ports {
+		rules[i] = jujunetwork.NewOpenIngressRule(portRange)
+	}
+	return rules
+}
+
+// singleSourceIngressRules splits the given ingress rules into rules
+// with a single source CIDR each, preserving their order.
+func singleSourceIngressRules(rules []jujunetwork.IngressRule) []jujunetwork.IngressRule {
+	var result []jujunetwork.IngressRule
+	for _, rule := range rules {
+		sourceCIDRs := rule.SourceCIDRs
+		if len(sourceCIDRs) == 0 {
+			sourceCIDRs = []string{jujunetwork.DefaultSourceCIDR}
+		}
+		for _, sourceCIDR := range sourceCIDRs {
+			result = append(result, jujunetwork.IngressRule{
+				PortRange:   rule.PortRange,
+				SourceCIDRs: []string{sourceCIDR},
+			})
+		}
+	}
+	return result
 }
 
 // deleteInstanceNetworkSecurityRules deletes network security rules in the
@@ -454,12 +559,17 @@ func instanceNetworkSecurityRulePrefix(id instance.Id) string {
 	return string(id) + "-"
 }
 
-// securityRuleName returns the security rule name for the given port range,
-// and prefix returned by instanceNetworkSecurityRulePrefix.
-func securityRuleName(prefix string, ports jujunetwork.PortRange) string {
+// securityRuleName returns the security rule name for the given port range
+// and source CIDR, and prefix returned by instanceNetworkSecurityRulePrefix.
+// Rules allowing traffic from anywhere have no source CIDR in their name.
+func securityRuleName(prefix string, ports jujunetwork.PortRange, sourceCIDR string) string {
 	ruleName := fmt.Sprintf("%s%s-%d", prefix, ports.Protocol, ports.FromPort)
 	if ports.FromPort != ports.ToPort {
 		ruleName += fmt.Sprintf("-%d", ports.ToPort)
 	}
+	if sourceCIDR != jujunetwork.DefaultSourceCIDR {
+		// Security rule names may not contain "/" or ":".
+		ruleName += "-" + strings.NewReplacer("/", "-", ":", "-").Replace(sourceCIDR)
+	}
 	return ruleName
 }
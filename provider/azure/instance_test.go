@@ -541,6 +541,170 @@ func (s *instanceSuite) TestInstanceOpenPortsNoInternalAddress(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "internal network address not found")
 }
 
+func (s *instanceSuite) TestInstanceIngressRules(c *gc.C) {
+	inst := s.getInstance(c).(instance.IngressRuleFirewaller)
+	nsgSender := networkSecurityGroupSender([]network.SecurityRule{{
+		Name: to.StringPtr("machine-0-tcp-80"),
+		Properties: &network.SecurityRulePropertiesFormat{
+			Protocol:             network.TCP,
+			DestinationPortRange: to.StringPtr("80"),
+			SourceAddressPrefix:  to.StringPtr("*"),
+			Access:               network.Allow,
+			Priority:             to.Int32Ptr(200),
+			Direction:            network.Inbound,
+		},
+	}, {
+		Name: to.StringPtr("machine-0-tcp-80-192.168.1.0-24"),
+		Properties: &network.SecurityRulePropertiesFormat{
+			Protocol:             network.TCP,
+			DestinationPortRange: to.StringPtr("80"),
+			SourceAddressPrefix:  to.StringPtr("192.168.1.0/24"),
+			Access:               network.Allow,
+			Priority:             to.Int32Ptr(201),
+			Direction:            network.Inbound,
+		},
+	}, {
+		Name: to.StringPtr("machine-0-udp-1000-2000-10.0.0.0-8"),
+		Properties: &network.SecurityRulePropertiesFormat{
+			Protocol:             network.UDP,
+			DestinationPortRange: to.StringPtr("1000-2000"),
+			SourceAddressPrefix:  to.StringPtr("10.0.0.0/8"),
+			Access:               network.Allow,
+			Priority:             to.Int32Ptr(202),
+			Direction:            network.Inbound,
+		},
+	}, {
+		Name: to.StringPtr("machine-00-ignored"),
+		Properties: &network.SecurityRulePropertiesFormat{
+			Protocol:             network.TCP,
+			DestinationPortRange: to.StringPtr("80"),
+			SourceAddressPrefix:  to.StringPtr("10.0.0.0/8"),
+			Access:               network.Allow,
+			Priority:             to.Int32Ptr(203),
+			Direction:            network.Inbound,
+		},
+	}})
+	s.sender = azuretesting.Senders{nsgSender}
+
+	rules, err := inst.IngressRules("0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rules, jc.DeepEquals, []jujunetwork.IngressRule{{
+		PortRange:   jujunetwork.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+		SourceCIDRs: []string{"0.0.0.0/0", "192.168.1.0/24"},
+	}, {
+		PortRange:   jujunetwork.PortRange{FromPort: 1000, ToPort: 2000, Protocol: "udp"},
+		SourceCIDRs: []string{"10.0.0.0/8"},
+	}})
+}
+
+func (s *instanceSuite) TestInstancePortsIgnoresSourceCIDRs(c *gc.C) {
+	inst := s.getInstance(c)
+	nsgSender := networkSecurityGroupSender([]network.SecurityRule{{
+		Name: to.StringPtr("machine-0-tcp-80-10.0.0.0-8"),
+		Properties: &network.SecurityRulePropertiesFormat{
+			Protocol:             network.TCP,
+			DestinationPortRange: to.StringPtr("80"),
+			SourceAddressPrefix:  to.StringPtr("10.0.0.0/8"),
+			Access:               network.Allow,
+			Priority:             to.Int32Ptr(200),
+			Direction:            network.Inbound,
+		},
+	}})
+	s.sender = azuretesting.Senders{nsgSender}
+
+	ports, err := inst.Ports("0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ports, gc.HasLen, 0)
+}
+
+func (s *instanceSuite) TestInstanceOpenIngressRules(c *gc.C) {
+	internalSubnetId := path.Join(
+		"/subscriptions", fakeSubscriptionId,
+		"resourceGroups/juju-testenv-model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+		"providers/Microsoft.Network/virtualnetworks/juju-internal-network/subnets/juju-internal-subnet",
+	)
+	ipConfiguration := network.InterfaceIPConfiguration{
+		Properties: &network.InterfaceIPConfigurationPropertiesFormat{
+			Primary:          to.BoolPtr(true),
+			PrivateIPAddress: to.StringPtr("10.0.0.4"),
+			Subnet: &network.Subnet{
+				ID: to.StringPtr(internalSubnetId),
+			},
+		},
+	}
+	s.networkInterfaces = []network.Interface{
+		makeNetworkInterface("nic-0", "machine-0", ipConfiguration),
+	}
+
+	inst := s.getInstance(c).(instance.IngressRuleFirewaller)
+	okSender := mocks.NewSender()
+	okSender.AppendResponse(mocks.NewResponseWithContent("{}"))
+	nsgSender := networkSecurityGroupSender(nil)
+	s.sender = azuretesting.Senders{nsgSender, okSender, okSender}
+
+	err := inst.OpenIngressRules("0", []jujunetwork.IngressRule{{
+		PortRange:   jujunetwork.PortRange{FromPort: 1000, ToPort: 1000, Protocol: "tcp"},
+		SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.requests, gc.HasLen, 3)
+	c.Assert(s.requests[0].Method, gc.Equals, "GET")
+	c.Assert(s.requests[0].URL.Path, gc.Equals, internalSecurityGroupPath)
+	c.Assert(s.requests[1].Method, gc.Equals, "PUT")
+	c.Assert(s.requests[1].URL.Path, gc.Equals, securityRulePath("machine-0-tcp-1000-10.0.0.0-8"))
+	assertRequestBody(c, s.requests[1], &network.SecurityRule{
+		Properties: &network.SecurityRulePropertiesFormat{
+			Description:              to.StringPtr("1000/tcp from 10.0.0.0/8"),
+			Protocol:                 network.TCP,
+			SourcePortRange:          to.StringPtr("*"),
+			SourceAddressPrefix:      to.StringPtr("10.0.0.0/8"),
+			DestinationPortRange:     to.StringPtr("1000"),
+			DestinationAddressPrefix: to.StringPtr("10.0.0.4"),
+			Access:    network.Allow,
+			Priority:  to.Int32Ptr(200),
+			Direction: network.Inbound,
+		},
+	})
+	c.Assert(s.requests[2].Method, gc.Equals, "PUT")
+	c.Assert(s.requests[2].URL.Path, gc.Equals, securityRulePath("machine-0-tcp-1000-192.168.1.0-24"))
+	assertRequestBody(c, s.requests[2], &network.SecurityRule{
+		Properties: &network.SecurityRulePropertiesFormat{
+			Description:              to.StringPtr("1000/tcp from 192.168.1.0/24"),
+			Protocol:                 network.TCP,
+			SourcePortRange:          to.StringPtr("*"),
+			SourceAddressPrefix:      to.StringPtr("192.168.1.0/24"),
+			DestinationPortRange:     to.StringPtr("1000"),
+			DestinationAddressPrefix: to.StringPtr("10.0.0.4"),
+			Access:    network.Allow,
+			Priority:  to.Int32Ptr(201),
+			Direction: network.Inbound,
+		},
+	})
+}
+
+func (s *instanceSuite) TestInstanceCloseIngressRules(c *gc.C) {
+	inst := s.getInstance(c).(instance.IngressRuleFirewaller)
+	sender := mocks.NewSender()
+	notFoundSender := mocks.NewSender()
+	notFoundSender.AppendResponse(mocks.NewResponseWithStatus(
+		"rule not found", http.StatusNotFound,
+	))
+	s.sender = azuretesting.Senders{sender, notFoundSender}
+
+	err := inst.CloseIngressRules("0", []jujunetwork.IngressRule{{
+		PortRange:   jujunetwork.PortRange{FromPort: 1000, ToPort: 1000, Protocol: "tcp"},
+		SourceCIDRs: []string{"0.0.0.0/0", "10.0.0.0/8"},
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.requests, gc.HasLen, 2)
+	c.Assert(s.requests[0].Method, gc.Equals, "DELETE")
+	c.Assert(s.requests[0].URL.Path, gc.Equals, securityRulePath("machine-0-tcp-1000"))
+	c.Assert(s.requests[1].Method, gc.Equals, "DELETE")
+	c.Assert(s.requests[1].URL.Path, gc.Equals, securityRulePath("machine-0-tcp-1000-10.0.0.0-8"))
+}
+
 func (s *instanceSuite) TestAllInstances(c *gc.C) {
 	s.sender = s.getInstancesSender()
 	instances, err := s.env.AllInstances()
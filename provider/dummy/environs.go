@@ -23,6 +23,7 @@ import (
 	"net"
 	"os"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -192,17 +193,19 @@ type OpStopInstances struct {
 }
 
 type OpOpenPorts struct {
-	Env        string
-	MachineId  string
-	InstanceId instance.Id
-	Ports      []network.PortRange
+	Env          string
+	MachineId    string
+	InstanceId   instance.Id
+	Ports        []network.PortRange
+	IngressRules []network.IngressRule
 }
 
 type OpClosePorts struct {
-	Env        string
-	MachineId  string
-	InstanceId instance.Id
-	Ports      []network.PortRange
+	Env          string
+	MachineId    string
+	InstanceId   instance.Id
+	Ports        []network.PortRange
+	IngressRules []network.IngressRule
 }
 
 type OpPutFile struct {
@@ -239,7 +242,7 @@ type environState struct {
 	maxId          int // maximum instance id allocated so far.
 	maxAddr        int // maximum allocated address last byte
 	insts          map[instance.Id]*dummyInstance
-	globalPorts    map[network.PortRange]bool
+	globalRules    map[ingressRuleKey]bool
 	bootstrapped   bool
 	apiListener    net.Listener
 	apiServer      *apiserver.Server
@@ -416,7 +419,7 @@ func newState(name string, ops chan<- Operation, newStatePolicy state.NewPolicyF
 		ops:            ops,
 		newStatePolicy: newStatePolicy,
 		insts:          make(map[instance.Id]*dummyInstance),
-		globalPorts:    make(map[network.PortRange]bool),
+		globalRules:    make(map[ingressRuleKey]bool),
 		creator:        string(buf),
 	}
 	return s
@@ -729,7 +732,7 @@ func (e *environ) Bootstrap(ctx environs.BootstrapContext, args environs.Bootstr
 	i := &dummyInstance{
 		id:           BootstrapInstanceId,
 		addresses:    network.NewAddresses("localhost"),
-		ports:        make(map[network.PortRange]bool),
+		rules:        make(map[ingressRuleKey]bool),
 		machineId:    agent.BootstrapMachineId,
 		series:       series,
 		firewallMode: e.Config().FirewallMode(),
@@ -996,7 +999,7 @@ func (e *environ) StartInstance(args environs.StartInstanceParams) (*environs.St
 	i := &dummyInstance{
 		id:           instance.Id(idString),
 		addresses:    addrs,
-		ports:        make(map[network.PortRange]bool),
+		rules:        make(map[ingressRuleKey]bool),
 		machineId:    machineId,
 		series:       series,
 		firewallMode: e.Config().FirewallMode(),
@@ -1388,6 +1391,31 @@ func (e *environ) AllInstances() ([]instance.Instance, error) {
 }
 
 func (e *environ) OpenPorts(ports []network.PortRange) error {
+	return e.OpenIngressRules(openIngressRules(ports))
+}
+
+func (e *environ) ClosePorts(ports []network.PortRange) error {
+	return e.CloseIngressRules(openIngressRules(ports))
+}
+
+// Ports returns the port ranges opened to anywhere for the whole model.
+func (e *environ) Ports() (ports []network.PortRange, err error) {
+	if mode := e.ecfg().FirewallMode(); mode != config.FwGlobal {
+		return nil, fmt.Errorf("invalid firewall mode %q for retrieving ports from model", mode)
+	}
+	estate, err := e.state()
+	if err != nil {
+		return nil, err
+	}
+	estate.mu.Lock()
+	defer estate.mu.Unlock()
+	return openPortRanges(estate.globalRules), nil
+}
+
+var _ environs.IngressRuleFirewaller = (*environ)(nil)
+
+// OpenIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) OpenIngressRules(rules []network.IngressRule) error {
 	if mode := e.ecfg().FirewallMode(); mode != config.FwGlobal {
 		return fmt.Errorf("invalid firewall mode %q for opening ports on model", mode)
 	}
@@ -1397,13 +1425,14 @@ func (e *environ) OpenPorts(ports []network.PortRange) error {
 	}
 	estate.mu.Lock()
 	defer estate.mu.Unlock()
-	for _, p := range ports {
-		estate.globalPorts[p] = true
+	for _, key := range ingressRuleKeys(rules) {
+		estate.globalRules[key] = true
 	}
 	return nil
 }
 
-func (e *environ) ClosePorts(ports []network.PortRange) error {
+// CloseIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) CloseIngressRules(rules []network.IngressRule) error {
 	if mode := e.ecfg().FirewallMode(); mode != config.FwGlobal {
 		return fmt.Errorf("invalid firewall mode %q for closing ports on model", mode)
 	}
@@ -1413,13 +1442,14 @@ func (e *environ) ClosePorts(ports []network.PortRange) error {
 	}
 	estate.mu.Lock()
 	defer estate.mu.Unlock()
-	for _, p := range ports {
-		delete(estate.globalPorts, p)
+	for _, key := range ingressRuleKeys(rules) {
+		delete(estate.globalRules, key)
 	}
 	return nil
 }
 
-func (e *environ) Ports() (ports []network.PortRange, err error) {
+// IngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) IngressRules() ([]network.IngressRule, error) {
 	if mode := e.ecfg().FirewallMode(); mode != config.FwGlobal {
 		return nil, fmt.Errorf("invalid firewall mode %q for retrieving ports from model", mode)
 	}
@@ -1429,11 +1459,7 @@ func (e *environ) Ports() (ports []network.PortRange, err error) {
 	}
 	estate.mu.Lock()
 	defer estate.mu.Unlock()
-	for p := range estate.globalPorts {
-		ports = append(ports, p)
-	}
-	network.SortPortRanges(ports)
-	return
+	return ingressRulesFromKeys(estate.globalRules), nil
 }
 
 func (*environ) Provider() environs.EnvironProvider {
@@ -1442,7 +1468,7 @@ func (*environ) Provider() environs.EnvironProvider {
 
 type dummyInstance struct {
 	state        *environState
-	ports        map[network.PortRange]bool
+	rules        map[ingressRuleKey]bool
 	id           instance.Id
 	status       string
 	machineId    string
@@ -1525,58 +1551,81 @@ func (inst *dummyInstance) Addresses() ([]network.Address, error) {
 }
 
 func (inst *dummyInstance) OpenPorts(machineId string, ports []network.PortRange) error {
+	return inst.openIngressRules("OpenPorts", machineId, openIngressRules(ports))
+}
+
+var _ instance.IngressRuleFirewaller = (*dummyInstance)(nil)
+
+// OpenIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *dummyInstance) OpenIngressRules(machineId string, rules []network.IngressRule) error {
+	return inst.openIngressRules("OpenIngressRules", machineId, rules)
+}
+
+func (inst *dummyInstance) openIngressRules(method, machineId string, rules []network.IngressRule) error {
 	defer delay()
-	logger.Infof("openPorts %s, %#v", machineId, ports)
+	logger.Infof("openIngressRules %s, %#v", machineId, rules)
 	if inst.firewallMode != config.FwInstance {
 		return fmt.Errorf("invalid firewall mode %q for opening ports on instance",
 			inst.firewallMode)
 	}
 	if inst.machineId != machineId {
-		panic(fmt.Errorf("OpenPorts with mismatched machine id, expected %q got %q", inst.machineId, machineId))
+		panic(fmt.Errorf("%s with mismatched machine id, expected %q got %q", method, inst.machineId, machineId))
 	}
 	inst.state.mu.Lock()
 	defer inst.state.mu.Unlock()
-	if err := inst.checkBroken("OpenPorts"); err != nil {
+	if err := inst.checkBroken(method); err != nil {
 		return err
 	}
 	inst.state.ops <- OpOpenPorts{
-		Env:        inst.state.name,
-		MachineId:  machineId,
-		InstanceId: inst.Id(),
-		Ports:      ports,
+		Env:          inst.state.name,
+		MachineId:    machineId,
+		InstanceId:   inst.Id(),
+		Ports:        ingressRulePortRanges(rules),
+		IngressRules: rules,
 	}
-	for _, p := range ports {
-		inst.ports[p] = true
+	for _, key := range ingressRuleKeys(rules) {
+		inst.rules[key] = true
 	}
 	return nil
 }
 
 func (inst *dummyInstance) ClosePorts(machineId string, ports []network.PortRange) error {
+	return inst.closeIngressRules("ClosePorts", machineId, openIngressRules(ports))
+}
+
+// CloseIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *dummyInstance) CloseIngressRules(machineId string, rules []network.IngressRule) error {
+	return inst.closeIngressRules("CloseIngressRules", machineId, rules)
+}
+
+func (inst *dummyInstance) closeIngressRules(method, machineId string, rules []network.IngressRule) error {
 	defer delay()
 	if inst.firewallMode != config.FwInstance {
 		return fmt.Errorf("invalid firewall mode %q for closing ports on instance",
 			inst.firewallMode)
 	}
 	if inst.machineId != machineId {
-		panic(fmt.Errorf("ClosePorts with mismatched machine id, expected %s got %s", inst.machineId, machineId))
+		panic(fmt.Errorf("%s with mismatched machine id, expected %s got %s", method, inst.machineId, machineId))
 	}
 	inst.state.mu.Lock()
 	defer inst.state.mu.Unlock()
-	if err := inst.checkBroken("ClosePorts"); err != nil {
+	if err := inst.checkBroken(method); err != nil {
 		return err
 	}
 	inst.state.ops <- OpClosePorts{
-		Env:        inst.state.name,
-		MachineId:  machineId,
-		InstanceId: inst.Id(),
-		Ports:      ports,
+		Env:          inst.state.name,
+		MachineId:    machineId,
+		InstanceId:   inst.Id(),
+		Ports:        ingressRulePortRanges(rules),
+		IngressRules: rules,
 	}
-	for _, p := range ports {
-		delete(inst.ports, p)
+	for _, key := range ingressRuleKeys(rules) {
+		delete(inst.rules, key)
 	}
 	return nil
 }
 
+// Ports returns the port ranges opened to anywhere on the instance.
 func (inst *dummyInstance) Ports(machineId string) (ports []network.PortRange, err error) {
 	defer delay()
 	if inst.firewallMode != config.FwInstance {
@@ -1591,11 +1640,90 @@ func (inst *dummyInstance) Ports(machineId string) (ports []network.PortRange, e
 	if err := inst.checkBroken("Ports"); err != nil {
 		return nil, err
 	}
-	for p := range inst.ports {
-		ports = append(ports, p)
+	return openPortRanges(inst.rules), nil
+}
+
+// IngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *dummyInstance) IngressRules(machineId string) ([]network.IngressRule, error) {
+	defer delay()
+	if inst.firewallMode != config.FwInstance {
+		return nil, fmt.Errorf("invalid firewall mode %q for retrieving ports from instance",
+			inst.firewallMode)
+	}
+	if inst.machineId != machineId {
+		panic(fmt.Errorf("IngressRules with mismatched machine id, expected %q got %q", inst.machineId, machineId))
+	}
+	inst.state.mu.Lock()
+	defer inst.state.mu.Unlock()
+	if err := inst.checkBroken("IngressRules"); err != nil {
+		return nil, err
+	}
+	return ingressRulesFromKeys(inst.rules), nil
+}
+
+// ingressRuleKey identifies a port range opened to a single source CIDR.
+type ingressRuleKey struct {
+	portRange  network.PortRange
+	sourceCIDR string
+}
+
+// ingressRuleKeys returns a key for each source CIDR of each rule.
+func ingressRuleKeys(rules []network.IngressRule) []ingressRuleKey {
+	var keys []ingressRuleKey
+	for _, rule := range rules {
+		sourceCIDRs := rule.SourceCIDRs
+		if len(sourceCIDRs) == 0 {
+			sourceCIDRs = []string{network.DefaultSourceCIDR}
+		}
+		for _, cidr := range sourceCIDRs {
+			keys = append(keys, ingressRuleKey{rule.PortRange, cidr})
+		}
+	}
+	return keys
+}
+
+// ingressRulesFromKeys returns the sorted ingress rules for the given
+// keys, combining the source CIDRs of each port range.
+func ingressRulesFromKeys(keys map[ingressRuleKey]bool) []network.IngressRule {
+	sourceCIDRs := make(map[network.PortRange][]string)
+	for key := range keys {
+		sourceCIDRs[key.portRange] = append(sourceCIDRs[key.portRange], key.sourceCIDR)
+	}
+	var rules []network.IngressRule
+	for portRange, cidrs := range sourceCIDRs {
+		sort.Strings(cidrs)
+		rules = append(rules, network.IngressRule{PortRange: portRange, SourceCIDRs: cidrs})
+	}
+	network.SortIngressRules(rules)
+	return rules
+}
+
+// openPortRanges returns the sorted port ranges opened to anywhere.
+func openPortRanges(keys map[ingressRuleKey]bool) []network.PortRange {
+	var ports []network.PortRange
+	for key := range keys {
+		if key.sourceCIDR == network.DefaultSourceCIDR {
+			ports = append(ports, key.portRange)
+		}
 	}
 	network.SortPortRanges(ports)
-	return
+	return ports
+}
+
+func openIngressRules(ports []network.PortRange) []network.IngressRule {
+	rules := make([]network.IngressRule, len(ports))
+	for i, portRange := range ports {
+		rules[i] = network.NewOpenIngressRule(portRange)
+	}
+	return rules
+}
+
+func ingressRulePortRanges(rules []network.IngressRule) []network.PortRange {
+	ports := make([]network.PortRange, len(rules))
+	for i, rule := range rules {
+		ports[i] = rule.PortRange
+	}
+	return ports
 }
 
 // providerDelay controls the delay before dummy responds.
@@ -4,7 +4,9 @@
 package ec2
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"sync"
 	"time"
 
@@ -57,9 +59,11 @@ const (
 
 // AWS error codes
 const (
-	deviceInUse        = "InvalidDevice.InUse"
-	attachmentNotFound = "InvalidAttachment.NotFound"
-	volumeNotFound     = "InvalidVolume.NotFound"
+	deviceInUse          = "InvalidDevice.InUse"
+	attachmentNotFound   = "InvalidAttachment.NotFound"
+	volumeNotFound       = "InvalidVolume.NotFound"
+	snapshotNotFound     = "InvalidSnapshot.NotFound"
+	unsupportedOperation = "UnsupportedOperation"
 )
 
 const (
@@ -248,6 +252,8 @@ type ebsVolumeSource struct {
 }
 
 var _ storage.VolumeSource = (*ebsVolumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*ebsVolumeSource)(nil)
+var _ storage.VolumeResizer = (*ebsVolumeSource)(nil)
 
 // parseVolumeOptions uses storage volume parameters to make a struct used to create volumes.
 func parseVolumeOptions(size uint64, attrs map[string]interface{}) (_ ec2.CreateVolume, _ error) {
@@ -345,6 +351,7 @@ func (v *ebsVolumeSource) createVolume(p storage.VolumeParams, instances instanc
 	}
 	vol, _ := parseVolumeOptions(p.Size, p.Attributes)
 	vol.AvailZone = inst.AvailZone
+	vol.SnapshotId = p.SnapshotId
 	resp, err := v.env.ec2.CreateVolume(vol)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
@@ -606,6 +613,199 @@ func (v *ebsVolumeSource) ValidateVolumeParams(params storage.VolumeParams) erro
 	return nil
 }
 
+// CreateSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) CreateSnapshots(params []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(params))
+	for i, p := range params {
+		snapshot, err := v.createSnapshot(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot of %s", p.VolumeId)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (v *ebsVolumeSource) createSnapshot(p storage.SnapshotParams) (*storage.Snapshot, error) {
+	description := resourceName(p.Volume, v.envName)
+	resp, err := v.env.ec2.CreateSnapshot(p.VolumeId, description)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	resourceTags := make(map[string]string)
+	for k, v := range p.ResourceTags {
+		resourceTags[k] = v
+	}
+	resourceTags[tagName] = description
+	if err := tagResources(v.env.ec2, resourceTags, resp.Id); err != nil {
+		return nil, errors.Annotate(err, "tagging snapshot")
+	}
+	return snapshotFromEC2(resp.Snapshot), nil
+}
+
+// ListSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) ListSnapshots(volIds []string) ([]storage.ListSnapshotsResult, error) {
+	filter := ec2.NewFilter()
+	filter.Add("volume-id", volIds...)
+	resp, err := v.env.ec2.Snapshots(nil, filter)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	byVolumeId := make(map[string][]storage.Snapshot)
+	for _, snapshot := range resp.Snapshots {
+		byVolumeId[snapshot.VolumeId] = append(
+			byVolumeId[snapshot.VolumeId], *snapshotFromEC2(snapshot),
+		)
+	}
+	results := make([]storage.ListSnapshotsResult, len(volIds))
+	for i, volId := range volIds {
+		results[i].Snapshots = byVolumeId[volId]
+	}
+	return results, nil
+}
+
+// DeleteSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) DeleteSnapshots(snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if _, err := v.env.ec2.DeleteSnapshots([]string{snapshotId}); err != nil {
+			if ec2ErrCode(err) == snapshotNotFound {
+				// Already deleted.
+				continue
+			}
+			results[i] = errors.Annotatef(err, "deleting %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+// RestoreSnapshots is specified on the storage.VolumeSnapshotter interface.
+func (v *ebsVolumeSource) RestoreSnapshots(params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	snapshotIds := make([]string, len(params))
+	for i, p := range params {
+		snapshotIds[i] = p.SnapshotId
+	}
+	snapshotSizes := make(map[string]uint64)
+	resp, err := v.env.ec2.Snapshots(snapshotIds, nil)
+	if err != nil {
+		logger.Debugf("querying snapshots: %v", err)
+		// We ignore the error, because we don't want an invalid
+		// SnapshotId reference in one VolumeParams to prevent the
+		// creation of another volume. Creating the volume from an
+		// invalid snapshot will fail below.
+	} else {
+		for _, snapshot := range resp.Snapshots {
+			snapshotSizes[snapshot.Id] = snapshotFromEC2(snapshot).Size
+		}
+	}
+
+	// A volume created from a snapshot must be at least as large
+	// as the snapshot, so we grow any requests that are smaller.
+	params = append([]storage.VolumeParams{}, params...)
+	for i, p := range params {
+		if size := snapshotSizes[p.SnapshotId]; size > p.Size {
+			params[i].Size = size
+		}
+	}
+	return v.CreateVolumes(params)
+}
+
+// snapshotFromEC2 converts an EC2 snapshot to a storage.Snapshot.
+func snapshotFromEC2(snapshot ec2.Snapshot) *storage.Snapshot {
+	result := &storage.Snapshot{
+		SnapshotId: snapshot.Id,
+		VolumeId:   snapshot.VolumeId,
+		Status:     snapshot.Status,
+	}
+	if size, err := strconv.ParseUint(snapshot.VolumeSize, 10, 64); err == nil {
+		result.Size = gibToMib(size)
+	}
+	if created, err := time.Parse(time.RFC3339, snapshot.StartTime); err == nil {
+		result.Created = created.UTC()
+	}
+	return result
+}
+
+// ResizesAttachedVolumes is specified on the storage.VolumeResizer interface.
+// EBS volumes can be modified while they are attached to running instances.
+func (v *ebsVolumeSource) ResizesAttachedVolumes() bool {
+	return true
+}
+
+// ResizeVolumes is specified on the storage.VolumeResizer interface.
+func (v *ebsVolumeSource) ResizeVolumes(params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(params))
+	for i, p := range params {
+		info, err := v.resizeVolume(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %q", p.VolumeId)
+			continue
+		}
+		results[i].VolumeInfo = info
+	}
+	return results, nil
+}
+
+func (v *ebsVolumeSource) resizeVolume(p storage.VolumeResizeParams) (*storage.VolumeInfo, error) {
+	vol, err := describeVolume(v.env.ec2, p.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var maxVolumeSize int
+	switch vol.VolumeType {
+	case volumeTypeStandard:
+		// EC2 refuses to modify magnetic volumes.
+		return nil, errors.NotSupportedf("resizing %s volumes", volumeTypeMagnetic)
+	case volumeTypeGP2:
+		maxVolumeSize = maxSSDVolumeSizeGiB
+	case volumeTypeIO1:
+		maxVolumeSize = maxProvisionedIopsVolumeSizeGiB
+	}
+
+	info := &storage.VolumeInfo{
+		VolumeId:   vol.Id,
+		Size:       gibToMib(uint64(vol.Size)),
+		Persistent: true,
+	}
+	for _, attachment := range vol.Attachments {
+		if attachment.DeleteOnTermination {
+			info.Persistent = false
+			break
+		}
+	}
+
+	// Juju size is MiB, AWS size is GiB.
+	newSize := int(mibToGib(p.Size))
+	if newSize <= vol.Size {
+		// EC2 refuses modifications that do not change
+		// the volume, so there is nothing more to do.
+		return info, nil
+	}
+	if maxVolumeSize > 0 && newSize > maxVolumeSize {
+		return nil, errors.NewNotSupported(nil, fmt.Sprintf(
+			"volume size %d GiB exceeds the maximum of %d GiB",
+			newSize, maxVolumeSize,
+		))
+	}
+	modification, err := modifyVolume(v.env.ec2, p.VolumeId, newSize)
+	if err != nil {
+		if ec2ErrCode(err) == unsupportedOperation {
+			// The volume's instance does not support
+			// modifying the volumes attached to it.
+			return nil, errors.NewNotSupported(err, "cannot modify volume")
+		}
+		return nil, errors.Trace(err)
+	}
+	logger.Debugf(
+		"modifying volume %q from %d GiB to %d GiB: %s",
+		p.VolumeId, modification.OriginalSize,
+		modification.TargetSize, modification.ModificationState,
+	)
+	info.Size = gibToMib(uint64(modification.TargetSize))
+	return info, nil
+}
+
 // AttachVolumes is specified on the storage.VolumeSource interface.
 func (v *ebsVolumeSource) AttachVolumes(attachParams []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
 	// We need the virtualisation types for each instance we are
@@ -418,6 +418,129 @@ func (s *ebsSuite) TestDescribeVolumesNotFound(c *gc.C) {
 	c.Assert(vols[0].Error, gc.ErrorMatches, "vol-42 not found")
 }
 
+func (s *ebsSuite) TestVolumeSnapshotter(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	_, ok := vs.(storage.VolumeSnapshotter)
+	c.Assert(ok, jc.IsTrue)
+}
+
+func (*ebsSuite) TestSnapshotFromEC2(c *gc.C) {
+	snapshot := ec2.SnapshotFromEC2(awsec2.Snapshot{
+		Id:         "snap-0",
+		VolumeId:   "vol-0",
+		VolumeSize: "10",
+		Status:     "pending",
+		StartTime:  "2017-03-01T12:00:00.000Z",
+	})
+	c.Assert(snapshot, jc.DeepEquals, &storage.Snapshot{
+		SnapshotId: "snap-0",
+		VolumeId:   "vol-0",
+		Size:       10240,
+		Created:    time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC),
+		Status:     "pending",
+	})
+}
+
+func (s *ebsSuite) TestVolumeResizer(c *gc.C) {
+	vs := s.volumeSource(c, nil)
+	resizer, ok := vs.(storage.VolumeResizer)
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(resizer.ResizesAttachedVolumes(), jc.IsTrue)
+}
+
+func (s *ebsSuite) TestResizeVolumes(c *gc.C) {
+	var calls []ec2.ModifyVolumeCall
+	s.PatchValue(ec2.ModifyVolume, ec2.FakeModifyVolume(&calls, nil))
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     15 * 1000,
+	}, {
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     10 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{
+		VolumeInfo: &storage.VolumeInfo{
+			Size:       15360,
+			VolumeId:   "vol-0",
+			Persistent: true,
+		},
+	}, {
+		VolumeInfo: &storage.VolumeInfo{
+			Size:       10240,
+			VolumeId:   "vol-0",
+			Persistent: true,
+		},
+	}})
+	// The volume is already as large as requested
+	// by the second resize, so EC2 is not asked
+	// to modify it.
+	c.Assert(calls, jc.DeepEquals, []ec2.ModifyVolumeCall{{"vol-0", 15}})
+}
+
+func (s *ebsSuite) TestResizeVolumesMagnetic(c *gc.C) {
+	var calls []ec2.ModifyVolumeCall
+	s.PatchValue(ec2.ModifyVolume, ec2.FakeModifyVolume(&calls, nil))
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+		Size:     40 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, `resizing volume "vol-1": resizing magnetic volumes not supported`)
+	c.Assert(results[0].Error, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(calls, gc.HasLen, 0)
+}
+
+func (s *ebsSuite) TestResizeVolumesTooLarge(c *gc.C) {
+	var calls []ec2.ModifyVolumeCall
+	s.PatchValue(ec2.ModifyVolume, ec2.FakeModifyVolume(&calls, nil))
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     17 * 1024 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, `resizing volume "vol-0": volume size 17408 GiB exceeds the maximum of 16384 GiB`)
+	c.Assert(results[0].Error, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(calls, gc.HasLen, 0)
+}
+
+func (s *ebsSuite) TestResizeVolumesUnsupportedOperation(c *gc.C) {
+	var calls []ec2.ModifyVolumeCall
+	s.PatchValue(ec2.ModifyVolume, ec2.FakeModifyVolume(&calls, &awsec2.Error{
+		StatusCode: 400,
+		Code:       "UnsupportedOperation",
+		Message:    "instance type is not supported",
+	}))
+	vs := s.volumeSource(c, nil)
+	s.assertCreateVolumes(c, vs, "")
+
+	results, err := vs.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "vol-0",
+		Size:     20 * 1024,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, `resizing volume "vol-0": cannot modify volume: .*`)
+	c.Assert(results[0].Error, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(calls, jc.DeepEquals, []ec2.ModifyVolumeCall{{"vol-0", 20}})
+}
+
 func (s *ebsSuite) TestListVolumes(c *gc.C) {
 	vs := s.volumeSource(c, nil)
 	s.assertCreateVolumes(c, vs, "")
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -1124,19 +1125,34 @@ func portsToIPPerms(ports []network.PortRange) []ec2.IPPerm {
 	return ipPerms
 }
 
-func (e *environ) openPortsInGroup(name string, ports []network.PortRange) error {
-	if len(ports) == 0 {
+func ingressRulesToIPPerms(rules []network.IngressRule) []ec2.IPPerm {
+	ipPerms := make([]ec2.IPPerm, len(rules))
+	for i, r := range rules {
+		ipPerms[i] = ec2.IPPerm{
+			Protocol:  r.Protocol,
+			FromPort:  r.FromPort,
+			ToPort:    r.ToPort,
+			SourceIPs: r.SourceCIDRs,
+		}
+		if len(ipPerms[i].SourceIPs) == 0 {
+			ipPerms[i].SourceIPs = []string{"0.0.0.0/0"}
+		}
+	}
+	return ipPerms
+}
+
+func (e *environ) openPortsInGroup(name string, ipPerms []ec2.IPPerm) error {
+	if len(ipPerms) == 0 {
 		return nil
 	}
-	// Give permissions for anyone to access the given ports.
+	// Give permissions for the source IPs to access the given ports.
 	g, err := e.groupByName(name)
 	if err != nil {
 		return err
 	}
-	ipPerms := portsToIPPerms(ports)
 	_, err = e.ec2.AuthorizeSecurityGroup(g, ipPerms)
 	if err != nil && ec2ErrCode(err) == "InvalidPermission.Duplicate" {
-		if len(ports) == 1 {
+		if len(ipPerms) == 1 {
 			return nil
 		}
 		// If there's more than one port and we get a duplicate error,
@@ -1157,40 +1173,64 @@ func (e *environ) openPortsInGroup(name string, ports []network.PortRange) error
 	return nil
 }
 
-func (e *environ) closePortsInGroup(name string, ports []network.PortRange) error {
-	if len(ports) == 0 {
+func (e *environ) closePortsInGroup(name string, ipPerms []ec2.IPPerm) error {
+	if len(ipPerms) == 0 {
 		return nil
 	}
-	// Revoke permissions for anyone to access the given ports.
+	// Revoke permissions for the source IPs to access the given ports.
 	// Note that ec2 allows the revocation of permissions that aren't
 	// granted, so this is naturally idempotent.
 	g, err := e.groupByName(name)
 	if err != nil {
 		return err
 	}
-	_, err = e.ec2.RevokeSecurityGroup(g, portsToIPPerms(ports))
+	_, err = e.ec2.RevokeSecurityGroup(g, ipPerms)
 	if err != nil {
 		return fmt.Errorf("cannot close ports: %v", err)
 	}
 	return nil
 }
 
-func (e *environ) portsInGroup(name string) (ports []network.PortRange, err error) {
+// ingressRulesInGroup returns the ingress rules of the named group.
+// Permissions granted to other security groups, rather than to source
+// IPs, are not included.
+func (e *environ) ingressRulesInGroup(name string) (rules []network.IngressRule, err error) {
 	group, err := e.groupInfoByName(name)
 	if err != nil {
 		return nil, err
 	}
 	for _, p := range group.IPPerms {
-		if len(p.SourceIPs) != 1 {
-			logger.Errorf("expected exactly one IP permission, found: %v", p)
+		if len(p.SourceIPs) == 0 {
+			logger.Errorf("expected at least one source IP, found: %v", p)
 			continue
 		}
-		ports = append(ports, network.PortRange{
-			Protocol: p.Protocol,
-			FromPort: p.FromPort,
-			ToPort:   p.ToPort,
+		sourceCIDRs := append([]string(nil), p.SourceIPs...)
+		sort.Strings(sourceCIDRs)
+		rules = append(rules, network.IngressRule{
+			PortRange: network.PortRange{
+				Protocol: p.Protocol,
+				FromPort: p.FromPort,
+				ToPort:   p.ToPort,
+			},
+			SourceCIDRs: sourceCIDRs,
 		})
 	}
+	network.SortIngressRules(rules)
+	return rules, nil
+}
+
+// portsInGroup returns the port ranges of the named group that are
+// open to anywhere.
+func (e *environ) portsInGroup(name string) (ports []network.PortRange, err error) {
+	rules, err := e.ingressRulesInGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.IsOpen() {
+			ports = append(ports, rule.PortRange)
+		}
+	}
 	network.SortPortRanges(ports)
 	return ports, nil
 }
@@ -1199,7 +1239,7 @@ func (e *environ) OpenPorts(ports []network.PortRange) error {
 	if e.Config().FirewallMode() != config.FwGlobal {
 		return errors.Errorf("invalid firewall mode %q for opening ports on model", e.Config().FirewallMode())
 	}
-	if err := e.openPortsInGroup(e.globalGroupName(), ports); err != nil {
+	if err := e.openPortsInGroup(e.globalGroupName(), portsToIPPerms(ports)); err != nil {
 		return errors.Trace(err)
 	}
 	logger.Infof("opened ports in global group: %v", ports)
@@ -1210,7 +1250,7 @@ func (e *environ) ClosePorts(ports []network.PortRange) error {
 	if e.Config().FirewallMode() != config.FwGlobal {
 		return errors.Errorf("invalid firewall mode %q for closing ports on model", e.Config().FirewallMode())
 	}
-	if err := e.closePortsInGroup(e.globalGroupName(), ports); err != nil {
+	if err := e.closePortsInGroup(e.globalGroupName(), portsToIPPerms(ports)); err != nil {
 		return errors.Trace(err)
 	}
 	logger.Infof("closed ports in global group: %v", ports)
@@ -1224,6 +1264,40 @@ func (e *environ) Ports() ([]network.PortRange, error) {
 	return e.portsInGroup(e.globalGroupName())
 }
 
+var _ environs.IngressRuleFirewaller = (*environ)(nil)
+
+// OpenIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) OpenIngressRules(rules []network.IngressRule) error {
+	if e.Config().FirewallMode() != config.FwGlobal {
+		return errors.Errorf("invalid firewall mode %q for opening ports on model", e.Config().FirewallMode())
+	}
+	if err := e.openPortsInGroup(e.globalGroupName(), ingressRulesToIPPerms(rules)); err != nil {
+		return errors.Trace(err)
+	}
+	logger.Infof("opened ingress rules in global group: %v", rules)
+	return nil
+}
+
+// CloseIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) CloseIngressRules(rules []network.IngressRule) error {
+	if e.Config().FirewallMode() != config.FwGlobal {
+		return errors.Errorf("invalid firewall mode %q for closing ports on model", e.Config().FirewallMode())
+	}
+	if err := e.closePortsInGroup(e.globalGroupName(), ingressRulesToIPPerms(rules)); err != nil {
+		return errors.Trace(err)
+	}
+	logger.Infof("closed ingress rules in global group: %v", rules)
+	return nil
+}
+
+// IngressRules is specified in environs.IngressRuleFirewaller.
+func (e *environ) IngressRules() ([]network.IngressRule, error) {
+	if e.Config().FirewallMode() != config.FwGlobal {
+		return nil, errors.Errorf("invalid firewall mode %q for retrieving ports from model", e.Config().FirewallMode())
+	}
+	return e.ingressRulesInGroup(e.globalGroupName())
+}
+
 func (*environ) Provider() environs.EnvironProvider {
 	return &providerInstance
 }
@@ -167,3 +167,23 @@ func (*Suite) TestPortsToIPPerms(c *gc.C) {
 		c.Assert(ipperms, gc.DeepEquals, t.expected)
 	}
 }
+
+func (*Suite) TestIngressRulesToIPPerms(c *gc.C) {
+	ipperms := ingressRulesToIPPerms([]network.IngressRule{{
+		PortRange:   network.PortRange{FromPort: 80, ToPort: 80, Protocol: "tcp"},
+		SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+	}, {
+		PortRange: network.PortRange{FromPort: 53, ToPort: 53, Protocol: "udp"},
+	}})
+	c.Assert(ipperms, gc.DeepEquals, []amzec2.IPPerm{{
+		Protocol:  "tcp",
+		FromPort:  80,
+		ToPort:    80,
+		SourceIPs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+	}, {
+		Protocol:  "udp",
+		FromPort:  53,
+		ToPort:    53,
+		SourceIPs: []string{"0.0.0.0/0"},
+	}})
+}
@@ -53,6 +53,7 @@ var (
 	GetBlockDeviceMappings      = getBlockDeviceMappings
 	IsVPCNotUsableError         = isVPCNotUsableError
 	IsVPCNotRecommendedError    = isVPCNotRecommendedError
+	SnapshotFromEC2             = snapshotFromEC2
 )
 
 const VPCIDNone = vpcIDNone
@@ -74,8 +75,32 @@ var (
 	DestroyVolumeAttempt           = &destroyVolumeAttempt
 	DeleteSecurityGroupInsistently = &deleteSecurityGroupInsistently
 	TerminateInstancesById         = &terminateInstancesById
+	ModifyVolume                   = &modifyVolume
 )
 
+// ModifyVolumeCall records the arguments of a call to ModifyVolume.
+type ModifyVolumeCall struct {
+	VolumeId string
+	Size     int
+}
+
+// FakeModifyVolume returns a function to patch over ModifyVolume. The
+// function records its calls, and reports each volume as being modified
+// to the requested size, or returns err if it is not nil.
+func FakeModifyVolume(calls *[]ModifyVolumeCall, err error) func(*ec2.EC2, string, int) (*volumeModification, error) {
+	return func(_ *ec2.EC2, volumeId string, size int) (*volumeModification, error) {
+		*calls = append(*calls, ModifyVolumeCall{volumeId, size})
+		if err != nil {
+			return nil, err
+		}
+		return &volumeModification{
+			VolumeId:          volumeId,
+			ModificationState: "modifying",
+			TargetSize:        size,
+		}, nil
+	}
+}
+
 func EC2ErrCode(err error) string {
 	return ec2ErrCode(err)
 }
@@ -80,7 +80,7 @@ func (inst *ec2Instance) OpenPorts(machineId string, ports []network.PortRange)
 			inst.e.Config().FirewallMode())
 	}
 	name := inst.e.machineGroupName(machineId)
-	if err := inst.e.openPortsInGroup(name, ports); err != nil {
+	if err := inst.e.openPortsInGroup(name, portsToIPPerms(ports)); err != nil {
 		return err
 	}
 	logger.Infof("opened ports in security group %s: %v", name, ports)
@@ -93,7 +93,7 @@ func (inst *ec2Instance) ClosePorts(machineId string, ports []network.PortRange)
 			inst.e.Config().FirewallMode())
 	}
 	name := inst.e.machineGroupName(machineId)
-	if err := inst.e.closePortsInGroup(name, ports); err != nil {
+	if err := inst.e.closePortsInGroup(name, portsToIPPerms(ports)); err != nil {
 		return err
 	}
 	logger.Infof("closed ports in security group %s: %v", name, ports)
@@ -112,3 +112,42 @@ func (inst *ec2Instance) Ports(machineId string) ([]network.PortRange, error) {
 	}
 	return ranges, nil
 }
+
+var _ instance.IngressRuleFirewaller = (*ec2Instance)(nil)
+
+// OpenIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *ec2Instance) OpenIngressRules(machineId string, rules []network.IngressRule) error {
+	if inst.e.Config().FirewallMode() != config.FwInstance {
+		return fmt.Errorf("invalid firewall mode %q for opening ports on instance",
+			inst.e.Config().FirewallMode())
+	}
+	name := inst.e.machineGroupName(machineId)
+	if err := inst.e.openPortsInGroup(name, ingressRulesToIPPerms(rules)); err != nil {
+		return err
+	}
+	logger.Infof("opened ingress rules in security group %s: %v", name, rules)
+	return nil
+}
+
+// CloseIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *ec2Instance) CloseIngressRules(machineId string, rules []network.IngressRule) error {
+	if inst.e.Config().FirewallMode() != config.FwInstance {
+		return fmt.Errorf("invalid firewall mode %q for closing ports on instance",
+			inst.e.Config().FirewallMode())
+	}
+	name := inst.e.machineGroupName(machineId)
+	if err := inst.e.closePortsInGroup(name, ingressRulesToIPPerms(rules)); err != nil {
+		return err
+	}
+	logger.Infof("closed ingress rules in security group %s: %v", name, rules)
+	return nil
+}
+
+// IngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *ec2Instance) IngressRules(machineId string) ([]network.IngressRule, error) {
+	if inst.e.Config().FirewallMode() != config.FwInstance {
+		return nil, fmt.Errorf("invalid firewall mode %q for retrieving ports from instance",
+			inst.e.Config().FirewallMode())
+	}
+	return inst.e.ingressRulesInGroup(inst.e.machineGroupName(machineId))
+}
@@ -0,0 +1,98 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils"
+	"gopkg.in/amz.v3/ec2"
+)
+
+// modifyVolumeAPIVersion is the version of the EC2 API that introduced
+// ModifyVolume. The amz.v3 ec2 client speaks an older version of the
+// API, and has no ModifyVolume call.
+const modifyVolumeAPIVersion = "2016-11-15"
+
+// volumeModification describes a modification of an EBS volume, as
+// returned by ModifyVolume.
+type volumeModification struct {
+	VolumeId          string `xml:"volumeId"`
+	ModificationState string `xml:"modificationState"`
+	OriginalSize      int    `xml:"originalSize"`
+	TargetSize        int    `xml:"targetSize"`
+}
+
+type modifyVolumeResponse struct {
+	RequestId          string             `xml:"requestId"`
+	VolumeModification volumeModification `xml:"volumeModification"`
+}
+
+type modifyVolumeErrorResponse struct {
+	RequestId string `xml:"RequestID"`
+	Errors    []struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Errors>Error"`
+}
+
+// modifyVolume grows the EBS volume with the specified ID to the
+// specified size, in GiB. The request is made with the EC2 query API,
+// signed with the client's credentials and sent to its endpoint, as
+// the client itself would do. Errors returned by EC2 are reported as
+// *ec2.Error, so that ec2ErrCode can be used on them.
+var modifyVolume = func(client *ec2.EC2, volumeId string, size int) (*volumeModification, error) {
+	endpoint, err := url.Parse(client.Region.EC2Endpoint)
+	if err != nil {
+		return nil, errors.Annotate(err, "parsing EC2 endpoint")
+	}
+	params := url.Values{
+		"Action":   {"ModifyVolume"},
+		"Version":  {modifyVolumeAPIVersion},
+		"VolumeId": {volumeId},
+		"Size":     {strconv.Itoa(size)},
+	}
+	endpoint.RawQuery = params.Encode()
+	req, err := http.NewRequest("GET", endpoint.String(), nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := client.Sign(req, client.Auth); err != nil {
+		return nil, errors.Annotate(err, "signing ModifyVolume request")
+	}
+
+	resp, err := utils.GetValidatingHTTPClient().Do(req)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, modifyVolumeError(resp)
+	}
+	var result modifyVolumeResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, errors.Annotate(err, "decoding ModifyVolume response")
+	}
+	return &result.VolumeModification, nil
+}
+
+// modifyVolumeError returns an *ec2.Error describing the first error
+// in the given ModifyVolume error response.
+func modifyVolumeError(resp *http.Response) error {
+	ec2err := &ec2.Error{StatusCode: resp.StatusCode}
+	var result modifyVolumeErrorResponse
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil || len(result.Errors) == 0 {
+		ec2err.Message = resp.Status
+		return ec2err
+	}
+	ec2err.RequestId = result.RequestId
+	ec2err.Code = result.Errors[0].Code
+	ec2err.Message = result.Errors[0].Message
+	return ec2err
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"gopkg.in/amz.v3/aws"
+	"gopkg.in/amz.v3/ec2"
+	gc "gopkg.in/check.v1"
+)
+
+type modifyVolumeSuite struct {
+	testing.IsolationSuite
+
+	requests []*http.Request
+	status   int
+	response string
+	server   *httptest.Server
+	client   *ec2.EC2
+}
+
+var _ = gc.Suite(&modifyVolumeSuite{})
+
+func (s *modifyVolumeSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.requests = nil
+	s.status = http.StatusOK
+	s.response = ""
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.requests = append(s.requests, req)
+		w.WriteHeader(s.status)
+		fmt.Fprint(w, s.response)
+	}))
+	s.AddCleanup(func(*gc.C) { s.server.Close() })
+
+	region := aws.Region{
+		Name:        "test",
+		EC2Endpoint: s.server.URL,
+	}
+	auth := aws.Auth{
+		AccessKey: "access",
+		SecretKey: "secret",
+	}
+	s.client = ec2.New(auth, region, aws.SignV4Factory(region.Name, "ec2"))
+}
+
+func (s *modifyVolumeSuite) TestModifyVolume(c *gc.C) {
+	s.response = `
+<ModifyVolumeResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>5jkdf074-37ed-4004-8671-a78ee82bf1cbEXAMPLE</requestId>
+  <volumeModification>
+    <modificationState>modifying</modificationState>
+    <originalSize>10</originalSize>
+    <targetSize>20</targetSize>
+    <volumeId>vol-0</volumeId>
+  </volumeModification>
+</ModifyVolumeResponse>`
+
+	modification, err := modifyVolume(s.client, "vol-0", 20)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(modification, jc.DeepEquals, &volumeModification{
+		VolumeId:          "vol-0",
+		ModificationState: "modifying",
+		OriginalSize:      10,
+		TargetSize:        20,
+	})
+
+	c.Assert(s.requests, gc.HasLen, 1)
+	req := s.requests[0]
+	c.Assert(req.Method, gc.Equals, "GET")
+	c.Assert(req.URL.Query(), jc.DeepEquals, url.Values{
+		"Action":   {"ModifyVolume"},
+		"Version":  {"2016-11-15"},
+		"VolumeId": {"vol-0"},
+		"Size":     {"20"},
+	})
+	c.Assert(
+		req.Header.Get("Authorization"), gc.Matches,
+		"AWS4-HMAC-SHA256 Credential=access/[0-9]{8}/test/ec2/aws4_request, .*",
+	)
+}
+
+func (s *modifyVolumeSuite) TestModifyVolumeError(c *gc.C) {
+	s.status = http.StatusBadRequest
+	s.response = `
+<Response>
+  <Errors>
+    <Error>
+      <Code>IncorrectModificationState</Code>
+      <Message>Volume vol-0 is already being modified</Message>
+    </Error>
+  </Errors>
+  <RequestID>ea966190-f9aa-478e-9ede-example</RequestID>
+</Response>`
+
+	_, err := modifyVolume(s.client, "vol-0", 20)
+	c.Assert(err, jc.DeepEquals, &ec2.Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       "IncorrectModificationState",
+		Message:    "Volume vol-0 is already being modified",
+		RequestId:  "ea966190-f9aa-478e-9ede-example",
+	})
+	c.Assert(ec2ErrCode(err), gc.Equals, "IncorrectModificationState")
+}
+
+func (s *modifyVolumeSuite) TestModifyVolumeErrorUnknownBody(c *gc.C) {
+	s.status = http.StatusInternalServerError
+	s.response = "oops"
+
+	_, err := modifyVolume(s.client, "vol-0", 20)
+	c.Assert(err, jc.DeepEquals, &ec2.Error{
+		StatusCode: http.StatusInternalServerError,
+		Message:    "500 Internal Server Error",
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/utils"
@@ -70,6 +71,8 @@ type volumeSource struct {
 	modelUUID string
 }
 
+var _ storage.VolumeSnapshotter = (*volumeSource)(nil)
+
 func (g *storageProvider) VolumeSource(cfg *storage.Config) (storage.VolumeSource, error) {
 	environConfig := g.env.Config()
 	source := &volumeSource{
@@ -205,6 +208,7 @@ func (v *volumeSource) createOneVolume(p storage.VolumeParams, instances instanc
 		Name:               volumeName,
 		PersistentDiskType: persistentType,
 		Description:        v.modelUUID,
+		SourceSnapshot:     p.SnapshotId,
 	}
 
 	gceDisks, err := v.gce.CreateDisks(zone, []google.DiskSpec{disk})
@@ -415,3 +419,113 @@ func (v *volumeSource) detachOneVolume(attachParam storage.VolumeAttachmentParam
 	}
 	return v.gce.DetachDisk(zone, string(instId), volumeName)
 }
+
+func (v *volumeSource) CreateSnapshots(params []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(params))
+	for i, p := range params {
+		snapshot, err := v.createOneSnapshot(p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "cannot create snapshot of %q", p.VolumeId)
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func nameSnapshot() (string, error) {
+	snapshotUUID, err := utils.NewUUID()
+	if err != nil {
+		return "", errors.Annotate(err, "cannot generate uuid to name the snapshot")
+	}
+	return "juju-snap-" + snapshotUUID.String(), nil
+}
+
+func (v *volumeSource) createOneSnapshot(p storage.SnapshotParams) (*storage.Snapshot, error) {
+	zone, _, err := parseVolumeId(p.VolumeId)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid volume id %q", p.VolumeId)
+	}
+	snapshotName, err := nameSnapshot()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	// As with disks, the model UUID is stored in the description.
+	snapshot, err := v.gce.CreateSnapshot(zone, p.VolumeId, snapshotName, v.modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return snapshotFromGoogle(snapshot), nil
+}
+
+func (v *volumeSource) ListSnapshots(volNames []string) ([]storage.ListSnapshotsResult, error) {
+	snapshots, err := v.gce.Snapshots()
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot list snapshots")
+	}
+	byVolumeName := make(map[string][]storage.Snapshot)
+	for _, snapshot := range snapshots {
+		byVolumeName[snapshot.VolumeName] = append(
+			byVolumeName[snapshot.VolumeName], *snapshotFromGoogle(snapshot),
+		)
+	}
+	results := make([]storage.ListSnapshotsResult, len(volNames))
+	for i, volName := range volNames {
+		results[i].Snapshots = byVolumeName[volName]
+	}
+	return results, nil
+}
+
+func (v *volumeSource) DeleteSnapshots(snapshotNames []string) ([]error, error) {
+	results := make([]error, len(snapshotNames))
+	for i, snapshotName := range snapshotNames {
+		if err := v.gce.RemoveSnapshot(snapshotName); err != nil {
+			results[i] = errors.Annotatef(err, "cannot delete snapshot %q", snapshotName)
+		}
+	}
+	return results, nil
+}
+
+func (v *volumeSource) RestoreSnapshots(params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(params))
+	var restoreParams []storage.VolumeParams
+	var restoreIndices []int
+	for i, p := range params {
+		snapshot, err := v.gce.Snapshot(p.SnapshotId)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "cannot restore snapshot %q", p.SnapshotId)
+			continue
+		}
+		// A disk created from a snapshot must be at least as
+		// large as the snapshotted disk.
+		if snapshot.Size > p.Size {
+			p.Size = snapshot.Size
+		}
+		restoreParams = append(restoreParams, p)
+		restoreIndices = append(restoreIndices, i)
+	}
+	if len(restoreParams) == 0 {
+		return results, nil
+	}
+	created, err := v.CreateVolumes(restoreParams)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for i, result := range created {
+		results[restoreIndices[i]] = result
+	}
+	return results, nil
+}
+
+func snapshotFromGoogle(snapshot *google.Snapshot) *storage.Snapshot {
+	result := &storage.Snapshot{
+		SnapshotId: snapshot.Name,
+		VolumeId:   snapshot.VolumeName,
+		Size:       snapshot.Size,
+		Status:     snapshot.Status,
+	}
+	if created, err := time.Parse(time.RFC3339, snapshot.Created); err == nil {
+		result.Created = created.UTC()
+	}
+	return result
+}
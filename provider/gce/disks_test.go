@@ -4,6 +4,8 @@
 package gce_test
 
 import (
+	"time"
+
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
@@ -302,3 +304,111 @@ func (s *volumeSourceSuite) TestDetachVolumes(c *gc.C) {
 	c.Assert(call[0].InstanceId, gc.Equals, string(s.instId))
 	c.Assert(call[0].VolumeName, gc.Equals, volName)
 }
+
+func (s *volumeSourceSuite) snapshotter(c *gc.C) storage.VolumeSnapshotter {
+	snapshotter, ok := s.source.(storage.VolumeSnapshotter)
+	c.Assert(ok, jc.IsTrue)
+	return snapshotter
+}
+
+func (s *volumeSourceSuite) baseSnapshot() *google.Snapshot {
+	return &google.Snapshot{
+		Name:       "juju-snap-1234",
+		VolumeName: s.BaseDisk.Name,
+		Size:       2048,
+		Created:    "2017-03-01T12:00:00.000-00:00",
+		Status:     "READY",
+	}
+}
+
+func (s *volumeSourceSuite) TestCreateSnapshots(c *gc.C) {
+	s.FakeConn.GoogleSnapshot = s.baseSnapshot()
+	res, err := s.snapshotter(c).CreateSnapshots([]storage.SnapshotParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: s.BaseDisk.Name,
+	}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: "juju-snap-1234",
+			VolumeId:   s.BaseDisk.Name,
+			Size:       2048,
+			Created:    time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC),
+			Status:     "READY",
+		},
+	}})
+
+	createCalled, call := s.FakeConn.WasCalled("CreateSnapshot")
+	c.Check(call, gc.HasLen, 1)
+	c.Assert(createCalled, jc.IsTrue)
+	c.Assert(call[0].ZoneName, gc.Equals, "home-zone")
+	c.Assert(call[0].VolumeName, gc.Equals, s.BaseDisk.Name)
+	c.Assert(call[0].ID, jc.HasPrefix, "juju-snap-")
+}
+
+func (s *volumeSourceSuite) TestCreateSnapshotsInvalidVolumeId(c *gc.C) {
+	res, err := s.snapshotter(c).CreateSnapshots([]storage.SnapshotParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-name",
+	}})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 1)
+	c.Assert(res[0].Error, gc.ErrorMatches, `cannot create snapshot of "volume-name": invalid volume id .*`)
+	createCalled, _ := s.FakeConn.WasCalled("CreateSnapshot")
+	c.Assert(createCalled, jc.IsFalse)
+}
+
+func (s *volumeSourceSuite) TestListSnapshots(c *gc.C) {
+	otherSnapshot := s.baseSnapshot()
+	otherSnapshot.Name = "juju-snap-5678"
+	otherSnapshot.VolumeName = "home-zone--566fe7b2-c026-4a86-a2cc-84cb7f9a4868"
+	s.FakeConn.GoogleSnapshots = []*google.Snapshot{s.baseSnapshot(), otherSnapshot}
+	res, err := s.snapshotter(c).ListSnapshots([]string{s.BaseDisk.Name, "a--volume-name"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 2)
+	c.Assert(res[0].Snapshots, gc.HasLen, 1)
+	c.Assert(res[0].Snapshots[0].SnapshotId, gc.Equals, "juju-snap-1234")
+	c.Assert(res[1].Snapshots, gc.HasLen, 0)
+}
+
+func (s *volumeSourceSuite) TestDeleteSnapshots(c *gc.C) {
+	errs, err := s.snapshotter(c).DeleteSnapshots([]string{"juju-snap-1234"})
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(errs, jc.DeepEquals, []error{nil})
+
+	removeCalled, call := s.FakeConn.WasCalled("RemoveSnapshot")
+	c.Check(call, gc.HasLen, 1)
+	c.Assert(removeCalled, jc.IsTrue)
+	c.Assert(call[0].ID, gc.Equals, "juju-snap-1234")
+}
+
+func (s *volumeSourceSuite) TestRestoreSnapshots(c *gc.C) {
+	s.FakeConn.Insts = []google.Instance{*s.BaseInstance}
+	s.FakeConn.GoogleDisks = []*google.Disk{s.BaseDisk}
+	s.FakeConn.GoogleDisk = s.BaseDisk
+	s.FakeConn.GoogleSnapshot = s.baseSnapshot()
+	s.FakeConn.AttachedDisk = &google.AttachedDisk{
+		VolumeName: s.BaseDisk.Name,
+		DeviceName: "home-zone-1234567",
+		Mode:       "READ_WRITE",
+	}
+	s.params[0].SnapshotId = "juju-snap-1234"
+	res, err := s.snapshotter(c).RestoreSnapshots(s.params)
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(res, gc.HasLen, 1)
+	c.Assert(res[0].Error, jc.ErrorIsNil)
+	c.Assert(res[0].Volume.VolumeId, gc.Equals, s.BaseDisk.Name)
+
+	snapshotCalled, call := s.FakeConn.WasCalled("Snapshot")
+	c.Check(call, gc.HasLen, 1)
+	c.Assert(snapshotCalled, jc.IsTrue)
+	c.Assert(call[0].ID, gc.Equals, "juju-snap-1234")
+
+	// The disk is created from the snapshot, and is grown to
+	// the size of the snapshotted disk.
+	createCalled, call := s.FakeConn.WasCalled("CreateDisks")
+	c.Check(call, gc.HasLen, 1)
+	c.Assert(createCalled, jc.IsTrue)
+	c.Assert(call[0].Disks[0].SourceSnapshot, gc.Equals, "juju-snap-1234")
+	c.Assert(call[0].Disks[0].SizeHintGB, gc.Equals, uint64(2))
+}
@@ -33,6 +33,10 @@ type gceConnection interface {
 	OpenPorts(fwname string, ports ...network.PortRange) error
 	ClosePorts(fwname string, ports ...network.PortRange) error
 
+	IngressRules(fwname string) ([]network.IngressRule, error)
+	OpenIngressRules(fwname string, rules ...network.IngressRule) error
+	CloseIngressRules(fwname string, rules ...network.IngressRule) error
+
 	AvailabilityZones(region string) ([]google.AvailabilityZone, error)
 
 	// Storage related methods.
@@ -57,6 +61,16 @@ type gceConnection interface {
 	InstanceDisks(zone, instanceId string) ([]*google.AttachedDisk, error)
 	// ListMachineTypes returns a list of machines available in the project and zone provided.
 	ListMachineTypes(zone string) ([]google.MachineType, error)
+	// CreateSnapshot will create a snapshot named <snapshotName> of the
+	// disk <volumeName> in <zone>, and return a Snapshot representing it.
+	CreateSnapshot(zone, volumeName, snapshotName, description string) (*google.Snapshot, error)
+	// Snapshots will return a list of all of the snapshots in the project.
+	Snapshots() ([]*google.Snapshot, error)
+	// Snapshot will return a Snapshot representing the snapshot
+	// identified by the passed <name> or error.
+	Snapshot(name string) (*google.Snapshot, error)
+	// RemoveSnapshot will destroy the snapshot identified by <name>.
+	RemoveSnapshot(name string) error
 }
 
 type environ struct {
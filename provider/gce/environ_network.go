@@ -6,10 +6,13 @@ package gce
 import (
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/environs"
 	"github.com/juju/juju/network"
 	"github.com/juju/juju/provider/common"
 )
 
+var _ environs.IngressRuleFirewaller = (*environ)(nil)
+
 // globalFirewallName returns the name to use for the global firewall.
 func (env *environ) globalFirewallName() string {
 	return common.EnvFullName(env.uuid)
@@ -38,3 +41,27 @@ func (env *environ) Ports() ([]network.PortRange, error) {
 	ports, err := env.gce.Ports(env.globalFirewallName())
 	return ports, errors.Trace(err)
 }
+
+// OpenIngressRules is specified in environs.IngressRuleFirewaller.
+// Must only be used if the environment was setup with the
+// FwGlobal firewall mode.
+func (env *environ) OpenIngressRules(rules []network.IngressRule) error {
+	err := env.gce.OpenIngressRules(env.globalFirewallName(), rules...)
+	return errors.Trace(err)
+}
+
+// CloseIngressRules is specified in environs.IngressRuleFirewaller.
+// Must only be used if the environment was setup with the
+// FwGlobal firewall mode.
+func (env *environ) CloseIngressRules(rules []network.IngressRule) error {
+	err := env.gce.CloseIngressRules(env.globalFirewallName(), rules...)
+	return errors.Trace(err)
+}
+
+// IngressRules is specified in environs.IngressRuleFirewaller.
+// Must only be used if the environment was setup with the
+// FwGlobal firewall mode.
+func (env *environ) IngressRules() ([]network.IngressRule, error) {
+	rules, err := env.gce.IngressRules(env.globalFirewallName())
+	return rules, errors.Trace(err)
+}
@@ -75,3 +75,37 @@ func (s *environNetSuite) TestPortsAPI(c *gc.C) {
 	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "Ports")
 	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, fwname)
 }
+
+func (s *environNetSuite) TestOpenIngressRulesAPI(c *gc.C) {
+	fwname := gce.GlobalFirewallName(s.Env)
+	err := s.Env.OpenIngressRules(s.Rules)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "OpenIngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, fwname)
+	c.Check(s.FakeConn.Calls[0].Rules, jc.DeepEquals, s.Rules)
+}
+
+func (s *environNetSuite) TestCloseIngressRulesAPI(c *gc.C) {
+	fwname := gce.GlobalFirewallName(s.Env)
+	err := s.Env.CloseIngressRules(s.Rules)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "CloseIngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, fwname)
+	c.Check(s.FakeConn.Calls[0].Rules, jc.DeepEquals, s.Rules)
+}
+
+func (s *environNetSuite) TestIngressRules(c *gc.C) {
+	s.FakeConn.Rules = s.Rules
+
+	rules, err := s.Env.IngressRules()
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(rules, jc.DeepEquals, s.Rules)
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "IngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, gce.GlobalFirewallName(s.Env))
+}
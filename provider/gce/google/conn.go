@@ -35,6 +35,10 @@ type rawConnectionWrapper interface {
 	// the named firewall and returns it. If the firewall is not found,
 	// errors.NotFound is returned.
 	GetFirewall(projectID, name string) (*compute.Firewall, error)
+	// ListFirewalls sends an API request to GCE for the information
+	// about the firewalls whose names match the given regular
+	// expression, and returns them.
+	ListFirewalls(projectID, pattern string) ([]*compute.Firewall, error)
 	// AddFirewall requests GCE to add a firewall with the provided info.
 	// If the firewall already exists then an error will be returned.
 	// The call blocks until the firewall is added or the request fails.
@@ -72,6 +76,15 @@ type rawConnectionWrapper interface {
 	InstanceDisks(project, zone, instanceId string) ([]*compute.AttachedDisk, error)
 	// ListMachineTypes returns a list of machines available in the project and zone provided.
 	ListMachineTypes(projectID, zone string) (*compute.MachineTypeList, error)
+	// CreateSnapshot will create a snapshot of the disk identified by
+	// disk that matches the specified in spec.
+	CreateSnapshot(project, zone, disk string, spec *compute.Snapshot) error
+	// ListSnapshots returns a list of snapshots available for a given project.
+	ListSnapshots(project string) ([]*compute.Snapshot, error)
+	// GetSnapshot will return the snapshot correspondent to the passed name.
+	GetSnapshot(project, name string) (*compute.Snapshot, error)
+	// RemoveSnapshot will delete the snapshot identified by name.
+	RemoveSnapshot(project, name string) error
 }
 
 // TODO(ericsnow) Add specific error types for common failures
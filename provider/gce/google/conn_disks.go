@@ -142,3 +142,42 @@ func (gce *Connection) InstanceDisks(zone, instanceId string) ([]*AttachedDisk,
 	}
 	return att, nil
 }
+
+// CreateSnapshot implements storage section of gceConnection.
+func (gce *Connection) CreateSnapshot(zone, volumeName, snapshotName, description string) (*Snapshot, error) {
+	spec := &compute.Snapshot{
+		Name:        snapshotName,
+		Description: description,
+	}
+	if err := gce.raw.CreateSnapshot(gce.projectID, zone, volumeName, spec); err != nil {
+		return nil, errors.Annotatef(err, "cannot create snapshot of %q", volumeName)
+	}
+	return gce.Snapshot(snapshotName)
+}
+
+// Snapshots implements storage section of gceConnection.
+func (gce *Connection) Snapshots() ([]*Snapshot, error) {
+	computeSnapshots, err := gce.raw.ListSnapshots(gce.projectID)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot list snapshots")
+	}
+	snapshots := make([]*Snapshot, len(computeSnapshots))
+	for i, snapshot := range computeSnapshots {
+		snapshots[i] = NewSnapshot(snapshot)
+	}
+	return snapshots, nil
+}
+
+// Snapshot implements storage section of gceConnection.
+func (gce *Connection) Snapshot(name string) (*Snapshot, error) {
+	snapshot, err := gce.raw.GetSnapshot(gce.projectID, name)
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get snapshot %q", name)
+	}
+	return NewSnapshot(snapshot), nil
+}
+
+// RemoveSnapshot implements storage section of gceConnection.
+func (gce *Connection) RemoveSnapshot(name string) error {
+	return gce.raw.RemoveSnapshot(gce.projectID, name)
+}
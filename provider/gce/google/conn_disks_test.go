@@ -142,3 +142,70 @@ func (s *connSuite) TestConnectionInstanceDisks(c *gc.C) {
 	c.Check(s.FakeConn.Calls[0].ZoneName, gc.Equals, "home-zone")
 	c.Check(s.FakeConn.Calls[0].InstanceId, gc.Equals, "a-fake-instance")
 }
+
+func fakeSnapshot() *compute.Snapshot {
+	return &compute.Snapshot{
+		Name:              "juju-snap-1234",
+		Description:       "model-uuid",
+		SourceDisk:        "/projects/spam/zones/home-zone/disks/" + fakeVolName,
+		DiskSizeGb:        10,
+		CreationTimestamp: "2017-03-01T12:00:00.000-00:00",
+		Status:            "READY",
+	}
+}
+
+func (s *connSuite) TestConnectionCreateSnapshot(c *gc.C) {
+	s.FakeConn.Snapshot = fakeSnapshot()
+	snapshot, err := s.Conn.CreateSnapshot("home-zone", fakeVolName, "juju-snap-1234", "model-uuid")
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(snapshot, jc.DeepEquals, &google.Snapshot{
+		Name:        "juju-snap-1234",
+		Description: "model-uuid",
+		VolumeName:  fakeVolName,
+		Size:        10240,
+		Created:     "2017-03-01T12:00:00.000-00:00",
+		Status:      "READY",
+	})
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 2)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "CreateSnapshot")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+	c.Check(s.FakeConn.Calls[0].ZoneName, gc.Equals, "home-zone")
+	c.Check(s.FakeConn.Calls[0].ID, gc.Equals, fakeVolName)
+	c.Check(s.FakeConn.Calls[0].Snapshot, jc.DeepEquals, &compute.Snapshot{
+		Name:        "juju-snap-1234",
+		Description: "model-uuid",
+	})
+	c.Check(s.FakeConn.Calls[1].FuncName, gc.Equals, "GetSnapshot")
+	c.Check(s.FakeConn.Calls[1].Name, gc.Equals, "juju-snap-1234")
+}
+
+func (s *connSuite) TestConnectionSnapshots(c *gc.C) {
+	s.FakeConn.Snapshots = []*compute.Snapshot{fakeSnapshot()}
+	snapshots, err := s.Conn.Snapshots()
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(snapshots, gc.HasLen, 1)
+	c.Assert(snapshots[0], jc.DeepEquals, google.NewSnapshot(fakeSnapshot()))
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "ListSnapshots")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+}
+
+func (s *connSuite) TestConnectionRemoveSnapshot(c *gc.C) {
+	err := s.Conn.RemoveSnapshot("juju-snap-1234")
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "RemoveSnapshot")
+	c.Check(s.FakeConn.Calls[0].ProjectID, gc.Equals, "spam")
+	c.Check(s.FakeConn.Calls[0].Name, gc.Equals, "juju-snap-1234")
+}
+
+func (s *connSuite) TestNewDetachedFromSnapshot(c *gc.C) {
+	spec, _, err := fakeDiskAndSpec()
+	c.Check(err, jc.ErrorIsNil)
+	spec.SourceSnapshot = "juju-snap-1234"
+	disk, err := google.NewDetached(spec)
+	c.Check(err, jc.ErrorIsNil)
+	c.Assert(disk.SourceSnapshot, gc.Equals, "global/snapshots/juju-snap-1234")
+}
@@ -4,7 +4,15 @@
 package google
 
 import (
+	"crypto/sha256"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
 	"github.com/juju/errors"
+	"github.com/juju/utils/set"
+	"google.golang.org/api/compute/v1"
 
 	"github.com/juju/juju/network"
 )
@@ -21,6 +29,11 @@ func (gce Connection) Ports(fwname string) ([]network.PortRange, error) {
 		return nil, errors.Annotate(err, "while getting ports from GCE")
 	}
 
+	return firewallPorts(firewall)
+}
+
+// firewallPorts returns the port ranges opened by the firewall.
+func firewallPorts(firewall *compute.Firewall) ([]network.PortRange, error) {
 	var ports []network.PortRange
 	for _, allowed := range firewall.Allowed {
 		for _, portRangeStr := range allowed.Ports {
@@ -32,7 +45,6 @@ func (gce Connection) Ports(fwname string) ([]network.PortRange, error) {
 			ports = append(ports, portRange)
 		}
 	}
-
 	return ports, nil
 }
 
@@ -111,3 +123,162 @@ func (gce Connection) ClosePorts(fwname string, ports ...network.PortRange) erro
 	}
 	return nil
 }
+
+// A GCE firewall allows traffic from a single set of source ranges, so
+// the ingress rules for the instances tagged with a firewall name are
+// held in one firewall for each set of source CIDRs. Rules open to
+// anywhere are held in the firewall with that name itself, which is
+// the one used by Ports, OpenPorts and ClosePorts; the firewall for any
+// other set of source CIDRs has a suffix derived from them.
+const ingressFirewallSuffix = "-src-"
+
+// ingressFirewallName returns the name of the firewall holding the
+// ingress rules that allow traffic from the source CIDRs to instances
+// tagged with fwname.
+func ingressFirewallName(fwname string, sourceCIDRs []string) string {
+	if len(sourceCIDRs) == 1 && sourceCIDRs[0] == network.DefaultSourceCIDR {
+		return fwname
+	}
+	sum := sha256.Sum256([]byte(strings.Join(sourceCIDRs, ",")))
+	return fmt.Sprintf("%s%s%x", fwname, ingressFirewallSuffix, sum[:4])
+}
+
+// ingressFirewallPattern returns a regular expression matching the
+// names of all the firewalls holding ingress rules for instances
+// tagged with fwname.
+func ingressFirewallPattern(fwname string) string {
+	return regexp.QuoteMeta(fwname) + "(" + ingressFirewallSuffix + "[0-9a-f]{8})?"
+}
+
+// ingressGroup holds the ports of the ingress rules that allow traffic
+// from the same source CIDRs.
+type ingressGroup struct {
+	sourceCIDRs []string
+	ports       network.PortSet
+}
+
+// groupIngressRules groups the ingress rules by their source CIDRs, in
+// a consistent order.
+func groupIngressRules(rules []network.IngressRule) []ingressGroup {
+	groups := make(map[string]*ingressGroup)
+	var keys []string
+	for _, rule := range rules {
+		sourceCIDRs := set.NewStrings(rule.SourceCIDRs...).SortedValues()
+		if len(sourceCIDRs) == 0 {
+			sourceCIDRs = []string{network.DefaultSourceCIDR}
+		}
+		key := strings.Join(sourceCIDRs, ",")
+		group, ok := groups[key]
+		if !ok {
+			group = &ingressGroup{
+				sourceCIDRs: sourceCIDRs,
+				ports:       network.NewPortSet(),
+			}
+			groups[key] = group
+			keys = append(keys, key)
+		}
+		group.ports.AddRanges(rule.PortRange)
+	}
+	sort.Strings(keys)
+	result := make([]ingressGroup, len(keys))
+	for i, key := range keys {
+		result[i] = *groups[key]
+	}
+	return result
+}
+
+// IngressRules returns the ingress rules opened on the firewalls for
+// instances tagged with the given firewall name (within the
+// Connection's project), sorted by network.SortIngressRules(). If
+// there are no such firewalls then the list will be empty and no
+// error is returned.
+func (gce Connection) IngressRules(fwname string) ([]network.IngressRule, error) {
+	firewalls, err := gce.raw.ListFirewalls(gce.projectID, ingressFirewallPattern(fwname))
+	if err != nil {
+		return nil, errors.Annotate(err, "while getting ingress rules from GCE")
+	}
+
+	var rules []network.IngressRule
+	for _, firewall := range firewalls {
+		ports, err := firewallPorts(firewall)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		sourceCIDRs := set.NewStrings(firewall.SourceRanges...).SortedValues()
+		for _, portRange := range ports {
+			rules = append(rules, network.IngressRule{
+				PortRange:   portRange,
+				SourceCIDRs: sourceCIDRs,
+			})
+		}
+	}
+	network.SortIngressRules(rules)
+	return rules, nil
+}
+
+// OpenIngressRules sends requests to the GCE API to open the provided
+// ingress rules for instances tagged with the given firewall name. The
+// rules are added to the firewall for their source CIDRs, which is
+// created if it does not exist yet. The call blocks until the rules
+// are opened or a request fails.
+func (gce Connection) OpenIngressRules(fwname string, rules ...network.IngressRule) error {
+	for _, group := range groupIngressRules(rules) {
+		name := ingressFirewallName(fwname, group.sourceCIDRs)
+		firewall, err := gce.raw.GetFirewall(gce.projectID, name)
+		if errors.IsNotFound(err) {
+			firewall := ingressFirewallSpec(name, fwname, group.sourceCIDRs, group.ports)
+			if err := gce.raw.AddFirewall(gce.projectID, firewall); err != nil {
+				return errors.Annotatef(err, "opening ingress rules %v", rules)
+			}
+			continue
+		}
+		if err != nil {
+			return errors.Annotate(err, "while getting ingress rules from GCE")
+		}
+		currentPorts, err := firewallPorts(firewall)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		newPortsSet := network.NewPortSet(currentPorts...).Union(group.ports)
+		firewall = ingressFirewallSpec(name, fwname, group.sourceCIDRs, newPortsSet)
+		if err := gce.raw.UpdateFirewall(gce.projectID, name, firewall); err != nil {
+			return errors.Annotatef(err, "opening ingress rules %v", rules)
+		}
+	}
+	return nil
+}
+
+// CloseIngressRules sends requests to the GCE API to close the
+// provided ingress rules for instances tagged with the given firewall
+// name. The rules are removed from the firewall for their source
+// CIDRs, if it exists, and the firewall is removed if it is left with
+// no ports. The call blocks until the rules are closed or a request
+// fails.
+func (gce Connection) CloseIngressRules(fwname string, rules ...network.IngressRule) error {
+	for _, group := range groupIngressRules(rules) {
+		name := ingressFirewallName(fwname, group.sourceCIDRs)
+		firewall, err := gce.raw.GetFirewall(gce.projectID, name)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Annotate(err, "while getting ingress rules from GCE")
+		}
+		currentPorts, err := firewallPorts(firewall)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		newPortsSet := network.NewPortSet(currentPorts...).Difference(group.ports)
+		if newPortsSet.IsEmpty() {
+			if err := gce.raw.RemoveFirewall(gce.projectID, name); err != nil {
+				return errors.Annotatef(err, "closing ingress rules %v", rules)
+			}
+			continue
+		}
+		firewall = ingressFirewallSpec(name, fwname, group.sourceCIDRs, newPortsSet)
+		if err := gce.raw.UpdateFirewall(gce.projectID, name, firewall); err != nil {
+			return errors.Annotatef(err, "closing ingress rules %v", rules)
+		}
+	}
+	return nil
+}
@@ -173,3 +173,124 @@ func (s *connSuite) TestConnectionClosePortsUpdate(c *gc.C) {
 		}},
 	})
 }
+
+func (s *connSuite) TestConnectionIngressRules(c *gc.C) {
+	s.FakeConn.Firewalls = []*compute.Firewall{{
+		Name:         "spam",
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"0.0.0.0/0"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"80-81"},
+		}},
+	}, {
+		Name:         "spam-src-0123abcd",
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"192.168.1.0/24", "10.0.0.0/8"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"443"},
+		}},
+	}}
+
+	rules, err := s.Conn.IngressRules("spam")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(rules, jc.DeepEquals, []network.IngressRule{{
+		PortRange:   network.PortRange{FromPort: 80, ToPort: 81, Protocol: "tcp"},
+		SourceCIDRs: []string{"0.0.0.0/0"},
+	}, {
+		PortRange:   network.PortRange{FromPort: 443, ToPort: 443, Protocol: "tcp"},
+		SourceCIDRs: []string{"10.0.0.0/8", "192.168.1.0/24"},
+	}})
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "ListFirewalls")
+	c.Check(s.FakeConn.Calls[0].Prefix, gc.Equals, "spam(-src-[0-9a-f]{8})?")
+}
+
+func (s *connSuite) TestConnectionOpenIngressRulesAdd(c *gc.C) {
+	s.FakeConn.Err = errors.NotFoundf("spam")
+
+	rule, err := network.NewIngressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.Conn.OpenIngressRules("spam", rule)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 2)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "GetFirewall")
+	c.Check(s.FakeConn.Calls[0].Name, gc.Matches, "spam-src-[0-9a-f]{8}")
+	c.Check(s.FakeConn.Calls[1].FuncName, gc.Equals, "AddFirewall")
+	c.Check(s.FakeConn.Calls[1].Firewall, jc.DeepEquals, &compute.Firewall{
+		Name:         s.FakeConn.Calls[0].Name,
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"10.0.0.0/8"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"443"},
+		}},
+	})
+}
+
+func (s *connSuite) TestConnectionOpenIngressRulesOpenToAnywhere(c *gc.C) {
+	s.FakeConn.Firewall = &compute.Firewall{
+		Name:         "spam",
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"0.0.0.0/0"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"80"},
+		}},
+	}
+
+	err := s.Conn.OpenIngressRules("spam", network.NewOpenIngressRule(network.MustParsePortRange("443/tcp")))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 2)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "GetFirewall")
+	c.Check(s.FakeConn.Calls[0].Name, gc.Equals, "spam")
+	c.Check(s.FakeConn.Calls[1].FuncName, gc.Equals, "UpdateFirewall")
+	sort.Strings(s.FakeConn.Calls[1].Firewall.Allowed[0].Ports)
+	c.Check(s.FakeConn.Calls[1].Firewall, jc.DeepEquals, &compute.Firewall{
+		Name:         "spam",
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"0.0.0.0/0"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"443", "80"},
+		}},
+	})
+}
+
+func (s *connSuite) TestConnectionCloseIngressRulesRemove(c *gc.C) {
+	s.FakeConn.Firewall = &compute.Firewall{
+		Name:         "spam-src-0123abcd",
+		TargetTags:   []string{"spam"},
+		SourceRanges: []string{"10.0.0.0/8"},
+		Allowed: []*compute.FirewallAllowed{{
+			IPProtocol: "tcp",
+			Ports:      []string{"443"},
+		}},
+	}
+
+	rule, err := network.NewIngressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.Conn.CloseIngressRules("spam", rule)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 2)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "GetFirewall")
+	c.Check(s.FakeConn.Calls[1].FuncName, gc.Equals, "RemoveFirewall")
+	c.Check(s.FakeConn.Calls[1].Name, gc.Equals, s.FakeConn.Calls[0].Name)
+}
+
+func (s *connSuite) TestConnectionCloseIngressRulesNotFound(c *gc.C) {
+	s.FakeConn.Err = errors.NotFoundf("spam")
+
+	rule, err := network.NewIngressRule(network.MustParsePortRange("443/tcp"), "10.0.0.0/8")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.Conn.CloseIngressRules("spam", rule)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "GetFirewall")
+}
@@ -114,6 +114,9 @@ type DiskSpec struct {
 	// Description was picked because it is not mutable (actually no field is) for disks.
 	// There is a metadata API but it is not supported for disks for the moment.
 	Description string
+	// SourceSnapshot is the name of the snapshot from which the disk
+	// should be initialized, if any.
+	SourceSnapshot string
 }
 
 // TooSmall checks the spec's size hint and indicates whether or not
@@ -175,13 +178,17 @@ func (ds *DiskSpec) newDetached() (*compute.Disk, error) {
 	if ds.PersistentDiskType == DiskLocalSSD {
 		return nil, errors.New("cannot create local ssd disks detached")
 	}
-	return &compute.Disk{
+	disk := &compute.Disk{
 		Name:        ds.Name,
 		SizeGb:      int64(ds.SizeGB()),
 		SourceImage: ds.ImageURL,
 		Type:        string(ds.PersistentDiskType),
 		Description: ds.Description,
-	}, nil
+	}
+	if ds.SourceSnapshot != "" {
+		disk.SourceSnapshot = snapshotsBase + ds.SourceSnapshot
+	}
+	return disk, nil
 }
 
 // AttachedDisk represents a disk that is attached to an instance.
@@ -228,3 +235,38 @@ func NewDisk(cd *compute.Disk) *Disk {
 	}
 	return d
 }
+
+// snapshotsBase is the partial URL of the project's snapshots, to
+// which a snapshot name is appended to reference the snapshot.
+const snapshotsBase = "global/snapshots/"
+
+// Snapshot represents a gce snapshot of a disk.
+type Snapshot struct {
+	// Name is a unique identifier string for each snapshot.
+	Name string
+	// Description holds the description field for a snapshot, we
+	// store env UUID here.
+	Description string
+	// VolumeName is the name of the disk from which the snapshot
+	// was taken.
+	VolumeName string
+	// Size is the size of the snapshotted disk, in MiB.
+	Size uint64
+	// Created is the time at which the snapshot was created, in
+	// RFC3339 format.
+	Created string
+	// Status holds the status of the snapshot, e.g. "CREATING" or
+	// "READY".
+	Status string
+}
+
+func NewSnapshot(cs *compute.Snapshot) *Snapshot {
+	return &Snapshot{
+		Name:        cs.Name,
+		Description: cs.Description,
+		VolumeName:  sourceToVolumeName(cs.SourceDisk),
+		Size:        gibToMib(cs.DiskSizeGb),
+		Created:     cs.CreationTimestamp,
+		Status:      cs.Status,
+	}
+}
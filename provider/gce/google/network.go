@@ -58,14 +58,22 @@ func (ns *NetworkSpec) newInterface(name string) *compute.NetworkInterface {
 // firewallSpec expands a port range set in to compute.FirewallAllowed
 // and returns a compute.Firewall for the provided name.
 func firewallSpec(name string, ps network.PortSet) *compute.Firewall {
+	return ingressFirewallSpec(name, name, []string{network.DefaultSourceCIDR}, ps)
+}
+
+// ingressFirewallSpec expands a port range set in to
+// compute.FirewallAllowed and returns a compute.Firewall for the
+// provided name, which allows traffic from the source CIDRs to the
+// instances tagged with the target.
+func ingressFirewallSpec(name, target string, sourceCIDRs []string, ps network.PortSet) *compute.Firewall {
 	firewall := compute.Firewall{
 		// Allowed is set below.
 		// Description is not set.
 		Name: name,
 		// Network: (defaults to global)
 		// SourceTags is not set.
-		TargetTags:   []string{name},
-		SourceRanges: []string{"0.0.0.0/0"},
+		TargetTags:   []string{target},
+		SourceRanges: sourceCIDRs,
 	}
 
 	for _, protocol := range ps.Protocols() {
@@ -129,6 +129,25 @@ func (rc *rawConn) GetFirewall(projectID, name string) (*compute.Firewall, error
 	return firewallList.Items[0], nil
 }
 
+func (rc *rawConn) ListFirewalls(projectID, pattern string) ([]*compute.Firewall, error) {
+	call := rc.Firewalls.List(projectID)
+	call = call.Filter("name eq " + pattern)
+
+	var results []*compute.Firewall
+	for {
+		firewallList, err := call.Do()
+		if err != nil {
+			return nil, errors.Annotate(err, "while listing firewalls from GCE")
+		}
+		results = append(results, firewallList.Items...)
+		if firewallList.NextPageToken == "" {
+			break
+		}
+		call = call.PageToken(firewallList.NextPageToken)
+	}
+	return results, nil
+}
+
 func (rc *rawConn) AddFirewall(projectID string, firewall *compute.Firewall) error {
 	call := rc.Firewalls.Insert(projectID, firewall)
 	operation, err := call.Do()
@@ -275,6 +294,50 @@ func (rc *rawConn) InstanceDisks(project, zone, instanceId string) ([]*compute.A
 	return instance.Disks, nil
 }
 
+func (rc *rawConn) CreateSnapshot(project, zone, disk string, spec *compute.Snapshot) error {
+	call := rc.Disks.CreateSnapshot(project, zone, disk, spec)
+	op, err := call.Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not create a snapshot of disk %q", disk)
+	}
+	return errors.Trace(rc.waitOperation(project, op, attemptsLong))
+}
+
+func (rc *rawConn) ListSnapshots(project string) ([]*compute.Snapshot, error) {
+	call := rc.Snapshots.List(project)
+	var results []*compute.Snapshot
+	for {
+		snapshotList, err := call.Do()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, snapshot := range snapshotList.Items {
+			results = append(results, snapshot)
+		}
+		if snapshotList.NextPageToken == "" {
+			break
+		}
+		call = call.PageToken(snapshotList.NextPageToken)
+	}
+	return results, nil
+}
+
+func (rc *rawConn) GetSnapshot(project, name string) (*compute.Snapshot, error) {
+	snapshot, err := rc.Snapshots.Get(project, name).Do()
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get snapshot %q in project %q", name, project)
+	}
+	return snapshot, nil
+}
+
+func (rc *rawConn) RemoveSnapshot(project, name string) error {
+	op, err := rc.Snapshots.Delete(project, name).Do()
+	if err != nil {
+		return errors.Annotatef(err, "could not delete snapshot %q", name)
+	}
+	return errors.Trace(rc.waitOperation(project, op, attemptsLong))
+}
+
 type waitError struct {
 	op    *compute.Operation
 	cause error
@@ -158,6 +158,7 @@ type fakeCall struct {
 	AttachedDisk *compute.AttachedDisk
 	DeviceName   string
 	ComputeDisk  *compute.Disk
+	Snapshot     *compute.Snapshot
 }
 
 type fakeConn struct {
@@ -167,12 +168,15 @@ type fakeConn struct {
 	Instance      *compute.Instance
 	Instances     []*compute.Instance
 	Firewall      *compute.Firewall
+	Firewalls     []*compute.Firewall
 	Zones         []*compute.Zone
 	Err           error
 	FailOnCall    int
 	Disks         []*compute.Disk
 	Disk          *compute.Disk
 	AttachedDisks []*compute.AttachedDisk
+	Snapshots     []*compute.Snapshot
+	Snapshot      *compute.Snapshot
 }
 
 func (rc *fakeConn) GetProject(projectID string) (*compute.Project, error) {
@@ -269,6 +273,21 @@ func (rc *fakeConn) GetFirewall(projectID, name string) (*compute.Firewall, erro
 	return rc.Firewall, err
 }
 
+func (rc *fakeConn) ListFirewalls(projectID, pattern string) ([]*compute.Firewall, error) {
+	call := fakeCall{
+		FuncName:  "ListFirewalls",
+		ProjectID: projectID,
+		Prefix:    pattern,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return rc.Firewalls, err
+}
+
 func (rc *fakeConn) AddFirewall(projectID string, firewall *compute.Firewall) error {
 	call := fakeCall{
 		FuncName:  "AddFirewall",
@@ -443,6 +462,67 @@ func (rc *fakeConn) InstanceDisks(project, zone, instanceId string) ([]*compute.
 	return rc.AttachedDisks, err
 }
 
+func (rc *fakeConn) CreateSnapshot(project, zone, disk string, spec *compute.Snapshot) error {
+	call := fakeCall{
+		FuncName:  "CreateSnapshot",
+		ProjectID: project,
+		ZoneName:  zone,
+		ID:        disk,
+		Snapshot:  spec,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return err
+}
+
+func (rc *fakeConn) ListSnapshots(project string) ([]*compute.Snapshot, error) {
+	call := fakeCall{
+		FuncName:  "ListSnapshots",
+		ProjectID: project,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return rc.Snapshots, err
+}
+
+func (rc *fakeConn) GetSnapshot(project, name string) (*compute.Snapshot, error) {
+	call := fakeCall{
+		FuncName:  "GetSnapshot",
+		ProjectID: project,
+		Name:      name,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return rc.Snapshot, err
+}
+
+func (rc *fakeConn) RemoveSnapshot(project, name string) error {
+	call := fakeCall{
+		FuncName:  "RemoveSnapshot",
+		ProjectID: project,
+		Name:      name,
+	}
+	rc.Calls = append(rc.Calls, call)
+
+	err := rc.Err
+	if len(rc.Calls) != rc.FailOnCall+1 {
+		err = nil
+	}
+	return err
+}
+
 func (rc *fakeConn) ListMachineTypes(projectID, zone string) (*compute.MachineTypeList, error) {
 	call := fakeCall{
 		FuncName:  "ListMachineTypes",
@@ -18,6 +18,7 @@ type environInstance struct {
 }
 
 var _ instance.Instance = (*environInstance)(nil)
+var _ instance.IngressRuleFirewaller = (*environInstance)(nil)
 
 func newInstance(base *google.Instance, env *environ) *environInstance {
 	return &environInstance{
@@ -101,3 +102,33 @@ func (inst *environInstance) Ports(machineID string) ([]network.PortRange, error
 	ports, err := inst.env.gce.Ports(name)
 	return ports, errors.Trace(err)
 }
+
+// OpenIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *environInstance) OpenIngressRules(machineID string, rules []network.IngressRule) error {
+	name, err := inst.env.namespace.Hostname(machineID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = inst.env.gce.OpenIngressRules(name, rules...)
+	return errors.Trace(err)
+}
+
+// CloseIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *environInstance) CloseIngressRules(machineID string, rules []network.IngressRule) error {
+	name, err := inst.env.namespace.Hostname(machineID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = inst.env.gce.CloseIngressRules(name, rules...)
+	return errors.Trace(err)
+}
+
+// IngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *environInstance) IngressRules(machineID string) ([]network.IngressRule, error) {
+	name, err := inst.env.namespace.Hostname(machineID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rules, err := inst.env.gce.IngressRules(name)
+	return rules, errors.Trace(err)
+}
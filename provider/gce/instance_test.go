@@ -85,3 +85,35 @@ func (s *instanceSuite) TestPortsAPI(c *gc.C) {
 	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "Ports")
 	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, s.InstName)
 }
+
+func (s *instanceSuite) TestOpenIngressRulesAPI(c *gc.C) {
+	err := s.Instance.OpenIngressRules("42", s.Rules)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "OpenIngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, s.InstName)
+	c.Check(s.FakeConn.Calls[0].Rules, jc.DeepEquals, s.Rules)
+}
+
+func (s *instanceSuite) TestCloseIngressRulesAPI(c *gc.C) {
+	err := s.Instance.CloseIngressRules("42", s.Rules)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "CloseIngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, s.InstName)
+	c.Check(s.FakeConn.Calls[0].Rules, jc.DeepEquals, s.Rules)
+}
+
+func (s *instanceSuite) TestIngressRules(c *gc.C) {
+	s.FakeConn.Rules = s.Rules
+
+	rules, err := s.Instance.IngressRules("42")
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(rules, jc.DeepEquals, s.Rules)
+	c.Check(s.FakeConn.Calls, gc.HasLen, 1)
+	c.Check(s.FakeConn.Calls[0].FuncName, gc.Equals, "IngressRules")
+	c.Check(s.FakeConn.Calls[0].FirewallName, gc.Equals, s.InstName)
+}
@@ -120,6 +120,7 @@ type BaseSuiteUnpatched struct {
 	InstanceType    instances.InstanceType
 
 	Ports []network.PortRange
+	Rules []network.IngressRule
 }
 
 var _ environs.Environ = (*environ)(nil)
@@ -219,6 +220,10 @@ func (s *BaseSuiteUnpatched) initNet(c *gc.C) {
 		ToPort:   80,
 		Protocol: "tcp",
 	}}
+	s.Rules = []network.IngressRule{{
+		PortRange:   s.Ports[0],
+		SourceCIDRs: []string{"10.0.0.0/8"},
+	}}
 }
 
 func (s *BaseSuiteUnpatched) setConfig(c *gc.C, cfg *config.Config) {
@@ -460,6 +465,7 @@ type fakeConnCall struct {
 	InstanceSpec google.InstanceSpec
 	FirewallName string
 	PortRanges   []network.PortRange
+	Rules        []network.IngressRule
 	Region       string
 	Disks        []google.DiskSpec
 	VolumeName   string
@@ -473,6 +479,7 @@ type fakeConn struct {
 	Inst       *google.Instance
 	Insts      []google.Instance
 	PortRanges []network.PortRange
+	Rules      []network.IngressRule
 	Zones      []google.AvailabilityZone
 
 	GoogleDisks   []*google.Disk
@@ -480,6 +487,9 @@ type fakeConn struct {
 	AttachedDisk  *google.AttachedDisk
 	AttachedDisks []*google.AttachedDisk
 
+	GoogleSnapshots []*google.Snapshot
+	GoogleSnapshot  *google.Snapshot
+
 	Err        error
 	FailOnCall int
 }
@@ -560,6 +570,32 @@ func (fc *fakeConn) ClosePorts(fwname string, ports ...network.PortRange) error
 	return fc.err()
 }
 
+func (fc *fakeConn) IngressRules(fwname string) ([]network.IngressRule, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName:     "IngressRules",
+		FirewallName: fwname,
+	})
+	return fc.Rules, fc.err()
+}
+
+func (fc *fakeConn) OpenIngressRules(fwname string, rules ...network.IngressRule) error {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName:     "OpenIngressRules",
+		FirewallName: fwname,
+		Rules:        rules,
+	})
+	return fc.err()
+}
+
+func (fc *fakeConn) CloseIngressRules(fwname string, rules ...network.IngressRule) error {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName:     "CloseIngressRules",
+		FirewallName: fwname,
+		Rules:        rules,
+	})
+	return fc.err()
+}
+
 func (fc *fakeConn) AvailabilityZones(region string) ([]google.AvailabilityZone, error) {
 	fc.Calls = append(fc.Calls, fakeConnCall{
 		FuncName: "AvailabilityZones",
@@ -633,6 +669,39 @@ func (fc *fakeConn) InstanceDisks(zone, instanceId string) ([]*google.AttachedDi
 	return fc.AttachedDisks, fc.err()
 }
 
+func (fc *fakeConn) CreateSnapshot(zone, volumeName, snapshotName, description string) (*google.Snapshot, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName:   "CreateSnapshot",
+		ZoneName:   zone,
+		VolumeName: volumeName,
+		ID:         snapshotName,
+	})
+	return fc.GoogleSnapshot, fc.err()
+}
+
+func (fc *fakeConn) Snapshots() ([]*google.Snapshot, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "Snapshots",
+	})
+	return fc.GoogleSnapshots, fc.err()
+}
+
+func (fc *fakeConn) Snapshot(name string) (*google.Snapshot, error) {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "Snapshot",
+		ID:       name,
+	})
+	return fc.GoogleSnapshot, fc.err()
+}
+
+func (fc *fakeConn) RemoveSnapshot(name string) error {
+	fc.Calls = append(fc.Calls, fakeConnCall{
+		FuncName: "RemoveSnapshot",
+		ID:       name,
+	})
+	return fc.err()
+}
+
 func (fc *fakeConn) WasCalled(funcName string) (bool, []fakeConnCall) {
 	var calls []fakeConnCall
 	called := false
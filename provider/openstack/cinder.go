@@ -4,7 +4,12 @@
 package openstack
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"math"
+	"net/http"
 	"net/url"
 	"sync"
 	"time"
@@ -12,6 +17,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/utils"
 	"gopkg.in/goose.v1/cinder"
+	goosehttp "gopkg.in/goose.v1/http"
 	"gopkg.in/goose.v1/identity"
 	"gopkg.in/goose.v1/nova"
 
@@ -81,8 +87,17 @@ var newOpenstackStorage = func(env *Environ) (OpenstackStorage, error) {
 		logger.Debugf("volume URL: %v", url)
 	}
 
+	httpClient := goosehttp.New()
+	if !env.ecfgUnlocked.SSLHostnameVerification() {
+		httpClient = goosehttp.NewNonSSLValidating()
+	}
 	return &openstackStorageAdapter{
-		cinderClient{cinder.Basic(env.volumeURL, client.TenantId(), client.Token)},
+		cinderClient{
+			cinder.Basic(env.volumeURL, client.TenantId(), client.Token),
+			env.volumeURL,
+			client.Token,
+			httpClient,
+		},
 		novaClient{env.novaUnlocked},
 	}, nil
 }
@@ -159,6 +174,8 @@ type cinderVolumeSource struct {
 }
 
 var _ storage.VolumeSource = (*cinderVolumeSource)(nil)
+var _ storage.VolumeSnapshotter = (*cinderVolumeSource)(nil)
+var _ storage.VolumeResizer = (*cinderVolumeSource)(nil)
 
 // CreateVolumes implements storage.VolumeSource.
 func (s *cinderVolumeSource) CreateVolumes(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
@@ -187,6 +204,7 @@ func (s *cinderVolumeSource) createVolume(arg storage.VolumeParams) (*storage.Vo
 		// TODO(axw) use the AZ of the initially attached machine.
 		AvailabilityZone: "",
 		Metadata:         metadata,
+		SnapshotId:       arg.SnapshotId,
 	})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -340,6 +358,142 @@ func destroyVolume(storageAdapter OpenstackStorage, volumeId string) error {
 	return nil
 }
 
+// CreateSnapshots implements storage.VolumeSnapshotter.
+func (s *cinderVolumeSource) CreateSnapshots(args []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := s.storageAdapter.CreateSnapshot(cinder.CreateSnapshotSnapshotParams{
+			VolumeId: arg.VolumeId,
+			Name:     resourceName(s.namespace, s.envName, arg.Volume.String()),
+			// Allow snapshots of volumes that are attached
+			// to running machines.
+			Force: true,
+		})
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot of %q", arg.VolumeId)
+			continue
+		}
+		results[i].Snapshot = cinderToJujuSnapshot(snapshot)
+	}
+	return results, nil
+}
+
+// ListSnapshots implements storage.VolumeSnapshotter.
+func (s *cinderVolumeSource) ListSnapshots(volumeIds []string) ([]storage.ListSnapshotsResult, error) {
+	cinderSnapshots, err := s.storageAdapter.GetSnapshotsDetail()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotsByVolumeId := make(map[string][]storage.Snapshot)
+	for i, snapshot := range cinderSnapshots {
+		snapshotsByVolumeId[snapshot.VolumeID] = append(
+			snapshotsByVolumeId[snapshot.VolumeID],
+			*cinderToJujuSnapshot(&cinderSnapshots[i]),
+		)
+	}
+	results := make([]storage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		results[i].Snapshots = snapshotsByVolumeId[volumeId]
+	}
+	return results, nil
+}
+
+// DeleteSnapshots implements storage.VolumeSnapshotter.
+func (s *cinderVolumeSource) DeleteSnapshots(snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if err := s.storageAdapter.DeleteSnapshot(snapshotId); err != nil {
+			results[i] = errors.Annotatef(err, "deleting snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+// RestoreSnapshots implements storage.VolumeSnapshotter.
+func (s *cinderVolumeSource) RestoreSnapshots(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	cinderSnapshots, err := s.storageAdapter.GetSnapshotsDetail()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotSizes := make(map[string]uint64)
+	for i, snapshot := range cinderSnapshots {
+		snapshotSizes[snapshot.ID] = cinderToJujuSnapshot(&cinderSnapshots[i]).Size
+	}
+	results := make([]storage.CreateVolumesResult, len(args))
+	for i, arg := range args {
+		snapshotSize, ok := snapshotSizes[arg.SnapshotId]
+		if !ok {
+			results[i].Error = errors.NotFoundf("snapshot %q", arg.SnapshotId)
+			continue
+		}
+		// A volume created from a snapshot must be at least
+		// as large as the snapshot.
+		if snapshotSize > arg.Size {
+			arg.Size = snapshotSize
+		}
+		volume, err := s.createVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Trace(err)
+			continue
+		}
+		results[i].Volume = volume
+	}
+	return results, nil
+}
+
+// ResizesAttachedVolumes implements storage.VolumeResizer.
+//
+// Cinder's v2 API only extends volumes that are not attached to a
+// server.
+func (s *cinderVolumeSource) ResizesAttachedVolumes() bool {
+	return false
+}
+
+// ResizeVolumes implements storage.VolumeResizer.
+func (s *cinderVolumeSource) ResizeVolumes(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		info, err := s.resizeVolume(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %q", arg.VolumeId)
+			continue
+		}
+		results[i].VolumeInfo = info
+	}
+	return results, nil
+}
+
+func (s *cinderVolumeSource) resizeVolume(arg storage.VolumeResizeParams) (*storage.VolumeInfo, error) {
+	volume, err := s.storageAdapter.GetVolume(arg.VolumeId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if volume.Status == volumeStatusInUse {
+		// Cinder would refuse the request, and would go
+		// on refusing it until the volume is detached.
+		return nil, errors.NewNotSupported(nil, "cannot resize a volume that is in use; detach the storage first")
+	}
+	// Cinder volumes are sized in GiB, so round up.
+	newSize := int((arg.Size + 1023) / 1024)
+	if err := s.storageAdapter.ExtendVolume(arg.VolumeId, newSize); err != nil {
+		return nil, errors.Trace(err)
+	}
+	cinderVolume, err := waitVolume(s.storageAdapter, arg.VolumeId, func(v *cinder.Volume) (bool, error) {
+		switch v.Status {
+		case volumeStatusError:
+			return false, errors.New("volume is in error state")
+		case volumeStatusAvailable:
+			return v.Size >= newSize, nil
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	info := cinderToJujuVolumeInfo(cinderVolume)
+	return &info, nil
+}
+
 // ValidateVolumeParams implements storage.VolumeSource.
 func (s *cinderVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
 	return nil
@@ -453,6 +607,23 @@ func cinderToJujuVolumeInfo(volume *cinder.Volume) storage.VolumeInfo {
 	}
 }
 
+// cinderSnapshotTimeLayout is the layout of the creation time of
+// Cinder snapshots, which is reported in UTC.
+const cinderSnapshotTimeLayout = "2006-01-02T15:04:05.999999"
+
+func cinderToJujuSnapshot(snapshot *cinder.Snapshot) *storage.Snapshot {
+	result := &storage.Snapshot{
+		SnapshotId: snapshot.ID,
+		VolumeId:   snapshot.VolumeID,
+		Size:       uint64(snapshot.Size * 1024),
+		Status:     snapshot.Status,
+	}
+	if created, err := time.Parse(cinderSnapshotTimeLayout, snapshot.CreatedAt); err == nil {
+		result.Created = created
+	}
+	return result
+}
+
 func detachVolume(instanceId, volumeId string, attachments []nova.VolumeAttachment, storageAdapter OpenstackStorage) error {
 	// TODO(axw) verify whether we need to do this find step. From looking at the example
 	// responses in the OpenStack docs, the "attachment ID" is always the same as the
@@ -481,6 +652,10 @@ type OpenstackStorage interface {
 	AttachVolume(serverId, volumeId, mountPoint string) (*nova.VolumeAttachment, error)
 	DetachVolume(serverId, attachmentId string) error
 	ListVolumeAttachments(serverId string) ([]nova.VolumeAttachment, error)
+	CreateSnapshot(cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error)
+	GetSnapshotsDetail() ([]cinder.Snapshot, error)
+	DeleteSnapshot(snapshotId string) error
+	ExtendVolume(volumeId string, newSize int) error
 }
 
 type endpointResolver interface {
@@ -515,6 +690,9 @@ type openstackStorageAdapter struct {
 
 type cinderClient struct {
 	*cinder.Client
+	endpoint   *url.URL
+	token      func() string
+	httpClient *goosehttp.Client
 }
 
 type novaClient struct {
@@ -547,3 +725,58 @@ func (ga *openstackStorageAdapter) GetVolume(volumeId string) (*cinder.Volume, e
 	}
 	return &resp.Volume, nil
 }
+
+// CreateSnapshot is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdapter) CreateSnapshot(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+	resp, err := ga.cinderClient.CreateSnapshot(args)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.Snapshot, nil
+}
+
+// GetSnapshotsDetail is part of the OpenstackStorage interface.
+func (ga *openstackStorageAdapter) GetSnapshotsDetail() ([]cinder.Snapshot, error) {
+	resp, err := ga.cinderClient.GetSnapshotsDetail()
+	if err != nil {
+		return nil, err
+	}
+	return resp.Snapshots, nil
+}
+
+// ExtendVolume is part of the OpenstackStorage interface.
+//
+// The goose cinder client does not support the os-extend volume
+// action, so the request is made directly, with a goose HTTP client
+// that verifies certificates as the environ's configuration says.
+// Cinder's v2 API only extends volumes that are not attached to a
+// server.
+func (ga *openstackStorageAdapter) ExtendVolume(volumeId string, newSize int) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"os-extend": map[string]int{"new_size": newSize},
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	actionURL := fmt.Sprintf("%s/volumes/%s/action", ga.endpoint, url.QueryEscape(volumeId))
+	req, err := http.NewRequest("POST", actionURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Auth-Token", ga.token())
+	resp, err := ga.httpClient.Do(req)
+	if err != nil {
+		return errors.Annotate(err, "extending volume")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return errors.Errorf(
+			"extending volume: %s: %s",
+			resp.Status, bytes.TrimSpace(respBody),
+		)
+	}
+	return nil
+}
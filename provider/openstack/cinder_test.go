@@ -463,6 +463,205 @@ func (s *cinderVolumeSourceSuite) TestCreateVolumeCleanupDestroys(c *gc.C) {
 	c.Assert(numDestroyCalls, gc.Equals, 1)
 }
 
+func (s *cinderVolumeSourceSuite) TestCreateSnapshots(c *gc.C) {
+	mockAdapter := &mockAdapter{
+		createSnapshot: func(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+			return &cinder.Snapshot{
+				ID:        "snap-0",
+				VolumeID:  args.VolumeId,
+				Size:      2,
+				Status:    "creating",
+				CreatedAt: "2017-03-01T12:00:00.000000",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	results, err := volSource.(storage.VolumeSnapshotter).CreateSnapshots([]storage.SnapshotParams{{
+		Volume:   mockVolumeTag,
+		VolumeId: mockVolId,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: "snap-0",
+			VolumeId:   mockVolId,
+			Size:       2048,
+			Created:    time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC),
+			Status:     "creating",
+		},
+	}})
+	mockAdapter.CheckCalls(c, []gitjujutesting.StubCall{{
+		"CreateSnapshot", []interface{}{cinder.CreateSnapshotSnapshotParams{
+			VolumeId: mockVolId,
+			Name:     "juju-testenv-volume-123",
+			Force:    true,
+		}},
+	}})
+}
+
+func (s *cinderVolumeSourceSuite) TestListSnapshots(c *gc.C) {
+	mockAdapter := &mockAdapter{
+		getSnapshotsDetail: func() ([]cinder.Snapshot, error) {
+			return []cinder.Snapshot{
+				{ID: "snap-0", VolumeID: "vol-0", Size: 1, Status: "available"},
+				{ID: "snap-1", VolumeID: "vol-1", Size: 2, Status: "available"},
+				{ID: "snap-2", VolumeID: "vol-0", Size: 1, Status: "creating"},
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	results, err := volSource.(storage.VolumeSnapshotter).ListSnapshots([]string{"vol-0", "vol-2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{
+			{SnapshotId: "snap-0", VolumeId: "vol-0", Size: 1024, Status: "available"},
+			{SnapshotId: "snap-2", VolumeId: "vol-0", Size: 1024, Status: "creating"},
+		},
+	}, {}})
+}
+
+func (s *cinderVolumeSourceSuite) TestDeleteSnapshots(c *gc.C) {
+	mockAdapter := &mockAdapter{
+		deleteSnapshot: func(snapshotId string) error {
+			if snapshotId == "snap-1" {
+				return errors.New("boom")
+			}
+			return nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	errs, err := volSource.(storage.VolumeSnapshotter).DeleteSnapshots([]string{"snap-0", "snap-1"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, gc.HasLen, 2)
+	c.Assert(errs[0], jc.ErrorIsNil)
+	c.Assert(errs[1], gc.ErrorMatches, `deleting snapshot "snap-1": boom`)
+	mockAdapter.CheckCalls(c, []gitjujutesting.StubCall{
+		{"DeleteSnapshot", []interface{}{"snap-0"}},
+		{"DeleteSnapshot", []interface{}{"snap-1"}},
+	})
+}
+
+func (s *cinderVolumeSourceSuite) TestRestoreSnapshots(c *gc.C) {
+	mockAdapter := &mockAdapter{
+		getSnapshotsDetail: func() ([]cinder.Snapshot, error) {
+			return []cinder.Snapshot{{ID: "snap-0", VolumeID: "vol-0", Size: 3}}, nil
+		},
+		createVolume: func(args cinder.CreateVolumeVolumeParams) (*cinder.Volume, error) {
+			return &cinder.Volume{ID: mockVolId}, nil
+		},
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   3,
+				Status: "available",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	results, err := volSource.(storage.VolumeSnapshotter).RestoreSnapshots([]storage.VolumeParams{{
+		Provider:   openstack.CinderProviderType,
+		Tag:        mockVolumeTag,
+		Size:       1024,
+		SnapshotId: "snap-0",
+	}, {
+		Provider:   openstack.CinderProviderType,
+		Tag:        names.NewVolumeTag("124"),
+		Size:       1024,
+		SnapshotId: "snap-1",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume, jc.DeepEquals, &storage.Volume{
+		mockVolumeTag,
+		storage.VolumeInfo{
+			VolumeId:   mockVolId,
+			Size:       3072,
+			Persistent: true,
+		},
+	})
+	c.Assert(results[1].Error, jc.Satisfies, errors.IsNotFound)
+	c.Assert(results[1].Error, gc.ErrorMatches, `snapshot "snap-1" not found`)
+
+	// The volume is grown to the size of the snapshot.
+	mockAdapter.CheckCall(c, 1, "CreateVolume", cinder.CreateVolumeVolumeParams{
+		Size:       3,
+		Name:       "juju-testenv-volume-123",
+		SnapshotId: "snap-0",
+	})
+}
+
+func (s *cinderVolumeSourceSuite) TestResizeVolumes(c *gc.C) {
+	var extended bool
+	mockAdapter := &mockAdapter{
+		extendVolume: func(volumeId string, newSize int) error {
+			if volumeId == "vol-1" {
+				return errors.New("boom")
+			}
+			extended = true
+			return nil
+		},
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			size := 1
+			if extended {
+				size = 3
+			}
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   size,
+				Status: "available",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	c.Assert(volSource.(storage.VolumeResizer).ResizesAttachedVolumes(), jc.IsFalse)
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     2049,
+	}, {
+		Tag:      names.NewVolumeTag("124"),
+		VolumeId: "vol-1",
+		Size:     2048,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].VolumeInfo, jc.DeepEquals, &storage.VolumeInfo{
+		VolumeId:   mockVolId,
+		Size:       3072,
+		Persistent: true,
+	})
+	c.Assert(results[1].Error, gc.ErrorMatches, `resizing volume "vol-1": boom`)
+
+	// The requested size is rounded up to the nearest GiB.
+	mockAdapter.CheckCall(c, 1, "ExtendVolume", mockVolId, 3)
+}
+
+func (s *cinderVolumeSourceSuite) TestResizeVolumesInUse(c *gc.C) {
+	mockAdapter := &mockAdapter{
+		getVolume: func(volumeId string) (*cinder.Volume, error) {
+			return &cinder.Volume{
+				ID:     volumeId,
+				Size:   1,
+				Status: "in-use",
+			}, nil
+		},
+	}
+	volSource := openstack.NewCinderVolumeSource(mockAdapter)
+	results, err := volSource.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      mockVolumeTag,
+		VolumeId: mockVolId,
+		Size:     2048,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(results[0].Error, gc.ErrorMatches,
+		`resizing volume "0": cannot resize a volume that is in use; detach the storage first`)
+	mockAdapter.CheckCallNames(c, "GetVolume")
+}
+
 type mockAdapter struct {
 	gitjujutesting.Stub
 	getVolume             func(string) (*cinder.Volume, error)
@@ -473,6 +672,10 @@ type mockAdapter struct {
 	volumeStatusNotifier  func(string, string, int, time.Duration) <-chan error
 	detachVolume          func(string, string) error
 	listVolumeAttachments func(string) ([]nova.VolumeAttachment, error)
+	createSnapshot        func(cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error)
+	getSnapshotsDetail    func() ([]cinder.Snapshot, error)
+	deleteSnapshot        func(string) error
+	extendVolume          func(string, int) error
 }
 
 func (ma *mockAdapter) GetVolume(volumeId string) (*cinder.Volume, error) {
@@ -534,6 +737,38 @@ func (ma *mockAdapter) ListVolumeAttachments(serverId string) ([]nova.VolumeAtta
 	return nil, nil
 }
 
+func (ma *mockAdapter) CreateSnapshot(args cinder.CreateSnapshotSnapshotParams) (*cinder.Snapshot, error) {
+	ma.MethodCall(ma, "CreateSnapshot", args)
+	if ma.createSnapshot != nil {
+		return ma.createSnapshot(args)
+	}
+	return nil, errors.NotImplementedf("CreateSnapshot")
+}
+
+func (ma *mockAdapter) GetSnapshotsDetail() ([]cinder.Snapshot, error) {
+	ma.MethodCall(ma, "GetSnapshotsDetail")
+	if ma.getSnapshotsDetail != nil {
+		return ma.getSnapshotsDetail()
+	}
+	return nil, nil
+}
+
+func (ma *mockAdapter) DeleteSnapshot(snapshotId string) error {
+	ma.MethodCall(ma, "DeleteSnapshot", snapshotId)
+	if ma.deleteSnapshot != nil {
+		return ma.deleteSnapshot(snapshotId)
+	}
+	return nil
+}
+
+func (ma *mockAdapter) ExtendVolume(volumeId string, newSize int) error {
+	ma.MethodCall(ma, "ExtendVolume", volumeId, newSize)
+	if ma.extendVolume != nil {
+		return ma.extendVolume(volumeId, newSize)
+	}
+	return nil
+}
+
 type testEndpointResolver struct {
 	authenticated   bool
 	regionEndpoints map[string]identity.ServiceURLs
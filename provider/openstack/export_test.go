@@ -515,6 +515,7 @@ func ResolveNetwork(e environs.Environ, networkName string) (string, error) {
 }
 
 var PortsToRuleInfo = portsToRuleInfo
+var IngressRulesToRuleInfo = ingressRulesToRuleInfo
 var RuleMatchesPortRange = ruleMatchesPortRange
 
 var MakeServiceURL = &makeServiceURL
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/retry"
 	"github.com/juju/utils/clock"
+	"github.com/juju/utils/set"
 	"gopkg.in/goose.v1/neutron"
 
 	"github.com/juju/juju/environs"
@@ -64,6 +65,24 @@ type Firewaller interface {
 
 	// InstancePorts returns the port ranges opened for the specified  instance.
 	InstancePorts(inst instance.Instance, machineId string) ([]network.PortRange, error)
+
+	// OpenIngressRules opens the given ingress rules for the whole environment.
+	OpenIngressRules(rules []network.IngressRule) error
+
+	// CloseIngressRules closes the given ingress rules for the whole environment.
+	CloseIngressRules(rules []network.IngressRule) error
+
+	// IngressRules returns the ingress rules opened for the whole environment.
+	IngressRules() ([]network.IngressRule, error)
+
+	// OpenInstanceIngressRules opens the given ingress rules for the specified instance.
+	OpenInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error
+
+	// CloseInstanceIngressRules closes the given ingress rules for the specified instance.
+	CloseInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error
+
+	// InstanceIngressRules returns the ingress rules opened for the specified instance.
+	InstanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error)
 }
 
 type firewallerFactory struct {
@@ -181,6 +200,48 @@ func (f *switchingFirewaller) InstancePorts(inst instance.Instance, machineId st
 	return f.fw.InstancePorts(inst, machineId)
 }
 
+func (f *switchingFirewaller) OpenIngressRules(rules []network.IngressRule) error {
+	if err := f.initFirewaller(); err != nil {
+		return errors.Trace(err)
+	}
+	return f.fw.OpenIngressRules(rules)
+}
+
+func (f *switchingFirewaller) CloseIngressRules(rules []network.IngressRule) error {
+	if err := f.initFirewaller(); err != nil {
+		return errors.Trace(err)
+	}
+	return f.fw.CloseIngressRules(rules)
+}
+
+func (f *switchingFirewaller) IngressRules() ([]network.IngressRule, error) {
+	if err := f.initFirewaller(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return f.fw.IngressRules()
+}
+
+func (f *switchingFirewaller) OpenInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	if err := f.initFirewaller(); err != nil {
+		return errors.Trace(err)
+	}
+	return f.fw.OpenInstanceIngressRules(inst, machineId, rules)
+}
+
+func (f *switchingFirewaller) CloseInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	if err := f.initFirewaller(); err != nil {
+		return errors.Trace(err)
+	}
+	return f.fw.CloseInstanceIngressRules(inst, machineId, rules)
+}
+
+func (f *switchingFirewaller) InstanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error) {
+	if err := f.initFirewaller(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return f.fw.InstanceIngressRules(inst, machineId)
+}
+
 type firewallerBase struct {
 	environ *Environ
 }
@@ -293,94 +354,130 @@ func deleteSecurityGroup(
 	}
 }
 
-func (c *firewallerBase) openPorts(
-	openPortsInGroup func(string, []network.PortRange) error,
-	ports []network.PortRange,
+func (c *firewallerBase) openIngressRules(
+	openRulesInGroup func(string, []network.IngressRule) error,
+	rules []network.IngressRule,
 ) error {
 	if c.environ.Config().FirewallMode() != config.FwGlobal {
 		return errors.Errorf("invalid firewall mode %q for opening ports on model",
 			c.environ.Config().FirewallMode())
 	}
-	if err := openPortsInGroup(c.globalGroupRegexp(), ports); err != nil {
+	if err := openRulesInGroup(c.globalGroupRegexp(), rules); err != nil {
 		return errors.Trace(err)
 	}
-	logger.Infof("opened ports in global group: %v", ports)
+	logger.Infof("opened ports in global group: %v", rules)
 	return nil
 }
 
-func (c *firewallerBase) closePorts(
-	closePortsInGroup func(string, []network.PortRange) error,
-	ports []network.PortRange,
+func (c *firewallerBase) closeIngressRules(
+	closeRulesInGroup func(string, []network.IngressRule) error,
+	rules []network.IngressRule,
 ) error {
 	if c.environ.Config().FirewallMode() != config.FwGlobal {
 		return errors.Errorf("invalid firewall mode %q for closing ports on model",
 			c.environ.Config().FirewallMode())
 	}
-	if err := closePortsInGroup(c.globalGroupRegexp(), ports); err != nil {
+	if err := closeRulesInGroup(c.globalGroupRegexp(), rules); err != nil {
 		return errors.Trace(err)
 	}
-	logger.Infof("closed ports in global group: %v", ports)
+	logger.Infof("closed ports in global group: %v", rules)
 	return nil
 }
 
-func (c *firewallerBase) ports(
-	portsInGroup func(string) ([]network.PortRange, error),
-) ([]network.PortRange, error) {
+func (c *firewallerBase) ingressRules(
+	rulesInGroup func(string) ([]network.IngressRule, error),
+) ([]network.IngressRule, error) {
 	if c.environ.Config().FirewallMode() != config.FwGlobal {
 		return nil, errors.Errorf("invalid firewall mode %q for retrieving ports from model",
 			c.environ.Config().FirewallMode())
 	}
-	return portsInGroup(c.globalGroupRegexp())
+	return rulesInGroup(c.globalGroupRegexp())
 }
 
-func (c *firewallerBase) openInstancePorts(
-	openPortsInGroup func(string, []network.PortRange) error,
+func (c *firewallerBase) openInstanceIngressRules(
+	openRulesInGroup func(string, []network.IngressRule) error,
 	machineId string,
-	ports []network.PortRange,
+	rules []network.IngressRule,
 ) error {
 	if c.environ.Config().FirewallMode() != config.FwInstance {
 		return errors.Errorf("invalid firewall mode %q for opening ports on instance",
 			c.environ.Config().FirewallMode())
 	}
 	nameRegexp := c.machineGroupRegexp(machineId)
-	if err := openPortsInGroup(nameRegexp, ports); err != nil {
+	if err := openRulesInGroup(nameRegexp, rules); err != nil {
 		return errors.Trace(err)
 	}
-	logger.Infof("opened ports in security group %s-%s: %v", c.environ.Config().UUID(), machineId, ports)
+	logger.Infof("opened ports in security group %s-%s: %v", c.environ.Config().UUID(), machineId, rules)
 	return nil
 }
 
-func (c *firewallerBase) closeInstancePorts(
-	closePortsInGroup func(string, []network.PortRange) error,
+func (c *firewallerBase) closeInstanceIngressRules(
+	closeRulesInGroup func(string, []network.IngressRule) error,
 	machineId string,
-	ports []network.PortRange,
+	rules []network.IngressRule,
 ) error {
 	if c.environ.Config().FirewallMode() != config.FwInstance {
 		return errors.Errorf("invalid firewall mode %q for closing ports on instance",
 			c.environ.Config().FirewallMode())
 	}
 	nameRegexp := c.machineGroupRegexp(machineId)
-	if err := closePortsInGroup(nameRegexp, ports); err != nil {
+	if err := closeRulesInGroup(nameRegexp, rules); err != nil {
 		return errors.Trace(err)
 	}
-	logger.Infof("closed ports in security group %s-%s: %v", c.environ.Config().UUID(), machineId, ports)
+	logger.Infof("closed ports in security group %s-%s: %v", c.environ.Config().UUID(), machineId, rules)
 	return nil
 }
 
-func (c *firewallerBase) instancePorts(
-	portsInGroup func(string) ([]network.PortRange, error),
+func (c *firewallerBase) instanceIngressRules(
+	rulesInGroup func(string) ([]network.IngressRule, error),
 	machineId string,
-) ([]network.PortRange, error) {
+) ([]network.IngressRule, error) {
 	if c.environ.Config().FirewallMode() != config.FwInstance {
 		return nil, errors.Errorf("invalid firewall mode %q for retrieving ports from instance",
 			c.environ.Config().FirewallMode())
 	}
 	nameRegexp := c.machineGroupRegexp(machineId)
-	portRanges, err := portsInGroup(nameRegexp)
+	rules, err := rulesInGroup(nameRegexp)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return portRanges, nil
+	return rules, nil
+}
+
+// portRangesToIngressRules returns ingress rules allowing traffic to
+// the given port ranges from anywhere.
+func portRangesToIngressRules(ports []network.PortRange) []network.IngressRule {
+	rules := make([]network.IngressRule, len(ports))
+	for i, portRange := range ports {
+		rules[i] = network.NewOpenIngressRule(portRange)
+	}
+	return rules
+}
+
+// openPortRanges returns the port ranges of those ingress rules that
+// allow traffic from anywhere.
+func openPortRanges(rules []network.IngressRule) []network.PortRange {
+	var ports []network.PortRange
+	for _, rule := range rules {
+		if rule.IsOpen() {
+			ports = append(ports, rule.PortRange)
+		}
+	}
+	return ports
+}
+
+// ingressRulesFromSources groups the given source CIDRs by port range
+// into sorted ingress rules.
+func ingressRulesFromSources(sources map[network.PortRange][]string) []network.IngressRule {
+	rules := make([]network.IngressRule, 0, len(sources))
+	for portRange, cidrs := range sources {
+		rules = append(rules, network.IngressRule{
+			PortRange:   portRange,
+			SourceCIDRs: set.NewStrings(cidrs...).SortedValues(),
+		})
+	}
+	network.SortIngressRules(rules)
+	return rules
 }
 
 func (c *firewallerBase) globalGroupName(controllerUUID string) string {
@@ -648,32 +745,70 @@ func (c *neutronFirewaller) DeleteAllModelGroups() error {
 
 // OpenPorts implements Firewaller interface.
 func (c *neutronFirewaller) OpenPorts(ports []network.PortRange) error {
-	return c.openPorts(c.openPortsInGroup, ports)
+	return c.OpenIngressRules(portRangesToIngressRules(ports))
 }
 
 // ClosePorts implements Firewaller interface.
 func (c *neutronFirewaller) ClosePorts(ports []network.PortRange) error {
-	return c.closePorts(c.closePortsInGroup, ports)
+	return c.CloseIngressRules(portRangesToIngressRules(ports))
 }
 
 // Ports implements Firewaller interface.
 func (c *neutronFirewaller) Ports() ([]network.PortRange, error) {
-	return c.ports(c.portsInGroup)
+	rules, err := c.IngressRules()
+	if err != nil {
+		return nil, err
+	}
+	return openPortRanges(rules), nil
 }
 
 // OpenInstancePorts implements Firewaller interface.
 func (c *neutronFirewaller) OpenInstancePorts(inst instance.Instance, machineId string, ports []network.PortRange) error {
-	return c.openInstancePorts(c.openPortsInGroup, machineId, ports)
+	return c.OpenInstanceIngressRules(inst, machineId, portRangesToIngressRules(ports))
 }
 
 // CloseInstancePorts implements Firewaller interface.
 func (c *neutronFirewaller) CloseInstancePorts(inst instance.Instance, machineId string, ports []network.PortRange) error {
-	return c.closeInstancePorts(c.closePortsInGroup, machineId, ports)
+	return c.CloseInstanceIngressRules(inst, machineId, portRangesToIngressRules(ports))
 }
 
 // InstancePorts implements Firewaller interface.
 func (c *neutronFirewaller) InstancePorts(inst instance.Instance, machineId string) ([]network.PortRange, error) {
-	return c.instancePorts(c.portsInGroup, machineId)
+	rules, err := c.InstanceIngressRules(inst, machineId)
+	if err != nil {
+		return nil, err
+	}
+	return openPortRanges(rules), nil
+}
+
+// OpenIngressRules implements Firewaller interface.
+func (c *neutronFirewaller) OpenIngressRules(rules []network.IngressRule) error {
+	return c.openIngressRules(c.openIngressRulesInGroup, rules)
+}
+
+// CloseIngressRules implements Firewaller interface.
+func (c *neutronFirewaller) CloseIngressRules(rules []network.IngressRule) error {
+	return c.closeIngressRules(c.closeIngressRulesInGroup, rules)
+}
+
+// IngressRules implements Firewaller interface.
+func (c *neutronFirewaller) IngressRules() ([]network.IngressRule, error) {
+	return c.ingressRules(c.ingressRulesInGroup)
+}
+
+// OpenInstanceIngressRules implements Firewaller interface.
+func (c *neutronFirewaller) OpenInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	return c.openInstanceIngressRules(c.openIngressRulesInGroup, machineId, rules)
+}
+
+// CloseInstanceIngressRules implements Firewaller interface.
+func (c *neutronFirewaller) CloseInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	return c.closeInstanceIngressRules(c.closeIngressRulesInGroup, machineId, rules)
+}
+
+// InstanceIngressRules implements Firewaller interface.
+func (c *neutronFirewaller) InstanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error) {
+	return c.instanceIngressRules(c.ingressRulesInGroup, machineId)
 }
 
 // Matching a security group by name only works if each name is unqiue.  Neutron
@@ -701,14 +836,13 @@ func (c *neutronFirewaller) matchingGroup(nameRegExp string) (neutron.SecurityGr
 	return matchingGroups[0], nil
 }
 
-func (c *neutronFirewaller) openPortsInGroup(nameRegExp string, portRanges []network.PortRange) error {
+func (c *neutronFirewaller) openIngressRulesInGroup(nameRegExp string, rules []network.IngressRule) error {
 	group, err := c.matchingGroup(nameRegExp)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	neutronClient := c.environ.neutron()
-	rules := portsToRuleInfo(group.Id, portRanges)
-	for _, rule := range rules {
+	for _, rule := range ingressRulesToRuleInfo(group.Id, rules) {
 		_, err := neutronClient.CreateSecurityGroupRuleV2(rule)
 		if err != nil {
 			// TODO: if err is not rule already exists, raise?
@@ -728,8 +862,17 @@ func ruleMatchesPortRange(rule neutron.SecurityGroupRuleV2, portRange network.Po
 		*rule.PortRangeMax == portRange.ToPort
 }
 
-func (c *neutronFirewaller) closePortsInGroup(nameRegExp string, portRanges []network.PortRange) error {
-	if len(portRanges) == 0 {
+// ruleSourceCIDR returns the source CIDR of the supplied neutron
+// security group rule.
+func ruleSourceCIDR(rule neutron.SecurityGroupRuleV2) string {
+	if rule.RemoteIPPrefix == "" {
+		return network.DefaultSourceCIDR
+	}
+	return rule.RemoteIPPrefix
+}
+
+func (c *neutronFirewaller) closeIngressRulesInGroup(nameRegExp string, rules []network.IngressRule) error {
+	if len(rules) == 0 {
 		return nil
 	}
 	group, err := c.matchingGroup(nameRegExp)
@@ -738,9 +881,14 @@ func (c *neutronFirewaller) closePortsInGroup(nameRegExp string, portRanges []ne
 	}
 	neutronClient := c.environ.neutron()
 	// TODO: Hey look ma, it's quadratic
-	for _, portRange := range portRanges {
+	for _, info := range ingressRulesToRuleInfo(group.Id, rules) {
+		portRange := network.PortRange{
+			Protocol: info.IPProtocol,
+			FromPort: info.PortRangeMin,
+			ToPort:   info.PortRangeMax,
+		}
 		for _, p := range group.Rules {
-			if !ruleMatchesPortRange(p, portRange) {
+			if !ruleMatchesPortRange(p, portRange) || ruleSourceCIDR(p) != info.RemoteIPPrefix {
 				continue
 			}
 			err := neutronClient.DeleteSecurityGroupRuleV2(p.Id)
@@ -753,11 +901,12 @@ func (c *neutronFirewaller) closePortsInGroup(nameRegExp string, portRanges []ne
 	return nil
 }
 
-func (c *neutronFirewaller) portsInGroup(nameRegexp string) (portRanges []network.PortRange, err error) {
+func (c *neutronFirewaller) ingressRulesInGroup(nameRegexp string) ([]network.IngressRule, error) {
 	group, err := c.matchingGroup(nameRegexp)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	sources := make(map[network.PortRange][]string)
 	for _, p := range group.Rules {
 		// Skip the default Security Group Rules created by Neutron
 		if p.Direction == "egress" {
@@ -772,8 +921,7 @@ func (c *neutronFirewaller) portsInGroup(nameRegexp string) (portRanges []networ
 		if p.PortRangeMax != nil {
 			portRange.ToPort = *p.PortRangeMax
 		}
-		portRanges = append(portRanges, portRange)
+		sources[portRange] = append(sources[portRange], ruleSourceCIDR(p))
 	}
-	network.SortPortRanges(portRanges)
-	return portRanges, nil
+	return ingressRulesFromSources(sources), nil
 }
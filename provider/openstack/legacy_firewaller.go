@@ -171,32 +171,70 @@ func (c *legacyNovaFirewaller) DeleteGroups(names ...string) error {
 
 // OpenPorts implements Firewaller interface.
 func (c *legacyNovaFirewaller) OpenPorts(ports []network.PortRange) error {
-	return c.openPorts(c.openPortsInGroup, ports)
+	return c.OpenIngressRules(portRangesToIngressRules(ports))
 }
 
 // ClosePorts implements Firewaller interface.
 func (c *legacyNovaFirewaller) ClosePorts(ports []network.PortRange) error {
-	return c.closePorts(c.closePortsInGroup, ports)
+	return c.CloseIngressRules(portRangesToIngressRules(ports))
 }
 
 // Ports implements Firewaller interface.
 func (c *legacyNovaFirewaller) Ports() ([]network.PortRange, error) {
-	return c.ports(c.portsInGroup)
+	rules, err := c.IngressRules()
+	if err != nil {
+		return nil, err
+	}
+	return openPortRanges(rules), nil
 }
 
 // OpenInstancePorts implements Firewaller interface.
 func (c *legacyNovaFirewaller) OpenInstancePorts(inst instance.Instance, machineId string, ports []network.PortRange) error {
-	return c.openInstancePorts(c.openPortsInGroup, machineId, ports)
+	return c.OpenInstanceIngressRules(inst, machineId, portRangesToIngressRules(ports))
 }
 
 // CloseInstancePorts implements Firewaller interface.
 func (c *legacyNovaFirewaller) CloseInstancePorts(inst instance.Instance, machineId string, ports []network.PortRange) error {
-	return c.closeInstancePorts(c.closePortsInGroup, machineId, ports)
+	return c.CloseInstanceIngressRules(inst, machineId, portRangesToIngressRules(ports))
 }
 
 // InstancePorts implements Firewaller interface.
 func (c *legacyNovaFirewaller) InstancePorts(inst instance.Instance, machineId string) ([]network.PortRange, error) {
-	return c.instancePorts(c.portsInGroup, machineId)
+	rules, err := c.InstanceIngressRules(inst, machineId)
+	if err != nil {
+		return nil, err
+	}
+	return openPortRanges(rules), nil
+}
+
+// OpenIngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) OpenIngressRules(rules []network.IngressRule) error {
+	return c.openIngressRules(c.openIngressRulesInGroup, rules)
+}
+
+// CloseIngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) CloseIngressRules(rules []network.IngressRule) error {
+	return c.closeIngressRules(c.closeIngressRulesInGroup, rules)
+}
+
+// IngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) IngressRules() ([]network.IngressRule, error) {
+	return c.ingressRules(c.ingressRulesInGroup)
+}
+
+// OpenInstanceIngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) OpenInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	return c.openInstanceIngressRules(c.openIngressRulesInGroup, machineId, rules)
+}
+
+// CloseInstanceIngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) CloseInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	return c.closeInstanceIngressRules(c.closeIngressRulesInGroup, machineId, rules)
+}
+
+// InstanceIngressRules implements Firewaller interface.
+func (c *legacyNovaFirewaller) InstanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error) {
+	return c.instanceIngressRules(c.ingressRulesInGroup, machineId)
 }
 
 func (c *legacyNovaFirewaller) matchingGroup(nameRegExp string) (nova.SecurityGroup, error) {
@@ -221,14 +259,13 @@ func (c *legacyNovaFirewaller) matchingGroup(nameRegExp string) (nova.SecurityGr
 	return matchingGroups[0], nil
 }
 
-func (c *legacyNovaFirewaller) openPortsInGroup(nameRegExp string, portRanges []network.PortRange) error {
+func (c *legacyNovaFirewaller) openIngressRulesInGroup(nameRegExp string, rules []network.IngressRule) error {
 	group, err := c.matchingGroup(nameRegExp)
 	if err != nil {
 		return errors.Trace(err)
 	}
 	novaclient := c.environ.nova()
-	rules := portsToRuleInfo(group.Id, portRanges)
-	for _, rule := range rules {
+	for _, rule := range ingressRulesToRuleInfo(group.Id, rules) {
 		_, err := novaclient.CreateSecurityGroupRule(legacyRuleInfo(rule))
 		if err != nil {
 			// TODO: if err is not rule already exists, raise?
@@ -258,8 +295,17 @@ func legacyRuleMatchesPortRange(rule nova.SecurityGroupRule, portRange network.P
 		*rule.ToPort == portRange.ToPort
 }
 
-func (c *legacyNovaFirewaller) closePortsInGroup(nameRegExp string, portRanges []network.PortRange) error {
-	if len(portRanges) == 0 {
+// legacyRuleSourceCIDR returns the source CIDR of the supplied nova
+// security group rule.
+func legacyRuleSourceCIDR(rule nova.SecurityGroupRule) string {
+	if cidr := rule.IPRange["cidr"]; cidr != "" {
+		return cidr
+	}
+	return network.DefaultSourceCIDR
+}
+
+func (c *legacyNovaFirewaller) closeIngressRulesInGroup(nameRegExp string, rules []network.IngressRule) error {
+	if len(rules) == 0 {
 		return nil
 	}
 	group, err := c.matchingGroup(nameRegExp)
@@ -267,9 +313,14 @@ func (c *legacyNovaFirewaller) closePortsInGroup(nameRegExp string, portRanges [
 		return errors.Trace(err)
 	}
 	novaclient := c.environ.nova()
-	for _, portRange := range portRanges {
+	for _, info := range ingressRulesToRuleInfo(group.Id, rules) {
+		portRange := network.PortRange{
+			Protocol: info.IPProtocol,
+			FromPort: info.PortRangeMin,
+			ToPort:   info.PortRangeMax,
+		}
 		for _, p := range group.Rules {
-			if !legacyRuleMatchesPortRange(p, portRange) {
+			if !legacyRuleMatchesPortRange(p, portRange) || legacyRuleSourceCIDR(p) != info.RemoteIPPrefix {
 				continue
 			}
 			err := novaclient.DeleteSecurityGroupRule(p.Id)
@@ -282,18 +333,19 @@ func (c *legacyNovaFirewaller) closePortsInGroup(nameRegExp string, portRanges [
 	return nil
 }
 
-func (c *legacyNovaFirewaller) portsInGroup(nameRegexp string) (portRanges []network.PortRange, err error) {
+func (c *legacyNovaFirewaller) ingressRulesInGroup(nameRegexp string) ([]network.IngressRule, error) {
 	group, err := c.matchingGroup(nameRegexp)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	sources := make(map[network.PortRange][]string)
 	for _, p := range group.Rules {
-		portRanges = append(portRanges, network.PortRange{
+		portRange := network.PortRange{
 			Protocol: *p.IPProtocol,
 			FromPort: *p.FromPort,
 			ToPort:   *p.ToPort,
-		})
+		}
+		sources[portRange] = append(sources[portRange], legacyRuleSourceCIDR(p))
 	}
-	network.SortPortRanges(portRanges)
-	return portRanges, nil
+	return ingressRulesFromSources(sources), nil
 }
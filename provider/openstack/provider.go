@@ -282,6 +282,7 @@ var _ simplestreams.HasRegion = (*Environ)(nil)
 var _ state.Prechecker = (*Environ)(nil)
 var _ instance.Distributor = (*Environ)(nil)
 var _ environs.InstanceTagger = (*Environ)(nil)
+var _ environs.IngressRuleFirewaller = (*Environ)(nil)
 
 type openstackInstance struct {
 	e        *Environ
@@ -299,6 +300,7 @@ func (inst *openstackInstance) String() string {
 }
 
 var _ instance.Instance = (*openstackInstance)(nil)
+var _ instance.IngressRuleFirewaller = (*openstackInstance)(nil)
 
 func (inst *openstackInstance) Refresh() error {
 	inst.mu.Lock()
@@ -445,6 +447,21 @@ func (inst *openstackInstance) Ports(machineId string) ([]network.PortRange, err
 	return inst.e.firewaller.InstancePorts(inst, machineId)
 }
 
+// OpenIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *openstackInstance) OpenIngressRules(machineId string, rules []network.IngressRule) error {
+	return inst.e.firewaller.OpenInstanceIngressRules(inst, machineId, rules)
+}
+
+// CloseIngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *openstackInstance) CloseIngressRules(machineId string, rules []network.IngressRule) error {
+	return inst.e.firewaller.CloseInstanceIngressRules(inst, machineId, rules)
+}
+
+// IngressRules is specified in instance.IngressRuleFirewaller.
+func (inst *openstackInstance) IngressRules(machineId string) ([]network.IngressRule, error) {
+	return inst.e.firewaller.InstanceIngressRules(inst, machineId)
+}
+
 func (e *Environ) ecfg() *environConfig {
 	e.ecfgMutex.Lock()
 	ecfg := e.ecfgUnlocked
@@ -1417,18 +1434,30 @@ func jujuMachineFilter() *nova.Filter {
 
 // portsToRuleInfo maps port ranges to nova rules
 func portsToRuleInfo(groupId string, ports []network.PortRange) []neutron.RuleInfoV2 {
-	rules := make([]neutron.RuleInfoV2, len(ports))
-	for i, portRange := range ports {
-		rules[i] = neutron.RuleInfoV2{
-			Direction:      "ingress",
-			ParentGroupId:  groupId,
-			PortRangeMin:   portRange.FromPort,
-			PortRangeMax:   portRange.ToPort,
-			IPProtocol:     portRange.Protocol,
-			RemoteIPPrefix: "0.0.0.0/0",
+	return ingressRulesToRuleInfo(groupId, portRangesToIngressRules(ports))
+}
+
+// ingressRulesToRuleInfo maps ingress rules to nova rules, with one
+// nova rule for each source CIDR.
+func ingressRulesToRuleInfo(groupId string, rules []network.IngressRule) []neutron.RuleInfoV2 {
+	var result []neutron.RuleInfoV2
+	for _, rule := range rules {
+		sourceCIDRs := rule.SourceCIDRs
+		if len(sourceCIDRs) == 0 {
+			sourceCIDRs = []string{network.DefaultSourceCIDR}
+		}
+		for _, sourceCIDR := range sourceCIDRs {
+			result = append(result, neutron.RuleInfoV2{
+				Direction:      "ingress",
+				ParentGroupId:  groupId,
+				PortRangeMin:   rule.FromPort,
+				PortRangeMax:   rule.ToPort,
+				IPProtocol:     rule.Protocol,
+				RemoteIPPrefix: sourceCIDR,
+			})
 		}
 	}
-	return rules
+	return result
 }
 
 func (e *Environ) OpenPorts(ports []network.PortRange) error {
@@ -1443,6 +1472,21 @@ func (e *Environ) Ports() ([]network.PortRange, error) {
 	return e.firewaller.Ports()
 }
 
+// OpenIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *Environ) OpenIngressRules(rules []network.IngressRule) error {
+	return e.firewaller.OpenIngressRules(rules)
+}
+
+// CloseIngressRules is specified in environs.IngressRuleFirewaller.
+func (e *Environ) CloseIngressRules(rules []network.IngressRule) error {
+	return e.firewaller.CloseIngressRules(rules)
+}
+
+// IngressRules is specified in environs.IngressRuleFirewaller.
+func (e *Environ) IngressRules() ([]network.IngressRule, error) {
+	return e.firewaller.IngressRules()
+}
+
 func (e *Environ) Provider() environs.EnvironProvider {
 	return providerInstance
 }
@@ -265,6 +265,36 @@ func (*localTests) TestPortsToRuleInfo(c *gc.C) {
 	}
 }
 
+func (*localTests) TestIngressRulesToRuleInfo(c *gc.C) {
+	groupId := "groupid"
+	rules := IngressRulesToRuleInfo(groupId, []network.IngressRule{
+		network.NewOpenIngressRule(network.PortRange{80, 80, "tcp"}),
+		{network.PortRange{53, 53, "udp"}, []string{"10.0.0.0/8", "192.168.1.0/24"}},
+	})
+	c.Assert(rules, gc.DeepEquals, []neutron.RuleInfoV2{{
+		Direction:      "ingress",
+		IPProtocol:     "tcp",
+		PortRangeMin:   80,
+		PortRangeMax:   80,
+		RemoteIPPrefix: "0.0.0.0/0",
+		ParentGroupId:  groupId,
+	}, {
+		Direction:      "ingress",
+		IPProtocol:     "udp",
+		PortRangeMin:   53,
+		PortRangeMax:   53,
+		RemoteIPPrefix: "10.0.0.0/8",
+		ParentGroupId:  groupId,
+	}, {
+		Direction:      "ingress",
+		IPProtocol:     "udp",
+		PortRangeMin:   53,
+		PortRangeMax:   53,
+		RemoteIPPrefix: "192.168.1.0/24",
+		ParentGroupId:  groupId,
+	}})
+}
+
 func (*localTests) TestRuleMatchesPortRange(c *gc.C) {
 	proto_tcp := "tcp"
 	proto_udp := "udp"
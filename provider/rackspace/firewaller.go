@@ -88,6 +88,69 @@ func (c *rackspaceFirewaller) InstancePorts(inst instance.Instance, machineId st
 	return configurator.FindOpenPorts()
 }
 
+// OpenIngressRules is not supported.
+func (c *rackspaceFirewaller) OpenIngressRules(rules []network.IngressRule) error {
+	return errors.NotSupportedf("OpenIngressRules")
+}
+
+// CloseIngressRules is not supported.
+func (c *rackspaceFirewaller) CloseIngressRules(rules []network.IngressRule) error {
+	return errors.NotSupportedf("CloseIngressRules")
+}
+
+// IngressRules is not supported.
+func (c *rackspaceFirewaller) IngressRules() ([]network.IngressRule, error) {
+	return nil, errors.NotSupportedf("IngressRules")
+}
+
+// OpenInstanceIngressRules implements Firewaller interface. Only rules
+// that allow traffic from anywhere can be opened; source CIDRs are not
+// supported.
+func (c *rackspaceFirewaller) OpenInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	ports := openPortRanges(rules, true)
+	if len(ports) == 0 {
+		return nil
+	}
+	return c.changePorts(inst, true, ports)
+}
+
+// CloseInstanceIngressRules implements Firewaller interface.
+func (c *rackspaceFirewaller) CloseInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	ports := openPortRanges(rules, false)
+	if len(ports) == 0 {
+		return nil
+	}
+	return c.changePorts(inst, false, ports)
+}
+
+// InstanceIngressRules implements Firewaller interface.
+func (c *rackspaceFirewaller) InstanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error) {
+	ports, err := c.InstancePorts(inst, machineId)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	rules := make([]network.IngressRule, len(ports))
+	for i, portRange := range ports {
+		rules[i] = network.NewOpenIngressRule(portRange)
+	}
+	return rules, nil
+}
+
+// openPortRanges returns the port ranges of those ingress rules that
+// allow traffic from anywhere. Rules restricted to source CIDRs cannot
+// be applied with iptables here, and are logged if being opened.
+func openPortRanges(rules []network.IngressRule, opening bool) []network.PortRange {
+	var ports []network.PortRange
+	for _, rule := range rules {
+		if rule.IsOpen() {
+			ports = append(ports, rule.PortRange)
+		} else if opening {
+			logger.Errorf("cannot open port range %v: firewall does not support source CIDRs", rule)
+		}
+	}
+	return ports
+}
+
 func (c *rackspaceFirewaller) changePorts(inst instance.Instance, insert bool, ports []network.PortRange) error {
 	addresses, sshClient, err := c.getInstanceConfigurator(inst)
 	if err != nil {
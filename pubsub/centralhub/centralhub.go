@@ -4,6 +4,9 @@
 package centralhub
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/juju/errors"
 	"github.com/juju/pubsub"
 	"github.com/juju/utils"
@@ -11,12 +14,24 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// Hub is a structured hub that also keeps track of its subscriptions
+// and of the published messages that are still being delivered, so
+// they can be reported by the introspection worker.
+type Hub struct {
+	*pubsub.StructuredHub
+
+	mu            sync.Mutex
+	nextID        int
+	subscriptions map[int]string
+	pending       map[pubsub.Topic]int
+	published     int
+}
+
 // New returns a new structured hub using yaml marshalling with an origin
 // specified. The post processing ensures that the maps all have string keys
 // so they messages can be marshalled between apiservers.
-func New(origin names.MachineTag) *pubsub.StructuredHub {
-
-	return pubsub.NewStructuredHub(
+func New(origin names.MachineTag) *Hub {
+	hub := pubsub.NewStructuredHub(
 		&pubsub.StructuredHubConfig{
 			Marshaller: &yamlMarshaller{},
 			Annotations: map[string]interface{}{
@@ -24,6 +39,98 @@ func New(origin names.MachineTag) *pubsub.StructuredHub {
 			},
 			PostProcess: ensureStringMaps,
 		})
+	return &Hub{
+		StructuredHub: hub,
+		subscriptions: make(map[int]string),
+		pending:       make(map[pubsub.Topic]int),
+	}
+}
+
+// Subscribe is part of the pubsub.StructuredHub interface. The
+// subscription is recorded until it is unsubscribed.
+func (h *Hub) Subscribe(matcher pubsub.TopicMatcher, handler interface{}) (pubsub.Unsubscriber, error) {
+	unsub, err := h.StructuredHub.Subscribe(matcher, handler)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	id := h.nextID
+	h.nextID++
+	h.subscriptions[id] = describeMatcher(matcher)
+	return &subscription{Unsubscriber: unsub, hub: h, id: id}, nil
+}
+
+// Publish is part of the pubsub.StructuredHub interface. The message
+// is counted as pending until all the subscribers have handled it.
+func (h *Hub) Publish(topic pubsub.Topic, data interface{}) (<-chan struct{}, error) {
+	done, err := h.StructuredHub.Publish(topic, data)
+	if err != nil {
+		return nil, err
+	}
+	h.mu.Lock()
+	h.pending[topic]++
+	h.published++
+	h.mu.Unlock()
+	go func() {
+		<-done
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if h.pending[topic]--; h.pending[topic] == 0 {
+			delete(h.pending, topic)
+		}
+	}()
+	return done, nil
+}
+
+// Report returns the hub's subscriptions, grouped by the topics they
+// match, and the number of messages per topic that are still being
+// delivered, for use by the introspection worker.
+func (h *Hub) Report() map[string]interface{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subscriptions := make(map[string]int)
+	for _, matcher := range h.subscriptions {
+		subscriptions[matcher]++
+	}
+	pending := make(map[string]int)
+	for topic, count := range h.pending {
+		pending[string(topic)] = count
+	}
+	return map[string]interface{}{
+		"subscriptions": subscriptions,
+		"pending":       pending,
+		"published":     h.published,
+	}
+}
+
+func (h *Hub) unsubscribe(id int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscriptions, id)
+}
+
+// describeMatcher returns a description of the topics matched by the
+// given matcher.
+func describeMatcher(matcher pubsub.TopicMatcher) string {
+	if topic, ok := matcher.(pubsub.Topic); ok {
+		return string(topic)
+	}
+	return fmt.Sprintf("%T", matcher)
+}
+
+type subscription struct {
+	pubsub.Unsubscriber
+	hub  *Hub
+	once sync.Once
+	id   int
+}
+
+// Unsubscribe is part of the pubsub.Unsubscriber interface.
+func (s *subscription) Unsubscribe() {
+	s.Unsubscriber.Unsubscribe()
+	s.once.Do(func() { s.hub.unsubscribe(s.id) })
 }
 
 type yamlMarshaller struct{}
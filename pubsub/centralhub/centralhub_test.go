@@ -114,3 +114,38 @@ func (s *CentralHubSuite) TestPostProcessingMaps(c *gc.C) {
 	s.waitForSubscribers(c, done)
 	c.Assert(called, jc.IsTrue)
 }
+
+func (s *CentralHubSuite) TestReport(c *gc.C) {
+	hub := centralhub.New(names.NewMachineTag("42"))
+	topic := pubsub.Topic("testing")
+	release := make(chan struct{})
+	unsub, err := hub.Subscribe(topic, func(pubsub.Topic, map[string]interface{}) {
+		<-release
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	other, err := hub.Subscribe(topic, func(pubsub.Topic, map[string]interface{}) {})
+	c.Assert(err, jc.ErrorIsNil)
+	other.Unsubscribe()
+
+	done, err := hub.Publish(topic, map[string]interface{}{"key": "value"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(hub.Report(), jc.DeepEquals, map[string]interface{}{
+		"subscriptions": map[string]int{"testing": 1},
+		"pending":       map[string]int{"testing": 1},
+		"published":     1,
+	})
+
+	close(release)
+	s.waitForSubscribers(c, done)
+	unsub.Unsubscribe()
+	for a := testing.LongAttempt.Start(); a.Next(); {
+		if len(hub.Report()["pending"].(map[string]int)) == 0 {
+			break
+		}
+	}
+	c.Assert(hub.Report(), jc.DeepEquals, map[string]interface{}{
+		"subscriptions": map[string]int{},
+		"pending":       map[string]int{},
+		"published":     1,
+	})
+}
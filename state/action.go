@@ -5,9 +5,11 @@ package state
 
 import (
 	"time"
+	"unicode/utf8"
 
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
+	jujutxn "github.com/juju/txn"
 	"github.com/juju/utils"
 	"gopkg.in/juju/names.v2"
 	"gopkg.in/mgo.v2"
@@ -45,6 +47,23 @@ const (
 
 	// ActionRunning indicates that the Action is currently running.
 	ActionRunning ActionStatus = "running"
+
+	// ActionAborting indicates that the Action is running but has been
+	// asked to stop.
+	ActionAborting ActionStatus = "aborting"
+
+	// ActionAborted means that the Action was stopped while running.
+	ActionAborted ActionStatus = "aborted"
+)
+
+const (
+	// maxActionMessages is the number of progress messages kept for
+	// each action; older messages are dropped.
+	maxActionMessages = 100
+
+	// maxActionMessageLength is the length in bytes beyond which
+	// progress messages are truncated.
+	maxActionMessageLength = 1024
 )
 
 type actionNotificationDoc struct {
@@ -104,6 +123,20 @@ type actionDoc struct {
 
 	// Results are the structured results from the action.
 	Results map[string]interface{} `bson:"results"`
+
+	// Logs holds the most recent progress messages logged by the
+	// action while running.
+	Logs []ActionMessage `bson:"messages"`
+
+	// LogCount is the number of progress messages logged by the
+	// action, including those no longer held in Logs.
+	LogCount int `bson:"message-count"`
+}
+
+// ActionMessage is a progress message logged by a running action.
+type ActionMessage struct {
+	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
+	Message   string    `bson:"message" json:"message"`
 }
 
 // action represents an instruction to do some "action" and is expected
@@ -162,6 +195,19 @@ func (a *action) Results() (map[string]interface{}, string) {
 	return a.doc.Results, a.doc.Message
 }
 
+// Messages returns the progress messages logged by the action, oldest
+// first.
+func (a *action) Messages() []ActionMessage {
+	messages := make([]ActionMessage, len(a.doc.Logs))
+	for i, msg := range a.doc.Logs {
+		messages[i] = ActionMessage{
+			Timestamp: msg.Timestamp.UTC(),
+			Message:   msg.Message,
+		}
+	}
+	return messages
+}
+
 // Tag implements the Entity interface and returns a names.Tag that
 // is a names.ActionTag.
 func (a *action) Tag() names.Tag {
@@ -201,6 +247,76 @@ func (a *action) Begin() (Action, error) {
 	return a.st.Action(a.Id())
 }
 
+// Log adds a timestamped progress message to the action. It asserts
+// that the action is running. Long messages are truncated, and only
+// the most recent messages are kept.
+func (a *action) Log(message string) error {
+	if len(message) > maxActionMessageLength {
+		n := maxActionMessageLength
+		for n > 0 && !utf8.RuneStart(message[n]) {
+			n--
+		}
+		message = message[:n]
+	}
+	msg := ActionMessage{
+		Timestamp: a.st.clock.Now().UTC(),
+		Message:   message,
+	}
+	err := a.st.runTransaction([]txn.Op{{
+		C:  actionsC,
+		Id: a.doc.DocId,
+		Assert: bson.D{{"status", bson.D{
+			{"$in", []interface{}{
+				ActionRunning,
+				ActionAborting,
+			}}}}},
+		Update: bson.D{
+			{"$push", bson.D{{"messages", bson.D{
+				{"$each", []ActionMessage{msg}},
+				{"$slice", -maxActionMessages},
+			}}}},
+			{"$inc", bson.D{{"message-count", 1}}},
+		},
+	}})
+	if err == txn.ErrAborted {
+		return errors.Errorf("cannot log message to action %q: action not running", a.Id())
+	}
+	return errors.Trace(err)
+}
+
+// Abort asks the unit running the action to stop it. It asserts that
+// the action is currently running; the unit records the result once
+// the action has stopped.
+func (a *action) Abort() (Action, error) {
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		if attempt > 0 {
+			current, err := a.st.Action(a.Id())
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			switch current.Status() {
+			case ActionRunning:
+			case ActionAborting:
+				return nil, jujutxn.ErrNoOperations
+			default:
+				return nil, errors.Errorf("cannot abort action %q with status %q", a.Id(), current.Status())
+			}
+		}
+		return []txn.Op{{
+			C:      actionsC,
+			Id:     a.doc.DocId,
+			Assert: bson.D{{"status", ActionRunning}},
+			Update: bson.D{{"$set", bson.D{
+				{"status", ActionAborting},
+			}}},
+		}}, nil
+	}
+	if err := a.st.run(buildTxn); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return a.st.Action(a.Id())
+}
+
 // Finish removes action from the pending queue and captures the output
 // and end state of the action.
 func (a *action) Finish(results ActionResults) (Action, error) {
@@ -220,6 +336,7 @@ func (a *action) removeAndLog(finalStatus ActionStatus, results map[string]inter
 					ActionCompleted,
 					ActionCancelled,
 					ActionFailed,
+					ActionAborted,
 				}}}}},
 			Update: bson.D{{"$set", bson.D{
 				{"status", finalStatus},
@@ -430,9 +547,12 @@ func (st *State) matchingActionsPending(ar ActionReceiver) ([]Action, error) {
 }
 
 // matchingActionsRunning finds actions that match ActionReceiver and
-// that are running.
+// that are running, including those being aborted.
 func (st *State) matchingActionsRunning(ar ActionReceiver) ([]Action, error) {
-	completed := bson.D{{"status", ActionRunning}}
+	completed := bson.D{{"$or", []bson.D{
+		{{"status", ActionRunning}},
+		{{"status", ActionAborting}},
+	}}}
 	return st.matchingActionsByReceiverAndStatus(ar.Tag(), completed)
 }
 
@@ -443,6 +563,7 @@ func (st *State) matchingActionsCompleted(ar ActionReceiver) ([]Action, error) {
 		{{"status", ActionCompleted}},
 		{{"status", ActionCancelled}},
 		{{"status", ActionFailed}},
+		{{"status", ActionAborted}},
 	}}}
 	return st.matchingActionsByReceiverAndStatus(ar.Tag(), completed)
 }
@@ -464,3 +585,124 @@ func (st *State) matchingActionsByReceiverAndStatus(tag names.Tag, statusConditi
 	}
 	return actions, errors.Trace(iter.Close())
 }
+
+// actionPruneBatchSize is the number of completed actions removed at a
+// time when pruning, so that pruning a large collection does not hold
+// up other writes for long.
+const actionPruneBatchSize = 1000
+
+// PruneActions removes the model's completed actions that finished
+// more than maxAge ago, and then removes the oldest remaining completed
+// actions until the actions collection is no larger than maxSizeMB. A
+// zero limit is not applied. Pending and running actions are never
+// removed.
+func PruneActions(st *State, maxAge time.Duration, maxSizeMB int) error {
+	if maxSizeMB < 0 {
+		return errors.NotValidf("negative maxSizeMB")
+	}
+	if maxAge < 0 {
+		return errors.NotValidf("negative maxAge")
+	}
+	actions, closer := st.getRawCollection(actionsC)
+	defer closer()
+	notifications, closer := st.getRawCollection(actionNotificationsC)
+	defer closer()
+
+	completed := bson.D{
+		{"model-uuid", st.ModelUUID()},
+		{"status", bson.D{{"$in", []ActionStatus{
+			ActionCompleted,
+			ActionCancelled,
+			ActionFailed,
+			ActionAborted,
+		}}}},
+	}
+
+	if maxAge > 0 {
+		query := append(bson.D{{"completed", bson.D{{"$lt", st.clock.Now().Add(-maxAge)}}}}, completed...)
+		removed, err := removeActionsInBatches(st, actions, notifications, query, -1)
+		if err != nil {
+			return errors.Annotate(err, "pruning actions by age")
+		}
+		if removed > 0 {
+			actionLogger.Debugf("removed %d actions older than %v", removed, maxAge)
+		}
+	}
+	if maxSizeMB == 0 {
+		return nil
+	}
+
+	collMB, err := getCollectionMB(actions)
+	if err != nil {
+		return errors.Annotate(err, "retrieving actions collection size")
+	}
+	if collMB <= maxSizeMB {
+		return nil
+	}
+	count, err := actions.Count()
+	if err != nil {
+		return errors.Annotate(err, "counting actions")
+	}
+	if count <= 0 {
+		return nil
+	}
+	// As with status history, assume that action documents are of a
+	// similar size on average, to estimate how many must go.
+	sizePerAction := float64(collMB) / float64(count)
+	toRemove := int(float64(collMB-maxSizeMB) / sizePerAction)
+	if toRemove <= 0 {
+		return nil
+	}
+	removed, err := removeActionsInBatches(st, actions, notifications, completed, toRemove)
+	if err != nil {
+		return errors.Annotate(err, "pruning actions by size")
+	}
+	actionLogger.Debugf("removed %d actions to reduce the actions collection below %dMB", removed, maxSizeMB)
+	return nil
+}
+
+// removeActionsInBatches removes up to limit actions matching the
+// query, oldest first, along with any notifications left for them. A
+// negative limit removes all matching actions. It returns the number
+// of actions removed.
+func removeActionsInBatches(st *State, actions, notifications *mgo.Collection, query bson.D, limit int) (int, error) {
+	removed := 0
+	for limit < 0 || removed < limit {
+		batchSize := actionPruneBatchSize
+		if limit >= 0 && limit-removed < batchSize {
+			batchSize = limit - removed
+		}
+		var docs []struct {
+			DocId    string `bson:"_id"`
+			Receiver string `bson:"receiver"`
+		}
+		err := actions.Find(query).
+			Sort("completed").
+			Select(bson.D{{"_id", 1}, {"receiver", 1}}).
+			Limit(batchSize).
+			All(&docs)
+		if err != nil {
+			return removed, errors.Trace(err)
+		}
+		if len(docs) == 0 {
+			break
+		}
+		ids := make([]string, len(docs))
+		notificationIds := make([]string, len(docs))
+		for i, doc := range docs {
+			ids[i] = doc.DocId
+			notificationIds[i] = st.docID(ensureActionMarker(doc.Receiver) + st.localID(doc.DocId))
+		}
+		if _, err := actions.RemoveAll(bson.D{{"_id", bson.D{{"$in", ids}}}}); err != nil {
+			return removed, errors.Trace(err)
+		}
+		if _, err := notifications.RemoveAll(bson.D{{"_id", bson.D{{"$in", notificationIds}}}}); err != nil {
+			return removed, errors.Trace(err)
+		}
+		removed += len(docs)
+		if len(docs) < batchSize {
+			break
+		}
+	}
+	return removed, nil
+}
@@ -7,8 +7,10 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/txn"
 	"github.com/juju/utils"
@@ -463,6 +465,68 @@ func (s *ActionSuite) TestComplete(c *gc.C) {
 	c.Assert(len(actions), gc.Equals, 0)
 }
 
+func (s *ActionSuite) TestAbort(c *gc.C) {
+	unit, err := s.State.Unit(s.unit.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	preventUnitDestroyRemove(c, unit)
+
+	a, err := unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A pending action cannot be aborted; it is cancelled instead.
+	_, err = a.Abort()
+	c.Assert(err, gc.ErrorMatches, `cannot abort action ".*" with status "pending"`)
+
+	a, err = a.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+	a, err = a.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(a.Status(), gc.Equals, state.ActionAborting)
+
+	// Aborting is idempotent, and the action is still running.
+	a, err = a.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(a.Status(), gc.Equals, state.ActionAborting)
+	running, err := unit.RunningActions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(running, gc.HasLen, 1)
+
+	// The unit records the result once the action has stopped.
+	_, err = a.Finish(state.ActionResults{Status: state.ActionAborted, Message: "action aborted"})
+	c.Assert(err, jc.ErrorIsNil)
+	results, err := unit.CompletedActions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Status(), gc.Equals, state.ActionAborted)
+
+	_, err = a.Abort()
+	c.Assert(err, gc.ErrorMatches, `cannot abort action ".*" with status "aborted"`)
+	_, err = a.Finish(state.ActionResults{Status: state.ActionCompleted})
+	c.Assert(err, gc.NotNil)
+}
+
+func (s *ActionSuite) TestWatchAbort(c *gc.C) {
+	unit, err := s.State.Unit(s.unit.Name())
+	c.Assert(err, jc.ErrorIsNil)
+	a, err := unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	a, err = a.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+
+	w := a.Watch()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	_, err = a.Abort()
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+
+	_, err = a.Finish(state.ActionResults{Status: state.ActionAborted})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
+
 func (s *ActionSuite) TestFindActionTagsByPrefix(c *gc.C) {
 	prefix := "feedbeef"
 	uuidMock := uuidMockHelper{}
@@ -773,6 +837,158 @@ func (s *ActionSuite) TestWatchActionNotifications(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *ActionSuite) TestLog(c *gc.C) {
+	clock := jujutesting.NewClock(time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC))
+	err := s.State.SetClockForTesting(clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	a, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = a.Log("too early")
+	c.Assert(err, gc.ErrorMatches, `cannot log message to action ".*": action not running`)
+
+	a, err = a.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(a.Messages(), gc.HasLen, 0)
+
+	w := s.State.WatchActionLogs(a.Id())
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChange()
+	wc.AssertNoChange()
+
+	err = a.Log("copying tables")
+	c.Assert(err, jc.ErrorIsNil)
+	clock.Advance(time.Minute)
+	err = a.Log("building indexes")
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChange(
+		`{"timestamp":"2017-03-01T10:30:00Z","message":"copying tables"}`,
+		`{"timestamp":"2017-03-01T10:31:00Z","message":"building indexes"}`,
+	)
+	wc.AssertNoChange()
+
+	a, err = s.State.Action(a.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(a.Messages(), jc.DeepEquals, []state.ActionMessage{{
+		Timestamp: time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC),
+		Message:   "copying tables",
+	}, {
+		Timestamp: time.Date(2017, 3, 1, 10, 31, 0, 0, time.UTC),
+		Message:   "building indexes",
+	}})
+
+	// Finishing the action is not a new message.
+	_, err = a.Finish(state.ActionResults{Status: state.ActionCompleted})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertNoChange()
+	err = a.Log("too late")
+	c.Assert(err, gc.ErrorMatches, `cannot log message to action ".*": action not running`)
+
+	// A new watcher starts with all the messages so far.
+	w2 := s.State.WatchActionLogs(a.Id())
+	defer statetesting.AssertStop(c, w2)
+	wc2 := statetesting.NewStringsWatcherC(c, s.State, w2)
+	wc2.AssertChange(
+		`{"timestamp":"2017-03-01T10:30:00Z","message":"copying tables"}`,
+		`{"timestamp":"2017-03-01T10:31:00Z","message":"building indexes"}`,
+	)
+}
+
+func (s *ActionSuite) TestLogLimits(c *gc.C) {
+	a, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	a, err = a.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+
+	for i := 0; i < state.MaxActionMessages+5; i++ {
+		err = a.Log(fmt.Sprintf("message %d", i))
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	a, err = s.State.Action(a.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	messages := a.Messages()
+	c.Assert(messages, gc.HasLen, state.MaxActionMessages)
+	c.Assert(messages[0].Message, gc.Equals, "message 5")
+	c.Assert(messages[len(messages)-1].Message, gc.Equals, fmt.Sprintf("message %d", state.MaxActionMessages+4))
+
+	// A watcher only reports messages logged after those it has
+	// seen, even though older ones are dropped.
+	w := s.State.WatchActionLogs(a.Id())
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewStringsWatcherC(c, s.State, w)
+	s.State.StartSync()
+	select {
+	case changes := <-w.Changes():
+		c.Assert(changes, gc.HasLen, state.MaxActionMessages)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for initial event")
+	}
+	err = a.Log(strings.Repeat("é", state.MaxActionMessageLength))
+	c.Assert(err, jc.ErrorIsNil)
+	s.State.StartSync()
+	select {
+	case changes := <-w.Changes():
+		c.Assert(changes, gc.HasLen, 1)
+	case <-time.After(testing.LongWait):
+		c.Fatalf("timed out waiting for change")
+	}
+	wc.AssertNoChange()
+
+	// Long messages are truncated on a character boundary.
+	a, err = s.State.Action(a.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	messages = a.Messages()
+	c.Assert(messages, gc.HasLen, state.MaxActionMessages)
+	c.Assert(messages[len(messages)-1].Message, gc.Equals, strings.Repeat("é", state.MaxActionMessageLength/2))
+}
+
+func (s *ActionSuite) TestPruneActions(c *gc.C) {
+	clock := jujutesting.NewClock(time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC))
+	err := s.State.SetClockForTesting(clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	old, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = old.Finish(state.ActionResults{Status: state.ActionCompleted})
+	c.Assert(err, jc.ErrorIsNil)
+	oldPending, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	oldRunning, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = oldRunning.Begin()
+	c.Assert(err, jc.ErrorIsNil)
+
+	clock.Advance(2 * time.Hour)
+	recent, err := s.unit.AddAction("snapshot", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = recent.Finish(state.ActionResults{Status: state.ActionFailed})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The collection is far below 1GB, so only the old completed
+	// action goes.
+	err = state.PruneActions(s.State, time.Hour, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.Action(old.Id())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	for _, a := range []state.Action{oldPending, oldRunning, recent} {
+		_, err = s.State.Action(a.Id())
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	pending, err := s.unit.PendingActions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(pending, gc.HasLen, 1)
+	c.Assert(pending[0].Id(), gc.Equals, oldPending.Id())
+}
+
+func (s *ActionSuite) TestPruneActionsInvalidArgs(c *gc.C) {
+	err := state.PruneActions(s.State, -time.Hour, 0)
+	c.Assert(err, gc.ErrorMatches, "negative maxAge not valid")
+	err = state.PruneActions(s.State, 0, -1)
+	c.Assert(err, gc.ErrorMatches, "negative maxSizeMB not valid")
+}
+
 func (s *ActionSuite) TestActionStatusWatcher(c *gc.C) {
 	testCase := []struct {
 		receiver state.ActionReceiver
@@ -0,0 +1,427 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+
+	"github.com/juju/juju/core/cron"
+	"github.com/juju/juju/permission"
+)
+
+// actionScheduleDoc records an action to be enqueued on a set of
+// receivers at a future time, once or on a recurring schedule.
+type actionScheduleDoc struct {
+	DocID     string `bson:"_id"`
+	Id        string `bson:"id"`
+	ModelUUID string `bson:"model-uuid"`
+
+	// Receivers holds the tags of the units, and of the applications
+	// on every unit of which, the action is enqueued.
+	Receivers  []string               `bson:"receivers"`
+	Name       string                 `bson:"name"`
+	Parameters map[string]interface{} `bson:"parameters"`
+
+	// Recurrence is the cron-style specification of when the action
+	// recurs. It is empty for actions that are enqueued only once.
+	Recurrence string `bson:"recurrence"`
+
+	// Next is when the action is next enqueued.
+	Next time.Time `bson:"next"`
+
+	// LastFired is when the action was last enqueued.
+	LastFired time.Time `bson:"last-fired"`
+
+	Created   time.Time `bson:"created"`
+	CreatedBy string    `bson:"created-by"`
+}
+
+// ActionSchedule represents an action that is enqueued at a future
+// time, once or on a recurring schedule.
+type ActionSchedule struct {
+	st  *State
+	doc actionScheduleDoc
+}
+
+// Id returns the id of the schedule.
+func (s *ActionSchedule) Id() string {
+	return s.doc.Id
+}
+
+// Receivers returns the tags of the units and applications the action
+// is enqueued on.
+func (s *ActionSchedule) Receivers() []names.Tag {
+	tags := make([]names.Tag, 0, len(s.doc.Receivers))
+	for _, receiver := range s.doc.Receivers {
+		tag, err := names.ParseTag(receiver)
+		if err != nil {
+			// Receivers are validated when the schedule is added.
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// Name returns the name of the action.
+func (s *ActionSchedule) Name() string {
+	return s.doc.Name
+}
+
+// Parameters returns the parameters of the action.
+func (s *ActionSchedule) Parameters() map[string]interface{} {
+	return s.doc.Parameters
+}
+
+// Recurrence returns the cron-style specification of when the action
+// recurs, or an empty string if it is enqueued only once.
+func (s *ActionSchedule) Recurrence() string {
+	return s.doc.Recurrence
+}
+
+// Next returns when the action is next enqueued.
+func (s *ActionSchedule) Next() time.Time {
+	return s.doc.Next.UTC()
+}
+
+// LastFired returns when the action was last enqueued, or the zero
+// time if it has not been.
+func (s *ActionSchedule) LastFired() time.Time {
+	return s.doc.LastFired.UTC()
+}
+
+// Created returns when the schedule was added.
+func (s *ActionSchedule) Created() time.Time {
+	return s.doc.Created.UTC()
+}
+
+// CreatedBy returns the tag of the user who added the schedule.
+func (s *ActionSchedule) CreatedBy() names.UserTag {
+	return names.NewUserTag(s.doc.CreatedBy)
+}
+
+// AddActionScheduleArgs holds the arguments for AddActionSchedule.
+type AddActionScheduleArgs struct {
+	// Receivers holds the tags of the units, and of the applications
+	// on every unit of which, the action is enqueued.
+	Receivers []names.Tag
+
+	// Name is the name of the action.
+	Name string
+
+	// Parameters holds the parameters of the action.
+	Parameters map[string]interface{}
+
+	// Start is when the action is first enqueued. If it is zero, the
+	// action is first enqueued at the next time matching Recurrence.
+	Start time.Time
+
+	// Recurrence is the cron-style specification of when the action
+	// recurs, as accepted by cron.Parse. If it is empty, the action is
+	// enqueued only once, at Start.
+	Recurrence string
+
+	// CreatedBy is the user adding the schedule.
+	CreatedBy names.UserTag
+}
+
+// AddActionSchedule adds a schedule for enqueuing an action.
+func (st *State) AddActionSchedule(args AddActionScheduleArgs) (*ActionSchedule, error) {
+	if args.Name == "" {
+		return nil, errors.New("action name required")
+	}
+	if len(args.Receivers) == 0 {
+		return nil, errors.New("no receivers specified")
+	}
+	now := st.NowToTheSecond()
+	next := args.Start.UTC()
+	if args.Recurrence != "" {
+		schedule, err := cron.Parse(args.Recurrence)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if next.IsZero() {
+			if next = schedule.Next(now); next.IsZero() {
+				return nil, errors.Errorf("recurrence %q never occurs", args.Recurrence)
+			}
+		}
+	} else if next.IsZero() {
+		return nil, errors.New("start time or recurrence required")
+	}
+
+	receivers := make([]string, len(args.Receivers))
+	var ops []txn.Op
+	for i, tag := range args.Receivers {
+		switch tag.(type) {
+		case names.UnitTag, names.ApplicationTag:
+		default:
+			return nil, errors.NotValidf("action receiver %q", tag)
+		}
+		coll, id, err := st.tagToCollectionAndId(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if alive, err := isAlive(st, coll, id); err != nil {
+			return nil, errors.Trace(err)
+		} else if !alive {
+			return nil, errors.NotFoundf("%s", names.ReadableString(tag))
+		}
+		receivers[i] = tag.String()
+		ops = append(ops, txn.Op{
+			C:      coll,
+			Id:     id,
+			Assert: isAliveDoc,
+		})
+	}
+
+	seq, err := st.sequence("actionschedule")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	id := strconv.Itoa(seq)
+	doc := actionScheduleDoc{
+		DocID:      st.docID(id),
+		Id:         id,
+		ModelUUID:  st.ModelUUID(),
+		Receivers:  receivers,
+		Name:       args.Name,
+		Parameters: args.Parameters,
+		Recurrence: args.Recurrence,
+		Next:       next,
+		Created:    now,
+		CreatedBy:  args.CreatedBy.Id(),
+	}
+	ops = append(ops, txn.Op{
+		C:      actionSchedulesC,
+		Id:     doc.DocID,
+		Assert: txn.DocMissing,
+		Insert: doc,
+	})
+	if err := st.runTransaction(ops); err == txn.ErrAborted {
+		return nil, errors.New("action receivers changed while adding schedule")
+	} else if err != nil {
+		return nil, errors.Annotate(err, "cannot add action schedule")
+	}
+	return &ActionSchedule{st: st, doc: doc}, nil
+}
+
+// ActionSchedule returns the action schedule with the given id.
+func (st *State) ActionSchedule(id string) (*ActionSchedule, error) {
+	schedules, closer := st.getCollection(actionSchedulesC)
+	defer closer()
+
+	var doc actionScheduleDoc
+	err := schedules.FindId(id).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, errors.NotFoundf("action schedule %q", id)
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "cannot get action schedule %q", id)
+	}
+	return &ActionSchedule{st: st, doc: doc}, nil
+}
+
+// ActionSchedules returns all the action schedules in the model,
+// ordered by when they are next enqueued.
+func (st *State) ActionSchedules() ([]*ActionSchedule, error) {
+	schedules, closer := st.getCollection(actionSchedulesC)
+	defer closer()
+
+	var docs []actionScheduleDoc
+	if err := schedules.Find(nil).Sort("next").All(&docs); err != nil {
+		return nil, errors.Annotate(err, "cannot get action schedules")
+	}
+	result := make([]*ActionSchedule, len(docs))
+	for i, doc := range docs {
+		result[i] = &ActionSchedule{st: st, doc: doc}
+	}
+	return result, nil
+}
+
+// RemoveActionSchedule removes the action schedule with the given id.
+func (st *State) RemoveActionSchedule(id string) error {
+	ops := []txn.Op{{
+		C:      actionSchedulesC,
+		Id:     st.docID(id),
+		Assert: txn.DocExists,
+		Remove: true,
+	}}
+	err := st.runTransaction(ops)
+	if err == txn.ErrAborted {
+		return errors.NotFoundf("action schedule %q", id)
+	}
+	return errors.Annotatef(err, "cannot remove action schedule %q", id)
+}
+
+// WatchActionSchedules returns a NotifyWatcher that notifies when
+// action schedules are added, removed or fired.
+func (st *State) WatchActionSchedules() NotifyWatcher {
+	return newNotifyCollWatcher(st, actionSchedulesC, isLocalID(st))
+}
+
+// FireDueActionSchedules enqueues the actions of all schedules that
+// are due. Recurring schedules are advanced to their next occurrence,
+// skipping any that were missed, and schedules that are enqueued only
+// once are removed. It returns when the next schedule is due, or the
+// zero time if there are none.
+func (st *State) FireDueActionSchedules() (time.Time, error) {
+	schedules, err := st.ActionSchedules()
+	if err != nil {
+		return time.Time{}, errors.Trace(err)
+	}
+	now := st.clock.Now()
+	var next time.Time
+	for _, schedule := range schedules {
+		scheduleNext := schedule.Next()
+		if !scheduleNext.After(now) {
+			fired, err := schedule.fire(now)
+			if err != nil {
+				return time.Time{}, errors.Annotatef(err, "firing action schedule %q", schedule.Id())
+			}
+			if !fired {
+				continue
+			}
+			scheduleNext = schedule.Next()
+			if scheduleNext.IsZero() {
+				continue
+			}
+		}
+		if next.IsZero() || scheduleNext.Before(next) {
+			next = scheduleNext
+		}
+	}
+	return next, nil
+}
+
+// fire advances or removes the schedule, and then enqueues its action
+// on each of its receivers that its creator may still run actions on.
+// It returns false if the schedule was changed or removed concurrently,
+// in which case nothing is enqueued, so that each occurrence is
+// enqueued at most once.
+func (s *ActionSchedule) fire(now time.Time) (bool, error) {
+	op := txn.Op{
+		C:      actionSchedulesC,
+		Id:     s.doc.DocID,
+		Assert: bson.D{{"next", s.doc.Next}},
+	}
+	var next time.Time
+	if s.doc.Recurrence == "" {
+		op.Remove = true
+	} else {
+		schedule, err := cron.Parse(s.doc.Recurrence)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		next = schedule.Next(now)
+		op.Update = bson.D{{"$set", bson.D{
+			{"next", next},
+			{"last-fired", now},
+		}}}
+	}
+	if err := s.st.runTransaction([]txn.Op{op}); err == txn.ErrAborted {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	s.doc.Next = next
+	s.doc.LastFired = now
+
+	canRun := make(map[string]bool)
+	for _, unit := range s.units() {
+		appName := unit.ApplicationName()
+		allowed, ok := canRun[appName]
+		if !ok {
+			var err error
+			if allowed, err = s.creatorCanRunActions(appName); err != nil {
+				return true, errors.Trace(err)
+			}
+			canRun[appName] = allowed
+		}
+		if !allowed {
+			logger.Warningf("not enqueuing scheduled action %q on unit %s: %s may no longer run actions on it",
+				s.doc.Name, unit.Name(), names.ReadableString(s.CreatedBy()))
+			continue
+		}
+		if _, err := unit.AddAction(s.doc.Name, s.doc.Parameters); err != nil {
+			logger.Warningf("cannot enqueue scheduled action %q on unit %s: %v", s.doc.Name, unit.Name(), err)
+		}
+	}
+	if next.IsZero() && s.doc.Recurrence != "" {
+		// The recurrence never occurs again, so the schedule is of
+		// no further use.
+		if err := s.st.RemoveActionSchedule(s.doc.Id); err != nil && !errors.IsNotFound(err) {
+			return true, errors.Trace(err)
+		}
+	}
+	return true, nil
+}
+
+// creatorCanRunActions reports whether the user who added the schedule
+// may still run actions on the named application: either because they
+// are a controller superuser, have write access or the run-actions
+// capability on the model, or have been granted write access to the
+// application.
+func (s *ActionSchedule) creatorCanRunActions(appName string) (bool, error) {
+	user := s.CreatedBy()
+	access, err := s.st.UserAccess(user, s.st.controllerTag)
+	if err != nil && !errors.IsNotFound(err) {
+		return false, errors.Trace(err)
+	}
+	if err == nil && access.Access.EqualOrGreaterControllerAccessThan(permission.SuperuserAccess) {
+		return true, nil
+	}
+	access, err = s.st.UserAccess(user, s.st.ModelTag())
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	if access.Access.EqualOrGreaterModelAccessThan(permission.WriteAccess) ||
+		access.Access.EqualOrGreaterModelAccessThan(permission.RunActionsAccess) {
+		return true, nil
+	}
+	access, err = s.st.applicationUserAccess(user, appName)
+	if errors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(err)
+	}
+	return access.Access.EqualOrGreaterModelAccessThan(permission.WriteAccess), nil
+}
+
+// units returns the units the schedule's action is enqueued on,
+// expanding applications to their units. Receivers that no longer
+// exist are skipped.
+func (s *ActionSchedule) units() []*Unit {
+	var units []*Unit
+	for _, tag := range s.Receivers() {
+		var err error
+		switch tag := tag.(type) {
+		case names.UnitTag:
+			var unit *Unit
+			if unit, err = s.st.Unit(tag.Id()); err == nil {
+				units = append(units, unit)
+			}
+		case names.ApplicationTag:
+			var app *Application
+			if app, err = s.st.Application(tag.Id()); err == nil {
+				var appUnits []*Unit
+				if appUnits, err = app.AllUnits(); err == nil {
+					units = append(units, appUnits...)
+				}
+			}
+		}
+		if err != nil {
+			logger.Warningf("cannot enqueue scheduled action %q on %s: %v", s.doc.Name, names.ReadableString(tag), err)
+		}
+	}
+	return units
+}
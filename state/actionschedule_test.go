@@ -0,0 +1,219 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+	statetesting "github.com/juju/juju/state/testing"
+	"github.com/juju/juju/testing/factory"
+)
+
+type ActionScheduleSuite struct {
+	ConnSuite
+	clock       *jujutesting.Clock
+	application *state.Application
+	unit0       *state.Unit
+	unit1       *state.Unit
+}
+
+var _ = gc.Suite(&ActionScheduleSuite{})
+
+func (s *ActionScheduleSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.clock = jujutesting.NewClock(time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC))
+	err := s.State.SetClockForTesting(s.clock)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.application = s.Factory.MakeApplication(c, &factory.ApplicationParams{
+		Charm: s.AddTestingCharm(c, "dummy"),
+	})
+	s.unit0 = s.Factory.MakeUnit(c, &factory.UnitParams{Application: s.application})
+	s.unit1 = s.Factory.MakeUnit(c, &factory.UnitParams{Application: s.application})
+}
+
+func (s *ActionScheduleSuite) addSchedule(c *gc.C, args state.AddActionScheduleArgs) *state.ActionSchedule {
+	if args.Name == "" {
+		args.Name = "snapshot"
+	}
+	if args.Receivers == nil {
+		args.Receivers = []names.Tag{s.application.ApplicationTag()}
+	}
+	if args.CreatedBy.Id() == "" {
+		args.CreatedBy = s.Owner
+	}
+	schedule, err := s.State.AddActionSchedule(args)
+	c.Assert(err, jc.ErrorIsNil)
+	return schedule
+}
+
+func (s *ActionScheduleSuite) TestAddActionSchedule(c *gc.C) {
+	schedule := s.addSchedule(c, state.AddActionScheduleArgs{
+		Parameters: map[string]interface{}{"outfile": "nightly.bz2"},
+		Recurrence: "0 2 * * *",
+	})
+	c.Check(schedule.Name(), gc.Equals, "snapshot")
+	c.Check(schedule.Receivers(), jc.DeepEquals, []names.Tag{s.application.ApplicationTag()})
+	c.Check(schedule.Parameters(), jc.DeepEquals, map[string]interface{}{"outfile": "nightly.bz2"})
+	c.Check(schedule.Recurrence(), gc.Equals, "0 2 * * *")
+	c.Check(schedule.Next(), gc.Equals, time.Date(2017, 3, 2, 2, 0, 0, 0, time.UTC))
+	c.Check(schedule.LastFired().IsZero(), jc.IsTrue)
+	c.Check(schedule.CreatedBy(), gc.Equals, s.Owner)
+
+	schedules, err := s.State.ActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(schedules, gc.HasLen, 1)
+	c.Check(schedules[0].Id(), gc.Equals, schedule.Id())
+	c.Check(schedules[0].Next(), gc.Equals, schedule.Next())
+}
+
+func (s *ActionScheduleSuite) TestAddActionScheduleInvalid(c *gc.C) {
+	for i, test := range []struct {
+		args state.AddActionScheduleArgs
+		err  string
+	}{{
+		args: state.AddActionScheduleArgs{Receivers: []names.Tag{s.unit0.UnitTag()}},
+		err:  "action name required",
+	}, {
+		args: state.AddActionScheduleArgs{Name: "snapshot"},
+		err:  "no receivers specified",
+	}, {
+		args: state.AddActionScheduleArgs{Name: "snapshot", Receivers: []names.Tag{s.unit0.UnitTag()}},
+		err:  "start time or recurrence required",
+	}, {
+		args: state.AddActionScheduleArgs{
+			Name: "snapshot", Receivers: []names.Tag{s.unit0.UnitTag()}, Recurrence: "@never",
+		},
+		err: `recurrence "@never" not valid`,
+	}, {
+		args: state.AddActionScheduleArgs{
+			Name: "snapshot", Receivers: []names.Tag{names.NewMachineTag("0")}, Recurrence: "@daily",
+		},
+		err: `action receiver "machine-0" not valid`,
+	}, {
+		args: state.AddActionScheduleArgs{
+			Name: "snapshot", Receivers: []names.Tag{names.NewUnitTag("foo/0")}, Recurrence: "@daily",
+		},
+		err: `unit foo/0 not found`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := s.State.AddActionSchedule(test.args)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *ActionScheduleSuite) TestRemoveActionSchedule(c *gc.C) {
+	schedule := s.addSchedule(c, state.AddActionScheduleArgs{Recurrence: "@daily"})
+
+	err := s.State.RemoveActionSchedule(schedule.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.ActionSchedule(schedule.Id())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = s.State.RemoveActionSchedule(schedule.Id())
+	c.Assert(err, gc.ErrorMatches, `action schedule ".*" not found`)
+}
+
+func (s *ActionScheduleSuite) TestFireOnce(c *gc.C) {
+	s.addSchedule(c, state.AddActionScheduleArgs{
+		Receivers: []names.Tag{s.unit0.UnitTag()},
+		Start:     s.clock.Now().Add(time.Hour),
+	})
+
+	next, err := s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(next, gc.Equals, s.clock.Now().Add(time.Hour))
+	s.assertActions(c, s.unit0, 0)
+
+	s.clock.Advance(time.Hour)
+	next, err = s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(next.IsZero(), jc.IsTrue)
+	s.assertActions(c, s.unit0, 1)
+	s.assertActions(c, s.unit1, 0)
+
+	schedules, err := s.State.ActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(schedules, gc.HasLen, 0)
+}
+
+func (s *ActionScheduleSuite) TestFireRecurring(c *gc.C) {
+	schedule := s.addSchedule(c, state.AddActionScheduleArgs{Recurrence: "0 2 * * *"})
+
+	// Missed occurrences are skipped.
+	s.clock.Advance(48 * time.Hour)
+	next, err := s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(next, gc.Equals, time.Date(2017, 3, 4, 2, 0, 0, 0, time.UTC))
+	s.assertActions(c, s.unit0, 1)
+	s.assertActions(c, s.unit1, 1)
+
+	schedule, err = s.State.ActionSchedule(schedule.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(schedule.Next(), gc.Equals, next)
+	c.Check(schedule.LastFired(), gc.Equals, s.clock.Now())
+
+	// Firing again before the next occurrence does nothing.
+	_, err = s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertActions(c, s.unit0, 1)
+}
+
+func (s *ActionScheduleSuite) TestFireChecksCreatorAccess(c *gc.C) {
+	user := s.Factory.MakeModelUser(c, &factory.ModelUserParams{
+		User:   "bob",
+		Access: permission.ReadAccess,
+	}).UserTag
+	_, err := s.State.SetUserAccess(user, s.application.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	s.addSchedule(c, state.AddActionScheduleArgs{
+		Recurrence: "@daily",
+		CreatedBy:  user,
+	})
+
+	s.clock.Advance(24 * time.Hour)
+	_, err = s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertActions(c, s.unit0, 1)
+
+	// Once the creator's access is revoked, nothing more is enqueued.
+	err = s.State.RemoveUserAccess(user, s.application.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	s.clock.Advance(24 * time.Hour)
+	_, err = s.State.FireDueActionSchedules()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertActions(c, s.unit0, 1)
+	s.assertActions(c, s.unit1, 1)
+}
+
+func (s *ActionScheduleSuite) TestWatchActionSchedules(c *gc.C) {
+	w := s.State.WatchActionSchedules()
+	defer statetesting.AssertStop(c, w)
+	wc := statetesting.NewNotifyWatcherC(c, s.State, w)
+	wc.AssertOneChange()
+
+	schedule := s.addSchedule(c, state.AddActionScheduleArgs{Recurrence: "@daily"})
+	wc.AssertOneChange()
+
+	err := s.State.RemoveActionSchedule(schedule.Id())
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertOneChange()
+}
+
+func (s *ActionScheduleSuite) assertActions(c *gc.C, unit *state.Unit, count int) {
+	actions, err := unit.Actions()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(actions, gc.HasLen, count)
+	for _, action := range actions {
+		c.Check(action.Name(), gc.Equals, "snapshot")
+	}
+}
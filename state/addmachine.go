@@ -601,8 +601,57 @@ func (st *State) machineStorageOps(
 		})
 	}
 
-	// TODO(axw) handle args.filesystemAttachments, args.volumeAttachments
-	// when we handle attaching to existing (e.g. shared) storage.
+	// Create attachments to existing volumes, such as those of storage
+	// instances that have been detached from another unit.
+	for tag, attachment := range args.volumeAttachments {
+		volumeOps = append(volumeOps, txn.Op{
+			C:      volumesC,
+			Id:     tag.Id(),
+			Assert: isAliveDoc,
+			Update: bson.D{{"$inc", bson.D{{"attachmentcount", 1}}}},
+		})
+		volumeAttachments = append(volumeAttachments, volumeAttachmentTemplate{
+			tag, attachment,
+		})
+	}
+
+	// Create attachments to existing filesystems, such as those of storage
+	// instances that have been detached from another unit. The volume
+	// backing such a filesystem is attached to the machine as well.
+	for tag, attachment := range args.filesystemAttachments {
+		f, err := st.filesystemByTag(tag)
+		if err != nil {
+			return nil, nil, nil, errors.Trace(err)
+		}
+		var storageTag names.StorageTag
+		if f.doc.StorageId != "" {
+			storageTag = names.NewStorageTag(f.doc.StorageId)
+		}
+		filesystemOps = append(filesystemOps, txn.Op{
+			C:      filesystemsC,
+			Id:     tag.Id(),
+			Assert: isAliveDoc,
+			Update: bson.D{{"$inc", bson.D{{"attachmentcount", 1}}}},
+		})
+		fsAttachments = append(fsAttachments, filesystemAttachmentTemplate{
+			tag, storageTag, attachment,
+		})
+		volumeTag, err := f.Volume()
+		if errors.Cause(err) == ErrNoBackingVolume {
+			continue
+		} else if err != nil {
+			return nil, nil, nil, errors.Trace(err)
+		}
+		volumeOps = append(volumeOps, txn.Op{
+			C:      volumesC,
+			Id:     volumeTag.Id(),
+			Assert: isAliveDoc,
+			Update: bson.D{{"$inc", bson.D{{"attachmentcount", 1}}}},
+		})
+		volumeAttachments = append(volumeAttachments, volumeAttachmentTemplate{
+			volumeTag, VolumeAttachmentParams{},
+		})
+	}
 
 	ops := make([]txn.Op, 0, len(filesystemOps)+len(volumeOps)+len(fsAttachments)+len(volumeAttachments))
 	if len(fsAttachments) > 0 {
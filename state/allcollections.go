@@ -334,10 +334,17 @@ func allCollections() collectionSchema {
 		actionsC: {
 			indexes: []mgo.Index{{
 				Key: []string{"model-uuid", "name"},
+			}, {
+				// Used by the action pruner.
+				Key: []string{"model-uuid", "status", "completed"},
 			}},
 		},
 		actionNotificationsC: {},
 
+		// This collection holds actions scheduled to be enqueued at a
+		// future time, once or on a recurring schedule.
+		actionSchedulesC: {},
+
 		// -----
 
 		// This collection holds information associated with charm payloads.
@@ -396,6 +403,11 @@ func allCollections() collectionSchema {
 		auditingC: {
 			global:    true,
 			rawAccess: true,
+			indexes: []mgo.Index{{
+				Key: []string{"model-uuid", "time"},
+			}, {
+				Key: []string{"time"},
+			}},
 		},
 	}
 	if featureflag.Enabled(feature.CrossModelRelations) {
@@ -438,6 +450,7 @@ func allCollections() collectionSchema {
 const (
 	actionNotificationsC     = "actionnotifications"
 	actionresultsC           = "actionresults"
+	actionSchedulesC         = "actionschedules"
 	actionsC                 = "actions"
 	annotationsC             = "annotations"
 	autocertCacheC           = "autocertCache"
@@ -14,6 +14,7 @@ import (
 	jujutxn "github.com/juju/txn"
 	"github.com/juju/utils/featureflag"
 	"github.com/juju/utils/series"
+	"github.com/juju/utils/set"
 	"gopkg.in/juju/charm.v6-unstable"
 	csparams "gopkg.in/juju/charmrepo.v2-unstable/csclient/params"
 	"gopkg.in/juju/names.v2"
@@ -24,6 +25,7 @@ import (
 	"github.com/juju/juju/constraints"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/feature"
+	"github.com/juju/juju/network"
 	"github.com/juju/juju/status"
 )
 
@@ -49,6 +51,7 @@ type applicationDoc struct {
 	UnitCount            int        `bson:"unitcount"`
 	RelationCount        int        `bson:"relationcount"`
 	Exposed              bool       `bson:"exposed"`
+	ExposedCIDRs         []string   `bson:"exposed-cidrs,omitempty"`
 	MinUnits             int        `bson:"minunits"`
 	TxnRevno             int64      `bson:"txn-revno"`
 	MetricCredentials    []byte     `bson:"metric-credentials"`
@@ -292,6 +295,12 @@ func (a *Application) removeOps(asserts bson.D) ([]txn.Op, error) {
 	ops = append(ops, charmOps...)
 	ops = append(ops, finalAppCharmRemoveOps(name, curl)...)
 
+	permissionOps, err := removeApplicationPermissionsOps(a.st, name)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ops = append(ops, permissionOps...)
+
 	globalKey := a.globalKey()
 	ops = append(ops,
 		removeEndpointBindingsOp(globalKey),
@@ -311,29 +320,71 @@ func (a *Application) IsExposed() bool {
 	return a.doc.Exposed
 }
 
-// SetExposed marks the application as exposed.
+// ExposedCIDRs returns the source CIDRs from which the opened ports of
+// an exposed application may be accessed. If no CIDRs are returned,
+// the ports may be accessed from anywhere. See SetExposedCIDRs.
+func (a *Application) ExposedCIDRs() []string {
+	if len(a.doc.ExposedCIDRs) == 0 {
+		return nil
+	}
+	cidrs := make([]string, len(a.doc.ExposedCIDRs))
+	copy(cidrs, a.doc.ExposedCIDRs)
+	return cidrs
+}
+
+// SetExposed marks the application as exposed, allowing its opened
+// ports to be accessed from anywhere.
 // See ClearExposed and IsExposed.
 func (a *Application) SetExposed() error {
-	return a.setExposed(true)
+	return a.setExposed(true, nil)
 }
 
-// ClearExposed removes the exposed flag from the application.
+// SetExposedCIDRs marks the application as exposed, allowing its
+// opened ports to be accessed only from the given source CIDRs. If
+// no CIDRs are specified, the ports may be accessed from anywhere.
+// See ClearExposed and ExposedCIDRs.
+func (a *Application) SetExposedCIDRs(cidrs []string) error {
+	if err := network.ValidateSourceCIDRs(cidrs); err != nil {
+		return errors.Annotatef(err, "cannot set exposed flag for application %q", a)
+	}
+	var sourceCIDRs []string
+	if len(cidrs) > 0 {
+		sourceCIDRs = set.NewStrings(cidrs...).SortedValues()
+	}
+	return a.setExposed(true, sourceCIDRs)
+}
+
+// ClearExposed removes the exposed flag, and any source CIDRs, from
+// the application.
 // See SetExposed and IsExposed.
 func (a *Application) ClearExposed() error {
-	return a.setExposed(false)
+	return a.setExposed(false, nil)
 }
 
-func (a *Application) setExposed(exposed bool) (err error) {
+func (a *Application) setExposed(exposed bool, cidrs []string) (err error) {
+	var update bson.D
+	if len(cidrs) > 0 {
+		update = bson.D{{"$set", bson.D{
+			{"exposed", exposed},
+			{"exposed-cidrs", cidrs},
+		}}}
+	} else {
+		update = bson.D{
+			{"$set", bson.D{{"exposed", exposed}}},
+			{"$unset", bson.D{{"exposed-cidrs", nil}}},
+		}
+	}
 	ops := []txn.Op{{
 		C:      applicationsC,
 		Id:     a.doc.DocID,
 		Assert: isAliveDoc,
-		Update: bson.D{{"$set", bson.D{{"exposed", exposed}}}},
+		Update: update,
 	}}
 	if err := a.st.runTransaction(ops); err != nil {
 		return errors.Errorf("cannot set exposed flag for application %q to %v: %v", a, exposed, onAbort(err, errNotAlive))
 	}
 	a.doc.Exposed = exposed
+	a.doc.ExposedCIDRs = cidrs
 	return nil
 }
 
@@ -1454,6 +1454,46 @@ func (s *ApplicationSuite) TestServiceExposed(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, notAliveErr)
 }
 
+func (s *ApplicationSuite) TestServiceExposedCIDRs(c *gc.C) {
+	c.Assert(s.mysql.ExposedCIDRs(), gc.HasLen, 0)
+
+	err := s.mysql.SetExposedCIDRs([]string{"192.168.1.0/24", "10.0.0.0/8", "10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.IsExposed(), jc.IsTrue)
+	c.Assert(s.mysql.ExposedCIDRs(), jc.DeepEquals, []string{"10.0.0.0/8", "192.168.1.0/24"})
+
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.IsExposed(), jc.IsTrue)
+	c.Assert(s.mysql.ExposedCIDRs(), jc.DeepEquals, []string{"10.0.0.0/8", "192.168.1.0/24"})
+
+	// Exposing without CIDRs allows access from anywhere.
+	err = s.mysql.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.IsExposed(), jc.IsTrue)
+	c.Assert(s.mysql.ExposedCIDRs(), gc.HasLen, 0)
+
+	// Unexposing clears the CIDRs.
+	err = s.mysql.SetExposedCIDRs([]string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.IsExposed(), jc.IsFalse)
+	c.Assert(s.mysql.ExposedCIDRs(), gc.HasLen, 0)
+}
+
+func (s *ApplicationSuite) TestServiceExposedCIDRsInvalid(c *gc.C) {
+	err := s.mysql.SetExposedCIDRs([]string{"10.0.0.0/8", "10.0.0.1"})
+	c.Assert(err, gc.ErrorMatches, `cannot set exposed flag for application "mysql": source CIDR "10.0.0.1" not valid`)
+	err = s.mysql.Refresh()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.mysql.IsExposed(), jc.IsFalse)
+}
+
 func (s *ApplicationSuite) TestAddUnit(c *gc.C) {
 	// Check that principal units can be added on their own.
 	unitZero, err := s.mysql.AddUnit()
@@ -0,0 +1,125 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+	"gopkg.in/mgo.v2/bson"
+	"gopkg.in/mgo.v2/txn"
+
+	"github.com/juju/juju/permission"
+)
+
+// applicationKey returns the key of the application with the given
+// name in the model with the given UUID, as used for the permissions
+// granted on it.
+func applicationKey(modelUUID, appName string) string {
+	return fmt.Sprintf("%s#%s", modelKey(modelUUID), applicationGlobalKey(appName))
+}
+
+// applicationUserAccess returns the access the user has been granted
+// on the application. Access to an application is granted in addition
+// to access to its model, so the user must also be a model user.
+func (st *State) applicationUserAccess(user names.UserTag, appName string) (permission.UserAccess, error) {
+	userDoc, err := st.modelUser(st.ModelUUID(), user)
+	if err != nil {
+		return permission.UserAccess{}, errors.Trace(err)
+	}
+	perm, err := st.userPermission(applicationKey(st.ModelUUID(), appName), userGlobalKey(userAccessID(user)))
+	if err != nil {
+		return permission.UserAccess{}, errors.Annotate(err, "obtaining application permission")
+	}
+	return newUserAccess(perm, userDoc, names.NewApplicationTag(appName)), nil
+}
+
+// setApplicationAccess grants the user the given access to the
+// application, replacing any access previously granted on it.
+func (st *State) setApplicationAccess(access permission.Access, user names.UserTag, appName string) error {
+	if err := permission.ValidateApplicationAccess(access); err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := st.modelUser(st.ModelUUID(), user); err != nil {
+		return errors.Trace(err)
+	}
+	objectKey := applicationKey(st.ModelUUID(), appName)
+	subjectKey := userGlobalKey(userAccessID(user))
+	buildTxn := func(int) ([]txn.Op, error) {
+		app, err := st.Application(appName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if app.Life() != Alive {
+			return nil, errors.Errorf("application %q is not alive", appName)
+		}
+		ops := []txn.Op{{
+			C:      applicationsC,
+			Id:     app.doc.DocID,
+			Assert: isAliveDoc,
+		}}
+		_, err = st.userPermission(objectKey, subjectKey)
+		switch {
+		case err == nil:
+			ops = append(ops, updatePermissionOp(objectKey, subjectKey, access))
+		case errors.IsNotFound(err):
+			ops = append(ops, createPermissionOp(objectKey, subjectKey, access))
+		default:
+			return nil, errors.Trace(err)
+		}
+		return ops, nil
+	}
+	return errors.Trace(st.run(buildTxn))
+}
+
+// removeApplicationUser removes the access granted to the user on
+// the application.
+func (st *State) removeApplicationUser(user names.UserTag, appName string) error {
+	op := removePermissionOp(applicationKey(st.ModelUUID(), appName), userGlobalKey(userAccessID(user)))
+	err := st.runTransaction([]txn.Op{op})
+	if err == txn.ErrAborted {
+		err = errors.NotFoundf("application user %q", user.Id())
+	}
+	return errors.Trace(err)
+}
+
+// removeApplicationPermissionsOps returns the operations that remove
+// the access granted to any user on the application.
+func removeApplicationPermissionsOps(st *State, appName string) ([]txn.Op, error) {
+	return removePermissionsOps(st, bson.D{
+		{"object-global-key", applicationKey(st.ModelUUID(), appName)},
+	})
+}
+
+// removeUserApplicationPermissionsOps returns the operations that
+// remove the access granted to the user on any application in the
+// model.
+func removeUserApplicationPermissionsOps(st *State, user names.UserTag) ([]txn.Op, error) {
+	prefix := applicationKey(st.ModelUUID(), "")
+	return removePermissionsOps(st, bson.D{
+		{"object-global-key", bson.RegEx{Pattern: "^" + regexp.QuoteMeta(prefix)}},
+		{"subject-global-key", userGlobalKey(userAccessID(user))},
+	})
+}
+
+func removePermissionsOps(st *State, query bson.D) ([]txn.Op, error) {
+	permissions, closer := st.getCollection(permissionsC)
+	defer closer()
+
+	var docs []permissionDoc
+	if err := permissions.Find(query).Select(bson.D{{"_id", 1}}).All(&docs); err != nil {
+		return nil, errors.Trace(err)
+	}
+	ops := make([]txn.Op, len(docs))
+	for i, doc := range docs {
+		ops[i] = txn.Op{
+			C:      permissionsC,
+			Id:     doc.ID,
+			Remove: true,
+		}
+	}
+	return ops, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/permission"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/testing/factory"
+)
+
+type ApplicationUserSuite struct {
+	ConnSuite
+	app  *state.Application
+	user names.UserTag
+}
+
+var _ = gc.Suite(&ApplicationUserSuite{})
+
+func (s *ApplicationUserSuite) SetUpTest(c *gc.C) {
+	s.ConnSuite.SetUpTest(c)
+	s.app = s.Factory.MakeApplication(c, &factory.ApplicationParams{Name: "mysql"})
+	s.user = s.Factory.MakeModelUser(c, &factory.ModelUserParams{
+		User:   "bob",
+		Access: permission.ReadAccess,
+	}).UserTag
+}
+
+func (s *ApplicationUserSuite) TestSetApplicationAccess(c *gc.C) {
+	appUser, err := s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(appUser.UserTag, gc.Equals, s.user)
+	c.Check(appUser.Object, gc.Equals, s.app.ApplicationTag())
+	c.Check(appUser.Access, gc.Equals, permission.WriteAccess)
+
+	appUser, err = s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.AdminAccess)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(appUser.Access, gc.Equals, permission.AdminAccess)
+
+	// The model access is unchanged.
+	modelUser, err := s.State.UserAccess(s.user, s.State.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(modelUser.Access, gc.Equals, permission.ReadAccess)
+}
+
+func (s *ApplicationUserSuite) TestSetApplicationAccessInvalid(c *gc.C) {
+	_, err := s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.ReadAccess)
+	c.Assert(err, gc.ErrorMatches, `"read" application access not valid`)
+}
+
+func (s *ApplicationUserSuite) TestSetApplicationAccessNotModelUser(c *gc.C) {
+	user := s.Factory.MakeUser(c, &factory.UserParams{Name: "eve", NoModelUser: true})
+	_, err := s.State.SetUserAccess(user.UserTag(), s.app.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ApplicationUserSuite) TestSetApplicationAccessMissingApplication(c *gc.C) {
+	_, err := s.State.SetUserAccess(s.user, names.NewApplicationTag("nope"), permission.WriteAccess)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ApplicationUserSuite) TestRemoveApplicationAccess(c *gc.C) {
+	_, err := s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.RemoveUserAccess(s.user, s.app.ApplicationTag())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.UserAccess(s.user, s.app.ApplicationTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	err = s.State.RemoveUserAccess(s.user, s.app.ApplicationTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ApplicationUserSuite) TestRemoveModelUserRemovesApplicationAccess(c *gc.C) {
+	_, err := s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.RemoveUserAccess(s.user, s.State.ModelTag())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.State.AddModelUser(s.State.ModelUUID(), state.UserAccessSpec{
+		User:      s.user,
+		CreatedBy: s.Owner,
+		Access:    permission.ReadAccess,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.State.UserAccess(s.user, s.app.ApplicationTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *ApplicationUserSuite) TestDestroyApplicationRemovesAccess(c *gc.C) {
+	_, err := s.State.SetUserAccess(s.user, s.app.ApplicationTag(), permission.WriteAccess)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.app.Destroy()
+	c.Assert(err, jc.ErrorIsNil)
+	s.Factory.MakeApplication(c, &factory.ApplicationParams{Name: "mysql"})
+
+	_, err = s.State.UserAccess(s.user, s.app.ApplicationTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
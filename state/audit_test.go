@@ -0,0 +1,76 @@
+// Copyright 2016 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state_test
+
+import (
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/audit"
+)
+
+type AuditSuite struct {
+	ConnSuite
+}
+
+var _ = gc.Suite(&AuditSuite{})
+
+func (s *AuditSuite) putEntry(c *gc.C, user, operation string, t time.Time) audit.AuditEntry {
+	entry := audit.AuditEntry{
+		JujuServerVersion: version.MustParse("2.1.0"),
+		ModelUUID:         s.State.ModelUUID(),
+		Timestamp:         t.UTC(),
+		RemoteAddress:     "10.0.0.1",
+		OriginType:        "API request",
+		OriginName:        user,
+		Operation:         operation,
+	}
+	err := s.State.PutAuditEntryFn()(entry)
+	c.Assert(err, jc.ErrorIsNil)
+	return entry
+}
+
+func (s *AuditSuite) TestAuditEntriesFilters(c *gc.C) {
+	t0 := time.Date(2016, time.June, 1, 10, 0, 0, 0, time.UTC)
+	e0 := s.putEntry(c, "user-bob", "Application:v3 - Deploy", t0)
+	e1 := s.putEntry(c, "user-mary", "Application:v3 - Deploy", t0.Add(time.Minute))
+	e2 := s.putEntry(c, "user-bob", "Application:v3 - Destroy", t0.Add(2*time.Minute))
+
+	for i, test := range []struct {
+		filter   audit.Filter
+		expected []audit.AuditEntry
+	}{{
+		filter:   audit.Filter{},
+		expected: []audit.AuditEntry{e0, e1, e2},
+	}, {
+		filter:   audit.Filter{ModelUUID: s.State.ModelUUID()},
+		expected: []audit.AuditEntry{e0, e1, e2},
+	}, {
+		filter:   audit.Filter{ModelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d"},
+		expected: []audit.AuditEntry{},
+	}, {
+		filter:   audit.Filter{OriginName: "user-bob"},
+		expected: []audit.AuditEntry{e0, e2},
+	}, {
+		filter:   audit.Filter{Operation: "Application:v3 - Deploy"},
+		expected: []audit.AuditEntry{e0, e1},
+	}, {
+		filter:   audit.Filter{After: t0.Add(time.Minute)},
+		expected: []audit.AuditEntry{e1, e2},
+	}, {
+		filter:   audit.Filter{Before: t0.Add(time.Minute)},
+		expected: []audit.AuditEntry{e0},
+	}, {
+		filter:   audit.Filter{Limit: 2},
+		expected: []audit.AuditEntry{e0, e1},
+	}} {
+		c.Logf("test %d: %+v", i, test.filter)
+		entries, err := s.State.AuditEntries(test.filter)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(entries, jc.DeepEquals, test.expected)
+	}
+}
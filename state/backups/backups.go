@@ -84,8 +84,9 @@ func StoreArchive(stor filestorage.FileStorage, meta *Metadata, file io.Reader)
 // Backups is an abstraction around all juju backup-related functionality.
 type Backups interface {
 	// Create creates and stores a new juju backup archive. It updates
-	// the provided metadata.
-	Create(meta *Metadata, paths *Paths, dbInfo *DBInfo) error
+	// the provided metadata. If encryptionKey is not nil, the archive
+	// is encrypted with it.
+	Create(meta *Metadata, paths *Paths, dbInfo *DBInfo, encryptionKey []byte) error
 
 	// Add stores the backup archive and returns its new ID.
 	Add(archive io.Reader, meta *Metadata) (string, error)
@@ -119,10 +120,19 @@ func NewBackups(stor filestorage.FileStorage) Backups {
 
 // Create creates and stores a new juju backup archive and updates the
 // provided metadata.
-func (b *backups) Create(meta *Metadata, paths *Paths, dbInfo *DBInfo) error {
+func (b *backups) Create(meta *Metadata, paths *Paths, dbInfo *DBInfo, encryptionKey []byte) error {
 	// TODO(fwereade): 2016-03-17 lp:1558657
 	meta.Started = time.Now().UTC()
 
+	// The fingerprint of the key is also recorded in the metadata file,
+	// so that it is available when restoring from the archive alone.
+	if encryptionKey != nil {
+		if err := ValidateEncryptionKey(encryptionKey); err != nil {
+			return errors.Trace(err)
+		}
+		meta.KeyFingerprint = KeyFingerprint(encryptionKey)
+	}
+
 	// The metadata file will not contain the ID or the "finished" data.
 	// However, that information is not as critical. The alternatives
 	// are either adding the metadata file to the archive after the fact
@@ -149,6 +159,16 @@ func (b *backups) Create(meta *Metadata, paths *Paths, dbInfo *DBInfo) error {
 	}
 	defer result.archiveFile.Close()
 
+	// Encrypt the archive. The metadata then describes the encrypted
+	// archive, since that is what is stored.
+	if encryptionKey != nil {
+		result, err = encryptArchive(result, encryptionKey)
+		if err != nil {
+			return errors.Annotate(err, "while encrypting backup archive")
+		}
+		defer result.archiveFile.Close()
+	}
+
 	// Finalize the metadata.
 	err = finishMeta(meta, result)
 	if err != nil {
@@ -6,6 +6,7 @@
 package backups
 
 import (
+	"io"
 	"net"
 	"strconv"
 
@@ -75,7 +76,19 @@ func (b *backups) Restore(backupId string, dbInfo *DBInfo, args RestoreArgs) (na
 
 	defer backupReader.Close()
 
-	workspace, err := NewArchiveWorkspaceReader(backupReader)
+	// Refuse a missing or wrong key before anything is changed.
+	if err := CheckEncryptionKey(meta, args.EncryptionKey); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var archive io.Reader = backupReader
+	if meta.KeyFingerprint != "" {
+		archive, err = NewDecryptingReader(backupReader, args.EncryptionKey)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	workspace, err := NewArchiveWorkspaceReader(archive)
 	if err != nil {
 		return nil, errors.Annotate(err, "cannot unpack backup file")
 	}
@@ -5,11 +5,13 @@ package backups_test
 
 import (
 	"bytes"
+	"io"
 	"io/ioutil"
 	"time" // Only used for time types.
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/filestorage"
 	"github.com/juju/utils/set"
 	gc "gopkg.in/check.v1"
 
@@ -58,7 +60,7 @@ func (s *backupsSuite) checkFailure(c *gc.C, expected string) {
 	dbInfo := backups.DBInfo{"a", "b", "c", targets, mongo.Mongo32wt}
 	meta := backupstesting.NewMetadataStarted()
 	meta.Notes = "some notes"
-	err := s.api.Create(meta, &paths, &dbInfo)
+	err := s.api.Create(meta, &paths, &dbInfo, nil)
 
 	c.Check(err, gc.ErrorMatches, expected)
 }
@@ -98,7 +100,7 @@ func (s *backupsSuite) TestCreateOkay(c *gc.C) {
 	meta := backupstesting.NewMetadataStarted()
 	backupstesting.SetOrigin(meta, "<model ID>", "<machine ID>", "<hostname>")
 	meta.Notes = "some notes"
-	err := s.api.Create(meta, &paths, &dbInfo)
+	err := s.api.Create(meta, &paths, &dbInfo, nil)
 
 	// Test the call values.
 	s.Storage.CheckCalled(c, "spam", meta, archiveFile, "Add", "Metadata")
@@ -134,6 +136,55 @@ func (s *backupsSuite) TestCreateOkay(c *gc.C) {
 	c.Check(string(data), gc.Equals, "<compressed tarball>")
 }
 
+func (s *backupsSuite) TestCreateEncrypted(c *gc.C) {
+	archiveFile := ioutil.NopCloser(bytes.NewBufferString("<compressed tarball>"))
+	result := backups.NewTestCreateResult(archiveFile, 10, "<checksum>")
+	received, testCreate := backups.NewTestCreate(result)
+	s.PatchValue(backups.RunCreate, testCreate)
+	s.PatchValue(backups.TestGetFilesToBackUp, func(root string, paths *backups.Paths, oldmachine string) ([]string, error) {
+		return []string{"<some file>"}, nil
+	})
+	s.PatchValue(backups.GetDBDumper, func(info *backups.DBInfo) (backups.DBDumper, error) {
+		return nil, nil
+	})
+	var stored []byte
+	s.PatchValue(backups.StoreArchiveRef, func(_ filestorage.FileStorage, meta *backups.Metadata, file io.Reader) error {
+		var err error
+		stored, err = ioutil.ReadAll(file)
+		return err
+	})
+
+	paths := backups.Paths{DataDir: "/var/lib/juju"}
+	dbInfo := backups.DBInfo{"a", "b", "c", set.NewStrings("juju"), mongo.Mongo32wt}
+	meta := backupstesting.NewMetadataStarted()
+	key := bytes.Repeat([]byte{0x42}, backups.EncryptionKeySize)
+	err := s.api.Create(meta, &paths, &dbInfo, key)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The fingerprint is recorded both in the metadata and in the
+	// metadata file in the archive.
+	c.Check(meta.KeyFingerprint, gc.Equals, backups.KeyFingerprint(key))
+	metadataFile, err := backups.NewMetadataJSONReader(backups.ExposeCreateMetadataReader(received))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(metadataFile.KeyFingerprint, gc.Equals, meta.KeyFingerprint)
+
+	// The metadata describes the encrypted archive that was stored.
+	c.Check(meta.Size(), gc.Equals, int64(len(stored)))
+	c.Check(meta.Checksum(), gc.Not(gc.Equals), "<checksum>")
+	r, err := backups.NewDecryptingReader(bytes.NewReader(stored), key)
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := ioutil.ReadAll(r)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "<compressed tarball>")
+}
+
+func (s *backupsSuite) TestCreateInvalidKey(c *gc.C) {
+	paths := backups.Paths{DataDir: "/var/lib/juju"}
+	meta := backupstesting.NewMetadataStarted()
+	err := s.api.Create(meta, &paths, nil, []byte("short"))
+	c.Check(err, gc.ErrorMatches, `backup encryption key of 5 bytes \(expected 32\) not valid`)
+}
+
 func (s *backupsSuite) TestCreateFailToListFiles(c *gc.C) {
 	s.PatchValue(backups.TestGetFilesToBackUp, func(root string, paths *backups.Paths, oldmachine string) ([]string, error) {
 		return nil, errors.New("failed!")
@@ -84,6 +84,50 @@ func create(args *createArgs) (_ *createResult, err error) {
 	return result, nil
 }
 
+// encryptArchive returns a new result holding the archive from the
+// given result, encrypted with the key. As with the original archive,
+// the encrypted archive file has already been removed by the time it
+// is returned, so the caller must close it.
+func encryptArchive(result *createResult, key []byte) (_ *createResult, err error) {
+	file, err := ioutil.TempFile("", tempPrefix)
+	if err != nil {
+		return nil, errors.Annotate(err, "while creating encrypted archive file")
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+		}
+	}()
+	if err := os.Remove(file.Name()); err != nil {
+		return nil, errors.Annotate(err, "while removing encrypted archive file")
+	}
+
+	hasher := hash.NewHashingWriter(file, sha1.New())
+	encrypter, err := NewEncryptingWriter(hasher, key)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, err := io.Copy(encrypter, result.archiveFile); err != nil {
+		return nil, errors.Annotate(err, "while encrypting archive file")
+	}
+	if err := encrypter.Close(); err != nil {
+		return nil, errors.Annotate(err, "while encrypting archive file")
+	}
+
+	size, err := file.Seek(0, os.SEEK_CUR)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if _, err := file.Seek(0, os.SEEK_SET); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &createResult{
+		archiveFile: file,
+		size:        size,
+		checksum:    hasher.Base64Sum(),
+	}, nil
+}
+
 // builder exposes the machinery for creating a backup of juju's state.
 type builder struct {
 	// rootDir is the root of the archive workspace.
@@ -0,0 +1,279 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// EncryptionKeySize is the size in bytes of a backup encryption key.
+const EncryptionKeySize = 32
+
+// An encrypted archive starts with a header holding encryptedMagic,
+// the fingerprint of the key and a random salt. The rest of the
+// archive is a sequence of chunks, each holding up to
+// encryptedChunkSize bytes of the original archive sealed with
+// AES-256-GCM, using a key derived from the encryption key and the
+// salt. The last chunk is always shorter than encryptedChunkSize and
+// is sealed as such, so that a truncated archive is detected.
+const (
+	encryptedMagic     = "JUJUBKE1"
+	encryptedChunkSize = 64 * 1024
+	fingerprintSize    = sha256.Size
+	saltSize           = 32
+	headerSize         = len(encryptedMagic) + fingerprintSize + saltSize
+)
+
+// ReadEncryptionKey reads a backup encryption key from the given
+// file, which must hold EncryptionKeySize bytes encoded as hex, as
+// generated by "openssl rand -hex 32".
+func ReadEncryptionKey(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot read backup encryption key")
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != EncryptionKeySize {
+		return nil, errors.NotValidf("backup encryption key in %q (expected %d hex-encoded bytes)", path, EncryptionKeySize)
+	}
+	return key, nil
+}
+
+// ValidateEncryptionKey returns an error if the key cannot be used to
+// encrypt backup archives.
+func ValidateEncryptionKey(key []byte) error {
+	if len(key) != EncryptionKeySize {
+		return errors.NotValidf("backup encryption key of %d bytes (expected %d)", len(key), EncryptionKeySize)
+	}
+	return nil
+}
+
+// KeyFingerprint returns the fingerprint of the encryption key, which
+// is recorded in the metadata of the backups encrypted with it.
+func KeyFingerprint(key []byte) string {
+	return hex.EncodeToString(fingerprint(key))
+}
+
+func fingerprint(key []byte) []byte {
+	sum := sha256.Sum256(key)
+	return sum[:]
+}
+
+// CheckEncryptionKey returns an error if the key cannot decrypt the
+// archive of the backup with the given metadata.
+func CheckEncryptionKey(meta *Metadata, key []byte) error {
+	switch {
+	case meta.KeyFingerprint == "":
+		return nil
+	case len(key) == 0:
+		return errors.Errorf("backup %q is encrypted; an encryption key is required", meta.ID())
+	case KeyFingerprint(key) != meta.KeyFingerprint:
+		return errors.Errorf("backup %q was encrypted with key %s, not %s",
+			meta.ID(), meta.KeyFingerprint, KeyFingerprint(key))
+	}
+	return nil
+}
+
+// IsEncryptedArchive reports whether the archive read from r was
+// encrypted. It consumes the start of the archive.
+func IsEncryptedArchive(r io.Reader) (bool, error) {
+	magic := make([]byte, len(encryptedMagic))
+	if _, err := io.ReadFull(r, magic); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return false, nil
+		}
+		return false, errors.Trace(err)
+	}
+	return string(magic) == encryptedMagic, nil
+}
+
+func newArchiveCipher(key, salt []byte) (cipher.AEAD, error) {
+	if err := ValidateEncryptionKey(key); err != nil {
+		return nil, errors.Trace(err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(salt)
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce returns the nonce for the numbered chunk. Each archive
+// uses its own derived key, so the nonces need only be unique within
+// an archive.
+func chunkNonce(aead cipher.AEAD, chunk uint64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], chunk)
+	return nonce
+}
+
+// chunkData is the additional data that distinguishes the last chunk.
+func chunkData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+type encryptingWriter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte
+	chunk uint64
+	err   error
+}
+
+// NewEncryptingWriter returns a writer that encrypts the backup
+// archive written to it with the given key, and writes the result to
+// w. The writer must be closed to complete the encrypted archive.
+func NewEncryptingWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, errors.Annotate(err, "cannot generate salt")
+	}
+	aead, err := newArchiveCipher(key, salt)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	header := make([]byte, 0, headerSize)
+	header = append(header, encryptedMagic...)
+	header = append(header, fingerprint(key)...)
+	header = append(header, salt...)
+	if _, err := w.Write(header); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &encryptingWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptedChunkSize),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (e *encryptingWriter) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		if e.err != nil {
+			return written, e.err
+		}
+		n := copy(e.buf[len(e.buf):cap(e.buf)], data)
+		e.buf = e.buf[:len(e.buf)+n]
+		data = data[n:]
+		written += n
+		if len(e.buf) == cap(e.buf) {
+			e.err = e.seal(false)
+		}
+	}
+	return written, e.err
+}
+
+// Close is part of the io.Closer interface. It writes the last chunk
+// of the archive, but does not close the underlying writer.
+func (e *encryptingWriter) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	if err := e.seal(true); err != nil {
+		e.err = err
+		return err
+	}
+	e.err = errors.New("write to closed encrypting writer")
+	return nil
+}
+
+func (e *encryptingWriter) seal(last bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.aead, e.chunk), e.buf, chunkData(last))
+	e.chunk++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(sealed)
+	return errors.Trace(err)
+}
+
+type decryptingReader struct {
+	r     io.Reader
+	aead  cipher.AEAD
+	buf   []byte
+	plain []byte
+	chunk uint64
+	done  bool
+}
+
+// NewDecryptingReader returns a reader that decrypts the encrypted
+// backup archive read from r with the given key. It returns an error
+// straight away if the archive was encrypted with a different key.
+func NewDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, errors.Annotate(err, "cannot read encrypted archive header")
+	}
+	if string(header[:len(encryptedMagic)]) != encryptedMagic {
+		return nil, errors.NotValidf("encrypted backup archive")
+	}
+	header = header[len(encryptedMagic):]
+	if !bytes.Equal(header[:fingerprintSize], fingerprint(key)) {
+		return nil, errors.Errorf("backup archive was encrypted with key %s, not %s",
+			hex.EncodeToString(header[:fingerprintSize]), KeyFingerprint(key))
+	}
+	aead, err := newArchiveCipher(key, header[fingerprintSize:])
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &decryptingReader{
+		r:    r,
+		aead: aead,
+		buf:  make([]byte, encryptedChunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) open() error {
+	n, err := io.ReadFull(d.r, d.buf)
+	last := false
+	switch err {
+	case nil:
+	case io.ErrUnexpectedEOF, io.EOF:
+		last = true
+	default:
+		return errors.Trace(err)
+	}
+	if n < d.aead.Overhead() {
+		return errors.New("encrypted backup archive is truncated")
+	}
+	plain, err := d.aead.Open(d.buf[:0], chunkNonce(d.aead, d.chunk), d.buf[:n], chunkData(last))
+	if err != nil {
+		return errors.New("encrypted backup archive is corrupt")
+	}
+	d.chunk++
+	d.plain = plain
+	d.done = last
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state/backups"
+	"github.com/juju/juju/testing"
+)
+
+type encryptSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&encryptSuite{})
+
+var (
+	testKey  = bytes.Repeat([]byte{0x42}, backups.EncryptionKeySize)
+	otherKey = bytes.Repeat([]byte{0x24}, backups.EncryptionKeySize)
+)
+
+func encrypt(c *gc.C, key, data []byte) []byte {
+	var buf bytes.Buffer
+	w, err := backups.NewEncryptingWriter(&buf, key)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = w.Write(data)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(w.Close(), jc.ErrorIsNil)
+	return buf.Bytes()
+}
+
+func (s *encryptSuite) TestRoundTrip(c *gc.C) {
+	for i, size := range []int{0, 1, 64*1024 - 1, 64 * 1024, 64*1024 + 1, 200 * 1024} {
+		c.Logf("test %d: %d bytes", i, size)
+		data := bytes.Repeat([]byte("archive!"), size/8+1)[:size]
+		encrypted := encrypt(c, testKey, data)
+		c.Check(bytes.Contains(encrypted, []byte("archive!")), jc.IsFalse)
+
+		isEncrypted, err := backups.IsEncryptedArchive(bytes.NewReader(encrypted))
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(isEncrypted, jc.IsTrue)
+
+		r, err := backups.NewDecryptingReader(bytes.NewReader(encrypted), testKey)
+		c.Assert(err, jc.ErrorIsNil)
+		decrypted, err := ioutil.ReadAll(r)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(bytes.Equal(decrypted, data), jc.IsTrue)
+	}
+}
+
+func (s *encryptSuite) TestWrongKey(c *gc.C) {
+	encrypted := encrypt(c, testKey, []byte("<archive>"))
+	_, err := backups.NewDecryptingReader(bytes.NewReader(encrypted), otherKey)
+	c.Check(err, gc.ErrorMatches, "backup archive was encrypted with key "+
+		backups.KeyFingerprint(testKey)+", not "+backups.KeyFingerprint(otherKey))
+}
+
+func (s *encryptSuite) TestTruncated(c *gc.C) {
+	encrypted := encrypt(c, testKey, bytes.Repeat([]byte{'x'}, 100*1024))
+	for _, size := range []int{len(encrypted) - 1, 64*1024 + 16 + 72} {
+		r, err := backups.NewDecryptingReader(bytes.NewReader(encrypted[:size]), testKey)
+		c.Assert(err, jc.ErrorIsNil)
+		_, err = ioutil.ReadAll(r)
+		c.Check(err, gc.ErrorMatches, "encrypted backup archive is (corrupt|truncated)")
+	}
+}
+
+func (s *encryptSuite) TestNotEncrypted(c *gc.C) {
+	isEncrypted, err := backups.IsEncryptedArchive(strings.NewReader("<archive>"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(isEncrypted, jc.IsFalse)
+
+	_, err = backups.NewDecryptingReader(strings.NewReader(strings.Repeat("x", 100)), testKey)
+	c.Check(err, gc.ErrorMatches, "encrypted backup archive not valid")
+}
+
+func (s *encryptSuite) TestReadEncryptionKey(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "backup.key")
+	err := ioutil.WriteFile(path, []byte(strings.Repeat("42", 32)+"\n"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	key, err := backups.ReadEncryptionKey(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(key, jc.DeepEquals, testKey)
+
+	err = ioutil.WriteFile(path, []byte("sekrit"), 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = backups.ReadEncryptionKey(path)
+	c.Check(err, gc.ErrorMatches, `backup encryption key in ".*" \(expected 32 hex-encoded bytes\) not valid`)
+}
+
+func (s *encryptSuite) TestCheckEncryptionKey(c *gc.C) {
+	meta := backups.NewMetadata()
+	c.Check(backups.CheckEncryptionKey(meta, nil), jc.ErrorIsNil)
+	c.Check(backups.CheckEncryptionKey(meta, testKey), jc.ErrorIsNil)
+
+	meta.SetID("spam")
+	meta.KeyFingerprint = backups.KeyFingerprint(testKey)
+	c.Check(backups.CheckEncryptionKey(meta, testKey), jc.ErrorIsNil)
+	c.Check(backups.CheckEncryptionKey(meta, nil), gc.ErrorMatches,
+		`backup "spam" is encrypted; an encryption key is required`)
+	c.Check(backups.CheckEncryptionKey(meta, otherKey), gc.ErrorMatches,
+		`backup "spam" was encrypted with key [0-9a-f]{64}, not [0-9a-f]{64}`)
+}
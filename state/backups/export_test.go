@@ -109,6 +109,12 @@ func ExposeCreateArgs(args *createArgs) ([]string, DBDumper) {
 	return args.filesToBackUp, args.db
 }
 
+// ExposeCreateMetadataReader extracts the metadata file reader in a
+// create() args value.
+func ExposeCreateMetadataReader(args *createArgs) io.Reader {
+	return args.metadataReader
+}
+
 // NewTestCreateResult builds a new create() result.
 func NewTestCreateResult(file io.ReadCloser, size int64, checksum string) *createResult {
 	result := createResult{
@@ -67,6 +67,18 @@ type Metadata struct {
 	// Notes is an optional user-supplied annotation.
 	Notes string
 
+	// Scheduled records that the backup was taken by the controller's
+	// backup scheduler rather than requested by a user.
+	Scheduled bool
+
+	// Target is the URL of the backup target holding the archive,
+	// or empty if the archive is stored in the controller.
+	Target string
+
+	// KeyFingerprint is the fingerprint of the key with which the
+	// archive was encrypted, or empty if it was not encrypted.
+	KeyFingerprint string
+
 	// TODO(wallyworld) - remove these ASAP
 	// These are only used by the restore CLI when re-bootstrapping.
 	// We will use a better solution but the way restore currently
@@ -158,14 +170,17 @@ type flatMetadata struct {
 
 	// backup
 
-	Started     time.Time
-	Finished    time.Time
-	Notes       string
-	Environment string
-	Machine     string
-	Hostname    string
-	Version     version.Number
-	Series      string
+	Started        time.Time
+	Finished       time.Time
+	Notes          string
+	Scheduled      bool   `json:",omitempty"`
+	Target         string `json:",omitempty"`
+	KeyFingerprint string `json:",omitempty"`
+	Environment    string
+	Machine        string
+	Hostname       string
+	Version        version.Number
+	Series         string
 
 	CACert       string
 	CAPrivateKey string
@@ -182,15 +197,18 @@ func (m *Metadata) AsJSONBuffer() (io.Reader, error) {
 		ChecksumFormat: m.ChecksumFormat(),
 		Size:           m.Size(),
 
-		Started:      m.Started,
-		Notes:        m.Notes,
-		Environment:  m.Origin.Model,
-		Machine:      m.Origin.Machine,
-		Hostname:     m.Origin.Hostname,
-		Version:      m.Origin.Version,
-		Series:       m.Origin.Series,
-		CACert:       m.CACert,
-		CAPrivateKey: m.CAPrivateKey,
+		Started:        m.Started,
+		Notes:          m.Notes,
+		Scheduled:      m.Scheduled,
+		Target:         m.Target,
+		KeyFingerprint: m.KeyFingerprint,
+		Environment:    m.Origin.Model,
+		Machine:        m.Origin.Machine,
+		Hostname:       m.Origin.Hostname,
+		Version:        m.Origin.Version,
+		Series:         m.Origin.Series,
+		CACert:         m.CACert,
+		CAPrivateKey:   m.CAPrivateKey,
 	}
 
 	stored := m.Stored()
@@ -233,6 +251,9 @@ func NewMetadataJSONReader(in io.Reader) (*Metadata, error) {
 		meta.Finished = &flat.Finished
 	}
 	meta.Notes = flat.Notes
+	meta.Scheduled = flat.Scheduled
+	meta.Target = flat.Target
+	meta.KeyFingerprint = flat.KeyFingerprint
 	meta.Origin = Origin{
 		Model:    flat.Environment,
 		Machine:  flat.Machine,
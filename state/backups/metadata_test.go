@@ -92,12 +92,28 @@ func (s *metadataSuite) TestNewMetadataJSONReader(c *gc.C) {
 	c.Check(meta.Started.Unix(), gc.Equals, int64(1410263974))
 	c.Check(meta.Finished.Unix(), gc.Equals, int64(1410264034))
 	c.Check(meta.Notes, gc.Equals, "")
+	c.Check(meta.Scheduled, jc.IsFalse)
 	c.Check(meta.Origin.Model, gc.Equals, "asdf-zxcv-qwe")
 	c.Check(meta.Origin.Machine, gc.Equals, "0")
 	c.Check(meta.Origin.Hostname, gc.Equals, "myhost")
 	c.Check(meta.Origin.Version.String(), gc.Equals, "1.21-alpha3")
 }
 
+func (s *metadataSuite) TestMetadataJSONScheduled(c *gc.C) {
+	meta := backups.NewMetadata()
+	meta.Scheduled = true
+	err := meta.MarkComplete(10, "123af2cef")
+	c.Assert(err, jc.ErrorIsNil)
+
+	buf, err := meta.AsJSONBuffer()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(buf.(*bytes.Buffer).String(), jc.Contains, `"Notes":"","Scheduled":true,`)
+
+	meta, err = backups.NewMetadataJSONReader(buf)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.Scheduled, jc.IsTrue)
+}
+
 func (s *metadataSuite) TestBuildMetadata(c *gc.C) {
 	archive, err := os.Create(filepath.Join(c.MkDir(), "juju-backup.tgz"))
 	c.Assert(err, jc.ErrorIsNil)
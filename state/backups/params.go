@@ -16,4 +16,8 @@ type RestoreArgs struct {
 	NewInstId      instance.Id
 	NewInstTag     names.Tag
 	NewInstSeries  string
+
+	// EncryptionKey is the key with which the backup archive was
+	// encrypted, if it was.
+	EncryptionKey []byte
 }
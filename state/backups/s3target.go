@@ -0,0 +1,196 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/amz.v3/aws"
+	"gopkg.in/amz.v3/s3"
+)
+
+const (
+	defaultS3Endpoint = "https://s3.amazonaws.com"
+	defaultS3Region   = "us-east-1"
+)
+
+// S3TargetConfig holds the configuration for a BackupTarget that
+// stores backup archives in S3-compatible object storage.
+type S3TargetConfig struct {
+	// Endpoint is the URL of the object storage service. Buckets
+	// are addressed by path, so that services other than AWS S3 can
+	// be used. It defaults to AWS S3.
+	Endpoint string
+
+	// Region is the region used to sign requests. It defaults to
+	// us-east-1.
+	Region string
+
+	// Bucket is the name of the bucket holding the archives, which
+	// must already exist.
+	Bucket string
+
+	// Prefix is prepended to the name of each archive.
+	Prefix string
+
+	// AccessKey and SecretKey are the credentials used to sign
+	// requests.
+	AccessKey string
+	SecretKey string
+}
+
+// Validate returns an error if the config is not valid.
+func (config S3TargetConfig) Validate() error {
+	if config.Bucket == "" {
+		return errors.NotValidf("empty Bucket")
+	}
+	if config.AccessKey == "" || config.SecretKey == "" {
+		return errors.NotValidf("missing S3 credentials")
+	}
+	if config.Endpoint != "" {
+		if _, err := url.Parse(config.Endpoint); err != nil {
+			return errors.NotValidf("Endpoint %q", config.Endpoint)
+		}
+	}
+	return nil
+}
+
+type s3Target struct {
+	config S3TargetConfig
+	bucket *s3.Bucket
+}
+
+// NewS3Target returns a BackupTarget that stores backup archives in
+// S3-compatible object storage.
+func NewS3Target(config S3TargetConfig) (BackupTarget, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = defaultS3Endpoint
+	}
+	if config.Region == "" {
+		config.Region = defaultS3Region
+	}
+	config.Prefix = strings.Trim(config.Prefix, "/")
+	auth := aws.Auth{
+		AccessKey: config.AccessKey,
+		SecretKey: config.SecretKey,
+	}
+	region := aws.Region{
+		Name:       config.Region,
+		S3Endpoint: config.Endpoint,
+	}
+	bucket, err := s3.New(auth, region).Bucket(config.Bucket)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &s3Target{config: config, bucket: bucket}, nil
+}
+
+// URL is part of the BackupTarget interface.
+func (t *s3Target) URL() string {
+	u := url.URL{
+		Scheme: "s3",
+		Host:   t.config.Bucket,
+	}
+	if t.config.Prefix != "" {
+		u.Path = "/" + t.config.Prefix
+	}
+	query := url.Values{}
+	if t.config.Endpoint != defaultS3Endpoint {
+		query.Set("endpoint", t.config.Endpoint)
+	}
+	if t.config.Region != defaultS3Region {
+		query.Set("region", t.config.Region)
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (t *s3Target) objectPath(name string) string {
+	return path.Join(t.config.Prefix, name)
+}
+
+// File is part of the filestorage.RawFileStorage interface.
+func (t *s3Target) File(id string) (io.ReadCloser, error) {
+	file, err := t.bucket.GetReader(t.objectPath(archiveName(id)))
+	if err != nil {
+		return nil, s3Error(err, "get", fmt.Sprintf("backup archive %q", id))
+	}
+	return file, nil
+}
+
+// AddFile is part of the filestorage.RawFileStorage interface.
+func (t *s3Target) AddFile(id string, file io.Reader, size int64) error {
+	err := t.bucket.PutReader(t.objectPath(archiveName(id)), file, size, "application/x-tar-gz", s3.Private)
+	if err != nil {
+		return s3Error(err, "store", fmt.Sprintf("backup archive %q", id))
+	}
+	return nil
+}
+
+// RemoveFile is part of the filestorage.RawFileStorage interface.
+func (t *s3Target) RemoveFile(id string) error {
+	if err := t.bucket.Del(t.objectPath(archiveName(id))); err != nil {
+		return s3Error(err, "remove", fmt.Sprintf("backup archive %q", id))
+	}
+	err := t.bucket.Del(t.objectPath(metadataName(id)))
+	if err != nil && !isS3NotFound(err) {
+		return s3Error(err, "remove", fmt.Sprintf("backup metadata %q", id))
+	}
+	return nil
+}
+
+// Metadata is part of the BackupTarget interface.
+func (t *s3Target) Metadata(id string) (*Metadata, error) {
+	file, err := t.bucket.GetReader(t.objectPath(metadataName(id)))
+	if err != nil {
+		return nil, s3Error(err, "get", fmt.Sprintf("backup metadata %q", id))
+	}
+	defer file.Close()
+	meta, err := NewMetadataJSONReader(file)
+	return meta, errors.Annotatef(err, "reading backup metadata %q", id)
+}
+
+// AddMetadata is part of the BackupTarget interface.
+func (t *s3Target) AddMetadata(meta *Metadata) error {
+	data, err := metadataJSON(meta)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	name := t.objectPath(metadataName(meta.ID()))
+	err = t.bucket.PutReader(name, bytes.NewReader(data), int64(len(data)), "application/json", s3.Private)
+	if err != nil {
+		return s3Error(err, "store", fmt.Sprintf("backup metadata %q", meta.ID()))
+	}
+	return nil
+}
+
+// Close is part of the filestorage.RawFileStorage interface.
+func (t *s3Target) Close() error {
+	return nil
+}
+
+// s3Error returns a NotFound error describing what if err reports
+// that the object does not exist, and otherwise annotates err with
+// the failed operation.
+func s3Error(err error, operation, what string) error {
+	if isS3NotFound(err) {
+		return errors.NotFoundf("%s", what)
+	}
+	return errors.Annotatef(err, "cannot %s %s", operation, what)
+}
+
+func isS3NotFound(err error) bool {
+	s3err, ok := err.(*s3.Error)
+	return ok && s3err.StatusCode == http.StatusNotFound
+}
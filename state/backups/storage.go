@@ -47,6 +47,16 @@ type storageMetaDoc struct {
 	Finished int64  `bson:"finished,minsize"`
 	Notes    string `bson:"notes,omitempty"`
 
+	// Scheduled is set for backups taken by the backup scheduler.
+	Scheduled bool `bson:"scheduled,omitempty"`
+
+	// Target is the URL of the backup target holding the archive.
+	Target string `bson:"target,omitempty"`
+
+	// KeyFingerprint identifies the key with which the archive was
+	// encrypted.
+	KeyFingerprint string `bson:"key-fingerprint,omitempty"`
+
 	// origin
 
 	Model    string         `bson:"model"`
@@ -123,6 +133,9 @@ func docAsMetadata(doc *storageMetaDoc) *Metadata {
 	meta := NewMetadata()
 	meta.Started = metadocUnixToTime(doc.Started)
 	meta.Notes = doc.Notes
+	meta.Scheduled = doc.Scheduled
+	meta.Target = doc.Target
+	meta.KeyFingerprint = doc.KeyFingerprint
 
 	meta.Origin.Model = doc.Model
 	meta.Origin.Machine = doc.Machine
@@ -174,6 +187,9 @@ func newStorageMetaDoc(meta *Metadata) storageMetaDoc {
 		doc.Finished = metadocTimeToUnix(*meta.Finished)
 	}
 	doc.Notes = meta.Notes
+	doc.Scheduled = meta.Scheduled
+	doc.Target = meta.Target
+	doc.KeyFingerprint = meta.KeyFingerprint
 
 	doc.Model = meta.Origin.Model
 	doc.Machine = meta.Origin.Machine
@@ -4,6 +4,10 @@
 package backups_test
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/juju/errors"
@@ -66,6 +70,9 @@ func (s *storageSuite) checkMeta(c *gc.C, meta, expected *backups.Metadata, id s
 		c.Check(meta.ID(), gc.Equals, id)
 	}
 	c.Check(meta.Notes, gc.Equals, expected.Notes)
+	c.Check(meta.Scheduled, gc.Equals, expected.Scheduled)
+	c.Check(meta.Target, gc.Equals, expected.Target)
+	c.Check(meta.KeyFingerprint, gc.Equals, expected.KeyFingerprint)
 	c.Check(meta.Started.Unix(), gc.Equals, expected.Started.Unix())
 	c.Check(meta.Checksum(), gc.Equals, expected.Checksum())
 	c.Check(meta.ChecksumFormat(), gc.Equals, expected.ChecksumFormat())
@@ -118,6 +125,31 @@ func (s *storageSuite) TestAddBackupMetadataSuccess(c *gc.C) {
 	s.checkMeta(c, meta, original, id)
 }
 
+func (s *storageSuite) TestAddBackupMetadataScheduled(c *gc.C) {
+	original := s.metadata(c)
+	original.Scheduled = true
+	id, err := backups.AddBackupMetadata(s.State, original)
+	c.Assert(err, jc.ErrorIsNil)
+
+	meta, err := backups.GetBackupMetadata(s.State, id)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.checkMeta(c, meta, original, id)
+	c.Check(meta.Scheduled, jc.IsTrue)
+}
+
+func (s *storageSuite) TestAddBackupMetadataEncrypted(c *gc.C) {
+	original := s.metadata(c)
+	original.KeyFingerprint = "0123456789abcdef"
+	id, err := backups.AddBackupMetadata(s.State, original)
+	c.Assert(err, jc.ErrorIsNil)
+
+	meta, err := backups.GetBackupMetadata(s.State, id)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.checkMeta(c, meta, original, id)
+}
+
 func (s *storageSuite) TestAddBackupMetadataGeneratedID(c *gc.C) {
 	original := s.metadata(c)
 	original.SetID("spam")
@@ -166,3 +198,75 @@ func (s *storageSuite) TestSetBackupStoredTimeNotFound(c *gc.C) {
 
 	c.Check(err, jc.Satisfies, errors.IsNotFound)
 }
+
+func (s *storageSuite) TestStorageWithTarget(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewLocalTarget(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	stor := backups.NewStorageWithTarget(s.State, target)
+	defer stor.Close()
+
+	original := s.metadata(c)
+	original.Target = target.URL()
+	archive := strings.Repeat("x", 42)
+	id, err := stor.Add(original, strings.NewReader(archive))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The archive is stored in the target, and the metadata in the
+	// controller.
+	data, err := ioutil.ReadFile(filepath.Join(dir, "juju-backup-"+id+".tar.gz"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, archive)
+	meta, err := backups.GetBackupMetadata(s.State, id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.Target, gc.Equals, target.URL())
+
+	// A copy of the metadata is stored alongside the archive.
+	meta, err = target.Metadata(id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.Stored(), gc.NotNil)
+	s.checkMeta(c, meta, original, id)
+
+	err = stor.Remove(id)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(dir, "juju-backup-"+id+".tar.gz"))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+	_, err = os.Stat(filepath.Join(dir, "juju-backup-"+id+".json"))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *storageSuite) TestStorageWithTargetMetadataOnlyInTarget(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewLocalTarget(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	stor := backups.NewStorageWithTarget(s.State, target)
+	defer stor.Close()
+
+	original := s.metadata(c)
+	original.Target = target.URL()
+	archive := strings.Repeat("x", 42)
+	id, err := stor.Add(original, strings.NewReader(archive))
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Simulate a controller that has never seen the backup, such as
+	// one that was rebootstrapped after the original was lost.
+	err = s.State.MongoSession().DB("backups").C("metadata").RemoveId(id)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = backups.GetBackupMetadata(s.State, id)
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+
+	meta, file, err := stor.Get(id)
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := ioutil.ReadAll(file)
+	file.Close()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, archive)
+	s.checkMeta(c, meta.(*backups.Metadata), original, id)
+
+	err = stor.Remove(id)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = os.Stat(filepath.Join(dir, "juju-backup-"+id+".tar.gz"))
+	c.Check(err, jc.Satisfies, os.IsNotExist)
+	_, _, err = stor.Get(id)
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+}
@@ -0,0 +1,292 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/filestorage"
+)
+
+// BackupTarget stores backup archives outside the controller's own
+// database, so that they survive the loss of the controller. Backup
+// metadata is kept in the controller and, so that a backup can be
+// restored by a controller that no longer holds it, alongside the
+// archive in the target.
+type BackupTarget interface {
+	filestorage.RawFileStorage
+
+	// URL identifies the target, without any credentials. It is
+	// recorded in the metadata of the backups stored there.
+	URL() string
+
+	// Metadata returns the metadata stored alongside the archive of
+	// the identified backup.
+	Metadata(id string) (*Metadata, error)
+
+	// AddMetadata stores the metadata of a backup, without the
+	// controller CA private key, alongside its archive. RemoveFile
+	// removes both.
+	AddMetadata(meta *Metadata) error
+}
+
+func archiveName(id string) string {
+	return FilenamePrefix + id + ".tar.gz"
+}
+
+func metadataName(id string) string {
+	return FilenamePrefix + id + ".json"
+}
+
+// metadataJSON returns the metadata to store alongside an archive in
+// a target. The controller CA private key is left out, since the
+// target copy is never encrypted; it remains in the metadata held by
+// the controller and in the archive itself.
+func metadataJSON(meta *Metadata) ([]byte, error) {
+	targetMeta := *meta
+	targetMeta.CAPrivateKey = ""
+	buf, err := targetMeta.AsJSONBuffer()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	data, err := ioutil.ReadAll(buf)
+	return data, errors.Trace(err)
+}
+
+// TargetSpec describes a backup target.
+type TargetSpec struct {
+	// URL identifies the target. It is either "file:///<directory>",
+	// for a local directory such as an NFS mount, or
+	// "s3://<bucket>[/<prefix>]" for S3-compatible object storage,
+	// in which case the "endpoint" and "region" query parameters
+	// select the service, defaulting to AWS S3 in us-east-1.
+	URL string
+
+	// AccessKey and SecretKey are the credentials used to access
+	// S3-compatible object storage.
+	AccessKey string
+	SecretKey string
+}
+
+// NewTarget returns the BackupTarget described by the spec.
+func NewTarget(spec TargetSpec) (BackupTarget, error) {
+	u, err := url.Parse(spec.URL)
+	if err != nil {
+		return nil, errors.NotValidf("backup target %q", spec.URL)
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Host != "" {
+			return nil, errors.NotValidf("backup target %q with host", spec.URL)
+		}
+		return NewLocalTarget(u.Path)
+	case "s3":
+		query := u.Query()
+		return NewS3Target(S3TargetConfig{
+			Endpoint:  query.Get("endpoint"),
+			Region:    query.Get("region"),
+			Bucket:    u.Host,
+			Prefix:    u.Path,
+			AccessKey: spec.AccessKey,
+			SecretKey: spec.SecretKey,
+		})
+	}
+	return nil, errors.NotSupportedf("backup target scheme %q", u.Scheme)
+}
+
+// NewStorageWithTarget returns a new FileStorage that stores backup
+// archives in the given target. Backup metadata is kept both in the
+// controller and in the target, and is read from the target for
+// backups the controller does not know about.
+func NewStorageWithTarget(st DB, target BackupTarget) filestorage.FileStorage {
+	modelUUID := st.ModelTag().Id()
+	db := st.MongoSession().DB(storageDBName)
+	dbWrap := newStorageDBWrapper(db, storageMetaName, modelUUID)
+	defer dbWrap.Close()
+
+	docs := newMetadataStorage(dbWrap)
+	docs.MetadataDocStorage = filestorage.MetadataDocStorage{&targetDocStorage{
+		DocStorage: docs.MetadataDocStorage.DocStorage,
+		target:     target,
+	}}
+	return filestorage.NewFileStorage(&targetMetadataStorage{docs, target}, target)
+}
+
+// targetDocStorage falls back to the metadata stored in the target for
+// backups whose metadata is not held by the controller.
+type targetDocStorage struct {
+	filestorage.DocStorage
+	target BackupTarget
+}
+
+// Doc implements filestorage.DocStorage.
+func (s *targetDocStorage) Doc(id string) (filestorage.Document, error) {
+	doc, err := s.DocStorage.Doc(id)
+	if errors.IsNotFound(err) {
+		meta, err := s.target.Metadata(id)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return meta, nil
+	}
+	return doc, errors.Trace(err)
+}
+
+// RemoveDoc implements filestorage.DocStorage. It succeeds if the
+// metadata is only stored in the target, so that the archive is then
+// removed from there along with it.
+func (s *targetDocStorage) RemoveDoc(id string) error {
+	_, err := s.DocStorage.Doc(id)
+	if errors.IsNotFound(err) {
+		_, err := s.target.Metadata(id)
+		return errors.Trace(err)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.DocStorage.RemoveDoc(id))
+}
+
+// targetMetadataStorage copies the metadata of each backup into the
+// target once its archive has been stored there.
+type targetMetadataStorage struct {
+	*backupsMetadataStorage
+	target BackupTarget
+}
+
+// SetStored records in the metadata the fact that the file was
+// stored, and then stores the metadata alongside it.
+func (s *targetMetadataStorage) SetStored(id string) error {
+	if err := s.backupsMetadataStorage.SetStored(id); err != nil {
+		return errors.Trace(err)
+	}
+	doc, err := s.Doc(id)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	meta, ok := doc.(*Metadata)
+	if !ok {
+		return errors.Errorf("doc must be of type *backups.Metadata")
+	}
+	return errors.Trace(s.target.AddMetadata(meta))
+}
+
+//---------------------------
+// local directory target
+
+type localTarget struct {
+	dir string
+}
+
+// NewLocalTarget returns a BackupTarget that stores backup archives
+// in the given directory, which must already exist.
+func NewLocalTarget(dir string) (BackupTarget, error) {
+	if !filepath.IsAbs(dir) {
+		return nil, errors.NotValidf("relative backup target directory %q", dir)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot use backup target")
+	}
+	if !info.IsDir() {
+		return nil, errors.NotValidf("backup target %q (not a directory)", dir)
+	}
+	return &localTarget{dir: filepath.Clean(dir)}, nil
+}
+
+func (t *localTarget) path(name string) string {
+	return filepath.Join(t.dir, name)
+}
+
+// URL is part of the BackupTarget interface.
+func (t *localTarget) URL() string {
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(t.dir)}
+	return u.String()
+}
+
+// File is part of the filestorage.RawFileStorage interface.
+func (t *localTarget) File(id string) (io.ReadCloser, error) {
+	file, err := os.Open(t.path(archiveName(id)))
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("backup archive %q", id)
+	}
+	return file, errors.Trace(err)
+}
+
+// AddFile is part of the filestorage.RawFileStorage interface.
+func (t *localTarget) AddFile(id string, file io.Reader, size int64) error {
+	what := fmt.Sprintf("backup archive %q", id)
+	return errors.Trace(t.write(archiveName(id), what, file, size))
+}
+
+// RemoveFile is part of the filestorage.RawFileStorage interface.
+func (t *localTarget) RemoveFile(id string) error {
+	err := os.Remove(t.path(archiveName(id)))
+	if os.IsNotExist(err) {
+		return errors.NotFoundf("backup archive %q", id)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	err = os.Remove(t.path(metadataName(id)))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// Metadata is part of the BackupTarget interface.
+func (t *localTarget) Metadata(id string) (*Metadata, error) {
+	file, err := os.Open(t.path(metadataName(id)))
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("backup metadata %q", id)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer file.Close()
+	meta, err := NewMetadataJSONReader(file)
+	return meta, errors.Annotatef(err, "reading backup metadata %q", id)
+}
+
+// AddMetadata is part of the BackupTarget interface.
+func (t *localTarget) AddMetadata(meta *Metadata) error {
+	data, err := metadataJSON(meta)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	what := fmt.Sprintf("backup metadata %q", meta.ID())
+	return errors.Trace(t.write(metadataName(meta.ID()), what, bytes.NewReader(data), int64(len(data))))
+}
+
+// write writes the named file in the target directory. The data is
+// written to a temporary file which is renamed once it is complete,
+// so that a partial file is never left under the name.
+func (t *localTarget) write(name, what string, data io.Reader, size int64) error {
+	tempFile, err := ioutil.TempFile(t.dir, ".juju-backup-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer os.Remove(tempFile.Name())
+	written, err := io.Copy(tempFile, data)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Annotatef(err, "writing %s", what)
+	}
+	if written != size {
+		return errors.Errorf("%s: expected %d bytes, got %d", what, size, written)
+	}
+	return errors.Trace(os.Rename(tempFile.Name(), t.path(name)))
+}
+
+// Close is part of the filestorage.RawFileStorage interface.
+func (t *localTarget) Close() error {
+	return nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backups_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/state/backups"
+	"github.com/juju/juju/testing"
+)
+
+type targetSuite struct {
+	testing.BaseSuite
+}
+
+var _ = gc.Suite(&targetSuite{})
+
+func (s *targetSuite) TestNewTargetLocal(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewTarget(backups.TargetSpec{URL: "file://" + dir})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(target.URL(), gc.Equals, "file://"+filepath.ToSlash(dir))
+}
+
+func (s *targetSuite) TestNewTargetS3(c *gc.C) {
+	target, err := backups.NewTarget(backups.TargetSpec{
+		URL:       "s3://juju-backups/controller-1?endpoint=http://10.0.0.1:9000&region=eu-west-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(target.URL(), gc.Equals,
+		"s3://juju-backups/controller-1?endpoint=http%3A%2F%2F10.0.0.1%3A9000&region=eu-west-1")
+
+	target, err = backups.NewTarget(backups.TargetSpec{
+		URL:       "s3://juju-backups",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(target.URL(), gc.Equals, "s3://juju-backups")
+}
+
+func (s *targetSuite) TestNewTargetErrors(c *gc.C) {
+	for i, test := range []struct {
+		spec backups.TargetSpec
+		err  string
+	}{{
+		spec: backups.TargetSpec{URL: "ftp://example.com/backups"},
+		err:  `backup target scheme "ftp" not supported`,
+	}, {
+		spec: backups.TargetSpec{URL: "file://example.com/backups"},
+		err:  `backup target "file://example.com/backups" with host not valid`,
+	}, {
+		spec: backups.TargetSpec{URL: "file:///no/such/directory"},
+		err:  "cannot use backup target: .*",
+	}, {
+		spec: backups.TargetSpec{URL: "s3://juju-backups"},
+		err:  "missing S3 credentials not valid",
+	}, {
+		spec: backups.TargetSpec{URL: "s3:///prefix", AccessKey: "a", SecretKey: "s"},
+		err:  "empty Bucket not valid",
+	}} {
+		c.Logf("test %d: %s", i, test.spec.URL)
+		_, err := backups.NewTarget(test.spec)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *targetSuite) TestLocalTargetNotDirectory(c *gc.C) {
+	path := filepath.Join(c.MkDir(), "file")
+	err := ioutil.WriteFile(path, nil, 0600)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = backups.NewLocalTarget(path)
+	c.Check(err, gc.ErrorMatches, `backup target ".*" \(not a directory\) not valid`)
+}
+
+func (s *targetSuite) TestLocalTarget(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewLocalTarget(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	s.checkTarget(c, target)
+
+	// Only the archive and its metadata were left behind, under its ID.
+	meta := backups.NewMetadata()
+	meta.SetID("20170301-020000.deadbeef")
+	err = target.AddFile(meta.ID(), strings.NewReader("<archive>"), 9)
+	c.Assert(err, jc.ErrorIsNil)
+	err = target.AddMetadata(meta)
+	c.Assert(err, jc.ErrorIsNil)
+	names, err := ioutil.ReadDir(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(names, gc.HasLen, 2)
+	c.Check(names[0].Name(), gc.Equals, "juju-backup-20170301-020000.deadbeef.json")
+	c.Check(names[1].Name(), gc.Equals, "juju-backup-20170301-020000.deadbeef.tar.gz")
+}
+
+func (s *targetSuite) TestLocalTargetMetadataOmitsCAPrivateKey(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewLocalTarget(dir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	meta := backups.NewMetadata()
+	meta.SetID("spam")
+	meta.CACert = "<ca-cert>"
+	meta.CAPrivateKey = "<ca-private-key>"
+	err = target.AddMetadata(meta)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.CAPrivateKey, gc.Equals, "<ca-private-key>")
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "juju-backup-spam.json"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), jc.Contains, "<ca-cert>")
+	c.Check(string(data), gc.Not(jc.Contains), "<ca-private-key>")
+	stored, err := target.Metadata("spam")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(stored.CAPrivateKey, gc.Equals, "")
+}
+
+func (s *targetSuite) TestLocalTargetShortArchive(c *gc.C) {
+	dir := c.MkDir()
+	target, err := backups.NewLocalTarget(dir)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = target.AddFile("spam", strings.NewReader("<arch"), 9)
+	c.Check(err, gc.ErrorMatches, `backup archive "spam": expected 9 bytes, got 5`)
+	names, err := ioutil.ReadDir(dir)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, gc.HasLen, 0)
+}
+
+func (s *targetSuite) TestS3Target(c *gc.C) {
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	target, err := backups.NewS3Target(backups.S3TargetConfig{
+		Endpoint:  server.URL,
+		Bucket:    "juju-backups",
+		Prefix:    "/controller-1/",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.checkTarget(c, target)
+
+	err = target.AddFile("spam", strings.NewReader("<archive>"), 9)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(fake.objects, jc.DeepEquals, map[string]string{
+		"/juju-backups/controller-1/juju-backup-spam.tar.gz": "<archive>",
+	})
+	c.Check(fake.authorization, gc.Matches,
+		`AWS4-HMAC-SHA256 Credential=access/[0-9]{8}/us-east-1/s3/aws4_request, .*`)
+}
+
+func (s *targetSuite) TestS3TargetMetadataOmitsCAPrivateKey(c *gc.C) {
+	fake := newFakeS3()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	target, err := backups.NewS3Target(backups.S3TargetConfig{
+		Endpoint:  server.URL,
+		Bucket:    "juju-backups",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	meta := backups.NewMetadata()
+	meta.SetID("spam")
+	meta.CAPrivateKey = "<ca-private-key>"
+	err = target.AddMetadata(meta)
+	c.Assert(err, jc.ErrorIsNil)
+
+	data, ok := fake.objects["/juju-backups/juju-backup-spam.json"]
+	c.Assert(ok, jc.IsTrue)
+	c.Check(data, gc.Not(jc.Contains), "<ca-private-key>")
+}
+
+func (s *targetSuite) TestS3TargetError(c *gc.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied", "Access Denied")
+	}))
+	defer server.Close()
+
+	target, err := backups.NewS3Target(backups.S3TargetConfig{
+		Endpoint:  server.URL,
+		Bucket:    "juju-backups",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = target.AddFile("spam", strings.NewReader("<archive>"), 9)
+	c.Check(err, gc.ErrorMatches, `cannot store backup archive "spam": Access Denied`)
+}
+
+// checkTarget checks that the target stores, returns and removes
+// archives.
+func (s *targetSuite) checkTarget(c *gc.C, target backups.BackupTarget) {
+	_, err := target.File("spam")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+
+	err = target.AddFile("spam", strings.NewReader("<archive>"), 9)
+	c.Assert(err, jc.ErrorIsNil)
+
+	file, err := target.File("spam")
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := ioutil.ReadAll(file)
+	file.Close()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(data), gc.Equals, "<archive>")
+
+	_, err = target.Metadata("spam")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	meta := backups.NewMetadata()
+	meta.SetID("spam")
+	meta.Notes = "nightly"
+	err = target.AddMetadata(meta)
+	c.Assert(err, jc.ErrorIsNil)
+	meta, err = target.Metadata("spam")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(meta.ID(), gc.Equals, "spam")
+	c.Check(meta.Notes, gc.Equals, "nightly")
+
+	err = target.RemoveFile("spam")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = target.File("spam")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	_, err = target.Metadata("spam")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+	err = target.RemoveFile("spam")
+	c.Check(err, jc.Satisfies, errors.IsNotFound)
+
+	c.Check(target.Close(), jc.ErrorIsNil)
+}
+
+// fakeS3 is a minimal in-memory S3-compatible object store.
+type fakeS3 struct {
+	mu            sync.Mutex
+	objects       map[string]string
+	authorization string
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: make(map[string]string)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.authorization = req.Header.Get("Authorization")
+	if f.authorization == "" {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied", "Access Denied")
+		return
+	}
+	key := req.URL.Path
+	switch req.Method {
+	case "PUT":
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(req.Body); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		f.objects[key] = buf.String()
+	case "GET":
+		data, ok := f.objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		w.Write([]byte(data))
+	case "DELETE":
+		if _, ok := f.objects[key]; !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+			return
+		}
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", req.Method)
+	}
+}
+
+// writeS3Error writes an S3 error response.
+func writeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
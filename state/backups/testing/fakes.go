@@ -38,6 +38,8 @@ type FakeBackups struct {
 	DBInfoArg *backups.DBInfo
 	// MetaArg holds the backup metadata that was passed in.
 	MetaArg *backups.Metadata
+	// EncryptionKeyArg holds the encryption key that was passed in.
+	EncryptionKeyArg []byte
 	// PrivateAddr Holds the address for the internal network of the machine.
 	PrivateAddr string
 	// InstanceId Is the id of the machine to be restored.
@@ -50,12 +52,13 @@ var _ backups.Backups = (*FakeBackups)(nil)
 
 // Create creates and stores a new juju backup archive and returns
 // its associated metadata.
-func (b *FakeBackups) Create(meta *backups.Metadata, paths *backups.Paths, dbInfo *backups.DBInfo) error {
+func (b *FakeBackups) Create(meta *backups.Metadata, paths *backups.Paths, dbInfo *backups.DBInfo, encryptionKey []byte) error {
 	b.Calls = append(b.Calls, "Create")
 
 	b.PathsArg = paths
 	b.DBInfoArg = dbInfo
 	b.MetaArg = meta
+	b.EncryptionKeyArg = encryptionKey
 
 	if b.Meta != nil {
 		*meta = *b.Meta
@@ -101,6 +104,7 @@ func (b *FakeBackups) Restore(bkpId string, dbInfo *backups.DBInfo, args backups
 	b.Calls = append(b.Calls, "Restore")
 	b.PrivateAddr = args.PrivateAddress
 	b.InstanceId = args.NewInstId
+	b.EncryptionKeyArg = args.EncryptionKey
 	return nil, errors.Trace(b.Error)
 }
 
@@ -388,7 +388,7 @@ func (st *State) cleanupRemovedUnit(unitId string) error {
 	}
 	for _, action := range actions {
 		switch action.Status() {
-		case ActionCompleted, ActionCancelled, ActionFailed:
+		case ActionCompleted, ActionCancelled, ActionFailed, ActionAborted:
 			// nothing to do here
 		default:
 			if _, err = action.Finish(cancelled); err != nil {
@@ -29,6 +29,11 @@ func (s *ControllerConfigSuite) TestControllerAndModelConfigInitialisation(c *gc
 		controller.AutocertURLKey:      true,
 		controller.AutocertDNSNameKey:  true,
 		controller.AllowModelAccessKey: true,
+
+		controller.AuditLogSinks:          true,
+		controller.AuditLogCaptureArgs:    true,
+		controller.AuditLogExcludeMethods: true,
+		controller.AuditLogRedactKeys:     true,
 	}
 	for _, controllerAttr := range controller.ControllerOnlyConfigAttributes {
 		v, ok := controllerSettings.Get(controllerAttr)
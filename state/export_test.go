@@ -46,6 +46,9 @@ const (
 	GUISettingsC      = guisettingsC
 	GlobalSettingsC   = globalSettingsC
 	SettingsC         = settingsC
+
+	MaxActionMessages      = maxActionMessages
+	MaxActionMessageLength = maxActionMessageLength
 )
 
 var (
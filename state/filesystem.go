@@ -137,6 +137,10 @@ type FilesystemParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId is the provider ID of the snapshot from which the
+	// filesystem's backing volume is to be restored, if any.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // FilesystemInfo describes information about a filesystem.
@@ -737,6 +741,13 @@ func (st *State) addFilesystemOps(params FilesystemParams, machineId string) ([]
 	if err != nil {
 		return nil, names.FilesystemTag{}, names.VolumeTag{}, errors.Trace(err)
 	}
+	if params.SnapshotId != "" && provider.Supports(storage.StorageKindFilesystem) {
+		// Only the volumes backing Juju-managed filesystems may
+		// be restored from snapshots.
+		return nil, names.FilesystemTag{}, names.VolumeTag{}, errors.NotSupportedf(
+			"restoring filesystem from a snapshot with pool %q", params.Pool,
+		)
+	}
 	if !provider.Supports(storage.StorageKindFilesystem) {
 		var volumeOps []txn.Op
 		volumeParams := VolumeParams{
@@ -744,6 +755,7 @@ func (st *State) addFilesystemOps(params FilesystemParams, machineId string) ([]
 			filesystemTag, // volume is bound to filesystem
 			params.Pool,
 			params.Size,
+			params.SnapshotId,
 		}
 		volumeOps, volumeTag, err = st.addVolumeOps(volumeParams, machineId)
 		if err != nil {
@@ -224,6 +224,10 @@ type Action interface {
 	// Results returns the structured output of the action and any error.
 	Results() (map[string]interface{}, string)
 
+	// Messages returns the progress messages logged by the action, oldest
+	// first.
+	Messages() []ActionMessage
+
 	// ActionTag returns an ActionTag constructed from this action's
 	// Prefix and Sequence.
 	ActionTag() names.ActionTag
@@ -232,6 +236,18 @@ type Action interface {
 	// It asserts that the action is currently pending.
 	Begin() (Action, error)
 
+	// Log adds a timestamped progress message to the action. It asserts
+	// that the action is running.
+	Log(message string) error
+
+	// Abort asks the unit running the action to stop it. It asserts
+	// that the action is currently running.
+	Abort() (Action, error)
+
+	// Watch returns a watcher for observing changes to the action,
+	// such as a request to abort it.
+	Watch() NotifyWatcher
+
 	// Finish removes action from the pending queue and captures the output
 	// and end state of the action.
 	Finish(results ActionResults) (Action, error)
@@ -4,11 +4,13 @@
 package audit
 
 import (
-	"github.com/juju/errors"
+	"time"
 
-	"github.com/juju/juju/audit"
+	"github.com/juju/errors"
 	"github.com/juju/version"
+	"gopkg.in/mgo.v2/bson"
 
+	"github.com/juju/juju/audit"
 	"github.com/juju/juju/mongo/utils"
 )
 
@@ -27,6 +29,10 @@ type auditEntryDoc struct {
 	// unmarshaled via time.Time::UnmarshalText.
 	Timestamp string `bson:"timestamp"`
 
+	// Time is the same instant as Timestamp, stored natively so that
+	// entries can be queried and sorted by time.
+	Time time.Time `bson:"time"`
+
 	// RemoteAddress is the IP of the machine from which the
 	// audit-event was triggered.
 	RemoteAddress string `bson:"remote-address"`
@@ -76,6 +82,7 @@ func auditEntryDocFromAuditEntry(auditEntry audit.AuditEntry) (auditEntryDoc, er
 		JujuServerVersion: auditEntry.JujuServerVersion,
 		ModelUUID:         auditEntry.ModelUUID,
 		Timestamp:         string(timeAsBlob),
+		Time:              auditEntry.Timestamp,
 		RemoteAddress:     auditEntry.RemoteAddress,
 		OriginType:        auditEntry.OriginType,
 		OriginName:        auditEntry.OriginName,
@@ -83,3 +90,71 @@ func auditEntryDocFromAuditEntry(auditEntry audit.AuditEntry) (auditEntryDoc, er
 		Data:              utils.EscapeKeys(auditEntry.Data),
 	}, nil
 }
+
+// QueryAuditEntriesFn creates a closure which when passed a Filter
+// will return the matching entries from the audit collection, oldest
+// first.
+func QueryAuditEntriesFn(
+	collectionName string,
+	findDocs func(collectionName string, query bson.D, limit int, docs interface{}) error,
+) func(audit.Filter) ([]audit.AuditEntry, error) {
+	return func(filter audit.Filter) ([]audit.AuditEntry, error) {
+		if err := filter.Validate(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		var docs []auditEntryDoc
+		if err := findDocs(collectionName, filterQuery(filter), filter.Limit, &docs); err != nil {
+			return nil, errors.Trace(err)
+		}
+		entries := make([]audit.AuditEntry, len(docs))
+		for i, doc := range docs {
+			entry, err := auditEntryFromAuditEntryDoc(doc)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			entries[i] = entry
+		}
+		return entries, nil
+	}
+}
+
+func filterQuery(filter audit.Filter) bson.D {
+	query := bson.D{}
+	if filter.ModelUUID != "" {
+		query = append(query, bson.DocElem{"model-uuid", filter.ModelUUID})
+	}
+	if filter.OriginName != "" {
+		query = append(query, bson.DocElem{"origin-name", filter.OriginName})
+	}
+	if filter.Operation != "" {
+		query = append(query, bson.DocElem{"operation", filter.Operation})
+	}
+	timeRange := bson.D{}
+	if !filter.After.IsZero() {
+		timeRange = append(timeRange, bson.DocElem{"$gte", filter.After.UTC()})
+	}
+	if !filter.Before.IsZero() {
+		timeRange = append(timeRange, bson.DocElem{"$lt", filter.Before.UTC()})
+	}
+	if len(timeRange) > 0 {
+		query = append(query, bson.DocElem{"time", timeRange})
+	}
+	return query
+}
+
+func auditEntryFromAuditEntryDoc(doc auditEntryDoc) (audit.AuditEntry, error) {
+	var timestamp time.Time
+	if err := timestamp.UnmarshalText([]byte(doc.Timestamp)); err != nil {
+		return audit.AuditEntry{}, errors.Trace(err)
+	}
+	return audit.AuditEntry{
+		JujuServerVersion: doc.JujuServerVersion,
+		ModelUUID:         doc.ModelUUID,
+		Timestamp:         timestamp.UTC(),
+		RemoteAddress:     doc.RemoteAddress,
+		OriginType:        doc.OriginType,
+		OriginName:        doc.OriginName,
+		Operation:         doc.Operation,
+		Data:              utils.UnescapeKeys(doc.Data),
+	}, nil
+}
@@ -4,6 +4,8 @@
 package audit_test
 
 import (
+	"time"
+
 	"github.com/juju/errors"
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
@@ -58,6 +60,7 @@ func (*AuditSuite) TestPutAuditEntry_PersistAuditEntry(c *gc.C) {
 			"juju-server-version": requested.JujuServerVersion,
 			"model-uuid":          requested.ModelUUID,
 			"timestamp":           string(requestedTimeBlob),
+			"time":                requested.Timestamp,
 			"remote-address":      "8.8.8.8",
 			"origin-type":         requested.OriginType,
 			"origin-name":         requested.OriginName,
@@ -111,3 +114,81 @@ func (*AuditSuite) TestPutAuditEntry_ValidateAuditEntry(c *gc.C) {
 	err := putAuditEntry(auditEntry)
 	c.Check(err, gc.ErrorMatches, validationErr.Error())
 }
+
+func (*AuditSuite) TestQueryAuditEntries_BuildsQuery(c *gc.C) {
+	after := coretesting.NonZeroTime().UTC()
+	before := after.Add(time.Hour)
+	filter := audit.Filter{
+		ModelUUID:  coretesting.ModelTag.Id(),
+		OriginName: "user-bob",
+		Operation:  "Application:v3 - Deploy",
+		After:      after,
+		Before:     before,
+		Limit:      10,
+	}
+
+	var findDocsCalled bool
+	findDocs := func(collectionName string, query bson.D, limit int, docs interface{}) error {
+		findDocsCalled = true
+		c.Check(collectionName, gc.Equals, "audit.log")
+		c.Check(limit, gc.Equals, 10)
+		c.Check(query, jc.DeepEquals, bson.D{
+			{"model-uuid", filter.ModelUUID},
+			{"origin-name", "user-bob"},
+			{"operation", "Application:v3 - Deploy"},
+			{"time", bson.D{{"$gte", after}, {"$lt", before}}},
+		})
+		return nil
+	}
+
+	queryAuditEntries := stateaudit.QueryAuditEntriesFn("audit.log", findDocs)
+	entries, err := queryAuditEntries(filter)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, gc.HasLen, 0)
+	c.Assert(findDocsCalled, jc.IsTrue)
+}
+
+func (*AuditSuite) TestQueryAuditEntries_RoundTrip(c *gc.C) {
+	requested := audit.AuditEntry{
+		JujuServerVersion: version.MustParse("1.0.0"),
+		ModelUUID:         utils.MustNewUUID().String(),
+		Timestamp:         coretesting.NonZeroTime().UTC(),
+		RemoteAddress:     "8.8.8.8",
+		OriginType:        "user",
+		OriginName:        "bob",
+		Operation:         "status",
+		Data: map[string]interface{}{
+			"$a.b": "c",
+		},
+	}
+
+	var stored []byte
+	insertDocs := func(collectionName string, docs ...interface{}) error {
+		var err error
+		stored, err = bson.Marshal(docs[0])
+		return err
+	}
+	err := stateaudit.PutAuditEntryFn("audit.log", insertDocs)(requested)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Present the stored document to the query as a single-element
+	// BSON array, as the collection would.
+	findDocs := func(collectionName string, query bson.D, limit int, docs interface{}) error {
+		raw := bson.Raw{Kind: 0x04}
+		var err error
+		raw.Data, err = bson.Marshal(bson.M{"0": bson.Raw{Kind: 0x03, Data: stored}})
+		if err != nil {
+			return err
+		}
+		return raw.Unmarshal(docs)
+	}
+	entries, err := stateaudit.QueryAuditEntriesFn("audit.log", findDocs)(audit.Filter{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(entries, jc.DeepEquals, []audit.AuditEntry{requested})
+}
+
+func (*AuditSuite) TestQueryAuditEntries_ValidatesFilter(c *gc.C) {
+	queryAuditEntries := stateaudit.QueryAuditEntriesFn("audit.log", nil)
+	_, err := queryAuditEntries(audit.Filter{Limit: -1})
+	c.Assert(err, gc.ErrorMatches, "negative Limit not valid")
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/juju/errors"
 	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
 	"github.com/juju/utils/deque"
 	"github.com/juju/utils/set"
 	"github.com/juju/version"
@@ -66,6 +67,9 @@ var logIndexes = [][]string{
 	// the same time have a consistent ordering.
 	{"e", "t", "_id"},
 	{"e", "n"},
+	// This index is used when tailing the logs of all models, which
+	// are merged in time order.
+	{"t", "_id"},
 }
 
 // InitDbLogs sets up the indexes for the logs collection. It should
@@ -331,17 +335,24 @@ type LogRecord struct {
 // LogTailerParams specifies the filtering a LogTailer should apply to
 // logs in order to decide which to return.
 type LogTailerParams struct {
-	StartID       int64
-	StartTime     time.Time
-	MinLevel      loggo.Level
-	InitialLines  int
-	NoTail        bool
-	IncludeEntity []string
-	ExcludeEntity []string
-	IncludeModule []string
-	ExcludeModule []string
-	Oplog         *mgo.Collection // For testing only
-	AllModels     bool
+	StartID         int64
+	StartTime       time.Time
+	EndTime         time.Time // If set, tailing stops once it has passed
+	MinLevel        loggo.Level
+	InitialLines    int
+	NoTail          bool
+	IncludeEntity   []string
+	ExcludeEntity   []string
+	IncludeModule   []string
+	ExcludeModule   []string
+	IncludeMessage  []string // regular expressions
+	ExcludeMessage  []string // regular expressions
+	IncludeLocation []string
+	ExcludeLocation []string
+	Oplog           *mgo.Collection // For testing only
+	Clock           clock.Clock     // For testing only
+	AllModels       bool
+	ModelUUIDs      []string // If set, restricts AllModels to these models
 }
 
 // oplogOverlap is used to decide on the initial oplog timestamp to
@@ -377,8 +388,13 @@ func NewLogTailer(st LogTailerState, params *LogTailerParams) (LogTailer, error)
 		return nil, errors.NewNotValid(nil, "not allowed to tail logs from all models: not a controller")
 	}
 
+	clk := params.Clock
+	if clk == nil {
+		clk = clock.WallClock
+	}
 	session := st.MongoSession().Copy()
 	t := &logTailer{
+		clock:     clk,
 		modelUUID: st.ModelUUID(),
 		session:   session,
 		logsColl:  session.DB(logsDB).C(logsC).With(session),
@@ -398,6 +414,7 @@ func NewLogTailer(st LogTailerState, params *LogTailerParams) (LogTailer, error)
 
 type logTailer struct {
 	tomb      tomb.Tomb
+	clock     clock.Clock
 	modelUUID string
 	session   *mgo.Session
 	logsColl  *mgo.Collection
@@ -438,6 +455,11 @@ func (t *logTailer) loop() error {
 	if t.params.NoTail {
 		return nil
 	}
+	if !t.params.EndTime.IsZero() && !t.clock.Now().Before(t.params.EndTime) {
+		// The end time has already passed, so logs recorded from
+		// now on can't fall before it and there is nothing to tail.
+		return nil
+	}
 
 	err = t.tailOplog()
 	return errors.Trace(err)
@@ -471,7 +493,15 @@ func (t *logTailer) processCollection() error {
 	// MongoDB's 32MB sort limit.  See https://pad.lv/1590605.
 	//
 	// TODO(ericsnow) Sort only by _id once it is a sequential int.
-	iter := query.Sort("e", "t", "_id").Iter()
+	//
+	// When tailing all models, records are merged in time order
+	// rather than grouped by model.
+	if t.params.AllModels {
+		query = query.Sort("t", "_id")
+	} else {
+		query = query.Sort("e", "t", "_id")
+	}
+	iter := query.Iter()
 	doc := new(logDoc)
 	for iter.Next(doc) {
 		rec, err := logDocToRecord(doc)
@@ -511,11 +541,20 @@ func (t *logTailer) tailOplog() error {
 	logger.Tracef("LogTailer starting oplog tailing: recent id count=%d, lastTime=%s, minOplogTs=%s",
 		recentIds.Length(), t.lastTime, minOplogTs)
 
+	// Tail until the end time, if any, has passed; the selector
+	// already excludes records stamped at or after it.
+	var endTimeReached <-chan time.Time
+	if !t.params.EndTime.IsZero() {
+		endTimeReached = t.clock.After(t.params.EndTime.Sub(t.clock.Now()))
+	}
+
 	skipCount := 0
 	for {
 		select {
 		case <-t.tomb.Dying():
 			return errors.Trace(tomb.ErrDying)
+		case <-endTimeReached:
+			return nil
 		case oplogDoc, ok := <-oplogTailer.Out():
 			if !ok {
 				return errors.Annotate(oplogTailer.Err(), "oplog tailer died")
@@ -550,11 +589,20 @@ func (t *logTailer) tailOplog() error {
 
 func (t *logTailer) paramsToSelector(params *LogTailerParams, prefix string) bson.D {
 	sel := bson.D{}
-	if !params.StartTime.IsZero() {
-		sel = append(sel, bson.DocElem{"t", bson.M{"$gte": params.StartTime.UnixNano()}})
+	if !params.StartTime.IsZero() || !params.EndTime.IsZero() {
+		timeSel := bson.M{}
+		if !params.StartTime.IsZero() {
+			timeSel["$gte"] = params.StartTime.UnixNano()
+		}
+		if !params.EndTime.IsZero() {
+			timeSel["$lt"] = params.EndTime.UnixNano()
+		}
+		sel = append(sel, bson.DocElem{"t", timeSel})
 	}
 	if !params.AllModels {
 		sel = append(sel, bson.DocElem{"e", t.modelUUID})
+	} else if len(params.ModelUUIDs) > 0 {
+		sel = append(sel, bson.DocElem{"e", bson.M{"$in": params.ModelUUIDs}})
 	}
 	if params.MinLevel > loggo.UNSPECIFIED {
 		sel = append(sel, bson.DocElem{"v", bson.M{"$gte": int(params.MinLevel)}})
@@ -575,6 +623,22 @@ func (t *logTailer) paramsToSelector(params *LogTailerParams, prefix string) bso
 		sel = append(sel,
 			bson.DocElem{"m", bson.M{"$not": bson.RegEx{Pattern: makeModulePattern(params.ExcludeModule)}}})
 	}
+	if len(params.IncludeMessage) > 0 {
+		sel = append(sel,
+			bson.DocElem{"x", bson.RegEx{Pattern: makeMessagePattern(params.IncludeMessage)}})
+	}
+	if len(params.ExcludeMessage) > 0 {
+		sel = append(sel,
+			bson.DocElem{"x", bson.M{"$not": bson.RegEx{Pattern: makeMessagePattern(params.ExcludeMessage)}}})
+	}
+	if len(params.IncludeLocation) > 0 {
+		sel = append(sel,
+			bson.DocElem{"l", bson.RegEx{Pattern: makeLocationPattern(params.IncludeLocation)}})
+	}
+	if len(params.ExcludeLocation) > 0 {
+		sel = append(sel,
+			bson.DocElem{"l", bson.M{"$not": bson.RegEx{Pattern: makeLocationPattern(params.ExcludeLocation)}}})
+	}
 	if prefix != "" {
 		for i, elem := range sel {
 			sel[i].Name = prefix + elem.Name
@@ -601,6 +665,33 @@ func makeModulePattern(modules []string) string {
 	return `^(` + strings.Join(patterns, "|") + `)(\..+)?$`
 }
 
+// makeMessagePattern returns a pattern which matches any message
+// matched by one of the supplied regular expressions. The expressions
+// are not anchored, so they match anywhere within a message.
+func makeMessagePattern(expressions []string) string {
+	var patterns []string
+	for _, expr := range expressions {
+		patterns = append(patterns, `(?:`+expr+`)`)
+	}
+	return strings.Join(patterns, "|")
+}
+
+// makeLocationPattern returns a pattern which matches the supplied
+// "filename:lineno" locations. A location without a line number
+// matches every line in the file, and * matches any sequence of
+// characters.
+func makeLocationPattern(locations []string) string {
+	var patterns []string
+	for _, location := range locations {
+		pattern := strings.Replace(regexp.QuoteMeta(location), `\*`, ".*", -1)
+		if !strings.Contains(location, ":") {
+			pattern += `(:\d+)?`
+		}
+		patterns = append(patterns, pattern)
+	}
+	return `^(` + strings.Join(patterns, "|") + `)$`
+}
+
 func newRecentIdTracker(maxLen int) *recentIdTracker {
 	return &recentIdTracker{
 		ids: deque.NewWithMaxLen(maxLen),
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/juju/loggo"
+	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/version"
 	gc "gopkg.in/check.v1"
@@ -161,6 +162,7 @@ func (s *LogsSuite) TestIndexesCreated(c *gc.C) {
 		"_id",     // default index
 		"e-t-_id", // model-uuid and timestamp
 		"e-n",     // model-uuid and entity
+		"t-_id",   // timestamp, for tailing all models
 	})
 }
 
@@ -467,6 +469,43 @@ func (s *LogTailerSuite) TestTailingLogsForAllModels(c *gc.C) {
 	s.checkLogTailerFiltering(c, s.State, &state.LogTailerParams{AllModels: true}, writeLogs, assert)
 }
 
+func (s *LogTailerSuite) TestTailingLogsForChosenModels(c *gc.C) {
+	wanted := logTemplate{ModelUUID: "someuuid0", Message: "good"}
+	writeLogs := func() {
+		s.writeLogs(c, 1, wanted)
+		s.writeLogs(c, 1, logTemplate{ModelUUID: "someuuid1", Message: "bad"})
+		s.writeLogs(c, 1, logTemplate{Message: "bad"})
+	}
+	params := &state.LogTailerParams{
+		AllModels:  true,
+		ModelUUIDs: []string{"someuuid0"},
+	}
+	assert := func(tailer state.LogTailer) {
+		s.assertTailer(c, tailer, 1, wanted)
+	}
+	s.checkLogTailerFiltering(c, s.State, params, writeLogs, assert)
+}
+
+func (s *LogTailerSuite) TestTailingLogsForAllModelsInTimeOrder(c *gc.C) {
+	t0 := coretesting.NonZeroTime()
+	first := logTemplate{ModelUUID: "someuuid1", Message: "first"}
+	second := logTemplate{ModelUUID: "someuuid0", Message: "second"}
+	third := logTemplate{ModelUUID: "someuuid1", Message: "third"}
+	s.writeLogsT(c, t0, t0, 1, first)
+	s.writeLogsT(c, t0.Add(time.Second), t0.Add(time.Second), 1, second)
+	s.writeLogsT(c, t0.Add(2*time.Second), t0.Add(2*time.Second), 1, third)
+
+	tailer, err := state.NewLogTailer(s.State, &state.LogTailerParams{
+		AllModels: true,
+		NoTail:    true,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer tailer.Stop()
+	s.assertTailer(c, tailer, 1, first)
+	s.assertTailer(c, tailer, 1, second)
+	s.assertTailer(c, tailer, 1, third)
+}
+
 func (s *LogTailerSuite) TestTailingLogsOnlyForControllerModel(c *gc.C) {
 	writeLogs := func() {
 		s.writeLogs(c, 1, logTemplate{
@@ -780,6 +819,125 @@ func (s *LogTailerSuite) TestIncludeExcludeModule(c *gc.C) {
 	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
 }
 
+func (s *LogTailerSuite) TestEndTime(c *gc.C) {
+	threshT := coretesting.NonZeroTime()
+	want := logTemplate{Message: "want"}
+	s.writeLogsT(c, threshT.Add(-5*time.Second), threshT.Add(-time.Millisecond), 5, want)
+	s.writeLogsT(c, threshT, threshT.Add(5*time.Second), 5, logTemplate{Message: "dont want"})
+
+	tailer, err := state.NewLogTailer(s.otherState, &state.LogTailerParams{
+		EndTime: threshT,
+		Oplog:   s.oplogColl,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer tailer.Stop()
+	s.assertTailer(c, tailer, 5, want)
+
+	// The tailer stops once the bounded query is complete.
+	select {
+	case _, ok := <-tailer.Logs():
+		if ok {
+			c.Fatal("shouldn't be any further logs")
+		}
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for logs channel to close")
+	}
+}
+
+func (s *LogTailerSuite) TestEndTimeInFuture(c *gc.C) {
+	threshT := coretesting.NonZeroTime().Add(time.Hour)
+	clock := jujutesting.NewClock(threshT.Add(-time.Minute))
+	want := logTemplate{Message: "want"}
+	s.writeLogsT(c, threshT.Add(-50*time.Minute), threshT.Add(-40*time.Minute), 5, want)
+
+	tailer, err := state.NewLogTailer(s.otherState, &state.LogTailerParams{
+		EndTime: threshT,
+		Oplog:   s.oplogColl,
+		Clock:   clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	defer tailer.Stop()
+	s.assertTailer(c, tailer, 5, want)
+
+	// Logs recorded before the end time are still tailed.
+	s.writeLogsT(c, threshT.Add(-30*time.Second), threshT.Add(-20*time.Second), 2, want)
+	s.assertTailer(c, tailer, 2, want)
+
+	// The tailer stops once the clock passes the end time.
+	err = clock.WaitAdvance(time.Minute, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	select {
+	case _, ok := <-tailer.Logs():
+		if ok {
+			c.Fatal("shouldn't be any further logs")
+		}
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("timed out waiting for logs channel to close")
+	}
+}
+
+func (s *LogTailerSuite) TestIncludeExcludeMessage(c *gc.C) {
+	started := logTemplate{Message: "started worker"}
+	stopped := logTemplate{Message: "stopped worker"}
+	failed := logTemplate{Message: "worker failed: boom"}
+	other := logTemplate{Message: "something else"}
+	writeLogs := func() {
+		s.writeLogs(c, 1, started)
+		s.writeLogs(c, 1, other)
+		s.writeLogs(c, 1, stopped)
+		s.writeLogs(c, 1, failed)
+	}
+	params := &state.LogTailerParams{
+		IncludeMessage: []string{"worker", "^something"},
+		ExcludeMessage: []string{"^st(art|opp)ed"},
+	}
+	assert := func(tailer state.LogTailer) {
+		s.assertTailer(c, tailer, 1, other)
+		s.assertTailer(c, tailer, 1, failed)
+	}
+	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
+}
+
+func (s *LogTailerSuite) TestIncludeLocation(c *gc.C) {
+	uniter10 := logTemplate{Location: "uniter.go:10"}
+	uniter20 := logTemplate{Location: "uniter.go:20"}
+	op10 := logTemplate{Location: "op.go:10"}
+	other := logTemplate{Location: "other.go:1"}
+	writeLogs := func() {
+		s.writeLogs(c, 1, uniter10)
+		s.writeLogs(c, 1, other)
+		s.writeLogs(c, 1, uniter20)
+		s.writeLogs(c, 1, op10)
+	}
+	params := &state.LogTailerParams{
+		IncludeLocation: []string{"uniter.go", "*.go:10"},
+	}
+	assert := func(tailer state.LogTailer) {
+		s.assertTailer(c, tailer, 1, uniter10)
+		s.assertTailer(c, tailer, 1, uniter20)
+		s.assertTailer(c, tailer, 1, op10)
+	}
+	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
+}
+
+func (s *LogTailerSuite) TestExcludeLocation(c *gc.C) {
+	uniter10 := logTemplate{Location: "uniter.go:10"}
+	uniter20 := logTemplate{Location: "uniter.go:20"}
+	other := logTemplate{Location: "other.go:1"}
+	writeLogs := func() {
+		s.writeLogs(c, 1, uniter10)
+		s.writeLogs(c, 1, other)
+		s.writeLogs(c, 1, uniter20)
+	}
+	params := &state.LogTailerParams{
+		ExcludeLocation: []string{"uniter.go:10", "other.go"},
+	}
+	assert := func(tailer state.LogTailer) {
+		s.assertTailer(c, tailer, 1, uniter20)
+	}
+	s.checkLogTailerFiltering(c, s.otherState, params, writeLogs, assert)
+}
+
 func (s *LogTailerSuite) checkLogTailerFiltering(
 	c *gc.C,
 	st *state.State,
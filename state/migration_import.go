@@ -884,6 +884,7 @@ func (i *importer) makeApplicationDoc(s description.Application) (*applicationDo
 		UnitCount:            len(s.Units()),
 		RelationCount:        i.relationCount(s.Name()),
 		Exposed:              s.Exposed(),
+		ExposedCIDRs:         s.ExposedCIDRs(),
 		MinUnits:             s.MinUnits(),
 		MetricCredentials:    s.MetricsCredentials(),
 	}, nil
@@ -1426,25 +1427,29 @@ func (i *importer) addStorageInstance(storage description.Storage) error {
 	doc := &storageInstanceDoc{
 		Id:              storage.Tag().Id(),
 		Kind:            kind,
-		Owner:           owner.String(),
 		StorageName:     storage.Name(),
 		AttachmentCount: len(attachments),
 	}
+	if owner != nil {
+		doc.Owner = owner.String()
+	}
 	ops = append(ops, txn.Op{
 		C:      storageInstancesC,
 		Id:     tag.Id(),
 		Assert: txn.DocMissing,
 		Insert: doc,
 	})
-
-	refcounts, closer := i.st.getCollection(refcountsC)
-	defer closer()
-	storageRefcountKey := entityStorageRefcountKey(owner, storage.Name())
-	incRefOp, err := nsRefcounts.CreateOrIncRefOp(refcounts, storageRefcountKey, 1)
-	if err != nil {
-		return errors.Trace(err)
+	// Detached storage doesn't count towards any entity's charm storage.
+	if owner != nil {
+		refcounts, closer := i.st.getCollection(refcountsC)
+		defer closer()
+		storageRefcountKey := entityStorageRefcountKey(owner, storage.Name())
+		incRefOp, err := nsRefcounts.CreateOrIncRefOp(refcounts, storageRefcountKey, 1)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		ops = append(ops, incRefOp)
 	}
-	ops = append(ops, incRefOp)
 
 	if err := i.st.runTransaction(ops); err != nil {
 		return errors.Trace(err)
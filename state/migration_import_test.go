@@ -327,7 +327,7 @@ func (s *MigrationImportSuite) TestApplications(c *gc.C) {
 	err = application.SetMetricCredentials([]byte("sekrit"))
 	c.Assert(err, jc.ErrorIsNil)
 	// Expose the application.
-	c.Assert(application.SetExposed(), jc.ErrorIsNil)
+	c.Assert(application.SetExposedCIDRs([]string{"10.0.0.0/8"}), jc.ErrorIsNil)
 	err = s.State.SetAnnotations(application, testAnnotations)
 	c.Assert(err, jc.ErrorIsNil)
 	s.primeStatusHistory(c, application, status.Active, 5)
@@ -348,6 +348,7 @@ func (s *MigrationImportSuite) TestApplications(c *gc.C) {
 	c.Assert(imported.ApplicationTag(), gc.Equals, exported.ApplicationTag())
 	c.Assert(imported.Series(), gc.Equals, exported.Series())
 	c.Assert(imported.IsExposed(), gc.Equals, exported.IsExposed())
+	c.Assert(imported.ExposedCIDRs(), jc.DeepEquals, exported.ExposedCIDRs())
 	c.Assert(imported.MetricCredentials(), jc.DeepEquals, exported.MetricCredentials())
 
 	exportedConfig, err := exported.ConfigSettings()
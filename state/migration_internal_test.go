@@ -75,6 +75,9 @@ func (s *MigrationSuite) TestKnownCollections(c *gc.C) {
 	)
 
 	ignoredCollections := set.NewStrings(
+		// Action schedules are not migrated; they need to be added
+		// again in the target model.
+		actionSchedulesC,
 		// Precheck ensures that there are no cleanup docs or pending
 		// machine removals.
 		cleanupsC,
@@ -360,6 +363,7 @@ func (s *MigrationSuite) TestApplicationDocFields(c *gc.C) {
 		"CharmModifiedVersion",
 		"ForceCharm",
 		"Exposed",
+		"ExposedCIDRs",
 		"MinUnits",
 		"MetricCredentials",
 	)
@@ -656,6 +660,9 @@ func (s *MigrationSuite) TestSSHHostKeyDocFields(c *gc.C) {
 func (s *MigrationSuite) TestActionDocFields(c *gc.C) {
 	ignored := set.NewStrings(
 		"ModelUUID",
+		// Progress messages are only of interest while the action
+		// runs, so they are not migrated.
+		"Logs",
 	)
 	migrated := set.NewStrings(
 		"DocId",
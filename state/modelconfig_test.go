@@ -188,6 +188,26 @@ func (s *ModelConfigSuite) TestUpdateModelConfigRejectsControllerConfig(c *gc.C)
 	c.Assert(err, gc.ErrorMatches, `cannot set controller attribute "api-port" on a model`)
 }
 
+func (s *ModelConfigSuite) TestUpdateModelConfigLogForwardSinks(c *gc.C) {
+	updateAttrs := map[string]interface{}{"logforward-sinks": "local: {type: file, path: fwd.log}\n"}
+	err := s.State.UpdateModelConfig(updateAttrs, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Each model has its own sinks.
+	st := s.Factory.MakeModel(c, nil)
+	defer st.Close()
+	hostedAttrs := map[string]interface{}{"logforward-sinks": "remote: {type: syslog-udp, host: 10.0.0.1:514}\n"}
+	err = st.UpdateModelConfig(hostedAttrs, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cfg, err := s.State.ModelConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AllAttrs()["logforward-sinks"], gc.Equals, updateAttrs["logforward-sinks"])
+	cfg, err = st.ModelConfig()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cfg.AllAttrs()["logforward-sinks"], gc.Equals, hostedAttrs["logforward-sinks"])
+}
+
 func (s *ModelConfigSuite) TestUpdateModelConfigRemoveInherited(c *gc.C) {
 	attrs := map[string]interface{}{
 		"apt-mirror":    "http://different-mirror", // controller
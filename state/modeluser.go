@@ -160,7 +160,12 @@ func removeModelUserOps(modelUUID string, user names.UserTag) []txn.Op {
 // removeModelUser removes a user from the database.
 func (st *State) removeModelUser(user names.UserTag) error {
 	ops := removeModelUserOps(st.ModelUUID(), user)
-	err := st.runTransaction(ops)
+	appOps, err := removeUserApplicationPermissionsOps(st, user)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	ops = append(ops, appOps...)
+	err = st.runTransaction(ops)
 	if err == txn.ErrAborted {
 		err = errors.NewNotFound(nil, fmt.Sprintf("model user %q does not exist", user.Id()))
 	}
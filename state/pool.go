@@ -158,3 +158,23 @@ func (p *StatePool) Close() error {
 	p.pool = make(map[string]*PoolItem)
 	return errors.Annotate(lastErr, "at least one error closing a state")
 }
+
+// Report returns the models whose State is held by the pool, along
+// with their reference counts, for use by the introspection worker.
+func (p *StatePool) Report() map[string]interface{} {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	models := make(map[string]interface{}, len(p.pool))
+	for modelUUID, item := range p.pool {
+		models[modelUUID] = map[string]interface{}{
+			"references": item.references,
+			"removing":   item.remove,
+		}
+	}
+	return map[string]interface{}{
+		"system-model": p.systemState.ModelUUID(),
+		"pool-size":    len(p.pool),
+		"models":       models,
+	}
+}
@@ -210,3 +210,29 @@ func (s *statePoolSuite) TestGetRemovedNotAllowed(c *gc.C) {
 	_, err = s.Pool.Get(s.ModelUUID1)
 	c.Assert(err, gc.ErrorMatches, fmt.Sprintf("model %v has been removed", s.ModelUUID1))
 }
+
+func (s *statePoolSuite) TestReport(c *gc.C) {
+	_, err := s.Pool.Get(s.ModelUUID1)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.Pool.Get(s.ModelUUID1)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.Pool.Get(s.ModelUUID2)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.Pool.Remove(s.ModelUUID2)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(s.Pool.Report(), jc.DeepEquals, map[string]interface{}{
+		"system-model": s.ModelUUID,
+		"pool-size":    2,
+		"models": map[string]interface{}{
+			s.ModelUUID1: map[string]interface{}{
+				"references": uint(2),
+				"removing":   false,
+			},
+			s.ModelUUID2: map[string]interface{}{
+				"references": uint(1),
+				"removing":   true,
+			},
+		},
+	})
+}
@@ -9,6 +9,7 @@ package presence
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -204,6 +205,10 @@ type reqAlive struct {
 	result chan bool
 }
 
+type reqReport struct {
+	result chan map[string]interface{}
+}
+
 func (w *Watcher) sendReq(req interface{}) {
 	select {
 	case w.request <- req:
@@ -256,6 +261,19 @@ func (w *Watcher) Alive(key string) (bool, error) {
 	return alive, nil
 }
 
+// Report returns the watcher's current view of which keys are alive
+// and which are being watched, for use by the introspection worker.
+func (w *Watcher) Report() map[string]interface{} {
+	result := make(chan map[string]interface{}, 1)
+	w.sendReq(reqReport{result})
+	select {
+	case report := <-result:
+		return report
+	case <-w.tomb.Dying():
+		return map[string]interface{}{"error": "watcher is dying"}
+	}
+}
+
 // period is the length of each time slot in seconds.
 // It's not a time.Duration because the code is more convenient like
 // this and also because sub-second timings don't work as the slot
@@ -354,11 +372,34 @@ func (w *Watcher) handle(req interface{}) {
 	case reqAlive:
 		_, alive := w.beingSeq[r.key]
 		r.result <- alive
+	case reqReport:
+		r.result <- w.report()
 	default:
 		panic(fmt.Errorf("unknown request: %T", req))
 	}
 }
 
+func (w *Watcher) report() map[string]interface{} {
+	alive := make([]string, 0, len(w.beingSeq))
+	for key := range w.beingSeq {
+		alive = append(alive, key)
+	}
+	sort.Strings(alive)
+	watches := make(map[string]int)
+	for key, chans := range w.watches {
+		if len(chans) > 0 {
+			watches[key] = len(chans)
+		}
+	}
+	return map[string]interface{}{
+		"model-uuid":     w.modelUUID,
+		"clock-delta":    w.delta.String(),
+		"alive":          alive,
+		"watches":        watches,
+		"pending-events": len(w.pending),
+	}
+}
+
 type beingInfo struct {
 	DocID     string `bson:"_id"`
 	Seq       int64  `bson:"seq,omitempty"`
@@ -130,6 +130,33 @@ func (s *PresenceSuite) TestAliveError(c *gc.C) {
 	w.Wait()
 }
 
+func (s *PresenceSuite) TestReport(c *gc.C) {
+	w := presence.NewWatcher(s.presence, s.modelTag)
+	pa := presence.NewPinger(s.presence, s.modelTag, "a")
+	defer assertStopped(c, w)
+	defer assertStopped(c, pa)
+
+	c.Assert(pa.Start(), gc.IsNil)
+	cha := make(chan presence.Change, 1)
+	w.Watch("a", cha)
+	w.Watch("b", make(chan presence.Change, 1))
+	w.Sync()
+	assertChange(c, cha, presence.Change{"a", true})
+
+	report := w.Report()
+	c.Check(report["model-uuid"], gc.Equals, s.modelTag.Id())
+	c.Check(report["alive"], jc.DeepEquals, []string{"a"})
+	c.Check(report["watches"], jc.DeepEquals, map[string]int{"a": 1, "b": 1})
+}
+
+func (s *PresenceSuite) TestReportDying(c *gc.C) {
+	w := presence.NewWatcher(s.presence, s.modelTag)
+	c.Assert(w.Stop(), gc.IsNil)
+
+	report := w.Report()
+	c.Check(report, jc.DeepEquals, map[string]interface{}{"error": "watcher is dying"})
+}
+
 func (s *PresenceSuite) TestWorkflow(c *gc.C) {
 	w := presence.NewWatcher(s.presence, s.modelTag)
 	pa := presence.NewPinger(s.presence, s.modelTag, "a")
@@ -356,6 +356,21 @@ func removeSettings(st *State, collection, key string) error {
 	return nil
 }
 
+// replaceSettings replaces the Settings for key with the supplied values.
+func replaceSettings(st *State, collection, key string, values map[string]interface{}) error {
+	op, _, err := replaceSettingsOp(st, collection, key, values)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = st.runTransaction([]txn.Op{op})
+	if err == txn.ErrAborted {
+		return errors.Errorf("cannot replace settings: concurrent settings change")
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
 func removeSettingsOp(collection, key string) txn.Op {
 	return txn.Op{
 		C:      collection,
@@ -482,6 +497,11 @@ func (s *StateSettings) RemoveSettings(key string) error {
 	return removeSettings(s.st, s.collection, key)
 }
 
+// ReplaceSettings exposes replaceSettings on state for use outside the state package.
+func (s *StateSettings) ReplaceSettings(key string, settings map[string]interface{}) error {
+	return replaceSettings(s.st, s.collection, key, settings)
+}
+
 // ListSettings exposes listSettings on state for use outside the state package.
 func (s *StateSettings) ListSettings(keyPrefix string) (map[string]map[string]interface{}, error) {
 	return listSettings(s.st, s.collection, keyPrefix)
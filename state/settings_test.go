@@ -243,6 +243,24 @@ func (s *SettingsSuite) TestReplaceSettingsEscape(c *gc.C) {
 	c.Assert(mgoData.Settings, gc.DeepEquals, mgoOptions)
 }
 
+func (s *SettingsSuite) TestReplaceSettings(c *gc.C) {
+	_, err := s.createSettings(s.key, map[string]interface{}{"alpha": "beta", "one": 1})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = replaceSettings(s.state, s.collection, s.key, map[string]interface{}{"alpha": "gamma"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	node, err := s.readSettings()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(node.Map(), gc.DeepEquals, map[string]interface{}{"alpha": "gamma"})
+}
+
+func (s *SettingsSuite) TestReplaceSettingsMissing(c *gc.C) {
+	err := replaceSettings(s.state, s.collection, s.key, map[string]interface{}{"alpha": "gamma"})
+	c.Assert(err, gc.ErrorMatches, "settings not found")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
 func (s *SettingsSuite) TestcreateSettingsEscape(c *gc.C) {
 	// Check that createSettings works as expected.
 	options := map[string]interface{}{"$baz": 1, "foo.bar": "beta"}
@@ -419,6 +419,40 @@ func (st *State) ApplicationLeaders() (map[string]string, error) {
 	return result, nil
 }
 
+// LeaseReport returns the current holders and expiry times of the
+// model's application leadership and singular controller leases, for
+// use by the introspection worker.
+func (st *State) LeaseReport() (map[string]interface{}, error) {
+	report := make(map[string]interface{})
+	for _, ns := range []struct {
+		name      string
+		getClient func() (lease.Client, error)
+	}{
+		{"application-leadership", st.getLeadershipLeaseClient},
+		{"singular-controller", st.getSingularLeaseClient},
+	} {
+		client, err := ns.getClient()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		leases := make(map[string]interface{})
+		for name, info := range client.Leases() {
+			leases[name] = map[string]interface{}{
+				"holder": info.Holder,
+				"expiry": info.Expiry.UTC().Format(time.RFC3339),
+			}
+		}
+		report[ns.name] = leases
+	}
+	return report, nil
+}
+
+// PresenceReport returns the state of the model's presence watcher,
+// for use by the introspection worker.
+func (st *State) PresenceReport() map[string]interface{} {
+	return st.workers.PresenceWatcher().Report()
+}
+
 func (st *State) getLeadershipLeaseClient() (lease.Client, error) {
 	client, err := statelease.NewClient(statelease.ClientConfig{
 		Id:         st.leaseClientId,
@@ -2134,6 +2168,22 @@ func (st *State) PutAuditEntryFn() func(audit.AuditEntry) error {
 	return stateaudit.PutAuditEntryFn(auditingC, insert)
 }
 
+// AuditEntries returns the audit entries recorded in the database
+// which match the supplied filter, oldest first.
+func (st *State) AuditEntries(filter audit.Filter) ([]audit.AuditEntry, error) {
+	find := func(collectionName string, query bson.D, limit int, docs interface{}) error {
+		collection, closeCollection := st.getCollection(collectionName)
+		defer closeCollection()
+
+		q := collection.Find(query).Sort("time", "_id")
+		if limit > 0 {
+			q = q.Limit(limit)
+		}
+		return errors.Trace(q.All(docs))
+	}
+	return stateaudit.QueryAuditEntriesFn(auditingC, find)(filter)
+}
+
 var tagPrefix = map[byte]string{
 	'm': names.MachineTagKind + "-",
 	'a': names.ApplicationTagKind + "-",
@@ -140,6 +140,21 @@ func (s *LeadershipSuite) TestApplicationLeaders(c *gc.C) {
 	})
 }
 
+func (s *LeadershipSuite) TestLeaseReport(c *gc.C) {
+	err := s.claimer.ClaimLeadership("application", "application/1", time.Minute)
+	c.Assert(err, jc.ErrorIsNil)
+
+	report, err := s.State.LeaseReport()
+	c.Assert(err, jc.ErrorIsNil)
+	leadership, ok := report["application-leadership"].(map[string]interface{})
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(leadership, gc.HasLen, 1)
+	info := leadership["application"].(map[string]interface{})
+	c.Check(info["holder"], gc.Equals, "application/1")
+	c.Check(info["expiry"], gc.Not(gc.Equals), "")
+	c.Check(report, gc.HasLen, 2)
+}
+
 func (s *LeadershipSuite) expire(c *gc.C, applicationname string) {
 	s.Clock.Advance(time.Hour)
 	s.Session.Fsync(false)
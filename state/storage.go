@@ -34,8 +34,10 @@ type StorageInstance interface {
 	Kind() StorageKind
 
 	// Owner returns the tag of the application or unit that owns this storage
-	// instance.
-	Owner() names.Tag
+	// instance, and a boolean indicating whether or not there is an owner.
+	// A storage instance that has been detached from its unit has no owner
+	// until it is attached to another unit.
+	Owner() (names.Tag, bool)
 
 	// StorageName returns the name of the storage, as defined in the charm
 	// storage metadata. This does not uniquely identify storage instances,
@@ -114,14 +116,17 @@ func (s *storageInstance) Kind() StorageKind {
 	return s.doc.Kind
 }
 
-func (s *storageInstance) Owner() names.Tag {
+func (s *storageInstance) Owner() (names.Tag, bool) {
+	if s.doc.Owner == "" {
+		return nil, false
+	}
 	tag, err := names.ParseTag(s.doc.Owner)
 	if err != nil {
 		// This should be impossible; we do not expose
-		// a means of modifying the owner tag.
+		// a means of setting an invalid owner tag.
 		panic(err)
 	}
-	return tag
+	return tag, true
 }
 
 func (s *storageInstance) StorageName() string {
@@ -147,9 +152,13 @@ type storageInstanceDoc struct {
 	Id              string      `bson:"id"`
 	Kind            StorageKind `bson:"storagekind"`
 	Life            Life        `bson:"life"`
-	Owner           string      `bson:"owner"`
+	Owner           string      `bson:"owner"` // empty if detached
 	StorageName     string      `bson:"storagename"`
 	AttachmentCount int         `bson:"attachmentcount"`
+
+	// SnapshotId is the provider ID of the snapshot from which the
+	// storage instance's volume is to be restored, if any.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 type storageAttachment struct {
@@ -264,7 +273,8 @@ func (st *State) destroyStorageInstanceOps(s *storageInstance) ([]txn.Op, error)
 		// remove the storage instance immediately.
 		hasNoAttachments := bson.D{{"attachmentcount", 0}}
 		assert := append(hasNoAttachments, isAliveDoc...)
-		return removeStorageInstanceOps(st, s.Owner(), s.StorageTag(), assert)
+		owner, _ := s.Owner()
+		return removeStorageInstanceOps(st, owner, s.StorageTag(), assert)
 	}
 	// There are still attachments: the storage instance will be removed
 	// when the last attachment is removed. We schedule a cleanup to destroy
@@ -287,7 +297,8 @@ func (st *State) destroyStorageInstanceOps(s *storageInstance) ([]txn.Op, error)
 }
 
 // removeStorageInstanceOps removes the storage instance with the given
-// tag from state, if the specified assertions hold true.
+// tag from state, if the specified assertions hold true. The owner tag
+// is nil if the storage instance is detached.
 func removeStorageInstanceOps(
 	st *State,
 	owner names.Tag,
@@ -337,6 +348,12 @@ func removeStorageInstanceOps(
 		return nil, errors.Trace(err)
 	}
 
+	if owner == nil {
+		// Detached storage instances do not count towards
+		// the charm storage of any entity.
+		return ops, nil
+	}
+
 	// Decrement the charm storage reference count.
 	refcounts, closer := st.getCollection(refcountsC)
 	defer closer()
@@ -469,6 +486,7 @@ func createStorageOps(
 				Kind:        kind,
 				Owner:       owner,
 				StorageName: t.storageName,
+				SnapshotId:  t.cons.snapshotId,
 			}
 			var machineOps []txn.Op
 			if unitTag, ok := entityTag.(names.UnitTag); ok {
@@ -523,7 +541,7 @@ func unitAssignedMachineStorageOps(
 	charmMeta *charm.Meta,
 	cons map[string]StorageConstraints,
 	series string,
-	storage StorageInstance,
+	storage *storageInstance,
 	machineAssignable machineAssignable,
 ) (ops []txn.Op, err error) {
 	storageParams, err := machineStorageParamsForStorageInstance(
@@ -691,6 +709,310 @@ func destroyStorageAttachmentOps(storage names.StorageTag, unit names.UnitTag) [
 	return ops
 }
 
+// DetachStorage ensures that the storage instance will be detached from
+// the unit at some point. Unlike DestroyStorageAttachment, the storage
+// instance is not removed along with its attachment: it loses its owner,
+// and once the attachment has been removed its volume is detached from
+// the unit's machine. A detached storage instance outlives the unit, and
+// may be attached to another unit with AttachStorage.
+//
+// Only block storage, and filesystem storage backed by a volume, can be
+// detached, and only if the volume may outlive its machine.
+func (st *State) DetachStorage(storage names.StorageTag, unit names.UnitTag) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot detach storage %s from unit %s", storage.Id(), unit.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		s, err := st.storageAttachment(storage, unit)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if s.doc.Life != Alive {
+			return nil, jujutxn.ErrNoOperations
+		}
+		si, err := st.storageInstance(storage)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if si.doc.Life != Alive {
+			return nil, errors.New("storage is not alive")
+		}
+		if si.doc.Owner != unit.String() {
+			return nil, errors.NotSupportedf("detaching storage that is not owned by the unit")
+		}
+		if err := st.validateStorageDetachable(si); err != nil {
+			return nil, errors.Trace(err)
+		}
+		u, err := st.Unit(unit.Id())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		charmMeta, ops, err := st.unitCharmMetaOps(u)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		countOp, count, err := st.countEntityStorageInstances(unit, si.doc.StorageName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if charmStorage, ok := charmMeta.Storage[si.doc.StorageName]; ok && count <= charmStorage.CountMin {
+			return nil, errors.Errorf(
+				"charm requires at least %d %q storage instance(s)",
+				charmStorage.CountMin, si.doc.StorageName,
+			)
+		}
+		countOp.Update = bson.D{{"$inc", bson.D{{"refcount", -1}}}}
+		ops = append(ops, countOp, txn.Op{
+			C:      storageInstancesC,
+			Id:     si.doc.Id,
+			Assert: bson.D{{"life", Alive}, {"owner", unit.String()}},
+			Update: bson.D{{"$set", bson.D{{"owner", ""}}}},
+		})
+		return append(ops, destroyStorageAttachmentOps(storage, unit)...), nil
+	}
+	return st.run(buildTxn)
+}
+
+// validateStorageDetachable returns an error if the storage instance
+// cannot be detached from its unit and attached to another.
+func (st *State) validateStorageDetachable(si *storageInstance) error {
+	var volumeTag names.VolumeTag
+	switch si.doc.Kind {
+	case StorageKindBlock:
+		volume, err := st.storageInstanceVolume(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return errors.Errorf("storage %s has no volume", si.doc.Id)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		volumeTag = volume.VolumeTag()
+	case StorageKindFilesystem:
+		filesystem, err := st.storageInstanceFilesystem(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return errors.Errorf("storage %s has no filesystem", si.doc.Id)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		volumeTag, err = filesystem.Volume()
+		if errors.Cause(err) == ErrNoBackingVolume {
+			return errors.NotSupportedf("detaching filesystem storage that is not backed by a volume")
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		return errors.NotSupportedf("detaching %s storage", si.doc.Kind)
+	}
+	machineBound, err := isVolumeInherentlyMachineBound(st, volumeTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if machineBound {
+		return errors.Errorf("volume %s cannot outlive its machine", volumeTag.Id())
+	}
+	return nil
+}
+
+// detachStorageOps returns txn.Ops to detach the volume or filesystem of
+// the storage instance from the machine that the unit is assigned to, if
+// it is attached. The volume backing a filesystem is detached once the
+// filesystem attachment has been removed.
+func (st *State) detachStorageOps(si *storageInstance, unit names.UnitTag) ([]txn.Op, error) {
+	u, err := st.Unit(unit.Id())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machineId, err := u.AssignedMachineId()
+	if errors.IsNotAssigned(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machine := names.NewMachineTag(machineId)
+	if si.doc.Kind == StorageKindFilesystem {
+		filesystem, err := st.storageInstanceFilesystem(si.StorageTag())
+		if errors.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		attachment, err := st.FilesystemAttachment(machine, filesystem.FilesystemTag())
+		if errors.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if attachment.Life() != Alive {
+			return nil, nil
+		}
+		return detachFilesystemOps(machine, filesystem.FilesystemTag()), nil
+	}
+	volume, err := st.storageInstanceVolume(si.StorageTag())
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	attachment, err := st.VolumeAttachment(machine, volume.VolumeTag())
+	if errors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if attachment.Life() != Alive {
+		return nil, nil
+	}
+	return detachVolumeOps(machine, volume.VolumeTag()), nil
+}
+
+// AttachStorage attaches the detached storage instance to the unit, which
+// then owns it. If the unit is assigned to a machine, the storage instance's
+// volume will be attached to the machine; otherwise it will be attached when
+// the unit is assigned to a machine.
+func (st *State) AttachStorage(storage names.StorageTag, unit names.UnitTag) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot attach storage %s to unit %s", storage.Id(), unit.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		u, err := st.Unit(unit.Id())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if u.Life() != Alive {
+			return nil, unitNotAliveErr
+		}
+		si, err := st.storageInstance(storage)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if si.doc.Life != Alive {
+			return nil, errors.New("storage is not alive")
+		}
+		if owner, ok := si.Owner(); ok {
+			return nil, errors.Errorf("storage is attached to %s", names.ReadableString(owner))
+		}
+		if si.doc.AttachmentCount > 0 {
+			return nil, errors.New("storage is still being detached")
+		}
+
+		charmMeta, ops, err := st.unitCharmMetaOps(u)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		charmStorage, ok := charmMeta.Storage[si.doc.StorageName]
+		if !ok {
+			return nil, errors.NotFoundf("charm storage %q", si.doc.StorageName)
+		}
+		kind := StorageKindFilesystem
+		if charmStorage.Type == charm.StorageBlock {
+			kind = StorageKindBlock
+		}
+		if kind != si.doc.Kind {
+			return nil, errors.Errorf(
+				"charm storage %q is %s storage, not %s",
+				si.doc.StorageName, kind, si.doc.Kind,
+			)
+		}
+		countOp, count, err := st.countEntityStorageInstances(unit, si.doc.StorageName)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if charmStorage.CountMax >= 0 && count >= charmStorage.CountMax {
+			return nil, errors.Errorf(
+				"charm allows at most %d %q storage instance(s)",
+				charmStorage.CountMax, si.doc.StorageName,
+			)
+		}
+		if countOp.Assert == txn.DocMissing {
+			key := entityStorageRefcountKey(unit, si.doc.StorageName)
+			countOp = nsRefcounts.JustCreateOp(refcountsC, key, 1)
+		} else {
+			countOp.Update = bson.D{{"$inc", bson.D{{"refcount", 1}}}}
+		}
+		ops = append(ops, countOp, txn.Op{
+			C:      storageInstancesC,
+			Id:     si.doc.Id,
+			Assert: bson.D{{"life", Alive}, {"owner", ""}, {"attachmentcount", 0}},
+			Update: bson.D{
+				{"$set", bson.D{{"owner", unit.String()}}},
+				{"$inc", bson.D{{"attachmentcount", 1}}},
+			},
+		}, createStorageAttachmentOp(storage, unit), txn.Op{
+			C:      unitsC,
+			Id:     u.doc.DocID,
+			Assert: isAliveDoc,
+			Update: bson.D{{"$inc", bson.D{{"storageattachmentcount", 1}}}},
+		})
+
+		si.doc.Owner = unit.String()
+		machineOps, err := unitAssignedMachineStorageOps(
+			st, unit, charmMeta, nil, u.Series(), si, u,
+		)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return append(ops, machineOps...), nil
+	}
+	return st.run(buildTxn)
+}
+
+// ReleaseStorageInstance removes the detached storage instance, and
+// its volume, from the model without destroying the volume. The volume
+// is left in the cloud, no longer managed by Juju.
+func (st *State) ReleaseStorageInstance(tag names.StorageTag) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot release storage %q", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		s, err := st.storageInstance(tag)
+		if errors.IsNotFound(err) {
+			return nil, jujutxn.ErrNoOperations
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if s.doc.Owner != "" || s.doc.AttachmentCount > 0 {
+			return nil, errors.New("storage is attached; detach it before releasing it")
+		}
+		ops := []txn.Op{{
+			C:      storageInstancesC,
+			Id:     s.doc.Id,
+			Assert: bson.D{{"owner", ""}, {"attachmentcount", 0}},
+			Remove: true,
+		}}
+		filesystem, err := st.storageInstanceFilesystem(tag)
+		if err == nil {
+			if filesystem.doc.AttachmentCount > 0 {
+				return nil, errors.Errorf("filesystem %s is still attached", filesystem.doc.FilesystemId)
+			}
+			// The filesystem is just data on its backing volume,
+			// so it is removed from state and the volume released.
+			ops = append(ops, txn.Op{
+				C:      filesystemsC,
+				Id:     filesystem.doc.FilesystemId,
+				Assert: bson.D{{"attachmentcount", 0}},
+				Remove: true,
+			}, removeStatusOp(st, filesystem.globalKey()))
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Trace(err)
+		}
+		volume, err := st.storageInstanceVolume(tag)
+		if errors.IsNotFound(err) {
+			return ops, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if volume.doc.AttachmentCount > 0 {
+			return nil, errors.Errorf("volume %s is still attached", volume.doc.Name)
+		}
+		// The volume is marked Dead without its provisioning info, so
+		// that the storage provisioner removes it from state without
+		// destroying it.
+		return append(ops, txn.Op{
+			C:      volumesC,
+			Id:     volume.doc.Name,
+			Assert: bson.D{{"life", Alive}, {"attachmentcount", 0}},
+			Update: bson.D{
+				{"$set", bson.D{{"life", Dead}, {"storageid", ""}}},
+				{"$unset", bson.D{{"info", nil}}},
+			},
+		}), nil
+	}
+	return st.run(buildTxn)
+}
+
 // Remove removes the storage attachment from state, and may remove its storage
 // instance as well, if the storage instance is Dying and no other references to
 // it exist. It will fail if the storage attachment is not Dying.
@@ -750,8 +1072,9 @@ func removeStorageAttachmentOps(
 			// Either the storage instance is dying, or its owner
 			// is a unit; in either case, no more attachments can
 			// be added to the instance, so it can be removed.
+			owner, _ := si.Owner()
 			siOps, err := removeStorageInstanceOps(
-				st, si.Owner(), si.StorageTag(), hasLastRef,
+				st, owner, si.StorageTag(), hasLastRef,
 			)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -773,6 +1096,16 @@ func removeStorageAttachmentOps(
 			{"life", Alive},
 			{"attachmentcount", bson.D{{"$gt", 0}}},
 		}
+		if si.doc.Owner == "" {
+			// The storage instance is being detached from the
+			// unit, so detach its volume or filesystem from the
+			// unit's machine.
+			detachOps, err := st.detachStorageOps(si, names.NewUnitTag(s.doc.Unit))
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			ops = append(ops, detachOps...)
+		}
 	} else {
 		// If it's not the last reference when we checked, we want to
 		// allow for concurrent attachment removals but want to ensure
@@ -829,6 +1162,12 @@ type StorageConstraints struct {
 
 	// Count is the required number of storage instances.
 	Count uint64 `bson:"count"`
+
+	// snapshotId is the provider ID of the snapshot from which the
+	// storage instances' volumes are to be restored. It is only ever
+	// set when adding storage to a unit, and is not persisted with
+	// the constraints.
+	snapshotId string
 }
 
 func createStorageConstraintsOp(key string, cons map[string]StorageConstraints) txn.Op {
@@ -1025,6 +1364,94 @@ func poolStorageProvider(st *State, poolName string) (storage.ProviderType, stor
 	return providerType, provider, nil
 }
 
+// StoragePoolInUse reports whether the storage pool with the specified
+// name is referenced by the model's default block storage source, or by
+// any storage constraints, volumes or filesystems in the model.
+func (st *State) StoragePoolInUse(name string) (bool, error) {
+	cfg, err := st.ModelConfig()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	if source, ok := cfg.StorageDefaultBlockSource(); ok && source == name {
+		return true, nil
+	}
+
+	usesPool := bson.D{{"$or", []bson.D{
+		{{"params.pool", name}},
+		{{"info.pool", name}},
+	}}}
+	for _, collection := range []string{volumesC, filesystemsC} {
+		coll, closer := st.getCollection(collection)
+		n, err := coll.Find(usesPool).Count()
+		closer()
+		if err != nil {
+			return false, errors.Annotatef(err, "cannot count %s in pool %q", collection, name)
+		}
+		if n > 0 {
+			return true, nil
+		}
+	}
+
+	coll, closer := st.getCollection(storageConstraintsC)
+	defer closer()
+	var doc storageConstraintsDoc
+	iter := coll.Find(nil).Iter()
+	for iter.Next(&doc) {
+		for _, cons := range doc.Constraints {
+			if cons.Pool == name {
+				iter.Close()
+				return true, nil
+			}
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return false, errors.Annotate(err, "cannot read storage constraints")
+	}
+	return false, nil
+}
+
+// RemoveStoragePool removes the storage pool with the specified name,
+// returning an error satisfying errors.IsNotFound if there is no such
+// pool. The pool is removed only if StoragePoolInUse reports that it
+// is not in use; the transaction asserts that the pool settings and
+// the model's default block storage source are unchanged since that
+// check was made.
+func (st *State) RemoveStoragePool(name string) error {
+	key := poolmanager.GlobalKey(name)
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		pool, err := readSettings(st, settingsC, key)
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundf("pool %q", name)
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		inUse, err := st.StoragePoolInUse(name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if inUse {
+			return nil, errors.Errorf("storage pool %q is in use", name)
+		}
+		return []txn.Op{{
+			C:      settingsC,
+			Id:     key,
+			Assert: bson.D{{"version", pool.version}},
+			Remove: true,
+		}, {
+			C:  settingsC,
+			Id: modelGlobalKey,
+			Assert: bson.D{{
+				"settings." + config.StorageDefaultBlockSourceKey,
+				bson.D{{"$ne", name}},
+			}},
+		}}, nil
+	}
+	if err := st.run(buildTxn); err != nil {
+		return errors.Annotatef(err, "removing storage pool %q", name)
+	}
+	return nil
+}
+
 // ErrNoDefaultStoragePool is returned when a storage pool is required but none
 // is specified nor available as a default.
 var ErrNoDefaultStoragePool = fmt.Errorf("no storage pool specifed and no default available")
@@ -1159,6 +1586,26 @@ func (st *State) AddStorageForUnit(
 	return nil
 }
 
+// AddStorageForUnitFromSnapshot adds a single storage instance to the
+// given unit, whose volume will be restored from the snapshot with the
+// specified provider ID rather than created empty. Filesystem storage
+// may only be restored if the filesystem is managed by Juju on a volume.
+// The constraints' pool must identify the storage provider that created
+// the snapshot.
+func (st *State) AddStorageForUnitFromSnapshot(
+	tag names.UnitTag, name string, cons StorageConstraints, snapshotId string,
+) error {
+	if snapshotId == "" {
+		return errors.NotValidf("empty snapshot ID")
+	}
+	if cons.Count > 1 {
+		return errors.NotValidf("adding %d storage instances from one snapshot", cons.Count)
+	}
+	cons.Count = 1
+	cons.snapshotId = snapshotId
+	return st.AddStorageForUnit(tag, name, cons)
+}
+
 // addStorage adds storage instances to given unit as specified.
 func (st *State) addStorageForUnitOps(
 	u *Unit,
@@ -1169,33 +1616,11 @@ func (st *State) addStorageForUnitOps(
 		return nil, unitNotAliveErr
 	}
 
-	// Storage addition is based on the charm metadata, so make sure that
-	// the charm URL for the unit or application does not change during
-	// the transaction. If the unit does not have a charm URL set yet,
-	// then we use the application's charm URL.
-	ops := []txn.Op{{
-		C:      unitsC,
-		Id:     u.doc.Name,
-		Assert: bson.D{{"charmurl", u.doc.CharmURL}},
-	}}
-	curl, ok := u.CharmURL()
-	if !ok {
-		a, err := u.Application()
-		if err != nil {
-			return nil, errors.Annotatef(err, "getting application for unit %v", u.doc.Name)
-		}
-		curl = a.doc.CharmURL
-		ops = append(ops, txn.Op{
-			C:      applicationsC,
-			Id:     a.doc.Name,
-			Assert: bson.D{{"charmurl", curl}},
-		})
-	}
-	ch, err := st.Charm(curl)
+	// Storage addition is based on the charm metadata.
+	charmMeta, ops, err := st.unitCharmMetaOps(u)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	charmMeta := ch.Meta()
 	charmStorageMeta, ok := charmMeta.Storage[storageName]
 	if !ok {
 		return nil, errors.NotFoundf("charm storage %q", storageName)
@@ -1215,6 +1640,20 @@ func (st *State) addStorageForUnitOps(
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if cons.snapshotId != "" && charmStorageMeta.Type == charm.StorageFilesystem {
+		// Only a volume can be restored from a snapshot, so the
+		// filesystem must be one that Juju manages on a volume.
+		_, provider, err := poolStorageProvider(st, completeCons.Pool)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if provider.Supports(storage.StorageKindFilesystem) {
+			return nil, errors.NotSupportedf(
+				"restoring filesystem storage %q from a snapshot with pool %q",
+				storageName, completeCons.Pool,
+			)
+		}
+	}
 
 	// This can happen for charm stores that specify instances range from 0,
 	// and no count was specified at deploy as storage constraints for this store,
@@ -1231,6 +1670,36 @@ func (st *State) addStorageForUnitOps(
 	return ops, nil
 }
 
+// unitCharmMetaOps returns the metadata of the charm that the unit is
+// running, along with txn.Ops that ensure that the charm URL for the unit
+// or application does not change during the transaction. If the unit does
+// not have a charm URL set yet, then we use the application's charm URL.
+func (st *State) unitCharmMetaOps(u *Unit) (*charm.Meta, []txn.Op, error) {
+	ops := []txn.Op{{
+		C:      unitsC,
+		Id:     u.doc.Name,
+		Assert: bson.D{{"charmurl", u.doc.CharmURL}},
+	}}
+	curl, ok := u.CharmURL()
+	if !ok {
+		a, err := u.Application()
+		if err != nil {
+			return nil, nil, errors.Annotatef(err, "getting application for unit %v", u.doc.Name)
+		}
+		curl = a.doc.CharmURL
+		ops = append(ops, txn.Op{
+			C:      applicationsC,
+			Id:     a.doc.Name,
+			Assert: bson.D{{"charmurl", curl}},
+		})
+	}
+	ch, err := st.Charm(curl)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return ch.Meta(), ops, nil
+}
+
 // addUnitStorageOps returns transaction ops to create storage for the given
 // unit. If countMin is non-negative, the Count field of the constraints will
 // be ignored, and as many storage instances as necessary to make up the
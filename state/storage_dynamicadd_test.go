@@ -344,3 +344,92 @@ func (s *storageAddSuite) TestAddStorageStatic(c *gc.C) {
 	s.assertFileSystemCount(c, 1) // no change
 	assertMachineStorageRefs(c, s.State, s.machineTag)
 }
+
+func (s *storageAddSuite) assertVolumeSnapshotId(c *gc.C, snapshotId string) {
+	volumes, err := s.State.AllVolumes()
+	c.Assert(err, jc.ErrorIsNil)
+	var restored []state.Volume
+	for _, v := range volumes {
+		params, ok := v.Params()
+		c.Assert(ok, jc.IsTrue)
+		if params.SnapshotId != "" {
+			c.Assert(params.SnapshotId, gc.Equals, snapshotId)
+			restored = append(restored, v)
+		}
+	}
+	c.Assert(restored, gc.HasLen, 1)
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshot(c *gc.C) {
+	u := s.setupMultipleStoragesForAdd(c)
+	s.assignUnit(c, u)
+
+	err := s.State.AddStorageForUnitFromSnapshot(
+		s.unitTag, "multi1to10", makeStorageCons("loop-pool", 1024, 0), "snap-1",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStorageCount(c, s.originalStorageCount+1)
+	s.assertVolumeCount(c, s.originalVolumeCount+1)
+	s.assertVolumeSnapshotId(c, "snap-1")
+	assertMachineStorageRefs(c, s.State, s.machineTag)
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshotNotAssigned(c *gc.C) {
+	u := s.setupMultipleStoragesForAdd(c)
+
+	err := s.State.AddStorageForUnitFromSnapshot(
+		s.unitTag, "multi1to10", makeStorageCons("loop-pool", 1024, 1), "snap-1",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStorageCount(c, s.originalStorageCount+1)
+
+	// The snapshot ID is carried over to the volume when
+	// the unit is assigned to a machine.
+	s.assignUnit(c, u)
+	s.assertVolumeSnapshotId(c, "snap-1")
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshotWithCount(c *gc.C) {
+	s.setupMultipleStoragesForAdd(c)
+	err := s.State.AddStorageForUnitFromSnapshot(
+		s.unitTag, "multi1to10", makeStorageCons("loop-pool", 1024, 2), "snap-1",
+	)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, "adding 2 storage instances from one snapshot not valid")
+	s.assertStorageCount(c, s.originalStorageCount)
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshotEmptyId(c *gc.C) {
+	s.setupMultipleStoragesForAdd(c)
+	err := s.State.AddStorageForUnitFromSnapshot(
+		s.unitTag, "multi1to10", makeStorageCons("loop-pool", 1024, 1), "",
+	)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	s.assertStorageCount(c, s.originalStorageCount)
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshotFilesystem(c *gc.C) {
+	_, u, _ := s.setupSingleStorage(c, "filesystem", "loop-pool")
+	s.assignUnit(c, u)
+	err := s.State.AddStorageForUnitFromSnapshot(
+		u.UnitTag(), "data", makeStorageCons("loop-pool", 1024, 1), "snap-1",
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertStorageCount(c, 2)
+	s.assertFileSystemCount(c, 2)
+
+	// The snapshot ID is carried over to the filesystem's
+	// backing volume.
+	s.assertVolumeSnapshotId(c, "snap-1")
+}
+
+func (s *storageAddSuite) TestAddStorageFromSnapshotFilesystemNotVolumeBacked(c *gc.C) {
+	_, u, _ := s.setupSingleStorage(c, "filesystem", "environscoped")
+	err := s.State.AddStorageForUnitFromSnapshot(
+		u.UnitTag(), "data", makeStorageCons("environscoped", 1024, 1), "snap-1",
+	)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+	c.Assert(err, gc.ErrorMatches, "adding storage to unit storage-filesystem/0: "+
+		`restoring filesystem storage "data" from a snapshot with pool "environscoped" not supported`)
+	s.assertStorageCount(c, 1) // no change
+}
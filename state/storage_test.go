@@ -546,7 +546,9 @@ func (s *StorageStateSuite) TestAllStorageInstances(c *gc.C) {
 	for _, one := range all {
 		c.Assert(one.Kind(), gc.DeepEquals, state.StorageKindBlock)
 		c.Assert(nameSet.Contains(one.StorageName()), jc.IsTrue)
-		c.Assert(ownerSet.Contains(one.Owner().String()), jc.IsTrue)
+		owner, ok := one.Owner()
+		c.Assert(ok, jc.IsTrue)
+		c.Assert(ownerSet.Contains(owner.String()), jc.IsTrue)
 	}
 }
 
@@ -580,6 +582,94 @@ func (s *StorageStateSuite) TestAllStorageInstancesEmpty(c *gc.C) {
 	c.Assert(all, gc.HasLen, 0)
 }
 
+func (s *StorageStateSuite) TestStoragePoolInUseNotUsed(c *gc.C) {
+	inUse, err := s.State.StoragePoolInUse("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsFalse)
+}
+
+func (s *StorageStateSuite) TestStoragePoolInUseStorageConstraints(c *gc.C) {
+	ch := s.AddTestingCharm(c, "storage-block")
+	storage := map[string]state.StorageConstraints{
+		"data": makeStorageCons("persistent-block", 1024, 1),
+	}
+	s.AddTestingServiceWithStorage(c, "storage-block", ch, storage)
+
+	inUse, err := s.State.StoragePoolInUse("persistent-block")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsTrue)
+	inUse, err = s.State.StoragePoolInUse("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsFalse)
+}
+
+func (s *StorageStateSuite) TestStoragePoolInUseVolume(c *gc.C) {
+	_, err := s.State.AddOneMachine(state.MachineTemplate{
+		Series: "quantal",
+		Jobs:   []state.MachineJob{state.JobHostUnits},
+		Volumes: []state.MachineVolumeParams{{
+			Volume: state.VolumeParams{Pool: "persistent-block", Size: 1024},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	inUse, err := s.State.StoragePoolInUse("persistent-block")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsTrue)
+	inUse, err = s.State.StoragePoolInUse("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsFalse)
+}
+
+func (s *StorageStateSuite) TestStoragePoolInUseModelDefault(c *gc.C) {
+	err := s.State.UpdateModelConfig(map[string]interface{}{
+		"storage-default-block-source": "loop-pool",
+	}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	inUse, err := s.State.StoragePoolInUse("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsTrue)
+	inUse, err = s.State.StoragePoolInUse("persistent-block")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(inUse, jc.IsFalse)
+}
+
+func (s *StorageStateSuite) TestRemoveStoragePool(c *gc.C) {
+	err := s.State.RemoveStoragePool("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+	pm := poolmanager.New(state.NewStateSettings(s.State), dummy.StorageProviders())
+	_, err = pm.Get("loop-pool")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+}
+
+func (s *StorageStateSuite) TestRemoveStoragePoolNotFound(c *gc.C) {
+	err := s.State.RemoveStoragePool("notfound")
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `removing storage pool "notfound": pool "notfound" not found`)
+}
+
+func (s *StorageStateSuite) TestRemoveStoragePoolInUse(c *gc.C) {
+	s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.RemoveStoragePool("loop-pool")
+	c.Assert(err, gc.ErrorMatches, `removing storage pool "loop-pool": storage pool "loop-pool" is in use`)
+}
+
+func (s *StorageStateSuite) TestRemoveStoragePoolModelDefaultSetConcurrently(c *gc.C) {
+	defer state.SetBeforeHooks(c, s.State, func() {
+		err := s.State.UpdateModelConfig(map[string]interface{}{
+			"storage-default-block-source": "loop-pool",
+		}, nil, nil)
+		c.Assert(err, jc.ErrorIsNil)
+	}).Check()
+
+	err := s.State.RemoveStoragePool("loop-pool")
+	c.Assert(err, gc.ErrorMatches, `removing storage pool "loop-pool": storage pool "loop-pool" is in use`)
+	pm := poolmanager.New(state.NewStateSettings(s.State), dummy.StorageProviders())
+	_, err = pm.Get("loop-pool")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *StorageStateSuite) TestUnitEnsureDead(c *gc.C) {
 	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
 	// destroying a unit with storage attachments is fine; this is what
@@ -795,6 +885,241 @@ func (s *StorageStateSuite) TestDestroyUnitStorageAttachments(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+// setupDetachableStorage adds a unit of an application with detachable
+// "allecto" block storage to a new machine, and returns the unit and
+// the allecto storage instance's tag.
+func (s *StorageStateSuite) setupDetachableStorage(c *gc.C, pool string) (*state.Application, *state.Unit, names.StorageTag) {
+	ch := s.AddTestingCharm(c, "storage-block")
+	storage := map[string]state.StorageConstraints{
+		"data":    makeStorageCons("environscoped", 1024, 1),
+		"allecto": makeStorageCons(pool, 1024, 1),
+	}
+	app := s.AddTestingServiceWithStorage(c, "storage-block", ch, storage)
+	u, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = u.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+
+	attachments, err := s.State.UnitStorageAttachments(u.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+	for _, a := range attachments {
+		si, err := s.State.StorageInstance(a.StorageInstance())
+		c.Assert(err, jc.ErrorIsNil)
+		if si.StorageName() == "allecto" {
+			return app, u, a.StorageInstance()
+		}
+	}
+	c.Fatalf("no allecto storage attached to %s", u.Name())
+	return nil, nil, names.StorageTag{}
+}
+
+// detachStorage detaches the storage instance from the unit, and removes
+// the attachment and the volume attachment.
+func (s *StorageStateSuite) detachStorage(c *gc.C, storageTag names.StorageTag, u *state.Unit) {
+	err := s.State.DetachStorage(storageTag, u.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.RemoveStorageAttachment(storageTag, u.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	machineId, err := u.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	volume := s.storageInstanceVolume(c, storageTag)
+	attachment := s.volumeAttachment(c, names.NewMachineTag(machineId), volume.VolumeTag())
+	c.Assert(attachment.Life(), gc.Equals, state.Dying)
+	err = s.State.RemoveVolumeAttachment(names.NewMachineTag(machineId), volume.VolumeTag())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *StorageStateSuite) TestDetachStorage(c *gc.C) {
+	_, u, storageTag := s.setupDetachableStorage(c, "environscoped")
+	s.detachStorage(c, storageTag, u)
+
+	si, err := s.State.StorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(si.Life(), gc.Equals, state.Alive)
+	_, ok := si.Owner()
+	c.Assert(ok, jc.IsFalse)
+
+	// The detached storage instance outlives the unit.
+	s.obliterateUnit(c, u.UnitTag())
+	c.Assert(s.storageInstanceExists(c, storageTag), jc.IsTrue)
+	volume := s.storageInstanceVolume(c, storageTag)
+	c.Assert(volume.Life(), gc.Equals, state.Alive)
+}
+
+func (s *StorageStateSuite) TestDetachStorageRequiredByCharm(c *gc.C) {
+	_, u, _ := s.setupDetachableStorage(c, "environscoped")
+	err := s.State.DetachStorage(names.NewStorageTag("data/0"), u.UnitTag())
+	c.Assert(err, gc.ErrorMatches, `cannot detach storage data/0 from unit storage-block/0: charm requires at least 1 "data" storage instance\(s\)`)
+}
+
+func (s *StorageStateSuite) TestDetachStorageMachineBound(c *gc.C) {
+	_, u, storageTag := s.setupDetachableStorage(c, "loop-pool")
+	err := s.State.DetachStorage(storageTag, u.UnitTag())
+	c.Assert(err, gc.ErrorMatches, `cannot detach storage .* from unit storage-block/0: volume .* cannot outlive its machine`)
+}
+
+func (s *StorageStateSuite) TestAttachStorage(c *gc.C) {
+	app, u, storageTag := s.setupDetachableStorage(c, "environscoped")
+	s.detachStorage(c, storageTag, u)
+
+	u2, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = u2.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.AttachStorage(storageTag, u2.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	si, err := s.State.StorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	owner, ok := si.Owner()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(owner, gc.Equals, u2.Tag())
+	_, err = s.State.StorageAttachment(storageTag, u2.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The volume is attached to the new unit's machine.
+	machineId, err := u2.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	volume := s.storageInstanceVolume(c, storageTag)
+	s.volumeAttachment(c, names.NewMachineTag(machineId), volume.VolumeTag())
+}
+
+func (s *StorageStateSuite) TestAttachStorageAttached(c *gc.C) {
+	app, _, storageTag := s.setupDetachableStorage(c, "environscoped")
+	u2, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.AttachStorage(storageTag, u2.UnitTag())
+	c.Assert(err, gc.ErrorMatches, `cannot attach storage .* to unit storage-block/1: storage is attached to unit storage-block/0`)
+}
+
+func (s *StorageStateSuite) TestReleaseStorageInstance(c *gc.C) {
+	_, u, storageTag := s.setupDetachableStorage(c, "environscoped")
+	s.detachStorage(c, storageTag, u)
+	volumeTag := s.storageInstanceVolume(c, storageTag).VolumeTag()
+
+	err := s.State.ReleaseStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.storageInstanceExists(c, storageTag), jc.IsFalse)
+
+	// The volume is left for the storage provisioner to remove from
+	// state, without destroying it.
+	volume := s.volume(c, volumeTag)
+	c.Assert(volume.Life(), gc.Equals, state.Dead)
+	_, err = volume.StorageInstance()
+	c.Assert(err, jc.Satisfies, errors.IsNotAssigned)
+}
+
+func (s *StorageStateSuite) TestReleaseStorageInstanceAttached(c *gc.C) {
+	_, _, storageTag := s.setupDetachableStorage(c, "environscoped")
+	err := s.State.ReleaseStorageInstance(storageTag)
+	c.Assert(err, gc.ErrorMatches, `cannot release storage ".*": storage is attached; detach it before releasing it`)
+}
+
+// setupDetachableFilesystemStorage adds a unit of an application with two
+// "data" filesystems from the given pool to a new machine, and returns
+// the unit and the tag of one of the storage instances.
+func (s *StorageStateSuite) setupDetachableFilesystemStorage(c *gc.C, pool string) (*state.Application, *state.Unit, names.StorageTag) {
+	ch := s.AddTestingCharm(c, "storage-filesystem")
+	storage := map[string]state.StorageConstraints{
+		"data": makeStorageCons(pool, 1024, 2),
+	}
+	app := s.AddTestingServiceWithStorage(c, "storage-filesystem", ch, storage)
+	u, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = u.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+	return app, u, names.NewStorageTag("data/1")
+}
+
+// detachFilesystemStorage detaches the storage instance from the unit,
+// and removes the attachment, the filesystem attachment and the volume
+// attachment, checking that the filesystem is detached before its
+// volume.
+func (s *StorageStateSuite) detachFilesystemStorage(c *gc.C, storageTag names.StorageTag, u *state.Unit) {
+	err := s.State.DetachStorage(storageTag, u.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.RemoveStorageAttachment(storageTag, u.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	machineId, err := u.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	machineTag := names.NewMachineTag(machineId)
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	volumeTag, err := filesystem.Volume()
+	c.Assert(err, jc.ErrorIsNil)
+	fsAttachment := s.filesystemAttachment(c, machineTag, filesystem.FilesystemTag())
+	c.Assert(fsAttachment.Life(), gc.Equals, state.Dying)
+	volumeAttachment := s.volumeAttachment(c, machineTag, volumeTag)
+	c.Assert(volumeAttachment.Life(), gc.Equals, state.Alive)
+
+	err = s.State.RemoveFilesystemAttachment(machineTag, filesystem.FilesystemTag())
+	c.Assert(err, jc.ErrorIsNil)
+	volumeAttachment = s.volumeAttachment(c, machineTag, volumeTag)
+	c.Assert(volumeAttachment.Life(), gc.Equals, state.Dying)
+	err = s.State.RemoveVolumeAttachment(machineTag, volumeTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *StorageStateSuite) TestDetachFilesystemStorage(c *gc.C) {
+	_, u, storageTag := s.setupDetachableFilesystemStorage(c, "environscoped-block")
+	s.detachFilesystemStorage(c, storageTag, u)
+
+	si, err := s.State.StorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok := si.Owner()
+	c.Assert(ok, jc.IsFalse)
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	c.Assert(filesystem.Life(), gc.Equals, state.Alive)
+	volumeTag, err := filesystem.Volume()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.volume(c, volumeTag).Life(), gc.Equals, state.Alive)
+}
+
+func (s *StorageStateSuite) TestDetachFilesystemStorageNotVolumeBacked(c *gc.C) {
+	_, u, storageTag := s.setupDetachableFilesystemStorage(c, "environscoped")
+	err := s.State.DetachStorage(storageTag, u.UnitTag())
+	c.Assert(err, gc.ErrorMatches, `cannot detach storage data/1 from unit storage-filesystem/0: detaching filesystem storage that is not backed by a volume not supported`)
+}
+
+func (s *StorageStateSuite) TestAttachFilesystemStorage(c *gc.C) {
+	app, u, storageTag := s.setupDetachableFilesystemStorage(c, "environscoped-block")
+	s.detachFilesystemStorage(c, storageTag, u)
+
+	u2, err := app.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = u2.AssignToNewMachine()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.AttachStorage(storageTag, u2.UnitTag())
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The existing filesystem, and the volume backing it, are attached
+	// to the new unit's machine.
+	machineId, err := u2.AssignedMachineId()
+	c.Assert(err, jc.ErrorIsNil)
+	machineTag := names.NewMachineTag(machineId)
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	s.filesystemAttachment(c, machineTag, filesystem.FilesystemTag())
+	volumeTag, err := filesystem.Volume()
+	c.Assert(err, jc.ErrorIsNil)
+	s.volumeAttachment(c, machineTag, volumeTag)
+}
+
+func (s *StorageStateSuite) TestReleaseFilesystemStorageInstance(c *gc.C) {
+	_, u, storageTag := s.setupDetachableFilesystemStorage(c, "environscoped-block")
+	s.detachFilesystemStorage(c, storageTag, u)
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	volumeTag, err := filesystem.Volume()
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ReleaseStorageInstance(storageTag)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(s.storageInstanceExists(c, storageTag), jc.IsFalse)
+	_, err = s.State.Filesystem(filesystem.FilesystemTag())
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(s.volume(c, volumeTag).Life(), gc.Equals, state.Dead)
+}
+
 func (s *StorageStateSuite) TestStorageLocationConflictIdentical(c *gc.C) {
 	s.testStorageLocationConflict(
 		c, "/srv", "/srv",
@@ -1775,7 +1775,7 @@ func unitMachineStorageParams(u *Unit) (*machineStorageParams, error) {
 	volumeAttachments := make(map[names.VolumeTag]VolumeAttachmentParams)
 	filesystemAttachments := make(map[names.FilesystemTag]FilesystemAttachmentParams)
 	for _, storageAttachment := range storageAttachments {
-		storage, err := u.st.StorageInstance(storageAttachment.StorageInstance())
+		storage, err := u.st.storageInstance(storageAttachment.StorageInstance())
 		if err != nil {
 			return nil, errors.Annotatef(err, "getting storage instance")
 		}
@@ -1815,7 +1815,7 @@ func machineStorageParamsForStorageInstance(
 	unit names.UnitTag,
 	series string,
 	allCons map[string]StorageConstraints,
-	storage StorageInstance,
+	storage *storageInstance,
 ) (*machineStorageParams, error) {
 
 	charmStorage := charmMeta.Storage[storage.StorageName()]
@@ -1830,28 +1830,31 @@ func machineStorageParamsForStorageInstance(
 		volumeAttachmentParams := VolumeAttachmentParams{
 			charmStorage.ReadOnly,
 		}
-		if unit == storage.Owner() {
+		volume, err := st.storageInstanceVolume(storage.StorageTag())
+		if err == nil {
+			// The storage instance already has a volume, either
+			// because it is shared by the application's units or
+			// because it was detached from another unit, so we
+			// will just add an attachment.
+			volumeAttachments[volume.VolumeTag()] = volumeAttachmentParams
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Annotatef(err, "getting volume for storage %q", storage.Tag().Id())
+		} else if owner, _ := storage.Owner(); owner == unit {
 			// The storage instance is owned by the unit, so we'll need
 			// to create a volume.
 			cons := allCons[storage.StorageName()]
 			volumeParams := VolumeParams{
-				storage: storage.StorageTag(),
-				binding: storage.StorageTag(),
-				Pool:    cons.Pool,
-				Size:    cons.Size,
+				storage:    storage.StorageTag(),
+				binding:    storage.StorageTag(),
+				Pool:       cons.Pool,
+				Size:       cons.Size,
+				SnapshotId: storage.doc.SnapshotId,
 			}
 			volumes = append(volumes, MachineVolumeParams{
 				volumeParams, volumeAttachmentParams,
 			})
 		} else {
-			// The storage instance is owned by the service, so there
-			// should be a (shared) volume already, for which we will
-			// just add an attachment.
-			volume, err := st.StorageInstanceVolume(storage.StorageTag())
-			if err != nil {
-				return nil, errors.Annotatef(err, "getting volume for storage %q", storage.Tag().Id())
-			}
-			volumeAttachments[volume.VolumeTag()] = volumeAttachmentParams
+			return nil, errors.NotFoundf("volume for storage %q", storage.Tag().Id())
 		}
 	case StorageKindFilesystem:
 		location, err := filesystemMountPoint(charmStorage, storage.StorageTag(), series)
@@ -1866,28 +1869,31 @@ func machineStorageParamsForStorageInstance(
 			location,
 			charmStorage.ReadOnly,
 		}
-		if unit == storage.Owner() {
+		filesystem, err := st.storageInstanceFilesystem(storage.StorageTag())
+		if err == nil {
+			// The storage instance already has a filesystem, either
+			// because it is shared by the application's units or
+			// because it was detached from another unit, so we
+			// will just add an attachment.
+			filesystemAttachments[filesystem.FilesystemTag()] = filesystemAttachmentParams
+		} else if !errors.IsNotFound(err) {
+			return nil, errors.Annotatef(err, "getting filesystem for storage %q", storage.Tag().Id())
+		} else if owner, _ := storage.Owner(); owner == unit {
 			// The storage instance is owned by the unit, so we'll need
 			// to create a filesystem.
 			cons := allCons[storage.StorageName()]
 			filesystemParams := FilesystemParams{
-				storage: storage.StorageTag(),
-				binding: storage.StorageTag(),
-				Pool:    cons.Pool,
-				Size:    cons.Size,
+				storage:    storage.StorageTag(),
+				binding:    storage.StorageTag(),
+				Pool:       cons.Pool,
+				Size:       cons.Size,
+				SnapshotId: storage.doc.SnapshotId,
 			}
 			filesystems = append(filesystems, MachineFilesystemParams{
 				filesystemParams, filesystemAttachmentParams,
 			})
 		} else {
-			// The storage instance is owned by the service, so there
-			// should be a (shared) filesystem already, for which we will
-			// just add an attachment.
-			filesystem, err := st.StorageInstanceFilesystem(storage.StorageTag())
-			if err != nil {
-				return nil, errors.Annotatef(err, "getting filesystem for storage %q", storage.Tag().Id())
-			}
-			filesystemAttachments[filesystem.FilesystemTag()] = filesystemAttachmentParams
+			return nil, errors.NotFoundf("filesystem for storage %q", storage.Tag().Id())
 		}
 	default:
 		return nil, errors.Errorf("invalid storage kind %v", storage.Kind())
@@ -46,6 +46,11 @@ type Volume interface {
 	// if it has not already been provisioned. Params returns true if the
 	// returned parameters are usable for provisioning, otherwise false.
 	Params() (VolumeParams, bool)
+
+	// PendingSize returns the size, in MiB, that the volume has been
+	// requested to grow to. PendingSize returns true if a resize has
+	// been requested and not yet completed, otherwise false.
+	PendingSize() (uint64, bool)
 }
 
 // VolumeAttachment describes an attachment of a volume to a machine.
@@ -91,6 +96,11 @@ type volumeDoc struct {
 	Binding         string        `bson:"binding,omitempty"`
 	Info            *VolumeInfo   `bson:"info,omitempty"`
 	Params          *VolumeParams `bson:"params,omitempty"`
+
+	// PendingSize is the size, in MiB, that the volume has been
+	// requested to grow to. It is unset once the volume's info
+	// records a size at least this large.
+	PendingSize uint64 `bson:"pendingsize,omitempty"`
 }
 
 // volumeAttachmentDoc records information about a volume attachment.
@@ -117,6 +127,10 @@ type VolumeParams struct {
 
 	Pool string `bson:"pool"`
 	Size uint64 `bson:"size"`
+
+	// SnapshotId is the provider ID of the snapshot from which the
+	// volume is to be restored, if any.
+	SnapshotId string `bson:"snapshotid,omitempty"`
 }
 
 // VolumeInfo describes information about a volume.
@@ -236,6 +250,14 @@ func (v *volume) Params() (VolumeParams, bool) {
 	return *v.doc.Params, true
 }
 
+// PendingSize is required to implement Volume.
+func (v *volume) PendingSize() (uint64, bool) {
+	if v.doc.PendingSize == 0 {
+		return 0, false
+	}
+	return v.doc.PendingSize, true
+}
+
 // Status is required to implement StatusGetter.
 func (v *volume) Status() (status.StatusInfo, error) {
 	return v.st.VolumeStatus(v.VolumeTag())
@@ -963,7 +985,7 @@ func (st *State) SetVolumeInfo(tag names.VolumeTag, info VolumeInfo) (err error)
 		// If the volume has parameters, unset them when
 		// we set info for the first time, ensuring that
 		// params and info are mutually exclusive.
-		var unsetParams bool
+		var unsetParams, unsetPendingSize bool
 		var ops []txn.Op
 		if params, ok := v.Params(); ok {
 			info.Pool = params.Pool
@@ -977,8 +999,13 @@ func (st *State) SetVolumeInfo(tag names.VolumeTag, info VolumeInfo) (err error)
 			if err := validateVolumeInfoChange(info, oldInfo); err != nil {
 				return nil, err
 			}
+			// Once the volume has grown to at least the requested
+			// size, the resize is complete.
+			if size, ok := v.PendingSize(); ok && info.Size >= size {
+				unsetPendingSize = true
+			}
 		}
-		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams)...)
+		ops = append(ops, setVolumeInfoOps(tag, info, unsetParams, unsetPendingSize)...)
 		return ops, nil
 	}
 	return st.run(buildTxn)
@@ -1000,15 +1027,22 @@ func validateVolumeInfoChange(newInfo, oldInfo VolumeInfo) error {
 	return nil
 }
 
-func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams bool) []txn.Op {
+func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams, unsetPendingSize bool) []txn.Op {
 	asserts := isAliveDoc
 	update := bson.D{
 		{"$set", bson.D{{"info", &info}}},
 	}
+	var unset bson.D
 	if unsetParams {
 		asserts = append(asserts, bson.DocElem{"info", bson.D{{"$exists", false}}})
 		asserts = append(asserts, bson.DocElem{"params", bson.D{{"$exists", true}}})
-		update = append(update, bson.DocElem{"$unset", bson.D{{"params", nil}}})
+		unset = append(unset, bson.DocElem{"params", nil})
+	}
+	if unsetPendingSize {
+		unset = append(unset, bson.DocElem{"pendingsize", nil})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.DocElem{"$unset", unset})
 	}
 	return []txn.Op{{
 		C:      volumesC,
@@ -1018,6 +1052,79 @@ func setVolumeInfoOps(tag names.VolumeTag, info VolumeInfo, unsetParams bool) []
 	}}
 }
 
+// ResizeStorageInstance requests that the volume backing the specified
+// storage instance be grown to the specified size, in MiB. For
+// filesystem-kind storage, the volume backing the filesystem is grown,
+// and the filesystem is then grown to fill it.
+func (st *State) ResizeStorageInstance(tag names.StorageTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot resize storage %q", tag.Id())
+	s, err := st.storageInstance(tag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var volumeTag names.VolumeTag
+	switch s.Kind() {
+	case StorageKindBlock:
+		v, err := st.storageInstanceVolume(tag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		volumeTag = v.VolumeTag()
+	case StorageKindFilesystem:
+		f, err := st.storageInstanceFilesystem(tag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		volumeTag, err = f.Volume()
+		if err == ErrNoBackingVolume {
+			return errors.NotSupportedf("resizing filesystem not backed by a volume")
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+	default:
+		return errors.Errorf("invalid storage kind %v", s.Kind())
+	}
+	return st.ResizeVolume(volumeTag, size)
+}
+
+// ResizeVolume requests that the provisioned volume with the specified
+// tag be grown to the specified size, in MiB. The volume is resized by
+// the storage provisioner responsible for it; volumes cannot be shrunk.
+func (st *State) ResizeVolume(tag names.VolumeTag, size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "cannot resize volume %q", tag.Id())
+	buildTxn := func(attempt int) ([]txn.Op, error) {
+		v, err := st.volumeByTag(tag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if v.Life() != Alive {
+			return nil, errors.New("volume is not alive")
+		}
+		info, err := v.Info()
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if size <= info.Size {
+			return nil, errors.NotValidf(
+				"size %dMiB, not larger than current size %dMiB",
+				size, info.Size,
+			)
+		}
+		if pending, ok := v.PendingSize(); ok && pending == size {
+			return nil, jujutxn.ErrNoOperations
+		}
+		return []txn.Op{{
+			C:  volumesC,
+			Id: tag.Id(),
+			Assert: append(bson.D{
+				{"info.size", info.Size},
+			}, isAliveDoc...),
+			Update: bson.D{{"$set", bson.D{{"pendingsize", size}}}},
+		}}, nil
+	}
+	return st.run(buildTxn)
+}
+
 // AllVolumes returns all Volumes scoped to the model.
 func (st *State) AllVolumes() ([]Volume, error) {
 	volumes, err := st.volumes(nil)
@@ -237,6 +237,93 @@ func (s *VolumeStateSuite) TestSetVolumeInfoImmutable(c *gc.C) {
 	s.assertVolumeInfo(c, volumeTag, volumeInfoSet)
 }
 
+func (s *VolumeStateSuite) TestResizeStorageInstance(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volume := s.storageInstanceVolume(c, storageTag)
+	volumeTag := volume.VolumeTag()
+
+	volumeInfoSet := state.VolumeInfo{Size: 123, VolumeId: "vol-ume"}
+	err = s.State.SetVolumeInfo(volumeTag, volumeInfoSet)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ResizeStorageInstance(storageTag, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+	size, ok := s.volume(c, volumeTag).PendingSize()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(size, gc.Equals, uint64(1024))
+
+	// Recording a smaller size leaves the resize pending.
+	volumeInfoSet.Pool = "loop-pool"
+	volumeInfoSet.Size = 512
+	err = s.State.SetVolumeInfo(volumeTag, volumeInfoSet)
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok = s.volume(c, volumeTag).PendingSize()
+	c.Assert(ok, jc.IsTrue)
+
+	// Recording the requested size completes the resize.
+	volumeInfoSet.Size = 1024
+	err = s.State.SetVolumeInfo(volumeTag, volumeInfoSet)
+	c.Assert(err, jc.ErrorIsNil)
+	_, ok = s.volume(c, volumeTag).PendingSize()
+	c.Assert(ok, jc.IsFalse)
+	s.assertVolumeInfo(c, volumeTag, volumeInfoSet)
+}
+
+func (s *VolumeStateSuite) TestResizeStorageInstanceFilesystem(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "filesystem", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	filesystem := s.storageInstanceFilesystem(c, storageTag)
+	volume := s.filesystemVolume(c, filesystem.FilesystemTag())
+
+	err = s.State.SetVolumeInfo(volume.VolumeTag(), state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ResizeStorageInstance(storageTag, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+	size, ok := s.volume(c, volume.VolumeTag()).PendingSize()
+	c.Assert(ok, jc.IsTrue)
+	c.Assert(size, gc.Equals, uint64(1024))
+}
+
+func (s *VolumeStateSuite) TestResizeStorageInstanceNoBackingVolume(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "filesystem", "rootfs")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ResizeStorageInstance(storageTag, 1024)
+	c.Assert(err, gc.ErrorMatches, `cannot resize storage "data/0": resizing filesystem not backed by a volume not supported`)
+	c.Assert(err, jc.Satisfies, errors.IsNotSupported)
+}
+
+func (s *VolumeStateSuite) TestResizeVolumeNotProvisioned(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volume := s.storageInstanceVolume(c, storageTag)
+
+	err = s.State.ResizeVolume(volume.VolumeTag(), 1024)
+	c.Assert(err, gc.ErrorMatches, `cannot resize volume "0/0": volume "0/0" not provisioned`)
+	c.Assert(err, jc.Satisfies, errors.IsNotProvisioned)
+}
+
+func (s *VolumeStateSuite) TestResizeVolumeNotLarger(c *gc.C) {
+	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
+	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+	volume := s.storageInstanceVolume(c, storageTag)
+	err = s.State.SetVolumeInfo(volume.VolumeTag(), state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.State.ResizeVolume(volume.VolumeTag(), 123)
+	c.Assert(err, gc.ErrorMatches, `cannot resize volume "0/0": size 123MiB, not larger than current size 123MiB not valid`)
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	_, ok := s.volume(c, volume.VolumeTag()).PendingSize()
+	c.Assert(ok, jc.IsFalse)
+}
+
 func (s *VolumeStateSuite) TestWatchVolumeAttachment(c *gc.C) {
 	_, u, storageTag := s.setupSingleStorage(c, "block", "loop-pool")
 	err := s.State.AssignUnit(u, state.AssignCleanEmpty)
@@ -375,6 +462,36 @@ func (s *VolumeStateSuite) TestWatchMachineVolumes(c *gc.C) {
 	wc.AssertNoChange()
 }
 
+func (s *VolumeStateSuite) TestWatchMachineVolumeResizes(c *gc.C) {
+	service := s.setupMixedScopeStorageService(c, "block")
+	u, err := service.AddUnit()
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.State.AssignUnit(u, state.AssignCleanEmpty)
+	c.Assert(err, jc.ErrorIsNil)
+
+	w := s.State.WatchMachineVolumeResizes(names.NewMachineTag("0"))
+	defer testing.AssertStop(c, w)
+	wc := testing.NewStringsWatcherC(c, s.State, w)
+	wc.AssertChangeInSingleEvent("0/1", "0/2") // initial
+	wc.AssertNoChange()
+
+	volumeTag := names.NewVolumeTag("0/1")
+	err = s.State.SetVolumeInfo(volumeTag, state.VolumeInfo{Size: 123, VolumeId: "vol-ume"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent("0/1")
+	wc.AssertNoChange()
+
+	err = s.State.ResizeVolume(volumeTag, 1024)
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertChangeInSingleEvent("0/1")
+	wc.AssertNoChange()
+
+	// Model-scoped volumes are not reported.
+	err = s.State.SetVolumeInfo(names.NewVolumeTag("0"), state.VolumeInfo{Size: 123, VolumeId: "vol-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	wc.AssertNoChange()
+}
+
 func (s *VolumeStateSuite) TestWatchMachineVolumeAttachments(c *gc.C) {
 	service := s.setupMixedScopeStorageService(c, "block")
 	addUnit := func(to *state.Machine) (u *state.Unit, m *state.Machine) {
@@ -4,6 +4,7 @@
 package state
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -276,6 +277,40 @@ func (st *State) watchMachineStorage(m names.MachineTag, collection string) Stri
 	return newLifecycleWatcher(st, collection, members, filter, nil)
 }
 
+// WatchModelVolumeResizes returns a StringsWatcher that notifies of
+// changes to any model-scoped volume, so that the storage provisioner
+// may grow volumes that have a pending resize.
+func (st *State) WatchModelVolumeResizes() StringsWatcher {
+	return newcollectionWatcher(st, colWCfg{
+		col: volumesC,
+		filter: func(id interface{}) bool {
+			k, err := st.strictLocalID(id.(string))
+			if err != nil {
+				return false
+			}
+			return !strings.Contains(k, "/")
+		},
+	})
+}
+
+// WatchMachineVolumeResizes returns a StringsWatcher that notifies of
+// changes to any volume scoped to the specified machine, so that the
+// machine's storage provisioner may grow volumes that have a pending
+// resize.
+func (st *State) WatchMachineVolumeResizes(m names.MachineTag) StringsWatcher {
+	prefix := m.Id() + "/"
+	return newcollectionWatcher(st, colWCfg{
+		col: volumesC,
+		filter: func(id interface{}) bool {
+			k, err := st.strictLocalID(id.(string))
+			if err != nil {
+				return false
+			}
+			return strings.HasPrefix(k, prefix)
+		},
+	})
+}
+
 // WatchEnvironVolumeAttachments returns a StringsWatcher that notifies of
 // changes to the lifecycles of all volume attachments related to environ-
 // scoped volumes.
@@ -1346,6 +1381,11 @@ func (u *Unit) Watch() NotifyWatcher {
 	return newEntityWatcher(u.st, unitsC, u.doc.DocID)
 }
 
+// Watch returns a watcher for observing changes to an action.
+func (a *action) Watch() NotifyWatcher {
+	return newEntityWatcher(a.st, actionsC, a.doc.DocId)
+}
+
 // Watch returns a watcher for observing changes to an model.
 func (e *Model) Watch() NotifyWatcher {
 	return newEntityWatcher(e.st, modelsC, e.doc.UUID)
@@ -1402,6 +1442,12 @@ func (st *State) WatchFilesystemAttachment(m names.MachineTag, f names.Filesyste
 	return newEntityWatcher(st, filesystemAttachmentsC, st.docID(id))
 }
 
+// WatchFilesystem returns a watcher for observing changes to a
+// filesystem.
+func (st *State) WatchFilesystem(f names.FilesystemTag) NotifyWatcher {
+	return newEntityWatcher(st, filesystemsC, st.docID(f.Id()))
+}
+
 // WatchConfigSettings returns a watcher for observing changes to the
 // unit's service configuration settings. The unit must have a charm URL
 // set before this method is called, and the returned watcher will be
@@ -2254,7 +2300,119 @@ func (st *State) WatchActionResults() StringsWatcher {
 // that notifies on new ActionResults being added for the ActionRecevers
 // being watched.
 func (st *State) WatchActionResultsFilteredBy(receivers ...ActionReceiver) StringsWatcher {
-	return newActionStatusWatcher(st, receivers, []ActionStatus{ActionCompleted, ActionCancelled, ActionFailed}...)
+	return newActionStatusWatcher(st, receivers, []ActionStatus{ActionCompleted, ActionCancelled, ActionFailed, ActionAborted}...)
+}
+
+// WatchActionLogs starts and returns a StringsWatcher that notifies on
+// new progress messages logged by the action with the given id. Each
+// message is a JSON-encoded ActionMessage; the first event holds all
+// the messages logged so far.
+func (st *State) WatchActionLogs(actionId string) StringsWatcher {
+	return newActionLogsWatcher(st, actionId)
+}
+
+// actionLogsWatcher notifies of progress messages logged by an action.
+type actionLogsWatcher struct {
+	commonWatcher
+	docId string
+	out   chan []string
+}
+
+var _ Watcher = (*actionLogsWatcher)(nil)
+
+func newActionLogsWatcher(st *State, actionId string) StringsWatcher {
+	w := &actionLogsWatcher{
+		commonWatcher: newCommonWatcher(st),
+		docId:         st.docID(actionId),
+		out:           make(chan []string),
+	}
+	go func() {
+		defer w.tomb.Done()
+		defer close(w.out)
+		w.tomb.Kill(w.loop())
+	}()
+	return w
+}
+
+// Changes returns the event channel for w.
+func (w *actionLogsWatcher) Changes() <-chan []string {
+	return w.out
+}
+
+// messages returns the action's messages logged after the first seen,
+// JSON-encoded, and the number of messages logged so far. Only the most
+// recent messages are kept, so any older ones that were dropped before
+// they were seen are skipped.
+func (w *actionLogsWatcher) messages(seen int) ([]string, int, error) {
+	actions, closer := w.st.getCollection(actionsC)
+	defer closer()
+
+	var doc actionDoc
+	err := actions.FindId(w.docId).One(&doc)
+	if err == mgo.ErrNotFound {
+		return nil, 0, errors.NotFoundf("action %q", w.st.localID(w.docId))
+	} else if err != nil {
+		return nil, 0, errors.Trace(err)
+	}
+	count := doc.LogCount
+	if count < len(doc.Logs) {
+		// The action was started before messages were counted.
+		count = len(doc.Logs)
+	}
+	from := len(doc.Logs) - (count - seen)
+	if from < 0 {
+		from = 0
+	}
+	result := []string{}
+	for i := from; i < len(doc.Logs); i++ {
+		msg := doc.Logs[i]
+		msg.Timestamp = msg.Timestamp.UTC()
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return nil, 0, errors.Trace(err)
+		}
+		result = append(result, string(data))
+	}
+	return result, count, nil
+}
+
+func (w *actionLogsWatcher) loop() error {
+	actions, closer := w.st.getCollection(actionsC)
+	revno, err := getTxnRevno(actions, w.docId)
+	closer()
+	if err != nil {
+		return err
+	}
+	in := make(chan watcher.Change)
+	w.watcher.Watch(actionsC, w.docId, revno, in)
+	defer w.watcher.Unwatch(actionsC, w.docId, in)
+
+	changes, seen, err := w.messages(0)
+	if err != nil {
+		return err
+	}
+	out := w.out
+	for {
+		select {
+		case <-w.watcher.Dead():
+			return stateWatcherDeadError(w.watcher.Err())
+		case <-w.tomb.Dying():
+			return tomb.ErrDying
+		case <-in:
+			messages, count, err := w.messages(seen)
+			if err != nil {
+				return err
+			}
+			seen = count
+			if len(messages) > 0 {
+				changes = append(changes, messages...)
+				out = w.out
+			}
+		case out <- changes:
+			changes = nil
+			out = nil
+		}
+	}
 }
 
 // openedPortsWatcher notifies of changes in the openedPorts
@@ -90,6 +90,9 @@ type PresenceWatcher interface {
 	Alive(key string) (bool, error)
 	Watch(key string, ch chan<- presence.Change)
 	Unwatch(key string, ch chan<- presence.Change)
+
+	// Report describes the watcher's state, for introspection.
+	Report() map[string]interface{}
 }
 
 // PresenceWorker includes the presence.Watcher's worker.Worker methods,
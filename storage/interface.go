@@ -127,6 +127,46 @@ type VolumeSource interface {
 	DetachVolumes(params []VolumeAttachmentParams) ([]error, error)
 }
 
+// VolumeSnapshotter is an interface that may optionally be implemented
+// by a VolumeSource, for taking point-in-time snapshots of volumes, and
+// for creating new volumes from those snapshots.
+type VolumeSnapshotter interface {
+	// CreateSnapshots creates snapshots of the volumes with the
+	// specified parameters.
+	CreateSnapshots(params []SnapshotParams) ([]CreateSnapshotsResult, error)
+
+	// ListSnapshots lists the snapshots of the volumes with the
+	// specified provider volume IDs, including snapshots that were
+	// not created by Juju.
+	ListSnapshots(volIds []string) ([]ListSnapshotsResult, error)
+
+	// DeleteSnapshots deletes the snapshots with the specified
+	// provider snapshot IDs.
+	DeleteSnapshots(snapshotIds []string) ([]error, error)
+
+	// RestoreSnapshots creates new volumes with the specified
+	// parameters, each initialised with the contents of the snapshot
+	// identified by its SnapshotId.
+	RestoreSnapshots(params []VolumeParams) ([]CreateVolumesResult, error)
+}
+
+// VolumeResizer is an interface that may optionally be implemented by
+// a VolumeSource, for growing volumes in place.
+type VolumeResizer interface {
+	// ResizesAttachedVolumes reports whether volumes can be grown
+	// while they are attached to machines. If not, volumes must be
+	// detached before they are resized.
+	ResizesAttachedVolumes() bool
+
+	// ResizeVolumes grows the volumes with the specified parameters
+	// to the specified sizes, in MiB. Volumes cannot be shrunk.
+	//
+	// Errors satisfying errors.IsNotSupported indicate that the
+	// volume cannot be resized in its current state, and that the
+	// resize should not be retried.
+	ResizeVolumes(params []VolumeResizeParams) ([]ResizeVolumesResult, error)
+}
+
 // FilesystemSource provides an interface for creating, destroying and
 // describing filesystems in the environment. A FilesystemSource is
 // configured in a particular way, and corresponds to a storage "pool".
@@ -160,6 +200,15 @@ type FilesystemSource interface {
 	DetachFilesystems(params []FilesystemAttachmentParams) ([]error, error)
 }
 
+// FilesystemResizer is an interface that may optionally be implemented
+// by a FilesystemSource, for growing filesystems in place after the
+// volumes backing them have been grown.
+type FilesystemResizer interface {
+	// ResizeFilesystems grows the filesystems with the specified
+	// parameters to fill their backing volumes.
+	ResizeFilesystems(params []FilesystemResizeParams) ([]ResizeFilesystemsResult, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage constraints, a
 // storage pool definition, and charm storage metadata.
@@ -194,6 +243,12 @@ type VolumeParams struct {
 	// once the instance is created there are still unprovisioned volumes,
 	// the dynamic storage provisioner will take care of creating them.
 	Attachment *VolumeAttachmentParams
+
+	// SnapshotId is the provider ID of the snapshot from which the
+	// volume should be restored, or empty if the volume should be
+	// created empty. Volumes with a snapshot ID are only ever passed
+	// to VolumeSnapshotter.RestoreSnapshots.
+	SnapshotId string
 }
 
 // VolumeAttachmentParams is a set of parameters for volume attachment or
@@ -210,6 +265,34 @@ type VolumeAttachmentParams struct {
 	VolumeId string
 }
 
+// SnapshotParams is a set of parameters for creating a snapshot of a
+// volume.
+type SnapshotParams struct {
+	// Volume is the tag assigned by Juju for the volume to snapshot.
+	Volume names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume to
+	// snapshot.
+	VolumeId string
+
+	// ResourceTags is a set of tags to set on the created snapshot, if
+	// the storage provider supports tags.
+	ResourceTags map[string]string
+}
+
+// VolumeResizeParams is a set of parameters for growing a volume.
+type VolumeResizeParams struct {
+	// Tag is the tag assigned by Juju for the volume to resize.
+	Tag names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume
+	// to resize.
+	VolumeId string
+
+	// Size is the size, in MiB, that the volume is to be grown to.
+	Size uint64
+}
+
 // AttachmentParams describes the parameters for attaching a volume or
 // filesystem to a machine.
 type AttachmentParams struct {
@@ -256,6 +339,22 @@ type FilesystemParams struct {
 	// ResourceTags is a set of tags to set on the created filesystem, if the
 	// storage provider supports tags.
 	ResourceTags map[string]string
+
+	// SnapshotId is the provider ID of the snapshot from which the
+	// backing volume was restored, if any. The backing volume then
+	// already holds a filesystem, which must be kept.
+	SnapshotId string
+}
+
+// FilesystemResizeParams is a set of parameters for growing a
+// filesystem.
+type FilesystemResizeParams struct {
+	// Tag is the tag assigned by Juju for the filesystem to resize.
+	Tag names.FilesystemTag
+
+	// FilesystemId is the unique provider-supplied ID for the
+	// filesystem to resize.
+	FilesystemId string
 }
 
 // FilesystemAttachmentParams is a set of parameters for filesystem attachment
@@ -299,6 +398,29 @@ type AttachVolumesResult struct {
 	Error            error
 }
 
+// CreateSnapshotsResult contains the result of a
+// VolumeSnapshotter.CreateSnapshots call for one volume. Snapshot should
+// only be used if Error is nil.
+type CreateSnapshotsResult struct {
+	Snapshot *Snapshot
+	Error    error
+}
+
+// ListSnapshotsResult contains the result of a
+// VolumeSnapshotter.ListSnapshots call for one volume. Snapshots should
+// only be used if Error is nil.
+type ListSnapshotsResult struct {
+	Snapshots []Snapshot
+	Error     error
+}
+
+// ResizeVolumesResult contains the result of a VolumeResizer.ResizeVolumes
+// call for one volume. VolumeInfo should only be used if Error is nil.
+type ResizeVolumesResult struct {
+	VolumeInfo *VolumeInfo
+	Error      error
+}
+
 // CreateFilesystemsResult contains the result of a FilesystemSource.CreateFilesystems call
 // for one filesystem. Filesystem should only be used if Error is nil.
 type CreateFilesystemsResult struct {
@@ -319,3 +441,11 @@ type AttachFilesystemsResult struct {
 	FilesystemAttachment *FilesystemAttachment
 	Error                error
 }
+
+// ResizeFilesystemsResult contains the result of a
+// FilesystemResizer.ResizeFilesystems call for one filesystem.
+// FilesystemInfo should only be used if Error is nil.
+type ResizeFilesystemsResult struct {
+	FilesystemInfo *FilesystemInfo
+	Error          error
+}
@@ -18,6 +18,10 @@ type PoolManager interface {
 	// Delete removes the pool with name from state.
 	Delete(name string) error
 
+	// Replace replaces the configuration attributes of the pool with
+	// name, and persists them to state.
+	Replace(name string, attrs map[string]interface{}) (*storage.Config, error)
+
 	// Get returns the pool with name from state.
 	Get(name string) (*storage.Config, error)
 
@@ -29,6 +33,7 @@ type SettingsManager interface {
 	CreateSettings(key string, settings map[string]interface{}) error
 	ReadSettings(key string) (map[string]interface{}, error)
 	RemoveSettings(key string) error
+	ReplaceSettings(key string, settings map[string]interface{}) error
 	ListSettings(keyPrefix string) (map[string]map[string]interface{}, error)
 }
 
@@ -65,6 +70,15 @@ func (m MemSettings) RemoveSettings(key string) error {
 	return nil
 }
 
+// ReplaceSettings is part of the SettingsManager interface.
+func (m MemSettings) ReplaceSettings(key string, settings map[string]interface{}) error {
+	if _, ok := m.Settings[key]; !ok {
+		return errors.NotFoundf("settings with key %q", key)
+	}
+	m.Settings[key] = settings
+	return nil
+}
+
 // ListSettings is part of the SettingsManager interface.
 func (m MemSettings) ListSettings(keyPrefix string) (map[string]map[string]interface{}, error) {
 	result := make(map[string]map[string]interface{})
@@ -35,7 +35,9 @@ type poolManager struct {
 
 const globalKeyPrefix = "pool#"
 
-func globalKey(name string) string {
+// GlobalKey returns the key of the settings document
+// for the storage pool with the specified name.
+func GlobalKey(name string) string {
 	return globalKeyPrefix + name
 }
 
@@ -63,7 +65,7 @@ func (pm *poolManager) Create(name string, providerType storage.ProviderType, at
 	poolAttrs := cfg.Attrs()
 	poolAttrs[Name] = name
 	poolAttrs[Type] = string(providerType)
-	if err := pm.settings.CreateSettings(globalKey(name), poolAttrs); err != nil {
+	if err := pm.settings.CreateSettings(GlobalKey(name), poolAttrs); err != nil {
 		return nil, errors.Annotatef(err, "creating pool %q", name)
 	}
 	return cfg, nil
@@ -71,16 +73,53 @@ func (pm *poolManager) Create(name string, providerType storage.ProviderType, at
 
 // Delete is defined on PoolManager interface.
 func (pm *poolManager) Delete(name string) error {
-	err := pm.settings.RemoveSettings(globalKey(name))
+	err := pm.settings.RemoveSettings(GlobalKey(name))
 	if err == nil || errors.IsNotFound(err) {
 		return nil
 	}
 	return errors.Annotatef(err, "deleting pool %q", name)
 }
 
+// Replace is defined on PoolManager interface.
+func (pm *poolManager) Replace(name string, attrs map[string]interface{}) (*storage.Config, error) {
+	// The existing settings are not validated, so that
+	// a pool with invalid configuration can be fixed.
+	settings, err := pm.settings.ReadSettings(GlobalKey(name))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NotFoundf("pool %q", name)
+		}
+		return nil, errors.Annotatef(err, "reading pool %q", name)
+	}
+	typeName, ok := settings[Type].(string)
+	if !ok {
+		return nil, errors.NotValidf("pool %q with no provider type", name)
+	}
+	providerType := storage.ProviderType(typeName)
+	cfg, err := storage.NewConfig(name, providerType, attrs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	p, err := pm.registry.StorageProvider(providerType)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := provider.ValidateConfig(p, cfg); err != nil {
+		return nil, errors.Annotate(err, "validating storage provider config")
+	}
+
+	poolAttrs := cfg.Attrs()
+	poolAttrs[Name] = name
+	poolAttrs[Type] = string(providerType)
+	if err := pm.settings.ReplaceSettings(GlobalKey(name), poolAttrs); err != nil {
+		return nil, errors.Annotatef(err, "replacing pool %q", name)
+	}
+	return cfg, nil
+}
+
 // Get is defined on PoolManager interface.
 func (pm *poolManager) Get(name string) (*storage.Config, error) {
-	settings, err := pm.settings.ReadSettings(globalKey(name))
+	settings, err := pm.settings.ReadSettings(GlobalKey(name))
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, errors.NotFoundf("pool %q", name)
@@ -140,3 +140,48 @@ func (s *poolSuite) TestDelete(c *gc.C) {
 	err = s.poolManager.Delete("testpool")
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *poolSuite) TestReplace(c *gc.C) {
+	s.createSettings(c)
+	replaced, err := s.poolManager.Replace("testpool", map[string]interface{}{"baz": "qux"})
+	c.Assert(err, jc.ErrorIsNil)
+	p, err := s.poolManager.Get("testpool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(replaced, gc.DeepEquals, p)
+	c.Assert(p.Attrs(), gc.DeepEquals, map[string]interface{}{"baz": "qux"})
+	c.Assert(p.Name(), gc.Equals, "testpool")
+	c.Assert(p.Provider(), gc.Equals, storage.ProviderType("loop"))
+}
+
+func (s *poolSuite) TestReplaceNotFound(c *gc.C) {
+	_, err := s.poolManager.Replace("testpool", map[string]interface{}{"foo": "bar"})
+	c.Assert(err, jc.Satisfies, errors.IsNotFound)
+	c.Assert(err, gc.ErrorMatches, `pool "testpool" not found`)
+}
+
+func (s *poolSuite) TestReplaceInvalidConfig(c *gc.C) {
+	s.createSettings(c)
+	s.registry.Providers["loop"] = &dummystorage.StorageProvider{
+		ValidateConfigFunc: func(cfg *storage.Config) error {
+			if _, ok := cfg.Attrs()["baz"]; ok {
+				return errors.New("no good")
+			}
+			return nil
+		},
+	}
+	_, err := s.poolManager.Replace("testpool", map[string]interface{}{"baz": "qux"})
+	c.Assert(err, gc.ErrorMatches, "validating storage provider config: no good")
+	p, err := s.poolManager.Get("testpool")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(p.Attrs(), gc.DeepEquals, map[string]interface{}{"foo": "bar"})
+}
+
+func (s *poolSuite) TestReplaceNoProviderType(c *gc.C) {
+	err := s.settings.CreateSettings("pool#testpool", map[string]interface{}{
+		"name": "testpool", "foo": "bar",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.poolManager.Replace("testpool", map[string]interface{}{"baz": "qux"})
+	c.Assert(err, jc.Satisfies, errors.IsNotValid)
+	c.Assert(err, gc.ErrorMatches, `pool "testpool" with no provider type not valid`)
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/juju/juju/storage"
 )
 
-var Getpagesize = &getpagesize
+var (
+	Getpagesize      = &getpagesize
+	LoopSnapshotTime = &loopSnapshotTime
+)
 
 func LoopVolumeSource(
 	storageDir string,
@@ -237,6 +237,58 @@ func (lvs *loopVolumeSource) detachVolume(tag names.VolumeTag) error {
 	return nil
 }
 
+var _ storage.VolumeResizer = (*loopVolumeSource)(nil)
+
+// ResizesAttachedVolumes is defined on the VolumeResizer interface.
+func (lvs *loopVolumeSource) ResizesAttachedVolumes() bool {
+	return true
+}
+
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (lvs *loopVolumeSource) ResizeVolumes(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	results := make([]storage.ResizeVolumesResult, len(args))
+	for i, arg := range args {
+		if err := lvs.resizeVolume(arg); err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Tag.Id())
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: arg.VolumeId,
+			Size:     arg.Size,
+		}
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) resizeVolume(arg storage.VolumeResizeParams) error {
+	loopFilePath := lvs.volumeFilePath(arg.Tag)
+	fi, err := os.Stat(loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "reading loop backing file")
+	}
+	if uint64(fi.Size()) > arg.Size*1024*1024 {
+		return errors.Errorf(
+			"cannot shrink volume from %dMiB to %dMiB",
+			uint64(fi.Size())/(1024*1024), arg.Size,
+		)
+	}
+	if err := createBlockFile(lvs.run, loopFilePath, arg.Size); err != nil {
+		return errors.Annotate(err, "growing block file")
+	}
+	// Any loop devices attached to the file must be told to
+	// pick up the new size of the file.
+	deviceNames, err := associatedLoopDevices(lvs.run, loopFilePath)
+	if err != nil {
+		return errors.Annotate(err, "locating loop device")
+	}
+	for _, deviceName := range deviceNames {
+		if err := refreshLoopDeviceCapacity(lvs.run, deviceName); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
 // createBlockFile creates a file at the specified path, with the
 // given size in mebibytes.
 func createBlockFile(run runCommandFunc, filePath string, sizeInMiB uint64) error {
@@ -287,6 +339,16 @@ func detachLoopDevice(run runCommandFunc, deviceName string) error {
 	return err
 }
 
+// refreshLoopDeviceCapacity updates the loop device with the specified
+// name to reflect the current size of its backing file.
+func refreshLoopDeviceCapacity(run runCommandFunc, deviceName string) error {
+	_, err := run("losetup", "-c", path.Join("/dev", deviceName))
+	if err != nil {
+		return errors.Annotatef(err, "refreshing capacity of loop device %q", deviceName)
+	}
+	return nil
+}
+
 // associatedLoopDevices returns the device names of the loop devices
 // associated with the specified file path.
 func associatedLoopDevices(run runCommandFunc, filePath string) ([]string, error) {
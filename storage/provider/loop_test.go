@@ -4,9 +4,11 @@
 package provider_test
 
 import (
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
@@ -272,3 +274,182 @@ func (s *loopSuite) TestDetachVolumesDetachFails(c *gc.C) {
 	_, err = os.Stat(fileName)
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *loopSuite) TestResizeVolumes(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	s.commands.expect("fallocate", "-l", "4MiB", fileName)
+	cmd := s.commands.expect("losetup", "-j", fileName)
+	cmd.respond("/dev/loop0: foo\n", nil)
+	s.commands.expect("losetup", "-c", "/dev/loop0")
+
+	results, err := source.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     4,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeVolumesResult{{
+		VolumeInfo: &storage.VolumeInfo{
+			VolumeId: "volume-0",
+			Size:     4,
+		},
+	}})
+}
+
+func (s *loopSuite) TestResizeVolumesShrink(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	fileName := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(fileName, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := source.(storage.VolumeResizer).ResizeVolumes([]storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+		Size:     1,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "resizing volume 0: cannot shrink volume from 2MiB to 1MiB")
+}
+
+func (s *loopSuite) TestCreateSnapshots(c *gc.C) {
+	source, dirFuncs := s.loopVolumeSource(c)
+	created := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.PatchValue(provider.LoopSnapshotTime, func() time.Time { return created })
+
+	volumeFilePath := filepath.Join(s.storageDir, "volume-0")
+	err := ioutil.WriteFile(volumeFilePath, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+	snapshotId := fmt.Sprintf("volume-0@%d", created.UnixNano())
+	s.commands.expect("cp", "--sparse=always", volumeFilePath, filepath.Join(s.storageDir, "snapshots", snapshotId))
+
+	results, err := source.(storage.VolumeSnapshotter).CreateSnapshots([]storage.SnapshotParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateSnapshotsResult{{
+		Snapshot: &storage.Snapshot{
+			SnapshotId: snapshotId,
+			VolumeId:   "volume-0",
+			Size:       2,
+			Created:    created,
+			Status:     "completed",
+		},
+	}})
+	c.Assert(dirFuncs.Dirs.Contains(filepath.Join(s.storageDir, "snapshots")), jc.IsTrue)
+}
+
+func (s *loopSuite) TestCreateSnapshotsVolumeNotFound(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	results, err := source.(storage.VolumeSnapshotter).CreateSnapshots([]storage.SnapshotParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "volume-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "creating snapshot of volume 0: reading loop backing file: .*")
+}
+
+func (s *loopSuite) TestListSnapshots(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotsDir := filepath.Join(s.storageDir, "snapshots")
+	err := os.Mkdir(snapshotsDir, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	created := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, name := range []string{
+		fmt.Sprintf("volume-0@%d", created.UnixNano()),
+		fmt.Sprintf("volume-1@%d", created.UnixNano()),
+		"garbage",
+	} {
+		err := ioutil.WriteFile(filepath.Join(snapshotsDir, name), make([]byte, 1024*1024), 0644)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	results, err := source.(storage.VolumeSnapshotter).ListSnapshots([]string{"volume-0", "volume-2"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{
+		Snapshots: []storage.Snapshot{{
+			SnapshotId: fmt.Sprintf("volume-0@%d", created.UnixNano()),
+			VolumeId:   "volume-0",
+			Size:       1,
+			Created:    created,
+			Status:     "completed",
+		}},
+	}, {}})
+}
+
+func (s *loopSuite) TestListSnapshotsNoSnapshotsDir(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	results, err := source.(storage.VolumeSnapshotter).ListSnapshots([]string{"volume-0"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ListSnapshotsResult{{}})
+}
+
+func (s *loopSuite) TestDeleteSnapshots(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotsDir := filepath.Join(s.storageDir, "snapshots")
+	err := os.Mkdir(snapshotsDir, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	fileName := filepath.Join(snapshotsDir, "volume-0@1")
+	err = ioutil.WriteFile(fileName, nil, 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	errs, err := source.(storage.VolumeSnapshotter).DeleteSnapshots([]string{
+		"volume-0@1", "../volume-0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(errs, gc.HasLen, 2)
+	c.Assert(errs[0], jc.ErrorIsNil)
+	c.Assert(errs[1], gc.ErrorMatches, `deleting snapshot "../volume-0": invalid loop snapshot ID "../volume-0"`)
+
+	_, err = os.Stat(fileName)
+	c.Assert(err, jc.Satisfies, os.IsNotExist)
+}
+
+func (s *loopSuite) TestRestoreSnapshots(c *gc.C) {
+	source, _ := s.loopVolumeSource(c)
+	snapshotsDir := filepath.Join(s.storageDir, "snapshots")
+	err := os.Mkdir(snapshotsDir, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	snapshotFilePath := filepath.Join(snapshotsDir, "volume-0@1")
+	err = ioutil.WriteFile(snapshotFilePath, make([]byte, 2*1024*1024), 0644)
+	c.Assert(err, jc.ErrorIsNil)
+
+	volumeFilePath := filepath.Join(s.storageDir, "volume-1")
+	s.commands.expect("cp", "--sparse=always", snapshotFilePath, volumeFilePath)
+	volumeFilePath2 := filepath.Join(s.storageDir, "volume-2")
+	s.commands.expect("cp", "--sparse=always", snapshotFilePath, volumeFilePath2)
+	s.commands.expect("fallocate", "-l", "4MiB", volumeFilePath2)
+
+	results, err := source.(storage.VolumeSnapshotter).RestoreSnapshots([]storage.VolumeParams{{
+		Tag:        names.NewVolumeTag("1"),
+		Size:       1,
+		SnapshotId: "volume-0@1",
+	}, {
+		Tag:        names.NewVolumeTag("2"),
+		Size:       4,
+		SnapshotId: "volume-0@1",
+	}, {
+		Tag:        names.NewVolumeTag("3"),
+		Size:       1,
+		SnapshotId: "volume-0@2",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 3)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+	c.Assert(results[0].Volume, jc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("1"),
+		storage.VolumeInfo{VolumeId: "volume-1", Size: 2},
+	})
+	c.Assert(results[1].Error, jc.ErrorIsNil)
+	c.Assert(results[1].Volume, jc.DeepEquals, &storage.Volume{
+		names.NewVolumeTag("2"),
+		storage.VolumeInfo{VolumeId: "volume-2", Size: 4},
+	})
+	c.Assert(results[2].Error, jc.Satisfies, errors.IsNotFound)
+	c.Assert(results[2].Error, gc.ErrorMatches, `restoring snapshot "volume-0@2": snapshot "volume-0@2" not found`)
+}
@@ -0,0 +1,210 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/storage"
+)
+
+// loopSnapshotsDir is the name of the directory, relative to the
+// storage directory, in which loop volume snapshots are stored.
+const loopSnapshotsDir = "snapshots"
+
+// loopSnapshotSeparator separates the volume ID from the creation
+// time in a loop snapshot ID, e.g. "volume-0@1488369600000000000".
+const loopSnapshotSeparator = "@"
+
+// loopSnapshotTime returns the current time, and is used to
+// timestamp new snapshots. It is a variable so it can be
+// overridden in tests.
+var loopSnapshotTime = time.Now
+
+var _ storage.VolumeSnapshotter = (*loopVolumeSource)(nil)
+
+// CreateSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) CreateSnapshots(args []storage.SnapshotParams) ([]storage.CreateSnapshotsResult, error) {
+	results := make([]storage.CreateSnapshotsResult, len(args))
+	for i, arg := range args {
+		snapshot, err := lvs.createSnapshot(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "creating snapshot of volume %s", arg.Volume.Id())
+			continue
+		}
+		results[i].Snapshot = snapshot
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) createSnapshot(arg storage.SnapshotParams) (*storage.Snapshot, error) {
+	tag, err := names.ParseVolumeTag(arg.VolumeId)
+	if err != nil {
+		return nil, errors.Errorf("invalid loop volume ID %q", arg.VolumeId)
+	}
+	loopFilePath := lvs.volumeFilePath(tag)
+	fi, err := os.Stat(loopFilePath)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading loop backing file")
+	}
+	snapshotsDir := lvs.snapshotsDir()
+	if err := ensureDir(lvs.dirFuncs, snapshotsDir); err != nil {
+		return nil, errors.Trace(err)
+	}
+	created := loopSnapshotTime().UTC()
+	snapshotId := arg.VolumeId + loopSnapshotSeparator + strconv.FormatInt(created.UnixNano(), 10)
+	if err := copyBlockFile(lvs.run, loopFilePath, filepath.Join(snapshotsDir, snapshotId)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.Snapshot{
+		SnapshotId: snapshotId,
+		VolumeId:   arg.VolumeId,
+		Size:       uint64(fi.Size()) / (1024 * 1024),
+		Created:    created,
+		Status:     "completed",
+	}, nil
+}
+
+// ListSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) ListSnapshots(volumeIds []string) ([]storage.ListSnapshotsResult, error) {
+	files, err := ioutil.ReadDir(lvs.snapshotsDir())
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Annotate(err, "reading snapshots directory")
+	}
+	snapshots := make(map[string][]storage.Snapshot)
+	for _, fi := range files {
+		volumeId, created, err := parseLoopSnapshotId(fi.Name())
+		if err != nil {
+			logger.Warningf("ignoring unexpected file in snapshots directory: %v", err)
+			continue
+		}
+		snapshots[volumeId] = append(snapshots[volumeId], storage.Snapshot{
+			SnapshotId: fi.Name(),
+			VolumeId:   volumeId,
+			Size:       uint64(fi.Size()) / (1024 * 1024),
+			Created:    created,
+			Status:     "completed",
+		})
+	}
+	results := make([]storage.ListSnapshotsResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		results[i].Snapshots = snapshots[volumeId]
+	}
+	return results, nil
+}
+
+// DeleteSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) DeleteSnapshots(snapshotIds []string) ([]error, error) {
+	results := make([]error, len(snapshotIds))
+	for i, snapshotId := range snapshotIds {
+		if err := lvs.deleteSnapshot(snapshotId); err != nil {
+			results[i] = errors.Annotatef(err, "deleting snapshot %q", snapshotId)
+		}
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) deleteSnapshot(snapshotId string) error {
+	if _, _, err := parseLoopSnapshotId(snapshotId); err != nil {
+		return errors.Trace(err)
+	}
+	err := os.Remove(filepath.Join(lvs.snapshotsDir(), snapshotId))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Annotate(err, "removing snapshot file")
+	}
+	return nil
+}
+
+// RestoreSnapshots is defined on the VolumeSnapshotter interface.
+func (lvs *loopVolumeSource) RestoreSnapshots(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(args))
+	for i, arg := range args {
+		volume, err := lvs.restoreSnapshot(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "restoring snapshot %q", arg.SnapshotId)
+			continue
+		}
+		results[i].Volume = volume
+	}
+	return results, nil
+}
+
+func (lvs *loopVolumeSource) restoreSnapshot(params storage.VolumeParams) (*storage.Volume, error) {
+	if _, _, err := parseLoopSnapshotId(params.SnapshotId); err != nil {
+		return nil, errors.Trace(err)
+	}
+	snapshotFilePath := filepath.Join(lvs.snapshotsDir(), params.SnapshotId)
+	fi, err := os.Stat(snapshotFilePath)
+	if os.IsNotExist(err) {
+		return nil, errors.NotFoundf("snapshot %q", params.SnapshotId)
+	} else if err != nil {
+		return nil, errors.Annotate(err, "reading snapshot file")
+	}
+	loopFilePath := lvs.volumeFilePath(params.Tag)
+	if err := ensureDir(lvs.dirFuncs, filepath.Dir(loopFilePath)); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := copyBlockFile(lvs.run, snapshotFilePath, loopFilePath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The restored volume is at least as large as the snapshot,
+	// and is grown to the requested size if that is larger.
+	size := uint64(fi.Size()) / (1024 * 1024)
+	if params.Size > size {
+		if err := createBlockFile(lvs.run, loopFilePath, params.Size); err != nil {
+			return nil, errors.Annotate(err, "growing restored block file")
+		}
+		size = params.Size
+	}
+	return &storage.Volume{
+		params.Tag,
+		storage.VolumeInfo{
+			VolumeId: params.Tag.String(),
+			Size:     size,
+		},
+	}, nil
+}
+
+// parseLoopSnapshotId parses a loop snapshot ID, returning the ID of
+// the snapshotted volume and the time at which the snapshot was created.
+func parseLoopSnapshotId(snapshotId string) (string, time.Time, error) {
+	fail := func() (string, time.Time, error) {
+		return "", time.Time{}, errors.Errorf("invalid loop snapshot ID %q", snapshotId)
+	}
+	pos := strings.LastIndex(snapshotId, loopSnapshotSeparator)
+	if pos == -1 {
+		return fail()
+	}
+	volumeId := snapshotId[:pos]
+	if _, err := names.ParseVolumeTag(volumeId); err != nil {
+		return fail()
+	}
+	nanos, err := strconv.ParseInt(snapshotId[pos+len(loopSnapshotSeparator):], 10, 64)
+	if err != nil {
+		return fail()
+	}
+	return volumeId, time.Unix(0, nanos).UTC(), nil
+}
+
+// copyBlockFile copies the file at the source path to the target path,
+// preserving any holes in the file.
+func copyBlockFile(run runCommandFunc, source, target string) error {
+	_, err := run("cp", "--sparse=always", source, target)
+	if err != nil {
+		return errors.Annotatef(err, "copying %q to %q", source, target)
+	}
+	return nil
+}
+
+func (lvs *loopVolumeSource) snapshotsDir() string {
+	return filepath.Join(lvs.storageDir, loopSnapshotsDir)
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"path"
 	"path/filepath"
+	"strings"
 	"unicode"
 
 	"github.com/juju/errors"
@@ -85,18 +86,22 @@ func (s *managedFilesystemSource) createFilesystem(arg storage.FilesystemParams)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	devicePath := devicePath(blockDevice)
-	if isDiskDevice(devicePath) {
-		if err := destroyPartitions(s.run, devicePath); err != nil {
-			return nil, errors.Trace(err)
+	// A volume restored from a snapshot already has a partition
+	// and filesystem on it, which hold the data being restored.
+	if arg.SnapshotId == "" {
+		devicePath := devicePath(blockDevice)
+		if isDiskDevice(devicePath) {
+			if err := destroyPartitions(s.run, devicePath); err != nil {
+				return nil, errors.Trace(err)
+			}
+			if err := createPartition(s.run, devicePath); err != nil {
+				return nil, errors.Trace(err)
+			}
+			devicePath = partitionDevicePath(devicePath)
 		}
-		if err := createPartition(s.run, devicePath); err != nil {
+		if err := createFilesystem(s.run, devicePath); err != nil {
 			return nil, errors.Trace(err)
 		}
-		devicePath = partitionDevicePath(devicePath)
-	}
-	if err := createFilesystem(s.run, devicePath); err != nil {
-		return nil, errors.Trace(err)
 	}
 	return &storage.Filesystem{
 		arg.Tag,
@@ -167,6 +172,47 @@ func (s *managedFilesystemSource) DetachFilesystems(args []storage.FilesystemAtt
 	return results, nil
 }
 
+var _ storage.FilesystemResizer = (*managedFilesystemSource)(nil)
+
+// ResizeFilesystems is defined on storage.FilesystemResizer.
+func (s *managedFilesystemSource) ResizeFilesystems(args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		info, err := s.resizeFilesystem(arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing filesystem %s", arg.Tag.Id())
+			continue
+		}
+		results[i].FilesystemInfo = info
+	}
+	return results, nil
+}
+
+func (s *managedFilesystemSource) resizeFilesystem(arg storage.FilesystemResizeParams) (*storage.FilesystemInfo, error) {
+	filesystem, ok := s.filesystems[arg.Tag]
+	if !ok {
+		return nil, errors.Errorf("filesystem %v is not yet provisioned", arg.Tag.Id())
+	}
+	blockDevice, err := s.backingVolumeBlockDevice(filesystem.Volume)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	devicePath := devicePath(blockDevice)
+	if isDiskDevice(devicePath) {
+		if err := growPartition(s.run, devicePath); err != nil {
+			return nil, errors.Trace(err)
+		}
+		devicePath = partitionDevicePath(devicePath)
+	}
+	if err := growFilesystem(s.run, devicePath); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &storage.FilesystemInfo{
+		filesystem.FilesystemId,
+		blockDevice.Size,
+	}, nil
+}
+
 func destroyPartitions(run runCommandFunc, devicePath string) error {
 	logger.Debugf("destroying partitions on %q", devicePath)
 	if _, err := run("sgdisk", "--zap-all", devicePath); err != nil {
@@ -196,6 +242,32 @@ func createFilesystem(run runCommandFunc, devicePath string) error {
 	return nil
 }
 
+// growPartition grows the single partition (1) on the disk with the
+// specified device path to fill the disk.
+func growPartition(run runCommandFunc, devicePath string) error {
+	logger.Debugf("growing partition on %q", devicePath)
+	if _, err := run("growpart", devicePath, "1"); err != nil {
+		// growpart fails with "NOCHANGE" if the partition
+		// already fills the disk.
+		if strings.Contains(err.Error(), "NOCHANGE") {
+			return nil
+		}
+		return errors.Annotate(err, "growpart failed")
+	}
+	return nil
+}
+
+// growFilesystem grows the filesystem on the specified device path to
+// fill the device. The filesystem may be mounted.
+func growFilesystem(run runCommandFunc, devicePath string) error {
+	logger.Debugf("growing filesystem on %q", devicePath)
+	if _, err := run("resize2fs", devicePath); err != nil {
+		return errors.Annotate(err, "resize2fs failed")
+	}
+	logger.Infof("grew filesystem on %q", devicePath)
+	return nil
+}
+
 func mountFilesystem(run runCommandFunc, dirFuncs dirFuncs, devicePath, mountPoint string, readOnly bool) error {
 	logger.Debugf("attempting to mount filesystem on %q at %q", devicePath, mountPoint)
 	if err := dirFuncs.mkDirAll(mountPoint, 0755); err != nil {
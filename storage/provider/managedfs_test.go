@@ -6,6 +6,7 @@ package provider_test
 import (
 	"path/filepath"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/names.v2"
@@ -101,6 +102,34 @@ func (s *managedfsSuite) TestCreateFilesystems(c *gc.C) {
 	}})
 }
 
+func (s *managedfsSuite) TestCreateFilesystemsFromSnapshot(c *gc.C) {
+	source := s.initSource(c)
+	// No commands are expected: the volume restored from the
+	// snapshot already has a partition and filesystem on it.
+	s.blockDevices[names.NewVolumeTag("0")] = storage.BlockDevice{
+		DeviceName: "sda",
+		HardwareId: "capncrunch",
+		Size:       2,
+	}
+	results, err := source.CreateFilesystems([]storage.FilesystemParams{{
+		Tag:        names.NewFilesystemTag("0/0"),
+		Volume:     names.NewVolumeTag("0"),
+		Size:       2,
+		SnapshotId: "snap-1",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.CreateFilesystemsResult{{
+		Filesystem: &storage.Filesystem{
+			names.NewFilesystemTag("0/0"),
+			names.NewVolumeTag("0"),
+			storage.FilesystemInfo{
+				FilesystemId: "filesystem-0-0",
+				Size:         2,
+			},
+		},
+	}})
+}
+
 func (s *managedfsSuite) TestCreateFilesystemsNoBlockDevice(c *gc.C) {
 	source := s.initSource(c)
 	results, err := source.CreateFilesystems([]storage.FilesystemParams{{
@@ -176,6 +205,89 @@ func (s *managedfsSuite) testAttachFilesystems(c *gc.C, readOnly, reattach bool)
 	}})
 }
 
+func (s *managedfsSuite) TestResizeFilesystems(c *gc.C) {
+	source := s.initSource(c)
+	// sda's partition is grown to fill the disk, and then
+	// the filesystem grown to fill the partition.
+	s.commands.expect("growpart", "/dev/sda", "1")
+	s.commands.expect("resize2fs", "/dev/sda1")
+	// xvdf1 does not have a partition.
+	s.commands.expect("resize2fs", "/dev/xvdf1")
+
+	s.blockDevices[names.NewVolumeTag("0")] = storage.BlockDevice{
+		DeviceName: "sda",
+		Size:       4,
+	}
+	s.blockDevices[names.NewVolumeTag("1")] = storage.BlockDevice{
+		DeviceName: "xvdf1",
+		Size:       6,
+	}
+	s.filesystems[names.NewFilesystemTag("0/0")] = storage.Filesystem{
+		Tag:            names.NewFilesystemTag("0/0"),
+		Volume:         names.NewVolumeTag("0"),
+		FilesystemInfo: storage.FilesystemInfo{FilesystemId: "filesystem-0-0", Size: 2},
+	}
+	s.filesystems[names.NewFilesystemTag("0/1")] = storage.Filesystem{
+		Tag:            names.NewFilesystemTag("0/1"),
+		Volume:         names.NewVolumeTag("1"),
+		FilesystemInfo: storage.FilesystemInfo{FilesystemId: "filesystem-0-1", Size: 3},
+	}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems([]storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0/0"),
+		FilesystemId: "filesystem-0-0",
+	}, {
+		Tag:          names.NewFilesystemTag("0/1"),
+		FilesystemId: "filesystem-0-1",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, []storage.ResizeFilesystemsResult{{
+		FilesystemInfo: &storage.FilesystemInfo{
+			FilesystemId: "filesystem-0-0",
+			Size:         4,
+		},
+	}, {
+		FilesystemInfo: &storage.FilesystemInfo{
+			FilesystemId: "filesystem-0-1",
+			Size:         6,
+		},
+	}})
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsPartitionUnchanged(c *gc.C) {
+	source := s.initSource(c)
+	cmd := s.commands.expect("growpart", "/dev/sda", "1")
+	cmd.respond("", errors.New("NOCHANGE: partition 1 is size 4095. it cannot be grown"))
+	s.commands.expect("resize2fs", "/dev/sda1")
+
+	s.blockDevices[names.NewVolumeTag("0")] = storage.BlockDevice{
+		DeviceName: "sda",
+		Size:       2,
+	}
+	s.filesystems[names.NewFilesystemTag("0/0")] = storage.Filesystem{
+		Tag:            names.NewFilesystemTag("0/0"),
+		Volume:         names.NewVolumeTag("0"),
+		FilesystemInfo: storage.FilesystemInfo{FilesystemId: "filesystem-0-0", Size: 2},
+	}
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems([]storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0/0"),
+		FilesystemId: "filesystem-0-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, jc.ErrorIsNil)
+}
+
+func (s *managedfsSuite) TestResizeFilesystemsNotProvisioned(c *gc.C) {
+	source := s.initSource(c)
+	results, err := source.(storage.FilesystemResizer).ResizeFilesystems([]storage.FilesystemResizeParams{{
+		Tag:          names.NewFilesystemTag("0/0"),
+		FilesystemId: "filesystem-0-0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Assert(results[0].Error, gc.ErrorMatches, "resizing filesystem 0/0: filesystem 0/0 is not yet provisioned")
+}
+
 func (s *managedfsSuite) TestDetachFilesystems(c *gc.C) {
 	source := s.initSource(c)
 	testDetachFilesystems(c, s.commands, source, true)
@@ -3,7 +3,11 @@
 
 package storage
 
-import "gopkg.in/juju/names.v2"
+import (
+	"time"
+
+	"gopkg.in/juju/names.v2"
+)
 
 // Volume identifies and describes a volume (disk, logical volume, etc.)
 type Volume struct {
@@ -73,3 +77,24 @@ type VolumeAttachmentInfo struct {
 	// ReadOnly signifies whether the volume is read only or writable.
 	ReadOnly bool
 }
+
+// Snapshot describes a point-in-time snapshot of a volume.
+type Snapshot struct {
+	// SnapshotId is a unique provider-supplied ID for the snapshot.
+	SnapshotId string
+
+	// VolumeId is the provider-supplied ID of the volume that the
+	// snapshot was taken from.
+	VolumeId string
+
+	// Size is the size of the volume that the snapshot was taken
+	// from, in MiB.
+	Size uint64
+
+	// Created is the time at which the snapshot was started.
+	Created time.Time
+
+	// Status is the provider-specific status of the snapshot, e.g.
+	// "pending" or "completed".
+	Status string
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionpruner
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/dependency"
+)
+
+// ManifoldConfig holds the dependencies and configuration for an
+// action pruner manifold.
+type ManifoldConfig struct {
+	APICallerName string
+	ClockName     string
+	PruneInterval time.Duration
+
+	NewFacade func(base.APICaller) (Facade, error)
+	NewWorker func(Config) (worker.Worker, error)
+}
+
+// Manifold returns a dependency.Manifold that runs an action pruner
+// worker according to the supplied configuration.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.APICallerName,
+			config.ClockName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			var clock clock.Clock
+			if err := context.Get(config.ClockName, &clock); err != nil {
+				return nil, errors.Trace(err)
+			}
+			var apiCaller base.APICaller
+			if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+				return nil, errors.Trace(err)
+			}
+			facade, err := config.NewFacade(apiCaller)
+			if err != nil {
+				return nil, errors.Annotate(err, "cannot create facade")
+			}
+			w, err := config.NewWorker(Config{
+				Facade:        facade,
+				Clock:         clock,
+				PruneInterval: config.PruneInterval,
+			})
+			if err != nil {
+				return nil, errors.Annotate(err, "cannot create worker")
+			}
+			return w, nil
+		},
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionpruner
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/actionpruner"
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/worker"
+)
+
+// NewFacade creates an *actionpruner.Facade and returns it as a Facade.
+func NewFacade(apiCaller base.APICaller) (Facade, error) {
+	return actionpruner.NewFacade(apiCaller), nil
+}
+
+// NewWorker creates a *Worker and returns it as a worker.Worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	worker, err := New(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionpruner provides a worker that periodically removes old
+// completed actions, within the limits set by the model's config.
+package actionpruner
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+var logger = loggo.GetLogger("juju.worker.actionpruner")
+
+// Facade exposes the controller functionality required by a Worker.
+type Facade interface {
+
+	// WatchForModelConfigChanges returns a watcher that notifies when
+	// the model's config changes.
+	WatchForModelConfigChanges() (watcher.NotifyWatcher, error)
+
+	// ModelConfig returns the model's current config.
+	ModelConfig() (*config.Config, error)
+
+	// Prune removes completed actions older than maxAge, and then
+	// the oldest ones until the actions collection is no larger
+	// than maxSizeMB.
+	Prune(maxAge time.Duration, maxSizeMB int) error
+}
+
+// Config holds the dependencies and configuration for a Worker.
+type Config struct {
+	Facade        Facade
+	Clock         clock.Clock
+	PruneInterval time.Duration
+}
+
+// Validate returns an error if the config cannot be expected to
+// drive a functional Worker.
+func (config Config) Validate() error {
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.PruneInterval <= 0 {
+		return errors.NotValidf("non-positive PruneInterval")
+	}
+	return nil
+}
+
+// New returns a Worker that prunes the model's completed actions every
+// PruneInterval, using the limits in the model's config.
+func New(config Config) (*Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &Worker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Worker prunes completed actions once the model's config is known,
+// and then every PruneInterval.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	watcher, err := w.config.Facade.WatchForModelConfigChanges()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+	var (
+		maxAge    time.Duration
+		maxSizeMB uint
+		prune     <-chan time.Time
+	)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-watcher.Changes():
+			if !ok {
+				return errors.New("model config watcher closed")
+			}
+			modelConfig, err := w.config.Facade.ModelConfig()
+			if err != nil {
+				return errors.Annotate(err, "cannot read model config")
+			}
+			maxAge = modelConfig.MaxActionResultsAge()
+			maxSizeMB = modelConfig.MaxActionResultsSizeMB()
+			if prune != nil {
+				// The new limits apply from the next prune.
+				continue
+			}
+		case <-prune:
+		}
+		if maxAge > 0 || maxSizeMB > 0 {
+			logger.Debugf("pruning actions older than %v or beyond %dMB", maxAge, maxSizeMB)
+			if err := w.config.Facade.Prune(maxAge, int(maxSizeMB)); err != nil {
+				return errors.Annotate(err, "cannot prune actions")
+			}
+		}
+		prune = w.config.Clock.After(w.config.PruneInterval)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionpruner_test
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/environs/config"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/actionpruner"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+
+	stub   *testing.Stub
+	clock  *testing.Clock
+	facade *mockFacade
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.stub = &testing.Stub{}
+	s.clock = testing.NewClock(time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC))
+	s.facade = &mockFacade{
+		stub:    s.stub,
+		changes: make(chan struct{}, 1),
+		pruned:  make(chan struct{}, 10),
+		config: coretesting.CustomModelConfig(c, coretesting.Attrs{
+			"max-action-results-age":  "72h",
+			"max-action-results-size": "500M",
+		}),
+	}
+}
+
+func (s *WorkerSuite) newWorker(c *gc.C) *actionpruner.Worker {
+	w, err := actionpruner.New(actionpruner.Config{
+		Facade:        s.facade,
+		Clock:         s.clock,
+		PruneInterval: time.Hour,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return w
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	_, err := actionpruner.New(actionpruner.Config{Clock: s.clock, PruneInterval: time.Hour})
+	c.Check(err, gc.ErrorMatches, "nil Facade not valid")
+	_, err = actionpruner.New(actionpruner.Config{Facade: s.facade, PruneInterval: time.Hour})
+	c.Check(err, gc.ErrorMatches, "nil Clock not valid")
+	_, err = actionpruner.New(actionpruner.Config{Facade: s.facade, Clock: s.clock})
+	c.Check(err, gc.ErrorMatches, "non-positive PruneInterval not valid")
+}
+
+func (s *WorkerSuite) TestWatchError(c *gc.C) {
+	s.stub.SetErrors(errors.New("boom"))
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "boom")
+	s.stub.CheckCallNames(c, "WatchForModelConfigChanges")
+}
+
+func (s *WorkerSuite) TestModelConfigError(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("boom"))
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "cannot read model config: boom")
+	s.stub.CheckCallNames(c, "WatchForModelConfigChanges", "ModelConfig")
+}
+
+func (s *WorkerSuite) TestPruneError(c *gc.C) {
+	s.stub.SetErrors(nil, nil, errors.New("boom"))
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "cannot prune actions: boom")
+}
+
+func (s *WorkerSuite) TestPrunesPeriodically(c *gc.C) {
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.waitPruned(c)
+	s.waitAlarm(c)
+	s.clock.Advance(time.Hour)
+	s.waitPruned(c)
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{"WatchForModelConfigChanges", nil},
+		{"ModelConfig", nil},
+		{"Prune", []interface{}{72 * time.Hour, 500}},
+		{"Prune", []interface{}{72 * time.Hour, 500}},
+	})
+}
+
+func (s *WorkerSuite) TestConfigChangeAppliesToNextPrune(c *gc.C) {
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+	s.waitPruned(c)
+	s.waitAlarm(c)
+
+	newConfig, err := s.facade.config.Apply(map[string]interface{}{
+		"max-action-results-age": "24h",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.facade.setConfig(newConfig)
+	s.facade.changes <- struct{}{}
+	select {
+	case <-s.facade.pruned:
+		c.Fatalf("pruned before interval")
+	case <-time.After(coretesting.ShortWait):
+	}
+
+	s.clock.Advance(time.Hour)
+	s.waitPruned(c)
+	s.stub.CheckCalls(c, []testing.StubCall{
+		{"WatchForModelConfigChanges", nil},
+		{"ModelConfig", nil},
+		{"Prune", []interface{}{72 * time.Hour, 500}},
+		{"ModelConfig", nil},
+		{"Prune", []interface{}{24 * time.Hour, 500}},
+	})
+}
+
+func (s *WorkerSuite) TestNoLimits(c *gc.C) {
+	noLimits, err := s.facade.config.Apply(map[string]interface{}{
+		"max-action-results-age":  "0",
+		"max-action-results-size": "0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	s.facade.config = noLimits
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.waitAlarm(c)
+	s.clock.Advance(time.Hour)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "WatchForModelConfigChanges", "ModelConfig")
+}
+
+func (s *WorkerSuite) waitPruned(c *gc.C) {
+	select {
+	case <-s.facade.pruned:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for actions to be pruned")
+	}
+}
+
+func (s *WorkerSuite) waitAlarm(c *gc.C) {
+	select {
+	case <-s.clock.Alarms():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for alarm")
+	}
+}
+
+type mockFacade struct {
+	stub    *testing.Stub
+	changes chan struct{}
+	pruned  chan struct{}
+
+	mu     sync.Mutex
+	config *config.Config
+}
+
+func (mock *mockFacade) setConfig(cfg *config.Config) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	mock.config = cfg
+}
+
+// WatchForModelConfigChanges is part of the actionpruner.Facade interface.
+func (mock *mockFacade) WatchForModelConfigChanges() (watcher.NotifyWatcher, error) {
+	mock.stub.AddCall("WatchForModelConfigChanges")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return &mockWatcher{
+		Worker:  workertest.NewErrorWorker(nil),
+		changes: mock.changes,
+	}, nil
+}
+
+// ModelConfig is part of the actionpruner.Facade interface.
+func (mock *mockFacade) ModelConfig() (*config.Config, error) {
+	mock.stub.AddCall("ModelConfig")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+	return mock.config, nil
+}
+
+// Prune is part of the actionpruner.Facade interface.
+func (mock *mockFacade) Prune(maxAge time.Duration, maxSizeMB int) error {
+	mock.stub.AddCall("Prune", maxAge, maxSizeMB)
+	defer func() { mock.pruned <- struct{}{} }()
+	return mock.stub.NextErr()
+}
+
+type mockWatcher struct {
+	worker.Worker
+	changes chan struct{}
+}
+
+func (w *mockWatcher) Changes() watcher.NotifyChannel {
+	return w.changes
+}
@@ -0,0 +1,111 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package actionscheduler provides a worker that enqueues scheduled
+// actions when they become due.
+package actionscheduler
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+// Facade exposes the controller functionality required by a Worker.
+type Facade interface {
+
+	// WatchSchedules returns a watcher that notifies when action
+	// schedules are added, removed or fired.
+	WatchSchedules() (watcher.NotifyWatcher, error)
+
+	// FireDueSchedules enqueues the actions of all the schedules that
+	// are due, and returns when the next schedule is due, or the zero
+	// time if there are none.
+	FireDueSchedules() (time.Time, error)
+}
+
+// Config holds the dependencies and configuration for a Worker.
+type Config struct {
+	Facade Facade
+	Clock  clock.Clock
+}
+
+// Validate returns an error if the config cannot be expected to
+// drive a functional Worker.
+func (config Config) Validate() error {
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	return nil
+}
+
+// New returns a Worker that fires the model's action schedules as they
+// become due.
+func New(config Config) (*Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	w := &Worker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Worker fires action schedules whenever they change, and whenever
+// the earliest of them becomes due.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	watcher, err := w.config.Facade.WatchSchedules()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+	var due <-chan time.Time
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-watcher.Changes():
+			if !ok {
+				return errors.New("action schedules watcher closed")
+			}
+		case <-due:
+		}
+		next, err := w.config.Facade.FireDueSchedules()
+		if err != nil {
+			return errors.Annotate(err, "cannot fire action schedules")
+		}
+		due = nil
+		if !next.IsZero() {
+			due = w.config.Clock.After(next.Sub(w.config.Clock.Now()))
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package actionscheduler_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/actionscheduler"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+
+	stub   *testing.Stub
+	clock  *testing.Clock
+	facade *mockFacade
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+var now = time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC)
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.stub = &testing.Stub{}
+	s.clock = testing.NewClock(now)
+	s.facade = &mockFacade{
+		stub:    s.stub,
+		changes: make(chan struct{}, 1),
+		fired:   make(chan struct{}, 10),
+	}
+}
+
+func (s *WorkerSuite) newWorker(c *gc.C) *actionscheduler.Worker {
+	w, err := actionscheduler.New(actionscheduler.Config{
+		Facade: s.facade,
+		Clock:  s.clock,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return w
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	_, err := actionscheduler.New(actionscheduler.Config{Clock: s.clock})
+	c.Check(err, gc.ErrorMatches, "nil Facade not valid")
+	_, err = actionscheduler.New(actionscheduler.Config{Facade: s.facade})
+	c.Check(err, gc.ErrorMatches, "nil Clock not valid")
+}
+
+func (s *WorkerSuite) TestWatchError(c *gc.C) {
+	s.stub.SetErrors(errors.New("boom"))
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "boom")
+	s.stub.CheckCallNames(c, "WatchSchedules")
+}
+
+func (s *WorkerSuite) TestFireError(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("boom"))
+	s.facade.changes <- struct{}{}
+	w := s.newWorker(c)
+	err := workertest.CheckKilled(c, w)
+	c.Check(err, gc.ErrorMatches, "cannot fire action schedules: boom")
+	s.stub.CheckCallNames(c, "WatchSchedules", "FireDueSchedules")
+}
+
+func (s *WorkerSuite) TestFiresOnChange(c *gc.C) {
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.facade.changes <- struct{}{}
+	s.waitFired(c)
+	s.facade.changes <- struct{}{}
+	s.waitFired(c)
+	s.stub.CheckCallNames(c, "WatchSchedules", "FireDueSchedules", "FireDueSchedules")
+}
+
+func (s *WorkerSuite) TestFiresWhenDue(c *gc.C) {
+	s.facade.next = []time.Time{now.Add(time.Hour), {}}
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.facade.changes <- struct{}{}
+	s.waitFired(c)
+	s.waitAlarm(c)
+
+	s.clock.Advance(time.Hour - time.Second)
+	select {
+	case <-s.facade.fired:
+		c.Fatalf("fired before due")
+	case <-time.After(coretesting.ShortWait):
+	}
+	s.clock.Advance(time.Second)
+	s.waitFired(c)
+	s.stub.CheckCallNames(c, "WatchSchedules", "FireDueSchedules", "FireDueSchedules")
+}
+
+func (s *WorkerSuite) waitFired(c *gc.C) {
+	select {
+	case <-s.facade.fired:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for schedules to be fired")
+	}
+}
+
+func (s *WorkerSuite) waitAlarm(c *gc.C) {
+	select {
+	case <-s.clock.Alarms():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for alarm")
+	}
+}
+
+type mockFacade struct {
+	stub    *testing.Stub
+	changes chan struct{}
+	fired   chan struct{}
+	next    []time.Time
+}
+
+// WatchSchedules is part of the actionscheduler.Facade interface.
+func (mock *mockFacade) WatchSchedules() (watcher.NotifyWatcher, error) {
+	mock.stub.AddCall("WatchSchedules")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return &mockWatcher{
+		Worker:  workertest.NewErrorWorker(nil),
+		changes: mock.changes,
+	}, nil
+}
+
+// FireDueSchedules is part of the actionscheduler.Facade interface.
+func (mock *mockFacade) FireDueSchedules() (time.Time, error) {
+	mock.stub.AddCall("FireDueSchedules")
+	defer func() { mock.fired <- struct{}{} }()
+	if err := mock.stub.NextErr(); err != nil {
+		return time.Time{}, err
+	}
+	var next time.Time
+	if len(mock.next) > 0 {
+		next, mock.next = mock.next[0], mock.next[1:]
+	}
+	return next, nil
+}
+
+type mockWatcher struct {
+	worker.Worker
+	changes chan struct{}
+}
+
+func (w *mockWatcher) Changes() watcher.NotifyChannel {
+	return w.changes
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/backups"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/dependency"
+	workerstate "github.com/juju/juju/worker/state"
+)
+
+// ManifoldConfig holds the dependencies and configuration for a
+// backup scheduler manifold.
+type ManifoldConfig struct {
+	AgentName string
+	StateName string
+	Clock     clock.Clock
+
+	NewBackend func(st *state.State, paths backups.Paths, machineID string) Backend
+	NewWorker  func(Config) (worker.Worker, error)
+}
+
+// Manifold returns a dependency.Manifold that runs a backup scheduler
+// worker according to the supplied configuration. The manifold
+// uninstalls itself if the controller has no backup schedule.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.StateName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			if config.Clock == nil {
+				return nil, errors.NotValidf("nil Clock")
+			}
+			var agent agent.Agent
+			if err := context.Get(config.AgentName, &agent); err != nil {
+				return nil, errors.Trace(err)
+			}
+			var stTracker workerstate.StateTracker
+			if err := context.Get(config.StateName, &stTracker); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			agentConfig := agent.CurrentConfig()
+			paths := backups.Paths{
+				DataDir: agentConfig.DataDir(),
+				LogsDir: agentConfig.LogDir(),
+			}
+
+			st, err := stTracker.Use()
+			if err != nil {
+				return nil, errors.Annotate(err, "acquiring state")
+			}
+			w, err := config.start(st, paths, agentConfig.Tag().Id())
+			if err != nil {
+				stTracker.Done()
+				return nil, errors.Trace(err)
+			}
+
+			// When the worker is done, indicate that we no longer
+			// need the State.
+			go func() {
+				w.Wait()
+				stTracker.Done()
+			}()
+			return w, nil
+		},
+	}
+}
+
+func (config ManifoldConfig) start(st *state.State, paths backups.Paths, machineID string) (worker.Worker, error) {
+	backend := config.NewBackend(st, paths, machineID)
+	controllerConfig, err := backend.ControllerConfig()
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot read controller config")
+	}
+	schedule := controllerConfig.BackupSchedule()
+	if schedule == "" {
+		return nil, dependency.ErrUninstall
+	}
+	w, err := config.NewWorker(Config{
+		Backend:        backend,
+		Clock:          config.Clock,
+		Schedule:       schedule,
+		RetentionCount: controllerConfig.BackupRetentionCount(),
+		RetentionAge:   controllerConfig.BackupRetentionAge(),
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot create worker")
+	}
+	return w, nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/utils/clock"
+	gc "gopkg.in/check.v1"
+	"gopkg.in/juju/names.v2"
+
+	"github.com/juju/juju/agent"
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/backups"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/backupscheduler"
+	"github.com/juju/juju/worker/dependency"
+	dt "github.com/juju/juju/worker/dependency/testing"
+)
+
+type ManifoldSuite struct {
+	testing.IsolationSuite
+
+	stub      *testing.Stub
+	backend   *mockBackend
+	stTracker *fakeStateTracker
+	manifold  dependency.Manifold
+	context   dependency.Context
+}
+
+var _ = gc.Suite(&ManifoldSuite{})
+
+func (s *ManifoldSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.stub = &testing.Stub{}
+	s.backend = &mockBackend{
+		stub: s.stub,
+		config: controller.Config{
+			controller.BackupSchedule:       "@daily",
+			controller.BackupRetentionCount: 7,
+			controller.BackupRetentionAge:   "720h",
+		},
+	}
+	s.stTracker = &fakeStateTracker{stub: s.stub}
+	s.manifold = backupscheduler.Manifold(backupscheduler.ManifoldConfig{
+		AgentName: "agent",
+		StateName: "state",
+		Clock:     fakeClock{},
+		NewBackend: func(st *state.State, paths backups.Paths, machineID string) backupscheduler.Backend {
+			s.stub.AddCall("NewBackend", st, paths, machineID)
+			return s.backend
+		},
+		NewWorker: func(config backupscheduler.Config) (worker.Worker, error) {
+			s.stub.AddCall("NewWorker", config)
+			if err := s.stub.NextErr(); err != nil {
+				return nil, err
+			}
+			return &fakeWorker{done: make(chan struct{})}, nil
+		},
+	})
+	s.context = dt.StubContext(nil, map[string]interface{}{
+		"agent": &fakeAgent{},
+		"state": s.stTracker,
+	})
+}
+
+func (s *ManifoldSuite) TestInputs(c *gc.C) {
+	c.Check(s.manifold.Inputs, jc.DeepEquals, []string{"agent", "state"})
+	c.Check(s.manifold.Output, gc.IsNil)
+}
+
+func (s *ManifoldSuite) TestMissingState(c *gc.C) {
+	context := dt.StubContext(nil, map[string]interface{}{
+		"agent": &fakeAgent{},
+		"state": dependency.ErrMissing,
+	})
+	_, err := s.manifold.Start(context)
+	c.Check(errors.Cause(err), gc.Equals, dependency.ErrMissing)
+}
+
+func (s *ManifoldSuite) TestNoSchedule(c *gc.C) {
+	delete(s.backend.config, controller.BackupSchedule)
+	_, err := s.manifold.Start(s.context)
+	c.Check(errors.Cause(err), gc.Equals, dependency.ErrUninstall)
+	s.stub.CheckCallNames(c, "Use", "NewBackend", "ControllerConfig", "Done")
+}
+
+func (s *ManifoldSuite) TestNewWorkerError(c *gc.C) {
+	s.stub.SetErrors(nil, nil, errors.New("boom"))
+	_, err := s.manifold.Start(s.context)
+	c.Check(err, gc.ErrorMatches, "cannot create worker: boom")
+	s.stub.CheckCallNames(c, "Use", "NewBackend", "ControllerConfig", "NewWorker", "Done")
+}
+
+func (s *ManifoldSuite) TestSuccess(c *gc.C) {
+	w, err := s.manifold.Start(s.context)
+	c.Assert(err, jc.ErrorIsNil)
+	s.stub.CheckCallNames(c, "Use", "NewBackend", "ControllerConfig", "NewWorker")
+	s.stub.CheckCall(c, 1, "NewBackend", (*state.State)(nil), backups.Paths{
+		DataDir: "/var/lib/juju",
+		LogsDir: "/var/log/juju",
+	}, "0")
+	s.stub.CheckCall(c, 3, "NewWorker", backupscheduler.Config{
+		Backend:        s.backend,
+		Clock:          fakeClock{},
+		Schedule:       "@daily",
+		RetentionCount: 7,
+		RetentionAge:   720 * time.Hour,
+	})
+
+	// The state is released when the worker stops.
+	w.Kill()
+	c.Assert(w.Wait(), jc.ErrorIsNil)
+	select {
+	case <-s.stTracker.done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for state to be released")
+	}
+}
+
+type fakeClock struct {
+	clock.Clock
+}
+
+type fakeWorker struct {
+	done chan struct{}
+}
+
+func (w *fakeWorker) Kill() {
+	close(w.done)
+}
+
+func (w *fakeWorker) Wait() error {
+	<-w.done
+	return nil
+}
+
+type fakeStateTracker struct {
+	stub *testing.Stub
+	done chan struct{}
+}
+
+// Use is part of the workerstate.StateTracker interface.
+func (t *fakeStateTracker) Use() (*state.State, error) {
+	t.stub.AddCall("Use")
+	t.done = make(chan struct{})
+	return nil, t.stub.NextErr()
+}
+
+// Done is part of the workerstate.StateTracker interface.
+func (t *fakeStateTracker) Done() error {
+	t.stub.AddCall("Done")
+	close(t.done)
+	return nil
+}
+
+// fakeAgent exists to expose the agent's paths and tag.
+type fakeAgent struct {
+	agent.Agent
+}
+
+// CurrentConfig returns an agent.Config with working DataDir(),
+// LogDir() and Tag() methods.
+func (a *fakeAgent) CurrentConfig() agent.Config {
+	return &fakeConfig{}
+}
+
+type fakeConfig struct {
+	agent.Config
+}
+
+func (c *fakeConfig) DataDir() string {
+	return "/var/lib/juju"
+}
+
+func (c *fakeConfig) LogDir() string {
+	return "/var/log/juju"
+}
+
+func (c *fakeConfig) Tag() names.Tag {
+	return names.NewMachineTag("0")
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/replicaset"
+
+	"github.com/juju/juju/mongo"
+	"github.com/juju/juju/state"
+	"github.com/juju/juju/state/backups"
+	"github.com/juju/juju/worker"
+)
+
+// This file contains untested shims to let us wrap state in a sensible
+// interface and avoid writing tests that depend on mongodb. If you were
+// to change any part of it so that it were no longer *obviously* and
+// *trivially* correct, you would be Doing It Wrong.
+
+// NewBackend returns a Backend that takes backups of the supplied
+// State, from the machine with the supplied id.
+func NewBackend(st *state.State, paths backups.Paths, machineID string) Backend {
+	return &stateBackend{
+		State:     st,
+		paths:     paths,
+		machineID: machineID,
+	}
+}
+
+// NewWorker creates a *Worker and returns it as a worker.Worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	worker, err := New(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return worker, nil
+}
+
+type stateBackend struct {
+	*state.State
+	paths     backups.Paths
+	machineID string
+}
+
+// IsMaster is part of the Backend interface.
+func (b *stateBackend) IsMaster() (bool, error) {
+	machine, err := b.State.Machine(b.machineID)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	return mongo.IsMaster(b.State.MongoSession(), machine)
+}
+
+// Create is part of the Backend interface. It follows the same steps
+// as the Backups facade's Create method.
+func (b *stateBackend) Create() (*backups.Metadata, error) {
+	stor := backups.NewStorage(b.State)
+	defer stor.Close()
+
+	session := b.State.MongoSession().Copy()
+	defer session.Close()
+
+	// Don't go if HA isn't ready.
+	if err := replicaset.WaitUntilReady(session, 60); err != nil {
+		return nil, errors.Annotatef(err, "HA not ready")
+	}
+
+	v, err := b.State.MongoVersion()
+	if err != nil {
+		return nil, errors.Annotatef(err, "discovering mongo version")
+	}
+	mongoVersion, err := mongo.NewVersion(v)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	dbInfo, err := backups.NewDBInfo(b.State.MongoConnectionInfo(), session, mongoVersion)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	machine, err := b.State.Machine(b.machineID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	meta, err := backups.NewMetadataState(b.State, b.machineID, machine.Series())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	meta.Scheduled = true
+
+	// Scheduled backups are encrypted with the controller's key, if
+	// it has one.
+	controllerConfig, err := b.State.ControllerConfig()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var key []byte
+	if path := controllerConfig.BackupEncryptionKeyFile(); path != "" {
+		if key, err = backups.ReadEncryptionKey(path); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+
+	if err := backups.NewBackups(stor).Create(meta, &b.paths, dbInfo, key); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return meta, nil
+}
+
+// List is part of the Backend interface.
+func (b *stateBackend) List() ([]*backups.Metadata, error) {
+	stor := backups.NewStorage(b.State)
+	defer stor.Close()
+	return backups.NewBackups(stor).List()
+}
+
+// Remove is part of the Backend interface.
+func (b *stateBackend) Remove(id string) error {
+	stor := backups.NewStorage(b.State)
+	defer stor.Close()
+	return backups.NewBackups(stor).Remove(id)
+}
@@ -0,0 +1,211 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package backupscheduler provides a worker that takes controller
+// backups on a schedule, and removes old scheduled backups according
+// to the controller's retention policy.
+package backupscheduler
+
+import (
+	"sort"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/core/cron"
+	"github.com/juju/juju/state/backups"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+var logger = loggo.GetLogger("juju.worker.backupscheduler")
+
+// Backend exposes the controller functionality required by a Worker
+// and its manifold.
+type Backend interface {
+
+	// ControllerConfig returns the controller's configuration, which
+	// holds the backup schedule and retention policy.
+	ControllerConfig() (controller.Config, error)
+
+	// IsMaster reports whether the agent's machine hosts the primary
+	// of the mongo replicaset. Only that machine takes scheduled
+	// backups, so that each is taken once in an HA controller.
+	IsMaster() (bool, error)
+
+	// Create takes a backup, tagged as scheduled, and returns its
+	// metadata.
+	Create() (*backups.Metadata, error)
+
+	// List returns the metadata for all stored backups.
+	List() ([]*backups.Metadata, error)
+
+	// Remove deletes the backup with the given id.
+	Remove(id string) error
+}
+
+// Config holds the dependencies and configuration for a Worker.
+type Config struct {
+	Backend Backend
+	Clock   clock.Clock
+
+	// Schedule is the cron-style specification of when backups are
+	// taken, as accepted by cron.Parse.
+	Schedule string
+
+	// RetentionCount is the number of scheduled backups that are
+	// kept, or zero if there is no limit.
+	RetentionCount int
+
+	// RetentionAge is how long scheduled backups are kept, or zero if
+	// there is no limit.
+	RetentionAge time.Duration
+}
+
+// Validate returns an error if the config cannot be expected to
+// drive a functional Worker.
+func (config Config) Validate() error {
+	if config.Backend == nil {
+		return errors.NotValidf("nil Backend")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Schedule == "" {
+		return errors.NotValidf("empty Schedule")
+	}
+	if _, err := cron.Parse(config.Schedule); err != nil {
+		return errors.Trace(err)
+	}
+	if config.RetentionCount < 0 {
+		return errors.NotValidf("negative RetentionCount")
+	}
+	if config.RetentionAge < 0 {
+		return errors.NotValidf("negative RetentionAge")
+	}
+	return nil
+}
+
+// New returns a Worker that takes backups according to the supplied
+// configuration.
+func New(config Config) (*Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	// The schedule was checked by Validate.
+	schedule, _ := cron.Parse(config.Schedule)
+	w := &Worker{
+		config:   config,
+		schedule: schedule,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Worker takes a backup whenever its schedule is due, and then removes
+// the scheduled backups that are no longer retained.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+	schedule cron.Schedule
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() error {
+	for {
+		now := w.config.Clock.Now()
+		next := w.schedule.Next(now)
+		if next.IsZero() {
+			logger.Warningf("backup schedule %q never occurs", w.config.Schedule)
+			<-w.catacomb.Dying()
+			return w.catacomb.ErrDying()
+		}
+		logger.Debugf("next scheduled backup at %s", next.Format(time.RFC3339))
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-w.config.Clock.After(next.Sub(now)):
+		}
+		// A failed backup is not fatal: the next one is attempted
+		// when the schedule is next due.
+		if err := w.backup(); err != nil {
+			logger.Errorf("scheduled backup failed: %v", err)
+		}
+	}
+}
+
+// backup takes a scheduled backup if the agent's machine hosts the
+// mongo primary, and then prunes the scheduled backups.
+func (w *Worker) backup() error {
+	master, err := w.config.Backend.IsMaster()
+	if err != nil {
+		return errors.Annotate(err, "cannot determine mongo primary")
+	}
+	if !master {
+		logger.Debugf("not the mongo primary; skipping scheduled backup")
+		return nil
+	}
+	meta, err := w.config.Backend.Create()
+	if err != nil {
+		return errors.Annotate(err, "cannot create backup")
+	}
+	logger.Infof("created scheduled backup %q", meta.ID())
+	return errors.Annotate(w.prune(), "cannot remove old backups")
+}
+
+// prune removes the scheduled backups that exceed the retention count
+// or age. Backups requested by users are never removed.
+func (w *Worker) prune() error {
+	if w.config.RetentionCount == 0 && w.config.RetentionAge == 0 {
+		return nil
+	}
+	all, err := w.config.Backend.List()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var scheduled []*backups.Metadata
+	for _, meta := range all {
+		if meta.Scheduled {
+			scheduled = append(scheduled, meta)
+		}
+	}
+	sort.Sort(byNewest(scheduled))
+
+	cutoff := w.config.Clock.Now().Add(-w.config.RetentionAge)
+	for i, meta := range scheduled {
+		tooMany := w.config.RetentionCount > 0 && i >= w.config.RetentionCount
+		tooOld := w.config.RetentionAge > 0 && meta.Started.Before(cutoff)
+		if !tooMany && !tooOld {
+			continue
+		}
+		if err := w.config.Backend.Remove(meta.ID()); err != nil {
+			return errors.Annotatef(err, "removing backup %q", meta.ID())
+		}
+		logger.Infof("removed scheduled backup %q", meta.ID())
+	}
+	return nil
+}
+
+// byNewest sorts backup metadata by start time, newest first.
+type byNewest []*backups.Metadata
+
+func (b byNewest) Len() int           { return len(b) }
+func (b byNewest) Less(i, j int) bool { return b[i].Started.After(b[j].Started) }
+func (b byNewest) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
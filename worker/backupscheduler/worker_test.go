@@ -0,0 +1,228 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package backupscheduler_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/controller"
+	"github.com/juju/juju/state/backups"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/backupscheduler"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type WorkerSuite struct {
+	testing.IsolationSuite
+
+	stub    *testing.Stub
+	clock   *testing.Clock
+	backend *mockBackend
+}
+
+var _ = gc.Suite(&WorkerSuite{})
+
+var now = time.Date(2017, 3, 1, 10, 30, 0, 0, time.UTC)
+
+func (s *WorkerSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.stub = &testing.Stub{}
+	s.clock = testing.NewClock(now)
+	s.backend = &mockBackend{
+		stub:   s.stub,
+		master: true,
+	}
+}
+
+func (s *WorkerSuite) config() backupscheduler.Config {
+	return backupscheduler.Config{
+		Backend:  s.backend,
+		Clock:    s.clock,
+		Schedule: "0 * * * *",
+	}
+}
+
+func (s *WorkerSuite) TestValidate(c *gc.C) {
+	tests := []struct {
+		mutate func(*backupscheduler.Config)
+		err    string
+	}{{
+		func(config *backupscheduler.Config) { config.Backend = nil },
+		"nil Backend not valid",
+	}, {
+		func(config *backupscheduler.Config) { config.Clock = nil },
+		"nil Clock not valid",
+	}, {
+		func(config *backupscheduler.Config) { config.Schedule = "" },
+		"empty Schedule not valid",
+	}, {
+		func(config *backupscheduler.Config) { config.Schedule = "0 * *" },
+		`recurrence "0 \* \*" \(expected 5 fields, got 3\) not valid`,
+	}, {
+		func(config *backupscheduler.Config) { config.RetentionCount = -1 },
+		"negative RetentionCount not valid",
+	}, {
+		func(config *backupscheduler.Config) { config.RetentionAge = -time.Hour },
+		"negative RetentionAge not valid",
+	}}
+	for i, test := range tests {
+		c.Logf("test %d", i)
+		config := s.config()
+		test.mutate(&config)
+		_, err := backupscheduler.New(config)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *WorkerSuite) TestBacksUpWhenDue(c *gc.C) {
+	w := s.startWorker(c, s.config())
+	defer workertest.CleanKill(c, w)
+
+	s.clock.Advance(30*time.Minute - time.Second)
+	select {
+	case <-s.clock.Alarms():
+		c.Fatalf("backed up before due")
+	case <-time.After(coretesting.ShortWait):
+	}
+	s.stub.CheckNoCalls(c)
+
+	s.clock.Advance(time.Second)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "IsMaster", "Create")
+}
+
+func (s *WorkerSuite) TestNotMaster(c *gc.C) {
+	s.backend.master = false
+	w := s.startWorker(c, s.config())
+	defer workertest.CleanKill(c, w)
+
+	s.clock.Advance(30 * time.Minute)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "IsMaster")
+}
+
+func (s *WorkerSuite) TestCreateErrorNotFatal(c *gc.C) {
+	s.stub.SetErrors(nil, errors.New("boom"))
+	w := s.startWorker(c, s.config())
+	defer workertest.CleanKill(c, w)
+
+	s.clock.Advance(30 * time.Minute)
+	s.waitAlarm(c)
+	s.clock.Advance(time.Hour)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "IsMaster", "Create", "IsMaster", "Create")
+}
+
+func (s *WorkerSuite) TestPruneByCount(c *gc.C) {
+	s.backend.list = []*backups.Metadata{
+		newMetadata("manual", now.Add(-72*time.Hour), false),
+		newMetadata("oldest", now.Add(-48*time.Hour), true),
+		newMetadata("newest", now.Add(-time.Hour), true),
+		newMetadata("older", now.Add(-24*time.Hour), true),
+	}
+	config := s.config()
+	config.RetentionCount = 1
+	w := s.startWorker(c, config)
+	defer workertest.CleanKill(c, w)
+
+	s.clock.Advance(30 * time.Minute)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "IsMaster", "Create", "List", "Remove", "Remove")
+	s.stub.CheckCall(c, 3, "Remove", "older")
+	s.stub.CheckCall(c, 4, "Remove", "oldest")
+}
+
+func (s *WorkerSuite) TestPruneByAge(c *gc.C) {
+	s.backend.list = []*backups.Metadata{
+		newMetadata("manual", now.Add(-72*time.Hour), false),
+		newMetadata("oldest", now.Add(-48*time.Hour), true),
+		newMetadata("newest", now.Add(-time.Hour), true),
+	}
+	config := s.config()
+	config.RetentionAge = 24 * time.Hour
+	w := s.startWorker(c, config)
+	defer workertest.CleanKill(c, w)
+
+	s.clock.Advance(30 * time.Minute)
+	s.waitAlarm(c)
+	s.stub.CheckCallNames(c, "IsMaster", "Create", "List", "Remove")
+	s.stub.CheckCall(c, 3, "Remove", "oldest")
+}
+
+func (s *WorkerSuite) startWorker(c *gc.C, config backupscheduler.Config) *backupscheduler.Worker {
+	w, err := backupscheduler.New(config)
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitAlarm(c)
+	return w
+}
+
+func (s *WorkerSuite) waitAlarm(c *gc.C) {
+	select {
+	case <-s.clock.Alarms():
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for alarm")
+	}
+}
+
+func newMetadata(id string, started time.Time, scheduled bool) *backups.Metadata {
+	meta := backups.NewMetadata()
+	meta.SetID(id)
+	meta.Started = started
+	meta.Scheduled = scheduled
+	return meta
+}
+
+type mockBackend struct {
+	stub   *testing.Stub
+	config controller.Config
+	master bool
+	list   []*backups.Metadata
+}
+
+// ControllerConfig is part of the backupscheduler.Backend interface.
+func (mock *mockBackend) ControllerConfig() (controller.Config, error) {
+	mock.stub.AddCall("ControllerConfig")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return mock.config, nil
+}
+
+// IsMaster is part of the backupscheduler.Backend interface.
+func (mock *mockBackend) IsMaster() (bool, error) {
+	mock.stub.AddCall("IsMaster")
+	if err := mock.stub.NextErr(); err != nil {
+		return false, err
+	}
+	return mock.master, nil
+}
+
+// Create is part of the backupscheduler.Backend interface.
+func (mock *mockBackend) Create() (*backups.Metadata, error) {
+	mock.stub.AddCall("Create")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return newMetadata("new", now.Add(30*time.Minute), true), nil
+}
+
+// List is part of the backupscheduler.Backend interface.
+func (mock *mockBackend) List() ([]*backups.Metadata, error) {
+	mock.stub.AddCall("List")
+	if err := mock.stub.NextErr(); err != nil {
+		return nil, err
+	}
+	return mock.list, nil
+}
+
+// Remove is part of the backupscheduler.Backend interface.
+func (mock *mockBackend) Remove(id string) error {
+	mock.stub.AddCall("Remove", id)
+	return mock.stub.NextErr()
+}
@@ -37,7 +37,7 @@ type Firewaller struct {
 	applicationids  map[names.ApplicationTag]*serviceData
 	exposedChange   chan *exposedChange
 	globalMode      bool
-	globalPortRef   map[network.PortRange]int
+	globalRuleRef   map[string]int
 	machinePorts    map[names.MachineTag]machineRanges
 }
 
@@ -63,7 +63,7 @@ func NewFirewaller(
 	case config.FwInstance:
 	case config.FwGlobal:
 		fw.globalMode = true
-		fw.globalPortRef = make(map[network.PortRange]int)
+		fw.globalRuleRef = make(map[string]int)
 	default:
 		return nil, errors.Errorf("invalid firewall-mode %q", mode)
 	}
@@ -150,6 +150,7 @@ func (fw *Firewaller) loop() error {
 			}
 		case change := <-fw.exposedChange:
 			change.serviced.exposed = change.exposed
+			change.serviced.exposedCIDRs = change.exposedCIDRs
 			unitds := []*unitData{}
 			for _, unitd := range change.serviced.unitds {
 				unitds = append(unitds, unitd)
@@ -168,7 +169,7 @@ func (fw *Firewaller) startMachine(tag names.MachineTag) error {
 		fw:           fw,
 		tag:          tag,
 		unitds:       make(map[names.UnitTag]*unitData),
-		openedPorts:  make([]network.PortRange, 0),
+		openedRules:  make([]network.IngressRule, 0),
 		definedPorts: make(map[network.PortRange]names.UnitTag),
 	}
 	m, err := machined.machine()
@@ -281,16 +282,21 @@ func (fw *Firewaller) startService(service *firewaller.Application) error {
 	if err != nil {
 		return err
 	}
+	exposedCIDRs, err := service.ExposedCIDRs()
+	if err != nil {
+		return err
+	}
 	serviced := &serviceData{
-		fw:          fw,
-		application: service,
-		exposed:     exposed,
-		unitds:      make(map[names.UnitTag]*unitData),
+		fw:           fw,
+		application:  service,
+		exposed:      exposed,
+		exposedCIDRs: exposedCIDRs,
+		unitds:       make(map[names.UnitTag]*unitData),
 	}
 	err = catacomb.Invoke(catacomb.Plan{
 		Site: &serviced.catacomb,
 		Work: func() error {
-			return serviced.watchLoop(exposed)
+			return serviced.watchLoop(exposed, exposedCIDRs)
 		},
 	})
 	if err != nil {
@@ -307,11 +313,11 @@ func (fw *Firewaller) startService(service *firewaller.Application) error {
 // units and services with the opened and closed ports globally and
 // opens and closes the appropriate ports for the whole environment.
 func (fw *Firewaller) reconcileGlobal() error {
-	initialPortRanges, err := fw.environ.Ports()
+	initialRules, err := fw.environIngressRules()
 	if err != nil {
 		return err
 	}
-	collector := make(map[network.PortRange]bool)
+	collector := make(map[string]network.IngressRule)
 	for _, machined := range fw.machineds {
 		for portRange, unitTag := range machined.definedPorts {
 			unitd, known := machined.unitds[unitTag]
@@ -320,30 +326,32 @@ func (fw *Firewaller) reconcileGlobal() error {
 				continue
 			}
 			if unitd.serviced.exposed {
-				collector[portRange] = true
+				for _, rule := range unitd.serviced.ingressRules(portRange) {
+					collector[rule.String()] = rule
+				}
 			}
 		}
 	}
-	wantedPorts := []network.PortRange{}
-	for port := range collector {
-		wantedPorts = append(wantedPorts, port)
+	wantedRules := []network.IngressRule{}
+	for _, rule := range collector {
+		wantedRules = append(wantedRules, rule)
 	}
 	// Check which ports to open or to close.
-	toOpen := diffRanges(wantedPorts, initialPortRanges)
-	toClose := diffRanges(initialPortRanges, wantedPorts)
+	toOpen := diffRules(wantedRules, initialRules)
+	toClose := diffRules(initialRules, wantedRules)
 	if len(toOpen) > 0 {
+		network.SortIngressRules(toOpen)
 		logger.Infof("opening global ports %v", toOpen)
-		if err := fw.environ.OpenPorts(toOpen); err != nil {
+		if err := fw.openEnvironIngressRules(toOpen); err != nil {
 			return err
 		}
-		network.SortPortRanges(toOpen)
 	}
 	if len(toClose) > 0 {
+		network.SortIngressRules(toClose)
 		logger.Infof("closing global ports %v", toClose)
-		if err := fw.environ.ClosePorts(toClose); err != nil {
+		if err := fw.closeEnvironIngressRules(toClose); err != nil {
 			return err
 		}
-		network.SortPortRanges(toClose)
 	}
 	return nil
 }
@@ -379,31 +387,31 @@ func (fw *Firewaller) reconcileInstances() error {
 			return err
 		}
 		machineId := machined.tag.Id()
-		initialPortRanges, err := instances[0].Ports(machineId)
+		initialRules, err := instanceIngressRules(instances[0], machineId)
 		if err != nil {
 			return err
 		}
 
 		// Check which ports to open or to close.
-		toOpen := diffRanges(machined.openedPorts, initialPortRanges)
-		toClose := diffRanges(initialPortRanges, machined.openedPorts)
+		toOpen := diffRules(machined.openedRules, initialRules)
+		toClose := diffRules(initialRules, machined.openedRules)
 		if len(toOpen) > 0 {
+			network.SortIngressRules(toOpen)
 			logger.Infof("opening instance port ranges %v for %q",
 				toOpen, machined.tag)
-			if err := instances[0].OpenPorts(machineId, toOpen); err != nil {
+			if err := openInstanceIngressRules(instances[0], machineId, toOpen); err != nil {
 				// TODO(mue) Add local retry logic.
 				return err
 			}
-			network.SortPortRanges(toOpen)
 		}
 		if len(toClose) > 0 {
+			network.SortIngressRules(toClose)
 			logger.Infof("closing instance port ranges %v for %q",
 				toClose, machined.tag)
-			if err := instances[0].ClosePorts(machineId, toClose); err != nil {
+			if err := closeInstanceIngressRules(instances[0], machineId, toClose); err != nil {
 				// TODO(mue) Add local retry logic.
 				return err
 			}
-			network.SortPortRanges(toClose)
 		}
 	}
 	return nil
@@ -525,7 +533,7 @@ func (fw *Firewaller) flushUnits(unitds []*unitData) error {
 // flushMachine opens and closes ports for the passed machine.
 func (fw *Firewaller) flushMachine(machined *machineData) error {
 	// Gather ports to open and close.
-	want := []network.PortRange{}
+	want := []network.IngressRule{}
 	for portRange, unitTag := range machined.definedPorts {
 		unitd, known := machined.unitds[unitTag]
 		if !known {
@@ -533,12 +541,12 @@ func (fw *Firewaller) flushMachine(machined *machineData) error {
 			continue
 		}
 		if unitd.serviced.exposed {
-			want = append(want, portRange)
+			want = append(want, unitd.serviced.ingressRules(portRange)...)
 		}
 	}
-	toOpen := diffRanges(want, machined.openedPorts)
-	toClose := diffRanges(machined.openedPorts, want)
-	machined.openedPorts = want
+	toOpen := diffRules(want, machined.openedRules)
+	toClose := diffRules(machined.openedRules, want)
+	machined.openedRules = want
 	if fw.globalMode {
 		return fw.flushGlobalPorts(toOpen, toClose)
 	}
@@ -548,44 +556,46 @@ func (fw *Firewaller) flushMachine(machined *machineData) error {
 // flushGlobalPorts opens and closes global ports in the environment.
 // It keeps a reference count for ports so that only 0-to-1 and 1-to-0 events
 // modify the environment.
-func (fw *Firewaller) flushGlobalPorts(rawOpen, rawClose []network.PortRange) error {
+func (fw *Firewaller) flushGlobalPorts(rawOpen, rawClose []network.IngressRule) error {
 	// Filter which ports are really to open or close.
-	var toOpen, toClose []network.PortRange
-	for _, portRange := range rawOpen {
-		if fw.globalPortRef[portRange] == 0 {
-			toOpen = append(toOpen, portRange)
+	var toOpen, toClose []network.IngressRule
+	for _, rule := range rawOpen {
+		key := rule.String()
+		if fw.globalRuleRef[key] == 0 {
+			toOpen = append(toOpen, rule)
 		}
-		fw.globalPortRef[portRange]++
-	}
-	for _, portRange := range rawClose {
-		fw.globalPortRef[portRange]--
-		if fw.globalPortRef[portRange] == 0 {
-			toClose = append(toClose, portRange)
-			delete(fw.globalPortRef, portRange)
+		fw.globalRuleRef[key]++
+	}
+	for _, rule := range rawClose {
+		key := rule.String()
+		fw.globalRuleRef[key]--
+		if fw.globalRuleRef[key] == 0 {
+			toClose = append(toClose, rule)
+			delete(fw.globalRuleRef, key)
 		}
 	}
 	// Open and close the ports.
 	if len(toOpen) > 0 {
-		if err := fw.environ.OpenPorts(toOpen); err != nil {
+		if err := fw.openEnvironIngressRules(toOpen); err != nil {
 			// TODO(mue) Add local retry logic.
 			return err
 		}
-		network.SortPortRanges(toOpen)
+		network.SortIngressRules(toOpen)
 		logger.Infof("opened port ranges %v in environment", toOpen)
 	}
 	if len(toClose) > 0 {
-		if err := fw.environ.ClosePorts(toClose); err != nil {
+		if err := fw.closeEnvironIngressRules(toClose); err != nil {
 			// TODO(mue) Add local retry logic.
 			return err
 		}
-		network.SortPortRanges(toClose)
+		network.SortIngressRules(toClose)
 		logger.Infof("closed port ranges %v in environment", toClose)
 	}
 	return nil
 }
 
 // flushInstancePorts opens and closes ports global on the machine.
-func (fw *Firewaller) flushInstancePorts(machined *machineData, toOpen, toClose []network.PortRange) error {
+func (fw *Firewaller) flushInstancePorts(machined *machineData, toOpen, toClose []network.IngressRule) error {
 	// If there's nothing to do, do nothing.
 	// This is important because when a machine is first created,
 	// it will have no instance id but also no open ports -
@@ -611,24 +621,124 @@ func (fw *Firewaller) flushInstancePorts(machined *machineData, toOpen, toClose
 	}
 	// Open and close the ports.
 	if len(toOpen) > 0 {
-		if err := instances[0].OpenPorts(machineId, toOpen); err != nil {
+		if err := openInstanceIngressRules(instances[0], machineId, toOpen); err != nil {
 			// TODO(mue) Add local retry logic.
 			return err
 		}
-		network.SortPortRanges(toOpen)
+		network.SortIngressRules(toOpen)
 		logger.Infof("opened port ranges %v on %q", toOpen, machined.tag)
 	}
 	if len(toClose) > 0 {
-		if err := instances[0].ClosePorts(machineId, toClose); err != nil {
+		if err := closeInstanceIngressRules(instances[0], machineId, toClose); err != nil {
 			// TODO(mue) Add local retry logic.
 			return err
 		}
-		network.SortPortRanges(toClose)
+		network.SortIngressRules(toClose)
 		logger.Infof("closed port ranges %v on %q", toClose, machined.tag)
 	}
 	return nil
 }
 
+// environIngressRules returns the ingress rules opened for the whole
+// environment.
+func (fw *Firewaller) environIngressRules() ([]network.IngressRule, error) {
+	if rf, ok := fw.environ.(environs.IngressRuleFirewaller); ok {
+		rules, err := rf.IngressRules()
+		if err != nil {
+			return nil, err
+		}
+		return splitIngressRules(rules), nil
+	}
+	ports, err := fw.environ.Ports()
+	if err != nil {
+		return nil, err
+	}
+	return openIngressRules(ports), nil
+}
+
+// openEnvironIngressRules opens the given ingress rules for the whole
+// environment.
+func (fw *Firewaller) openEnvironIngressRules(rules []network.IngressRule) error {
+	if rf, ok := fw.environ.(environs.IngressRuleFirewaller); ok {
+		return rf.OpenIngressRules(rules)
+	}
+	ports := unrestrictedPortRanges(rules, true)
+	if len(ports) == 0 {
+		return nil
+	}
+	return fw.environ.OpenPorts(ports)
+}
+
+// closeEnvironIngressRules closes the given ingress rules for the whole
+// environment.
+func (fw *Firewaller) closeEnvironIngressRules(rules []network.IngressRule) error {
+	if rf, ok := fw.environ.(environs.IngressRuleFirewaller); ok {
+		return rf.CloseIngressRules(rules)
+	}
+	ports := unrestrictedPortRanges(rules, false)
+	if len(ports) == 0 {
+		return nil
+	}
+	return fw.environ.ClosePorts(ports)
+}
+
+// instanceIngressRules returns the ingress rules opened on the instance.
+func instanceIngressRules(inst instance.Instance, machineId string) ([]network.IngressRule, error) {
+	if rf, ok := inst.(instance.IngressRuleFirewaller); ok {
+		rules, err := rf.IngressRules(machineId)
+		if err != nil {
+			return nil, err
+		}
+		return splitIngressRules(rules), nil
+	}
+	ports, err := inst.Ports(machineId)
+	if err != nil {
+		return nil, err
+	}
+	return openIngressRules(ports), nil
+}
+
+// openInstanceIngressRules opens the given ingress rules on the instance.
+func openInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	if rf, ok := inst.(instance.IngressRuleFirewaller); ok {
+		return rf.OpenIngressRules(machineId, rules)
+	}
+	ports := unrestrictedPortRanges(rules, true)
+	if len(ports) == 0 {
+		return nil
+	}
+	return inst.OpenPorts(machineId, ports)
+}
+
+// closeInstanceIngressRules closes the given ingress rules on the instance.
+func closeInstanceIngressRules(inst instance.Instance, machineId string, rules []network.IngressRule) error {
+	if rf, ok := inst.(instance.IngressRuleFirewaller); ok {
+		return rf.CloseIngressRules(machineId, rules)
+	}
+	ports := unrestrictedPortRanges(rules, false)
+	if len(ports) == 0 {
+		return nil
+	}
+	return inst.ClosePorts(machineId, ports)
+}
+
+// unrestrictedPortRanges returns the port ranges of the given rules
+// that allow traffic from anywhere, for use with firewalls that cannot
+// restrict the source of traffic. Rules restricted to source CIDRs are
+// never opened to everyone instead; if opening is true, an error is
+// logged for each of them.
+func unrestrictedPortRanges(rules []network.IngressRule, opening bool) []network.PortRange {
+	var ports []network.PortRange
+	for _, rule := range rules {
+		if rule.IsOpen() {
+			ports = append(ports, rule.PortRange)
+		} else if opening {
+			logger.Errorf("cannot open port range %v: firewall does not support source CIDRs", rule)
+		}
+	}
+	return ports
+}
+
 // machineLifeChanged starts watching new machines when the firewaller
 // is starting, or when new machines come to life, and stops watching
 // machines that are dying.
@@ -723,7 +833,7 @@ type machineData struct {
 	fw          *Firewaller
 	tag         names.MachineTag
 	unitds      map[names.UnitTag]*unitData
-	openedPorts []network.PortRange
+	openedRules []network.IngressRule
 	// ports defined by units on this machine
 	definedPorts map[network.PortRange]names.UnitTag
 }
@@ -773,23 +883,42 @@ type unitData struct {
 	machined *machineData
 }
 
-// exposedChange contains the changed exposed flag for one specific service.
+// exposedChange contains the changed exposed flag and source CIDRs
+// for one specific service.
 type exposedChange struct {
-	serviced *serviceData
-	exposed  bool
+	serviced     *serviceData
+	exposed      bool
+	exposedCIDRs []string
 }
 
 // serviceData holds service details and watches exposure changes.
 type serviceData struct {
-	catacomb    catacomb.Catacomb
-	fw          *Firewaller
-	application *firewaller.Application
-	exposed     bool
-	unitds      map[names.UnitTag]*unitData
+	catacomb     catacomb.Catacomb
+	fw           *Firewaller
+	application  *firewaller.Application
+	exposed      bool
+	exposedCIDRs []string
+	unitds       map[names.UnitTag]*unitData
 }
 
-// watchLoop watches the service's exposed flag for changes.
-func (sd *serviceData) watchLoop(exposed bool) error {
+// ingressRules returns the ingress rules, one for each source CIDR,
+// allowing access to the given port range of the exposed service.
+func (sd *serviceData) ingressRules(portRange network.PortRange) []network.IngressRule {
+	if len(sd.exposedCIDRs) == 0 {
+		return []network.IngressRule{network.NewOpenIngressRule(portRange)}
+	}
+	rules := make([]network.IngressRule, len(sd.exposedCIDRs))
+	for i, cidr := range sd.exposedCIDRs {
+		rules[i] = network.IngressRule{
+			PortRange:   portRange,
+			SourceCIDRs: []string{cidr},
+		}
+	}
+	return rules
+}
+
+// watchLoop watches the service's exposed flag and source CIDRs for changes.
+func (sd *serviceData) watchLoop(exposed bool, exposedCIDRs []string) error {
 	serviceWatcher, err := sd.application.Watch()
 	if err != nil {
 		return errors.Trace(err)
@@ -815,13 +944,19 @@ func (sd *serviceData) watchLoop(exposed bool) error {
 			if err != nil {
 				return errors.Trace(err)
 			}
-			if change == exposed {
+			changeCIDRs, err := sd.application.ExposedCIDRs()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			// The source CIDRs are always reported in sorted order.
+			sameCIDRs := strings.Join(changeCIDRs, ",") == strings.Join(exposedCIDRs, ",")
+			if change == exposed && sameCIDRs {
 				continue
 			}
 
-			exposed = change
+			exposed, exposedCIDRs = change, changeCIDRs
 			select {
-			case sd.fw.exposedChange <- &exposedChange{sd, change}:
+			case sd.fw.exposedChange <- &exposedChange{sd, change, changeCIDRs}:
 			case <-sd.catacomb.Dying():
 				return sd.catacomb.ErrDying()
 			}
@@ -839,12 +974,12 @@ func (sd *serviceData) Wait() error {
 	return sd.catacomb.Wait()
 }
 
-// diffRanges returns all the port rangess that exist in A but not B.
-func diffRanges(A, B []network.PortRange) (missing []network.PortRange) {
+// diffRules returns all the ingress rules that exist in A but not B.
+func diffRules(A, B []network.IngressRule) (missing []network.IngressRule) {
 next:
 	for _, a := range A {
 		for _, b := range B {
-			if a == b {
+			if a.EqualTo(b) {
 				continue next
 			}
 		}
@@ -853,6 +988,36 @@ next:
 	return
 }
 
+// splitIngressRules returns the given ingress rules with a separate
+// rule for each source CIDR, so that they may be compared with the
+// rules the firewaller opens.
+func splitIngressRules(rules []network.IngressRule) []network.IngressRule {
+	var result []network.IngressRule
+	for _, rule := range rules {
+		if len(rule.SourceCIDRs) <= 1 {
+			result = append(result, rule)
+			continue
+		}
+		for _, cidr := range rule.SourceCIDRs {
+			result = append(result, network.IngressRule{
+				PortRange:   rule.PortRange,
+				SourceCIDRs: []string{cidr},
+			})
+		}
+	}
+	return result
+}
+
+// openIngressRules returns ingress rules allowing traffic from
+// anywhere to each of the given port ranges.
+func openIngressRules(ports []network.PortRange) []network.IngressRule {
+	rules := make([]network.IngressRule, len(ports))
+	for i, portRange := range ports {
+		rules[i] = network.NewOpenIngressRule(portRange)
+	}
+	return rules
+}
+
 // parsePortsKey parses a ports document global key coming from the ports
 // watcher (e.g. "42:0.1.2.0/24") and returns the machine and subnet tags from
 // its components (in the last example "machine-42" and "subnet-0.1.2.0/24").
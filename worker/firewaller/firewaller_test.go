@@ -13,6 +13,7 @@ import (
 
 	"github.com/juju/juju/api"
 	apifirewaller "github.com/juju/juju/api/firewaller"
+	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/instance"
 	"github.com/juju/juju/juju"
@@ -113,6 +114,52 @@ func (s *firewallerBaseSuite) assertEnvironPorts(c *gc.C, expected []network.Por
 	}
 }
 
+// assertIngressRules retrieves the ingress rules of the instance and
+// compares them to the expected.
+func (s *firewallerBaseSuite) assertIngressRules(c *gc.C, inst instance.Instance, machineId string, expected []network.IngressRule) {
+	s.BackingState.StartSync()
+	start := time.Now()
+	for {
+		got, err := inst.(instance.IngressRuleFirewaller).IngressRules(machineId)
+		if err != nil {
+			c.Fatal(err)
+			return
+		}
+		if reflect.DeepEqual(got, expected) {
+			c.Succeed()
+			return
+		}
+		if time.Since(start) > coretesting.LongWait {
+			c.Fatalf("timed out: expected %q; got %q", expected, got)
+			return
+		}
+		time.Sleep(coretesting.ShortWait)
+	}
+}
+
+// assertEnvironIngressRules retrieves the ingress rules of the environment
+// and compares them to the expected.
+func (s *firewallerBaseSuite) assertEnvironIngressRules(c *gc.C, expected []network.IngressRule) {
+	s.BackingState.StartSync()
+	start := time.Now()
+	for {
+		got, err := s.Environ.(environs.IngressRuleFirewaller).IngressRules()
+		if err != nil {
+			c.Fatal(err)
+			return
+		}
+		if reflect.DeepEqual(got, expected) {
+			c.Succeed()
+			return
+		}
+		if time.Since(start) > coretesting.LongWait {
+			c.Fatalf("timed out: expected %q; got %q", expected, got)
+			return
+		}
+		time.Sleep(coretesting.ShortWait)
+	}
+}
+
 func (s *firewallerBaseSuite) addUnit(c *gc.C, app *state.Application) (*state.Unit, *state.Machine) {
 	units, err := juju.AddUnits(s.State, app, app.Name(), 1, nil)
 	c.Assert(err, jc.ErrorIsNil)
@@ -202,6 +249,68 @@ func (s *InstanceModeSuite) TestExposedService(c *gc.C) {
 	s.assertPorts(c, inst, m.Id(), []network.PortRange{{8080, 8080, "tcp"}})
 }
 
+func (s *InstanceModeSuite) TestExposedServiceCIDRs(c *gc.C) {
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	app := s.AddTestingService(c, "wordpress", s.charm)
+
+	err := app.SetExposedCIDRs([]string{"10.0.0.0/8", "192.168.1.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+	u, m := s.addUnit(c, app)
+	inst := s.startInstance(c, m)
+
+	err = u.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The port is opened only to the exposed CIDRs.
+	s.assertIngressRules(c, inst, m.Id(), []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8", "192.168.1.0/24"}},
+	})
+	s.assertPorts(c, inst, m.Id(), nil)
+
+	// Changing the CIDRs of the exposed application updates the rules.
+	err = app.SetExposedCIDRs([]string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertIngressRules(c, inst, m.Id(), []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8"}},
+	})
+
+	// Exposing the application to anywhere opens the port to everyone.
+	err = app.SetExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertIngressRules(c, inst, m.Id(), []network.IngressRule{
+		network.NewOpenIngressRule(network.PortRange{80, 80, "tcp"}),
+	})
+	s.assertPorts(c, inst, m.Id(), []network.PortRange{{80, 80, "tcp"}})
+
+	err = app.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertIngressRules(c, inst, m.Id(), nil)
+}
+
+func (s *InstanceModeSuite) TestStartWithStateExposedCIDRs(c *gc.C) {
+	app := s.AddTestingService(c, "wordpress", s.charm)
+	err := app.SetExposedCIDRs([]string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+	u, m := s.addUnit(c, app)
+	inst := s.startInstance(c, m)
+
+	err = u.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Open a port to everyone that the firewaller should close.
+	err = inst.OpenPorts(m.Id(), []network.PortRange{{80, 80, "tcp"}})
+	c.Assert(err, jc.ErrorIsNil)
+
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	s.assertIngressRules(c, inst, m.Id(), []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8"}},
+	})
+}
+
 func (s *InstanceModeSuite) TestMultipleExposedServices(c *gc.C) {
 	fw := s.newFirewaller(c)
 	defer statetesting.AssertKillAndWait(c, fw)
@@ -574,7 +683,7 @@ func (s *InstanceModeSuite) TestStartWithStateOpenPortsBroken(c *gc.C) {
 
 	// Nothing open without firewaller.
 	s.assertPorts(c, inst, m.Id(), nil)
-	dummy.SetInstanceBroken(inst, "OpenPorts")
+	dummy.SetInstanceBroken(inst, "OpenIngressRules")
 
 	// Starting the firewaller should attempt to open the ports,
 	// and fail due to the method being broken.
@@ -586,7 +695,7 @@ func (s *InstanceModeSuite) TestStartWithStateOpenPortsBroken(c *gc.C) {
 	select {
 	case err := <-errc:
 		c.Assert(err, gc.ErrorMatches,
-			`cannot respond to units changes for "machine-1": dummyInstance.OpenPorts is broken`)
+			`cannot respond to units changes for "machine-1": dummyInstance.OpenIngressRules is broken`)
 	case <-time.After(coretesting.LongWait):
 		fw.Kill()
 		fw.Wait()
@@ -663,6 +772,44 @@ func (s *GlobalModeSuite) TestGlobalMode(c *gc.C) {
 	s.assertEnvironPorts(c, nil)
 }
 
+func (s *GlobalModeSuite) TestGlobalModeExposedCIDRs(c *gc.C) {
+	fw := s.newFirewaller(c)
+	defer statetesting.AssertKillAndWait(c, fw)
+
+	app1 := s.AddTestingService(c, "wordpress", s.charm)
+	err := app1.SetExposedCIDRs([]string{"10.0.0.0/8", "192.168.1.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+	u1, m1 := s.addUnit(c, app1)
+	s.startInstance(c, m1)
+	err = u1.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	app2 := s.AddTestingService(c, "moinmoin", s.charm)
+	err = app2.SetExposedCIDRs([]string{"10.0.0.0/8"})
+	c.Assert(err, jc.ErrorIsNil)
+	u2, m2 := s.addUnit(c, app2)
+	s.startInstance(c, m2)
+	err = u2.OpenPort("tcp", 80)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertEnvironIngressRules(c, []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8", "192.168.1.0/24"}},
+	})
+	s.assertEnvironPorts(c, nil)
+
+	// Unexposing one application leaves the source CIDRs still
+	// required by the other.
+	err = app1.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertEnvironIngressRules(c, []network.IngressRule{
+		{network.PortRange{80, 80, "tcp"}, []string{"10.0.0.0/8"}},
+	})
+
+	err = app2.ClearExposed()
+	c.Assert(err, jc.ErrorIsNil)
+	s.assertEnvironIngressRules(c, nil)
+}
+
 func (s *GlobalModeSuite) TestStartWithUnexposedService(c *gc.C) {
 	m, err := s.State.AddMachine("quantal", state.JobHostUnits)
 	c.Assert(err, jc.ErrorIsNil)
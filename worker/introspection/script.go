@@ -77,10 +77,60 @@ juju-engine-report () {
   jujuMachineOrUnit depengine/ $@
 }
 
+juju-leases-report () {
+  jujuMachineOrUnit leases/ $@
+}
+
+juju-presence-report () {
+  jujuMachineOrUnit presence/ $@
+}
+
+juju-statepool-report () {
+  jujuMachineOrUnit statepool/ $@
+}
+
+juju-pubsub-report () {
+  jujuMachineOrUnit pubsub/ $@
+}
+
+juju-wrench () {
+  # With no args (or just the agent name) the wrenches are listed.
+  # Otherwise the args are: set|clear category feature, then for set
+  # optionally count=N, probability=P and delay=D, and finally an
+  # optional agent name.
+  local agent=$(jujuMachineAgentName)
+  if [ "$#" -le 1 ]; then
+    if [ "$#" -eq 1 ]; then
+      agent=$1
+    fi
+    jujuAgentCall $agent wrench/
+    return
+  fi
+  if [ "$#" -lt 3 ]; then
+    echo "expected set|clear, category and feature"
+    return 1
+  fi
+  local action=$1
+  local query="category=$2&feature=$3"
+  shift 3
+  for i in "$@"; do
+    case $i in
+      *=*) query="$query&$i" ;;
+      *) agent=$i ;;
+    esac
+  done
+  echo -e "POST /wrench/$action?$query HTTP/1.0\r\n" | socat abstract-connect:jujud-$agent STDIO
+}
+
 export -f jujuAgentCall
 export -f jujuMachineAgentName
 export -f jujuMachineOrUnit
 export -f juju-goroutines
 export -f juju-heap-profile
 export -f juju-engine-report
+export -f juju-leases-report
+export -f juju-presence-report
+export -f juju-statepool-report
+export -f juju-pubsub-report
+export -f juju-wrench
 `
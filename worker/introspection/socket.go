@@ -29,11 +29,27 @@ type DepEngineReporter interface {
 	Report() map[string]interface{}
 }
 
+// Reporter provides insight into some other part of the agent, such as
+// the leases it holds or the state connections it has open.
+type Reporter interface {
+	// Report returns a map describing the state of the receiver. It is expected
+	// to be goroutine-safe.
+	Report() map[string]interface{}
+}
+
 // Config describes the arguments required to create the introspection worker.
 type Config struct {
 	SocketName         string
 	Reporter           DepEngineReporter
 	PrometheusGatherer prometheus.Gatherer
+
+	// Leases, Presence, StatePool and PubSub are optional; the
+	// corresponding endpoints report a missing reporter if they
+	// are not set.
+	Leases    Reporter
+	Presence  Reporter
+	StatePool Reporter
+	PubSub    Reporter
 }
 
 // Validate checks the config values to assert they are valid to create the worker.
@@ -53,6 +69,10 @@ type socketListener struct {
 	listener           *net.UnixListener
 	reporter           DepEngineReporter
 	prometheusGatherer prometheus.Gatherer
+	leases             Reporter
+	presence           Reporter
+	statePool          Reporter
+	pubSub             Reporter
 	done               chan struct{}
 }
 
@@ -82,6 +102,10 @@ func NewWorker(config Config) (worker.Worker, error) {
 		listener:           l,
 		reporter:           config.Reporter,
 		prometheusGatherer: config.PrometheusGatherer,
+		leases:             config.Leases,
+		presence:           config.Presence,
+		statePool:          config.StatePool,
+		pubSub:             config.PubSub,
 		done:               make(chan struct{}),
 	}
 	go w.serve()
@@ -95,7 +119,12 @@ func (w *socketListener) serve() {
 	mux.Handle("/debug/pprof/cmdline", http.HandlerFunc(pprof.Cmdline))
 	mux.Handle("/debug/pprof/profile", http.HandlerFunc(pprof.Profile))
 	mux.Handle("/debug/pprof/symbol", http.HandlerFunc(pprof.Symbol))
-	mux.Handle("/depengine/", http.HandlerFunc(w.depengineReport))
+	mux.Handle("/depengine/", reportHandler{"Dependency Engine Report", w.reporter})
+	mux.Handle("/leases/", reportHandler{"Lease Report", w.leases})
+	mux.Handle("/presence/", reportHandler{"Presence Report", w.presence})
+	mux.Handle("/statepool/", reportHandler{"State Pool Report", w.statePool})
+	mux.Handle("/pubsub/", reportHandler{"PubSub Report", w.pubSub})
+	mux.Handle("/wrench/", wrenchHandler{})
 	mux.Handle("/metrics", promhttp.HandlerFor(w.prometheusGatherer, promhttp.HandlerOpts{}))
 
 	srv := http.Server{
@@ -128,13 +157,21 @@ func (w *socketListener) Wait() error {
 	return w.tomb.Wait()
 }
 
-func (s *socketListener) depengineReport(w http.ResponseWriter, r *http.Request) {
-	if s.reporter == nil {
+// reportHandler serves the YAML-formatted report of its reporter
+// under the given title.
+type reportHandler struct {
+	title    string
+	reporter Reporter
+}
+
+// ServeHTTP implements http.Handler.
+func (h reportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if h.reporter == nil {
 		w.WriteHeader(http.StatusNotFound)
 		fmt.Fprintln(w, "missing reporter")
 		return
 	}
-	bytes, err := yaml.Marshal(s.reporter.Report())
+	bytes, err := yaml.Marshal(h.reporter.Report())
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "error: %v\n", err)
@@ -143,6 +180,6 @@ func (s *socketListener) depengineReport(w http.ResponseWriter, r *http.Request)
 
 	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 
-	fmt.Fprint(w, "Dependency Engine Report\n\n")
+	fmt.Fprintf(w, "%s\n\n", h.title)
 	w.Write(bytes)
 }
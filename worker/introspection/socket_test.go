@@ -54,6 +54,7 @@ type introspectionSuite struct {
 	name     string
 	worker   worker.Worker
 	reporter introspection.DepEngineReporter
+	leases   introspection.Reporter
 	gatherer prometheus.Gatherer
 }
 
@@ -65,6 +66,7 @@ func (s *introspectionSuite) SetUpTest(c *gc.C) {
 	}
 	s.IsolationSuite.SetUpTest(c)
 	s.reporter = nil
+	s.leases = nil
 	s.worker = nil
 	s.gatherer = newPrometheusGatherer()
 	s.startWorker(c)
@@ -76,6 +78,7 @@ func (s *introspectionSuite) startWorker(c *gc.C) {
 		SocketName:         s.name,
 		Reporter:           s.reporter,
 		PrometheusGatherer: s.gatherer,
+		Leases:             s.leases,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	s.worker = w
@@ -85,12 +88,16 @@ func (s *introspectionSuite) startWorker(c *gc.C) {
 }
 
 func (s *introspectionSuite) call(c *gc.C, url string) []byte {
+	return s.request(c, "GET", url)
+}
+
+func (s *introspectionSuite) request(c *gc.C, method, url string) []byte {
 	path := "@" + s.name
 	conn, err := net.Dial("unix", path)
 	c.Assert(err, jc.ErrorIsNil)
 	defer conn.Close()
 
-	_, err = fmt.Fprintf(conn, "GET %s HTTP/1.0\r\n\r\n", url)
+	_, err = fmt.Fprintf(conn, "%s %s HTTP/1.0\r\n\r\n", method, url)
 	c.Assert(err, jc.ErrorIsNil)
 
 	buf, err := ioutil.ReadAll(conn)
@@ -132,6 +139,62 @@ func (s *introspectionSuite) TestEngineReporter(c *gc.C) {
 	matches(c, buf, "working: true")
 }
 
+func (s *introspectionSuite) TestMissingReportEndpoints(c *gc.C) {
+	for _, path := range []string{"/leases/", "/presence/", "/statepool/", "/pubsub/"} {
+		c.Logf("checking %s", path)
+		buf := s.call(c, path)
+		matches(c, buf, "404 Not Found")
+		matches(c, buf, "missing reporter")
+	}
+}
+
+func (s *introspectionSuite) TestLeasesReporter(c *gc.C) {
+	workertest.CheckKill(c, s.worker)
+	s.leases = &reporter{
+		values: map[string]interface{}{
+			"application-leadership": map[string]interface{}{
+				"mysql": map[string]interface{}{
+					"holder": "mysql/0",
+				},
+			},
+		},
+	}
+	s.startWorker(c)
+	buf := s.call(c, "/leases/")
+
+	matches(c, buf, "200 OK")
+	matches(c, buf, "Lease Report")
+	matches(c, buf, "holder: mysql/0")
+}
+
+func (s *introspectionSuite) TestWrenchReport(c *gc.C) {
+	buf := s.call(c, "/wrench/")
+	matches(c, buf, "200 OK")
+	matches(c, buf, "Wrench Report")
+}
+
+func (s *introspectionSuite) TestWrenchSetInvalid(c *gc.C) {
+	buf := s.request(c, "POST", "/wrench/set?category=foo&feature=bar&count=-1")
+	matches(c, buf, "400 Bad Request")
+	matches(c, buf, "error: negative count not valid")
+
+	buf = s.request(c, "POST", "/wrench/set?category=foo&feature=bar&delay=soon")
+	matches(c, buf, "400 Bad Request")
+	matches(c, buf, `error: delay "soon" not valid`)
+}
+
+func (s *introspectionSuite) TestWrenchClearNotSet(c *gc.C) {
+	buf := s.request(c, "POST", "/wrench/clear?category=foo&feature=bar")
+	matches(c, buf, "404 Not Found")
+	matches(c, buf, "wrench foo/bar not set")
+}
+
+func (s *introspectionSuite) TestWrenchUnknownAction(c *gc.C) {
+	buf := s.request(c, "POST", "/wrench/throw")
+	matches(c, buf, "404 Not Found")
+	matches(c, buf, `unknown wrench action "/wrench/throw"`)
+}
+
 func (s *introspectionSuite) TestPrometheusMetrics(c *gc.C) {
 	buf := s.call(c, "/metrics")
 	c.Assert(buf, gc.NotNil)
@@ -0,0 +1,95 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package introspection
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/wrench"
+)
+
+// wrenchHandler reports the wrenches set at runtime on GET, and sets
+// or clears them on POST to /wrench/set and /wrench/clear.
+type wrenchHandler struct{}
+
+// ServeHTTP implements http.Handler.
+func (wrenchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		reportHandler{"Wrench Report", reportFunc(wrench.Report)}.ServeHTTP(w, r)
+		return
+	case "POST":
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		fmt.Fprintf(w, "method %q not allowed\n", r.Method)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "error: %v\n", err)
+		return
+	}
+	category := r.Form.Get("category")
+	feature := r.Form.Get("feature")
+	switch r.URL.Path {
+	case "/wrench/set":
+		fault, err := parseFault(r)
+		if err == nil {
+			err = wrench.SetFault(category, feature, fault)
+		}
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "error: %v\n", err)
+			return
+		}
+		fmt.Fprintf(w, "wrench %s/%s set\n", category, feature)
+	case "/wrench/clear":
+		if !wrench.ClearFault(category, feature) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, "wrench %s/%s not set\n", category, feature)
+			return
+		}
+		fmt.Fprintf(w, "wrench %s/%s cleared\n", category, feature)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, "unknown wrench action %q\n", r.URL.Path)
+	}
+}
+
+// parseFault returns the fault described by the count, probability
+// and delay form values of the request.
+func parseFault(r *http.Request) (wrench.Fault, error) {
+	var fault wrench.Fault
+	var err error
+	if value := r.Form.Get("count"); value != "" {
+		if fault.Count, err = strconv.Atoi(value); err != nil {
+			return fault, errors.NotValidf("count %q", value)
+		}
+	}
+	if value := r.Form.Get("probability"); value != "" {
+		if fault.Probability, err = strconv.ParseFloat(value, 64); err != nil {
+			return fault, errors.NotValidf("probability %q", value)
+		}
+	}
+	if value := r.Form.Get("delay"); value != "" {
+		if fault.Delay, err = time.ParseDuration(value); err != nil {
+			return fault, errors.NotValidf("delay %q", value)
+		}
+	}
+	return fault, nil
+}
+
+// reportFunc adapts a function to the Reporter interface.
+type reportFunc func() map[string]interface{}
+
+// Report is part of the Reporter interface.
+func (f reportFunc) Report() map[string]interface{} {
+	return f()
+}
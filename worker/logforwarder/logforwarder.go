@@ -40,9 +40,6 @@ type sender interface {
 	Send([]logfwd.Record) error
 }
 
-// TODO(ericsnow) It is likely that eventually we will want to support
-// multiplexing to multiple senders, each in its own goroutine (or worker).
-
 // LogForwarder is a worker that forwards log records from a source
 // to a sender.
 type LogForwarder struct {
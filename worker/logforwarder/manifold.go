@@ -4,8 +4,11 @@
 package logforwarder
 
 import (
+	"path/filepath"
+
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/agent"
 	apiagent "github.com/juju/juju/api/agent"
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/logstream"
@@ -73,7 +76,78 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				OpenLogStream:    openLogStream,
 				OpenLogForwarder: openForwarder,
 			})
-			return orchestrator, errors.Annotate(err, "creating log forwarding orchestrator")
+			if err != nil {
+				return nil, errors.Annotate(err, "creating log forwarding orchestrator")
+			}
+			return orchestrator, nil
+		},
+	}
+}
+
+// SinksManifoldConfig defines the names of the manifolds on which a
+// SinksManifold will depend.
+type SinksManifoldConfig struct {
+	// These are the dependency resource names.
+	AgentName     string
+	APICallerName string
+
+	// OpenConfiguredSink opens the log sinks configured for the model.
+	OpenConfiguredSink ConfiguredSinkFn
+
+	// OpenLogStream is the function that will be used to for the
+	// log stream.
+	OpenLogStream LogStreamFn
+}
+
+// SinksManifold returns a dependency manifold that forwards the logs
+// of a single model to the sinks configured for that model, using the
+// resource names defined in the supplied config. The paths of the
+// model's file sinks are relative to a directory of its own.
+func SinksManifold(config SinksManifoldConfig) dependency.Manifold {
+	openLogStream := config.OpenLogStream
+	if openLogStream == nil {
+		openLogStream = func(caller base.APICaller, cfg params.LogStreamConfig, controllerUUID string) (LogStream, error) {
+			return logstream.Open(caller, cfg, controllerUUID)
+		}
+	}
+
+	return dependency.Manifold{
+		Inputs: []string{
+			config.AgentName,
+			config.APICallerName,
+		},
+		Start: func(context dependency.Context) (worker.Worker, error) {
+			var agent agent.Agent
+			if err := context.Get(config.AgentName, &agent); err != nil {
+				return nil, errors.Trace(err)
+			}
+			var apiCaller base.APICaller
+			if err := context.Get(config.APICallerName, &apiCaller); err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			agentFacade := apiagent.NewState(apiCaller)
+			controllerCfg, err := agentFacade.ControllerConfig()
+			if err != nil {
+				return nil, errors.Annotate(err, "cannot read controller config")
+			}
+
+			agentConfig := agent.CurrentConfig()
+			modelUUID := agentConfig.Model().Id()
+			orchestrator, err := newOrchestratorForController(OrchestratorArgs{
+				ControllerUUID:     controllerCfg.ControllerUUID(),
+				ModelUUID:          modelUUID,
+				LogForwardConfig:   agentFacade,
+				Caller:             apiCaller,
+				OpenLogStream:      openLogStream,
+				SinksConfig:        agentFacade,
+				OpenConfiguredSink: config.OpenConfiguredSink,
+				FileSinkDir:        filepath.Join(agentConfig.LogDir(), FileSinkDirName, modelUUID),
+			})
+			if err != nil {
+				return nil, errors.Annotate(err, "creating log forwarding orchestrator")
+			}
+			return orchestrator, nil
 		},
 	}
 }
@@ -4,13 +4,26 @@
 package logforwarder
 
 import (
+	"reflect"
+
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/worker"
+	"github.com/juju/juju/worker/catacomb"
 )
 
+// orchestrator runs a log forwarder for each log sink, so that a
+// failing sink is restarted on its own without holding up the others.
 type orchestrator struct {
-	*LogForwarder // For now its just a single forwarder.
+	catacomb catacomb.Catacomb
+	args     OrchestratorArgs
+	runner   worker.Runner
+
+	// configured holds the config of the configured sinks that
+	// have been started, keyed by name.
+	configured map[string]logfwd.SinkConfig
 }
 
 // OrchestratorArgs holds the info needed to open a log forwarding
@@ -19,6 +32,10 @@ type OrchestratorArgs struct {
 	// ControllerUUID is the UUID of the controller for which we will forward logs.
 	ControllerUUID string
 
+	// ModelUUID is the UUID of the model whose logs are forwarded
+	// to the configured sinks.
+	ModelUUID string
+
 	// LogForwardConfig is the API used to access log forward config.
 	LogForwardConfig LogForwardConfig
 
@@ -35,25 +52,158 @@ type OrchestratorArgs struct {
 
 	// OpenLogForwarder opens each log forwarder that will be used.
 	OpenLogForwarder func(OpenLogForwarderArgs) (*LogForwarder, error)
+
+	// SinksConfig is the API used to access the configuration of
+	// the model's log sinks. If it or OpenConfiguredSink is nil, only
+	// Sinks are used.
+	SinksConfig LogForwardSinksConfig
+
+	// OpenConfiguredSink opens the sink for each configured sink.
+	OpenConfiguredSink ConfiguredSinkFn
+
+	// FileSinkDir is the directory on the controller to which the
+	// paths of the model's file sinks are relative.
+	FileSinkDir string
 }
 
+// FileSinkDirName is the name of the directory, within the agent's
+// log directory, in which file sinks write their records. Each model
+// has its own directory within it, named for the model's UUID.
+const FileSinkDirName = "logforward"
+
 func newOrchestratorForController(args OrchestratorArgs) (*orchestrator, error) {
-	// For now we work with only 1 forwarder. Later we can have a proper
-	// orchestrator that spawns a sub-worker for each log sink.
-	if len(args.Sinks) == 0 {
-		return nil, nil
-	}
-	if len(args.Sinks) > 1 {
-		return nil, errors.Errorf("multiple log forwarding targets not supported (yet)")
-	}
-	lf, err := args.OpenLogForwarder(OpenLogForwarderArgs{
-		AllModels:        true,
-		ControllerUUID:   args.ControllerUUID,
-		LogForwardConfig: args.LogForwardConfig,
-		Caller:           args.Caller,
-		Name:             args.Sinks[0].Name,
-		OpenSink:         args.Sinks[0].OpenFn,
-		OpenLogStream:    args.OpenLogStream,
+	o := &orchestrator{
+		args:       args,
+		runner:     worker.NewRunner(neverFatal, neverImportant, worker.RestartDelay),
+		configured: make(map[string]logfwd.SinkConfig),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &o.catacomb,
+		Work: o.loop,
+		Init: []worker.Worker{o.runner},
 	})
-	return &orchestrator{lf}, errors.Annotate(err, "opening log forwarder")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return o, nil
+}
+
+func (o *orchestrator) loop() error {
+	for _, spec := range o.args.Sinks {
+		spec := spec
+		err := o.runner.StartWorker(spec.Name, func() (worker.Worker, error) {
+			lf, err := o.args.OpenLogForwarder(OpenLogForwarderArgs{
+				AllModels:        true,
+				ControllerUUID:   o.args.ControllerUUID,
+				LogForwardConfig: o.args.LogForwardConfig,
+				Caller:           o.args.Caller,
+				Name:             spec.Name,
+				OpenSink:         spec.OpenFn,
+				OpenLogStream:    o.args.OpenLogStream,
+			})
+			if err != nil {
+				return nil, errors.Annotate(err, "opening log forwarder")
+			}
+			return lf, nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if o.args.SinksConfig == nil || o.args.OpenConfiguredSink == nil {
+		<-o.catacomb.Dying()
+		return o.catacomb.ErrDying()
+	}
+	configWatcher, err := o.args.SinksConfig.WatchForLogForwardConfigChanges()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := o.catacomb.Add(configWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	for {
+		select {
+		case <-o.catacomb.Dying():
+			return o.catacomb.ErrDying()
+		case _, ok := <-configWatcher.Changes():
+			if !ok {
+				return errors.New("log forwarding configuration watcher closed")
+			}
+			if err := o.updateConfiguredSinks(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// updateConfiguredSinks stops the forwarders of the sinks that have
+// been removed or changed, and starts forwarders for the new and
+// changed sinks.
+func (o *orchestrator) updateConfiguredSinks() error {
+	configs, err := o.args.SinksConfig.LogForwardSinks()
+	if err != nil {
+		return errors.Annotate(err, "reading log forwarding sinks")
+	}
+	wanted := make(map[string]logfwd.SinkConfig)
+	for _, cfg := range configs {
+		wanted[cfg.Name] = cfg
+	}
+
+	for name, cfg := range o.configured {
+		if newCfg, ok := wanted[name]; ok && reflect.DeepEqual(cfg, newCfg) {
+			continue
+		}
+		logger.Infof("stopping log forwarding to sink %q", name)
+		if err := o.runner.StopWorker(ConfiguredSinkName(name)); err != nil {
+			return errors.Trace(err)
+		}
+		delete(o.configured, name)
+	}
+
+	for name, cfg := range wanted {
+		if _, ok := o.configured[name]; ok {
+			continue
+		}
+		logger.Infof("starting log forwarding to %s sink %q", cfg.Type, name)
+		cfg := cfg
+		err := o.runner.StartWorker(ConfiguredSinkName(name), func() (worker.Worker, error) {
+			sf, err := NewSinkForwarder(SinkForwarderArgs{
+				ControllerUUID: o.args.ControllerUUID,
+				ModelUUID:      o.args.ModelUUID,
+				Caller:         o.args.Caller,
+				Config:         cfg,
+				FileSinkDir:    o.args.FileSinkDir,
+				OpenSink:       o.args.OpenConfiguredSink,
+				OpenLogStream:  o.args.OpenLogStream,
+			})
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return sf, nil
+		})
+		if err != nil {
+			return errors.Trace(err)
+		}
+		o.configured[name] = cfg
+	}
+	return nil
+}
+
+// Kill implements Worker.Kill()
+func (o *orchestrator) Kill() {
+	o.catacomb.Kill(nil)
+}
+
+// Wait implements Worker.Wait()
+func (o *orchestrator) Wait() error {
+	return o.catacomb.Wait()
+}
+
+func neverFatal(error) bool {
+	return false
+}
+
+func neverImportant(error, error) bool {
+	return false
 }
@@ -4,6 +4,7 @@
 package logforwarder
 
 import (
+	"github.com/juju/juju/logfwd"
 	"github.com/juju/juju/logfwd/syslog"
 	"github.com/juju/juju/watcher"
 )
@@ -18,6 +19,17 @@ type LogForwardConfig interface {
 	LogForwardConfig() (*syslog.RawConfig, bool, error)
 }
 
+// LogForwardSinksConfig provides access to the configuration of the
+// additional log forwarding sinks for a model.
+type LogForwardSinksConfig interface {
+	// WatchForLogForwardConfigChanges return a NotifyWatcher waiting for the
+	// log forward configuration to change.
+	WatchForLogForwardConfigChanges() (watcher.NotifyWatcher, error)
+
+	// LogForwardSinks returns the current configuration of the sinks.
+	LogForwardSinks() ([]logfwd.SinkConfig, error)
+}
+
 type LogSinkSpec struct {
 	// Name is the name of the log sink.
 	Name string
@@ -29,6 +41,10 @@ type LogSinkSpec struct {
 // LogSinkFn is a function that opens a log sink.
 type LogSinkFn func(cfg *syslog.RawConfig) (*LogSink, error)
 
+// ConfiguredSinkFn is a function that opens a configured log sink.
+// The path of a file sink is relative to fileSinkDir.
+type ConfiguredSinkFn func(cfg logfwd.SinkConfig, fileSinkDir string) (*LogSink, error)
+
 // LogSink is a single log sink, to which log records may be sent.
 type LogSink struct {
 	SendCloser
@@ -0,0 +1,163 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder
+
+import (
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/worker/catacomb"
+)
+
+// ConfiguredSinkName returns the name under which the records sent to
+// the configured sink with the given name are tracked. It is distinct
+// from the names of the built-in sinks, so each configured sink
+// resumes from its own last sent record.
+func ConfiguredSinkName(name string) string {
+	return "juju-log-forward-" + name
+}
+
+// SinkForwarderArgs holds the info needed to open a SinkForwarder.
+type SinkForwarderArgs struct {
+	// ControllerUUID identifies the controller.
+	ControllerUUID string
+
+	// ModelUUID identifies the model whose logs are forwarded.
+	ModelUUID string
+
+	// Caller is the API caller that will be used.
+	Caller base.APICaller
+
+	// Config is the configuration of the sink.
+	Config logfwd.SinkConfig
+
+	// FileSinkDir is the directory to which the path of a file
+	// sink is relative.
+	FileSinkDir string
+
+	// OpenSink is the function that opens the sink.
+	OpenSink ConfiguredSinkFn
+
+	// OpenLogStream is the function that will be used to for the
+	// log stream.
+	OpenLogStream LogStreamFn
+}
+
+// SinkForwarder is a worker that forwards the log records of a
+// model that match a configured sink's filter to that sink.
+type SinkForwarder struct {
+	catacomb catacomb.Catacomb
+	args     SinkForwarderArgs
+}
+
+// NewSinkForwarder returns a worker that forwards logs to the
+// configured sink. Unlike LogForwarder it does not watch for config
+// changes; it is expected to be restarted when the sink's config
+// changes.
+func NewSinkForwarder(args SinkForwarderArgs) (*SinkForwarder, error) {
+	sf := &SinkForwarder{
+		args: args,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &sf.catacomb,
+		Work: func() error {
+			return errors.Trace(sf.loop())
+		},
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return sf, nil
+}
+
+func (sf *SinkForwarder) loop() error {
+	name := ConfiguredSinkName(sf.args.Config.Name)
+	sink, err := sf.args.OpenSink(sf.args.Config, sf.args.FileSinkDir)
+	if err != nil {
+		return errors.Annotatef(err, "opening sink %q", sf.args.Config.Name)
+	}
+	// The sink is also closed as soon as the worker is killed, so
+	// that a send which is waiting to retry is abandoned.
+	var closeOnce sync.Once
+	closeSink := func() {
+		closeOnce.Do(func() {
+			if err := sink.Close(); err != nil {
+				logger.Warningf("closing sink %q: %v", sf.args.Config.Name, err)
+			}
+		})
+	}
+	defer closeSink()
+	go func() {
+		<-sf.catacomb.Dying()
+		closeSink()
+	}()
+
+	streamCfg := params.LogStreamConfig{
+		Sink:               name,
+		MaxLookbackRecords: 100,
+	}
+	stream, err := sf.args.OpenLogStream(sf.args.Caller, streamCfg, sf.args.ControllerUUID)
+	if err != nil {
+		return errors.Annotate(err, "creating log stream")
+	}
+	tracker := newLastSentTracker(name, sf.args.Caller)
+
+	records := make(chan []logfwd.Record)
+	go func() {
+		for {
+			recs, err := stream.Next()
+			if err != nil {
+				sf.catacomb.Kill(errors.Annotate(err, "getting next log record"))
+				return
+			}
+			select {
+			case <-sf.catacomb.Dying():
+				return
+			case records <- recs:
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-sf.catacomb.Dying():
+			return sf.catacomb.ErrDying()
+		case recs := <-records:
+			var selected []logfwd.Record
+			for i, rec := range recs {
+				// The stream only includes the model's records,
+				// so it does not tell us which model they are for.
+				rec.Origin.ModelUUID = sf.args.ModelUUID
+				recs[i] = rec
+				if sf.args.Config.Filter.Match(rec) {
+					selected = append(selected, rec)
+				}
+			}
+			if len(selected) > 0 {
+				if err := sink.Send(selected); err != nil {
+					return errors.Annotatef(err, "sending to sink %q", sf.args.Config.Name)
+				}
+			}
+			// Records the filter skips count as sent, so that they
+			// are not considered again when the worker restarts.
+			if err := tracker.setLastSent(false, recs); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+}
+
+// Kill implements Worker.Kill()
+func (sf *SinkForwarder) Kill() {
+	sf.catacomb.Kill(nil)
+}
+
+// Wait implements Worker.Wait()
+func (sf *SinkForwarder) Wait() error {
+	return sf.catacomb.Wait()
+}
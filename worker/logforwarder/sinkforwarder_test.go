@@ -0,0 +1,153 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package logforwarder_test
+
+import (
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/api/base"
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/logfwd"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/logforwarder"
+	"github.com/juju/juju/worker/workertest"
+)
+
+type SinkForwarderSuite struct {
+	testing.IsolationSuite
+
+	stream *stubStream
+	sender *stubSender
+	caller *recordingCaller
+	rec    logfwd.Record
+}
+
+var _ = gc.Suite(&SinkForwarderSuite{})
+
+func (s *SinkForwarderSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.stream = newStubStream()
+	s.sender = newStubSender()
+	s.caller = &recordingCaller{
+		lastSent: make(chan params.LogForwardingSetLastSentParam, 16),
+	}
+	s.rec = logfwd.Record{
+		Origin: logfwd.Origin{
+			ControllerUUID: "feebdaed-2f18-4fd2-967d-db9663db7bea",
+			ModelUUID:      "deadbeef-2f18-4fd2-967d-db9663db7bea",
+			Type:           logfwd.OriginTypeMachine,
+			Name:           "99",
+		},
+		ID:        10,
+		Timestamp: time.Now(),
+		Level:     loggo.INFO,
+		Location: logfwd.SourceLocation{
+			Module:   "juju.worker.test",
+			Filename: "test.go",
+			Line:     42,
+		},
+		Message: "hello",
+	}
+}
+
+func (s *SinkForwarderSuite) newArgs(c *gc.C, cfg logfwd.SinkConfig) logforwarder.SinkForwarderArgs {
+	return logforwarder.SinkForwarderArgs{
+		ControllerUUID: "feebdaed-2f18-4fd2-967d-db9663db7bea",
+		ModelUUID:      "deadbeef-2f18-4fd2-967d-db9663db7bea",
+		Caller:         s.caller,
+		Config:         cfg,
+		OpenSink: func(cfg logfwd.SinkConfig, _ string) (*logforwarder.LogSink, error) {
+			s.sender.host = cfg.Name
+			return &logforwarder.LogSink{s.sender}, nil
+		},
+		OpenLogStream: func(_ base.APICaller, streamCfg params.LogStreamConfig, controllerUUID string) (logforwarder.LogStream, error) {
+			c.Check(streamCfg.AllModels, jc.IsFalse)
+			c.Check(streamCfg.Sink, gc.Equals, "juju-log-forward-"+cfg.Name)
+			return s.stream, nil
+		},
+	}
+}
+
+func (s *SinkForwarderSuite) TestFilteredRecordsTracked(c *gc.C) {
+	rec0 := s.rec
+	rec1 := s.rec
+	rec1.ID = 11
+	rec1.Level = loggo.DEBUG
+	// The model's log stream does not include the model UUID.
+	streamed0, streamed1 := rec0, rec1
+	streamed0.Origin.ModelUUID = ""
+	streamed1.Origin.ModelUUID = ""
+	s.stream.addRecords(c, streamed0, streamed1)
+
+	sf, err := logforwarder.NewSinkForwarder(s.newArgs(c, logfwd.SinkConfig{
+		Name:   "audit",
+		Type:   logfwd.SinkSyslogUDP,
+		Host:   "10.0.0.1",
+		Filter: logfwd.Filter{Level: loggo.INFO},
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, sf)
+
+	s.sender.waitForSend(c)
+	// Both records are recorded as sent, even though the second
+	// one was not selected by the filter.
+	for _, rec := range []logfwd.Record{rec0, rec1} {
+		lastSent := s.caller.waitForLastSent(c)
+		c.Check(lastSent.ModelTag, gc.Equals, "model-deadbeef-2f18-4fd2-967d-db9663db7bea")
+		c.Check(lastSent.Sink, gc.Equals, "juju-log-forward-audit")
+		c.Check(lastSent.RecordID, gc.Equals, rec.ID)
+	}
+
+	workertest.CleanKill(c, sf)
+	rec0.Message = "send to audit"
+	s.sender.stub.CheckCalls(c, []testing.StubCall{
+		{"Send", []interface{}{[]logfwd.Record{rec0}}},
+		{"Close", nil},
+	})
+}
+
+func (s *SinkForwarderSuite) TestOpenSinkError(c *gc.C) {
+	args := s.newArgs(c, logfwd.SinkConfig{Name: "audit"})
+	args.OpenSink = func(logfwd.SinkConfig, string) (*logforwarder.LogSink, error) {
+		return nil, errors.New("boom")
+	}
+	sf, err := logforwarder.NewSinkForwarder(args)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, sf)
+
+	err = workertest.CheckKilled(c, sf)
+	c.Check(err, gc.ErrorMatches, `opening sink "audit": boom`)
+	s.stream.stub.CheckCallNames(c)
+}
+
+type recordingCaller struct {
+	mockCaller
+	lastSent chan params.LogForwardingSetLastSentParam
+}
+
+func (r *recordingCaller) APICall(objType string, version int, id, request string, args, response interface{}) error {
+	if request == "SetLastSent" {
+		for _, p := range args.(params.LogForwardingSetLastSentParams).Params {
+			r.lastSent <- p
+		}
+	}
+	return nil
+}
+
+func (r *recordingCaller) waitForLastSent(c *gc.C) params.LogForwardingSetLastSentParam {
+	select {
+	case p := <-r.lastSent:
+		return p
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for SetLastSent")
+	}
+	panic("unreachable")
+}
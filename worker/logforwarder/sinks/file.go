@@ -0,0 +1,57 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+// OpenFile returns a sink that appends records as JSON lines to a
+// file within dir on the controller, creating it if necessary. The
+// sink's path must be relative, and may not leave dir.
+func OpenFile(cfg logfwd.SinkConfig, dir string) (*logforwarder.LogSink, error) {
+	if cfg.Type != logfwd.SinkFile {
+		return nil, errors.Errorf("sink %q is not a file sink", cfg.Name)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !filepath.IsAbs(dir) {
+		return nil, errors.Errorf("sink %q: directory %q is not absolute", cfg.Name, dir)
+	}
+	path := filepath.Join(dir, filepath.FromSlash(cfg.Path))
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, errors.Trace(err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &logforwarder.LogSink{SendCloser: &fileSender{f}}, nil
+}
+
+type fileSender struct {
+	file *os.File
+}
+
+// Send implements logforwarder.SendCloser.
+func (s *fileSender) Send(records []logfwd.Record) error {
+	data, err := marshalJSONLines(records)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = s.file.Write(data)
+	return errors.Trace(err)
+}
+
+// Close implements logforwarder.SendCloser.
+func (s *fileSender) Close() error {
+	return errors.Trace(s.file.Close())
+}
@@ -0,0 +1,123 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/clock"
+
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+const (
+	defaultHTTPBatchSize  = 100
+	defaultHTTPMaxRetries = 3
+)
+
+// httpRetryDelay is the delay before the first retry of a failed
+// post. It doubles with each subsequent retry.
+var httpRetryDelay = time.Second
+
+// OpenHTTP returns a sink that posts records to an HTTP endpoint as
+// JSON lines, in batches of at most the configured batch size.
+// Failed posts are retried with an exponential backoff.
+func OpenHTTP(cfg logfwd.SinkConfig) (*logforwarder.LogSink, error) {
+	if cfg.Type != logfwd.SinkHTTP {
+		return nil, errors.Errorf("sink %q is not an http sink", cfg.Name)
+	}
+	sender := &httpSender{
+		url:        cfg.URL,
+		batchSize:  cfg.BatchSize,
+		maxRetries: cfg.MaxRetries,
+		client:     &http.Client{Timeout: 30 * time.Second},
+		clock:      clock.WallClock,
+		abort:      make(chan struct{}),
+	}
+	if sender.batchSize == 0 {
+		sender.batchSize = defaultHTTPBatchSize
+	}
+	if sender.maxRetries == 0 {
+		sender.maxRetries = defaultHTTPMaxRetries
+	}
+	return &logforwarder.LogSink{SendCloser: sender}, nil
+}
+
+type httpSender struct {
+	url        string
+	batchSize  int
+	maxRetries int
+	client     *http.Client
+	clock      clock.Clock
+
+	// abort is closed when the sender is closed, so that a send
+	// waiting to retry a failed post gives up immediately.
+	abort     chan struct{}
+	closeOnce sync.Once
+}
+
+// Send implements logforwarder.SendCloser.
+func (s *httpSender) Send(records []logfwd.Record) error {
+	for len(records) > 0 {
+		n := len(records)
+		if n > s.batchSize {
+			n = s.batchSize
+		}
+		body, err := marshalJSONLines(records[:n])
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := s.postWithRetry(body); err != nil {
+			return errors.Trace(err)
+		}
+		records = records[n:]
+	}
+	return nil
+}
+
+func (s *httpSender) postWithRetry(body []byte) error {
+	delay := httpRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := s.post(body)
+		if err == nil {
+			return nil
+		}
+		if attempt == s.maxRetries {
+			return errors.Annotatef(err, "posting to %s failed after %d attempts", s.url, attempt+1)
+		}
+		logger.Debugf("posting to %s failed, retrying in %v: %v", s.url, delay, err)
+		select {
+		case <-s.abort:
+			return errors.Annotatef(err, "posting to %s aborted after %d attempts", s.url, attempt+1)
+		case <-s.clock.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func (s *httpSender) post(body []byte) error {
+	resp, err := s.client.Post(s.url, "application/x-ndjson", bytes.NewReader(body))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected response %q", resp.Status)
+	}
+	return nil
+}
+
+// Close implements logforwarder.SendCloser. It may be called while
+// a send is in progress, to abandon any further retries.
+func (s *httpSender) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.abort)
+	})
+	return nil
+}
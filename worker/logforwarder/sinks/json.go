@@ -0,0 +1,66 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/version"
+
+	"github.com/juju/juju/logfwd"
+)
+
+// jsonRecord is the JSON representation of a log record sent to the
+// http and file sinks.
+type jsonRecord struct {
+	ID              int64     `json:"id"`
+	Timestamp       time.Time `json:"timestamp"`
+	ControllerUUID  string    `json:"controller-uuid"`
+	ModelUUID       string    `json:"model-uuid"`
+	Hostname        string    `json:"hostname,omitempty"`
+	OriginType      string    `json:"origin-type"`
+	OriginName      string    `json:"origin-name,omitempty"`
+	Software        string    `json:"software,omitempty"`
+	SoftwareVersion string    `json:"software-version,omitempty"`
+	Level           string    `json:"level"`
+	Module          string    `json:"module,omitempty"`
+	Location        string    `json:"location,omitempty"`
+	Message         string    `json:"message"`
+}
+
+func newJSONRecord(rec logfwd.Record) jsonRecord {
+	jrec := jsonRecord{
+		ID:             rec.ID,
+		Timestamp:      rec.Timestamp.UTC(),
+		ControllerUUID: rec.Origin.ControllerUUID,
+		ModelUUID:      rec.Origin.ModelUUID,
+		Hostname:       rec.Origin.Hostname,
+		OriginType:     rec.Origin.Type.String(),
+		OriginName:     rec.Origin.Name,
+		Software:       rec.Origin.Software.Name,
+		Level:          rec.Level.String(),
+		Module:         rec.Location.Module,
+		Location:       rec.Location.String(),
+		Message:        rec.Message,
+	}
+	if rec.Origin.Software.Version != version.Zero {
+		jrec.SoftwareVersion = rec.Origin.Software.Version.String()
+	}
+	return jrec
+}
+
+// marshalJSONLines returns the records encoded as JSON, one per line.
+func marshalJSONLines(records []logfwd.Record) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range records {
+		if err := enc.Encode(newJSONRecord(rec)); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return buf.Bytes(), nil
+}
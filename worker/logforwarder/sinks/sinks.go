@@ -0,0 +1,33 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/loggo"
+
+	"github.com/juju/juju/logfwd"
+	"github.com/juju/juju/logfwd/syslog"
+	"github.com/juju/juju/worker/logforwarder"
+)
+
+var logger = loggo.GetLogger("juju.worker.logforwarder.sinks")
+
+// Open returns the sink described by the config of a configured
+// log sink. The path of a file sink is relative to fileSinkDir.
+func Open(cfg logfwd.SinkConfig, fileSinkDir string) (*logforwarder.LogSink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	switch {
+	case cfg.Type.IsSyslog():
+		syslogCfg := syslog.SinkRawConfig(cfg)
+		return OpenSyslog(&syslogCfg)
+	case cfg.Type == logfwd.SinkHTTP:
+		return OpenHTTP(cfg)
+	case cfg.Type == logfwd.SinkFile:
+		return OpenFile(cfg, fileSinkDir)
+	}
+	return nil, errors.NotSupportedf("sink type %q", cfg.Type)
+}
@@ -0,0 +1,238 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package sinks_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/juju/loggo"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/logfwd"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/logforwarder/sinks"
+)
+
+type SinksSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&SinksSuite{})
+
+func (s *SinksSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.PatchValue(sinks.HTTPRetryDelay, time.Millisecond)
+}
+
+func makeRecords(n int) []logfwd.Record {
+	records := make([]logfwd.Record, n)
+	for i := range records {
+		records[i] = logfwd.Record{
+			ID: int64(i + 1),
+			Origin: logfwd.Origin{
+				ControllerUUID: "feebdaed-2f18-4fd2-967d-db9663db7bea",
+				ModelUUID:      "deadbeef-2f18-4fd2-967d-db9663db7bea",
+				Type:           logfwd.OriginTypeMachine,
+				Name:           "0",
+			},
+			Timestamp: time.Date(2017, 2, 1, 10, 0, i, 0, time.UTC),
+			Level:     loggo.INFO,
+			Location: logfwd.SourceLocation{
+				Module:   "juju.worker",
+				Filename: "worker.go",
+				Line:     42,
+			},
+			Message: "hello",
+		}
+	}
+	return records
+}
+
+func (s *SinksSuite) TestOpenInvalid(c *gc.C) {
+	_, err := sinks.Open(logfwd.SinkConfig{Name: "x", Type: "nope"}, "")
+	c.Assert(err, gc.ErrorMatches, `sink "x": type "nope" not valid`)
+}
+
+func (s *SinksSuite) TestHTTPBatches(c *gc.C) {
+	var batches [][]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		c.Check(req.Header.Get("Content-Type"), gc.Equals, "application/x-ndjson")
+		var batch []map[string]interface{}
+		scanner := bufio.NewScanner(req.Body)
+		for scanner.Scan() {
+			var rec map[string]interface{}
+			c.Check(json.Unmarshal(scanner.Bytes(), &rec), jc.ErrorIsNil)
+			batch = append(batch, rec)
+		}
+		batches = append(batches, batch)
+	}))
+	defer server.Close()
+
+	sink, err := sinks.Open(logfwd.SinkConfig{
+		Name:      "collector",
+		Type:      logfwd.SinkHTTP,
+		URL:       server.URL,
+		BatchSize: 2,
+	}, "")
+	c.Assert(err, jc.ErrorIsNil)
+	defer sink.Close()
+
+	err = sink.Send(makeRecords(3))
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(batches, gc.HasLen, 2)
+	c.Check(batches[0], gc.HasLen, 2)
+	c.Check(batches[1], gc.HasLen, 1)
+	c.Check(batches[1][0], jc.DeepEquals, map[string]interface{}{
+		"id":              float64(3),
+		"timestamp":       "2017-02-01T10:00:02Z",
+		"controller-uuid": "feebdaed-2f18-4fd2-967d-db9663db7bea",
+		"model-uuid":      "deadbeef-2f18-4fd2-967d-db9663db7bea",
+		"origin-type":     "machine",
+		"origin-name":     "0",
+		"level":           "INFO",
+		"module":          "juju.worker",
+		"location":        "worker.go:42",
+		"message":         "hello",
+	})
+}
+
+func (s *SinksSuite) TestHTTPRetries(c *gc.C) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	sink, err := sinks.Open(logfwd.SinkConfig{
+		Name: "collector",
+		Type: logfwd.SinkHTTP,
+		URL:  server.URL,
+	}, "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = sink.Send(makeRecords(1))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(attempts, gc.Equals, 3)
+}
+
+func (s *SinksSuite) TestHTTPCloseAbortsRetries(c *gc.C) {
+	s.PatchValue(sinks.HTTPRetryDelay, time.Hour)
+	attempted := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		select {
+		case attempted <- struct{}{}:
+		default:
+		}
+	}))
+	defer server.Close()
+
+	sink, err := sinks.Open(logfwd.SinkConfig{
+		Name: "collector",
+		Type: logfwd.SinkHTTP,
+		URL:  server.URL,
+	}, "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- sink.Send(makeRecords(1))
+	}()
+	select {
+	case <-attempted:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for post")
+	}
+	c.Assert(sink.Close(), jc.ErrorIsNil)
+	select {
+	case err := <-errs:
+		c.Assert(err, gc.ErrorMatches, `posting to .* aborted after 1 attempts: unexpected response "503 Service Unavailable"`)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("send was not aborted")
+	}
+}
+
+func (s *SinksSuite) TestHTTPGivesUp(c *gc.C) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink, err := sinks.Open(logfwd.SinkConfig{
+		Name:       "collector",
+		Type:       logfwd.SinkHTTP,
+		URL:        server.URL,
+		MaxRetries: 1,
+	}, "")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = sink.Send(makeRecords(1))
+	c.Assert(err, gc.ErrorMatches, `posting to .* failed after 2 attempts: unexpected response "500 Internal Server Error"`)
+	c.Check(attempts, gc.Equals, 2)
+}
+
+func (s *SinksSuite) TestFile(c *gc.C) {
+	dir := c.MkDir()
+	cfg := logfwd.SinkConfig{
+		Name: "archive",
+		Type: logfwd.SinkFile,
+		Path: "archive/forwarded.log",
+	}
+	sink, err := sinks.Open(cfg, filepath.Join(dir, "logforward"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = sink.Send(makeRecords(2))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sink.Close(), jc.ErrorIsNil)
+
+	// Records are appended when the sink is reopened.
+	sink, err = sinks.Open(cfg, filepath.Join(dir, "logforward"))
+	c.Assert(err, jc.ErrorIsNil)
+	err = sink.Send(makeRecords(1))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(sink.Close(), jc.ErrorIsNil)
+
+	path := filepath.Join(dir, "logforward", "archive", "forwarded.log")
+	info, err := os.Stat(path)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(info.Mode().Perm(), gc.Equals, os.FileMode(0600))
+
+	data, err := ioutil.ReadFile(path)
+	c.Assert(err, jc.ErrorIsNil)
+	lines := 0
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var rec map[string]interface{}
+		c.Check(json.Unmarshal(scanner.Bytes(), &rec), jc.ErrorIsNil)
+		lines++
+	}
+	c.Check(lines, gc.Equals, 3)
+}
+
+func (s *SinksSuite) TestFileOutsideDirectory(c *gc.C) {
+	dir := c.MkDir()
+	_, err := sinks.Open(logfwd.SinkConfig{
+		Name: "escape",
+		Type: logfwd.SinkFile,
+		Path: "../escaped.log",
+	}, filepath.Join(dir, "logforward"))
+	c.Assert(err, gc.ErrorMatches, `sink "escape": path "../escaped.log" .* not valid`)
+	_, err = os.Stat(filepath.Join(dir, "escaped.log"))
+	c.Assert(os.IsNotExist(err), jc.IsTrue)
+}
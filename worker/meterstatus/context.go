@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/watcher"
 	"github.com/juju/juju/worker/uniter/runner/context"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
 )
@@ -64,6 +65,22 @@ func (ctx *limitedContext) UnitName() string {
 // SetProcess implements runner.Context.
 func (ctx *limitedContext) SetProcess(process context.HookProcess) {}
 
+// GetProcess implements runner.Context.
+func (ctx *limitedContext) GetProcess() context.HookProcess { return nil }
+
+// ActionStatus implements runner.Context.
+func (ctx *limitedContext) ActionStatus() (string, error) {
+	return "", jujuc.ErrRestrictedContext
+}
+
+// WatchActionStatus implements runner.Context.
+func (ctx *limitedContext) WatchActionStatus() (watcher.NotifyWatcher, error) {
+	return nil, jujuc.ErrRestrictedContext
+}
+
+// SetActionAborted implements runner.Context.
+func (ctx *limitedContext) SetActionAborted() {}
+
 // ActionData implements runner.Context.
 func (ctx *limitedContext) ActionData() (*context.ActionData, error) {
 	return nil, jujuc.ErrRestrictedContext
@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/watcher"
 	"github.com/juju/juju/worker/metrics/spool"
 	"github.com/juju/juju/worker/uniter/runner/context"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
@@ -71,6 +72,22 @@ func (ctx *hookContext) addJujuUnitsMetric() error {
 // SetProcess implements runner.Context.
 func (ctx *hookContext) SetProcess(process context.HookProcess) {}
 
+// GetProcess implements runner.Context.
+func (ctx *hookContext) GetProcess() context.HookProcess { return nil }
+
+// ActionStatus implements runner.Context.
+func (ctx *hookContext) ActionStatus() (string, error) {
+	return "", jujuc.ErrRestrictedContext
+}
+
+// WatchActionStatus implements runner.Context.
+func (ctx *hookContext) WatchActionStatus() (watcher.NotifyWatcher, error) {
+	return nil, jujuc.ErrRestrictedContext
+}
+
+// SetActionAborted implements runner.Context.
+func (ctx *hookContext) SetActionAborted() {}
+
 // ActionData implements runner.Context.
 func (ctx *hookContext) ActionData() (*context.ActionData, error) {
 	return nil, jujuc.ErrRestrictedContext
@@ -575,6 +575,7 @@ func constructStartInstanceParams(
 				},
 				Volume: volumeTag,
 			},
+			v.SnapshotId,
 		}
 	}
 
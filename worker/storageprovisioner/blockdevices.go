@@ -13,11 +13,10 @@ import (
 
 // machineBlockDevicesChanged is called when the block devices of the scoped
 // machine have been seen to have changed. This triggers a refresh of all
-// block devices for attached volumes backing pending filesystems.
+// block devices for attached volumes backing pending filesystems, and for
+// volumes backing provisioned filesystems, so that the filesystems may be
+// grown if their volumes have been resized.
 func machineBlockDevicesChanged(ctx *context) error {
-	if len(ctx.incompleteFilesystemParams) == 0 {
-		return nil
-	}
 	volumeTags := make([]names.VolumeTag, 0, len(ctx.incompleteFilesystemParams))
 	// We only need to query volumes for incomplete filesystems,
 	// and not incomplete filesystem attachments, because a
@@ -37,15 +36,26 @@ func machineBlockDevicesChanged(ctx *context) error {
 		}
 		volumeTags = append(volumeTags, params.Volume)
 	}
+	for _, filesystem := range ctx.filesystems {
+		if filesystem.Volume == (names.VolumeTag{}) {
+			// Filesystem is not volume-backed.
+			continue
+		}
+		volumeTags = append(volumeTags, filesystem.Volume)
+	}
 	if len(volumeTags) == 0 {
 		return nil
 	}
-	return refreshVolumeBlockDevices(ctx, volumeTags)
+	if err := refreshVolumeBlockDevices(ctx, volumeTags); err != nil {
+		return errors.Trace(err)
+	}
+	return growFilesystems(ctx)
 }
 
 // processPendingVolumeBlockDevices is called before waiting for any events,
 // to force a block-device query for any volumes for which we have not
-// previously observed block devices.
+// previously observed block devices, and to grow any filesystems backed
+// by those volumes.
 func processPendingVolumeBlockDevices(ctx *context) error {
 	if len(ctx.pendingVolumeBlockDevices) == 0 {
 		logger.Tracef("no pending volume block devices")
@@ -57,7 +67,12 @@ func processPendingVolumeBlockDevices(ctx *context) error {
 	}
 	// Clear out the pending set, so we don't force-refresh again.
 	ctx.pendingVolumeBlockDevices = set.NewTags()
-	return refreshVolumeBlockDevices(ctx, volumeTags)
+	if err := refreshVolumeBlockDevices(ctx, volumeTags); err != nil {
+		return errors.Trace(err)
+	}
+	// The volumes backing provisioned filesystems may have been
+	// resized while the worker was not running.
+	return growFilesystems(ctx)
 }
 
 // refreshVolumeBlockDevices refreshes the block devices for the specified
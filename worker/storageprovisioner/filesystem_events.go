@@ -368,6 +368,9 @@ func processAliveFilesystemAttachments(
 	if len(pending) == 0 {
 		return nil
 	}
+	if err := refreshForeignFilesystems(ctx, pending); err != nil {
+		return errors.Trace(err)
+	}
 	params, err := filesystemAttachmentParams(ctx, pending)
 	if err != nil {
 		return errors.Trace(err)
@@ -378,6 +381,53 @@ func processAliveFilesystemAttachments(
 	return nil
 }
 
+// refreshForeignFilesystems obtains the details of the provisioned
+// filesystems of the given attachments that are scoped to another
+// machine, which the filesystems watcher does not report. Such
+// filesystems are volume-backed, and were detached from the machine
+// they were created on.
+func refreshForeignFilesystems(ctx *context, ids []params.MachineStorageId) error {
+	var tags []names.FilesystemTag
+	for _, id := range ids {
+		tag, err := names.ParseFilesystemTag(id.AttachmentTag)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if _, ok := ctx.filesystems[tag]; ok {
+			continue
+		}
+		machineTag, ok := names.FilesystemMachine(tag)
+		if !ok || machineTag == ctx.config.Scope {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	results, err := ctx.config.Filesystems.Filesystems(tags)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem information")
+	}
+	for i, result := range results {
+		if result.Error != nil {
+			if params.IsCodeNotProvisioned(result.Error) {
+				continue
+			}
+			return errors.Annotatef(result.Error, "getting information for filesystem %s", tags[i].Id())
+		}
+		filesystem, err := filesystemFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting filesystem info")
+		}
+		updateFilesystem(ctx, filesystem)
+		if filesystem.Volume != (names.VolumeTag{}) {
+			maybeAddPendingVolumeBlockDevice(ctx, filesystem.Volume)
+		}
+	}
+	return nil
+}
+
 // filesystemAttachmentParams obtains the specified attachments' parameters.
 func filesystemAttachmentParams(
 	ctx *context, ids []params.MachineStorageId,
@@ -462,6 +512,7 @@ func filesystemParamsFromParams(in params.FilesystemParams) (storage.FilesystemP
 		providerType,
 		in.Attributes,
 		in.Tags,
+		in.SnapshotId,
 	}, nil
 }
 
@@ -330,6 +330,71 @@ func detachFilesystems(ctx *context, ops map[params.MachineStorageId]*detachFile
 	return nil
 }
 
+// growFilesystems grows provisioned, volume-backed filesystems whose
+// backing volumes' block devices have grown larger than the filesystems.
+func growFilesystems(ctx *context) error {
+	var resizeParams []storage.FilesystemResizeParams
+	for _, filesystem := range ctx.filesystems {
+		if filesystem.Volume == (names.VolumeTag{}) {
+			continue
+		}
+		blockDevice, ok := ctx.volumeBlockDevices[filesystem.Volume]
+		if !ok || blockDevice.Size <= filesystem.Size {
+			continue
+		}
+		resizeParams = append(resizeParams, storage.FilesystemResizeParams{
+			Tag:          filesystem.Tag,
+			FilesystemId: filesystem.FilesystemId,
+		})
+	}
+	if len(resizeParams) == 0 {
+		return nil
+	}
+	resizer, ok := ctx.managedFilesystemSource.(storage.FilesystemResizer)
+	if !ok {
+		logger.Warningf("cannot grow filesystems: resizing filesystems not supported")
+		return nil
+	}
+	logger.Debugf("growing filesystems: %v", resizeParams)
+	results, err := resizer.ResizeFilesystems(resizeParams)
+	if err != nil {
+		return errors.Annotate(err, "growing filesystems")
+	}
+	var filesystems []storage.Filesystem
+	for i, result := range results {
+		tag := resizeParams[i].Tag
+		if result.Error != nil {
+			// The filesystem will be grown again the next
+			// time the machine's block devices change.
+			logger.Errorf("failed to grow %s: %v", names.ReadableString(tag), result.Error)
+			continue
+		}
+		filesystem := ctx.filesystems[tag]
+		filesystem.FilesystemInfo = *result.FilesystemInfo
+		filesystems = append(filesystems, filesystem)
+	}
+	if len(filesystems) == 0 {
+		return nil
+	}
+	errorResults, err := ctx.config.Filesystems.SetFilesystemInfo(filesystemsFromStorage(filesystems))
+	if err != nil {
+		return errors.Annotate(err, "publishing filesystems to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			logger.Errorf(
+				"publishing filesystem %s to state: %v",
+				filesystems[i].Tag.Id(),
+				result.Error,
+			)
+		}
+	}
+	for _, v := range filesystems {
+		updateFilesystem(ctx, v)
+	}
+	return nil
+}
+
 // filesystemParamsBySource separates the filesystem parameters by filesystem source.
 func filesystemParamsBySource(
 	baseStorageDir string,
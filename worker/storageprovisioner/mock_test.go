@@ -92,10 +92,13 @@ type mockVolumeAccessor struct {
 	volumesWatcher         *mockStringsWatcher
 	attachmentsWatcher     *mockAttachmentsWatcher
 	blockDevicesWatcher    *mockNotifyWatcher
+	resizesWatcher         *mockStringsWatcher
 	provisionedMachines    map[string]instance.Id
 	provisionedVolumes     map[string]params.Volume
 	provisionedAttachments map[params.MachineStorageId]params.VolumeAttachment
 	blockDevices           map[params.MachineStorageId]storage.BlockDevice
+	snapshotIds            map[string]string
+	resizeParams           map[string]params.VolumeResizeParams
 
 	setVolumeInfo           func([]params.Volume) ([]params.ErrorResult, error)
 	setVolumeAttachmentInfo func([]params.VolumeAttachment) ([]params.ErrorResult, error)
@@ -120,6 +123,10 @@ func (w *mockVolumeAccessor) WatchVolumeAttachments() (watcher.MachineStorageIds
 	return w.attachmentsWatcher, nil
 }
 
+func (w *mockVolumeAccessor) WatchVolumeResizes() (watcher.StringsWatcher, error) {
+	return w.resizesWatcher, nil
+}
+
 func (w *mockVolumeAccessor) WatchBlockDevices(tag names.MachineTag) (watcher.NotifyWatcher, error) {
 	return w.blockDevicesWatcher, nil
 }
@@ -181,6 +188,7 @@ func (v *mockVolumeAccessor) VolumeParams(volumes []names.VolumeTag) ([]params.V
 			Tags: map[string]string{
 				"very": "fancy",
 			},
+			SnapshotId: v.snapshotIds[tag.String()],
 		}
 		volumeParams.Attachment = &params.VolumeAttachmentParams{
 			VolumeTag:  tag.String(),
@@ -211,6 +219,20 @@ func (v *mockVolumeAccessor) VolumeAttachmentParams(ids []params.MachineStorageI
 	return result, nil
 }
 
+func (v *mockVolumeAccessor) VolumeResizeParams(volumes []names.VolumeTag) ([]params.VolumeResizeParamsResult, error) {
+	var result []params.VolumeResizeParamsResult
+	for _, tag := range volumes {
+		if p, ok := v.resizeParams[tag.String()]; ok {
+			result = append(result, params.VolumeResizeParamsResult{Result: p})
+		} else {
+			result = append(result, params.VolumeResizeParamsResult{
+				Error: common.ServerError(errors.NotFoundf("pending resize of volume %q", tag.Id())),
+			})
+		}
+	}
+	return result, nil
+}
+
 func (v *mockVolumeAccessor) SetVolumeInfo(volumes []params.Volume) ([]params.ErrorResult, error) {
 	if v.setVolumeInfo != nil {
 		return v.setVolumeInfo(volumes)
@@ -230,10 +252,13 @@ func newMockVolumeAccessor() *mockVolumeAccessor {
 		volumesWatcher:         newMockStringsWatcher(),
 		attachmentsWatcher:     newMockAttachmentsWatcher(),
 		blockDevicesWatcher:    newMockNotifyWatcher(),
+		resizesWatcher:         newMockStringsWatcher(),
 		provisionedMachines:    make(map[string]instance.Id),
 		provisionedVolumes:     make(map[string]params.Volume),
 		provisionedAttachments: make(map[params.MachineStorageId]params.VolumeAttachment),
 		blockDevices:           make(map[params.MachineStorageId]storage.BlockDevice),
+		snapshotIds:            make(map[string]string),
+		resizeParams:           make(map[string]params.VolumeResizeParams),
 	}
 }
 
@@ -432,6 +457,8 @@ type dummyProvider struct {
 	destroyVolumesFunc           func([]string) ([]error, error)
 	destroyFilesystemsFunc       func([]string) ([]error, error)
 	validateVolumeParamsFunc     func(storage.VolumeParams) error
+	restoreSnapshotsFunc         func([]storage.VolumeParams) ([]storage.CreateVolumesResult, error)
+	resizeVolumesFunc            func([]storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error)
 	validateFilesystemParamsFunc func(storage.FilesystemParams) error
 }
 
@@ -447,10 +474,29 @@ type dummyFilesystemSource struct {
 	createFilesystemsArgs [][]storage.FilesystemParams
 }
 
+// dummyVolumeSnapshotter is a dummyVolumeSource that supports
+// restoring volumes from snapshots.
+type dummyVolumeSnapshotter struct {
+	storage.VolumeSnapshotter
+	*dummyVolumeSource
+}
+
+// dummyVolumeResizer is a dummyVolumeSource that supports
+// resizing volumes.
+type dummyVolumeResizer struct {
+	*dummyVolumeSource
+}
+
 func (p *dummyProvider) VolumeSource(providerConfig *storage.Config) (storage.VolumeSource, error) {
 	if p.volumeSourceFunc != nil {
 		return p.volumeSourceFunc(providerConfig)
 	}
+	if p.resizeVolumesFunc != nil {
+		return &dummyVolumeResizer{&dummyVolumeSource{provider: p}}, nil
+	}
+	if p.restoreSnapshotsFunc != nil {
+		return &dummyVolumeSnapshotter{dummyVolumeSource: &dummyVolumeSource{provider: p}}, nil
+	}
 	return &dummyVolumeSource{provider: p}, nil
 }
 
@@ -498,6 +544,21 @@ func (s *dummyVolumeSource) CreateVolumes(params []storage.VolumeParams) ([]stor
 	return results, nil
 }
 
+// RestoreSnapshots restores volumes from snapshots.
+func (s *dummyVolumeSnapshotter) RestoreSnapshots(params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	return s.provider.restoreSnapshotsFunc(params)
+}
+
+// ResizesAttachedVolumes reports that attached volumes can be resized.
+func (s *dummyVolumeResizer) ResizesAttachedVolumes() bool {
+	return true
+}
+
+// ResizeVolumes resizes volumes.
+func (s *dummyVolumeResizer) ResizeVolumes(params []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+	return s.provider.resizeVolumesFunc(params)
+}
+
 // DestroyVolumes destroys volumes.
 func (s *dummyVolumeSource) DestroyVolumes(volumeIds []string) ([]error, error) {
 	if s.provider.destroyVolumesFunc != nil {
@@ -678,6 +739,27 @@ func (s *mockManagedFilesystemSource) DetachFilesystems(params []storage.Filesys
 	return nil, errors.NotImplementedf("DetachFilesystems")
 }
 
+func (s *mockManagedFilesystemSource) ResizeFilesystems(args []storage.FilesystemResizeParams) ([]storage.ResizeFilesystemsResult, error) {
+	results := make([]storage.ResizeFilesystemsResult, len(args))
+	for i, arg := range args {
+		filesystem, ok := s.filesystems[arg.Tag]
+		if !ok {
+			results[i].Error = errors.Errorf("filesystem %v has not been created", arg.Tag.Id())
+			continue
+		}
+		blockDevice, ok := s.blockDevices[filesystem.Volume]
+		if !ok {
+			results[i].Error = errors.Errorf("filesystem %v's backing-volume is not attached", arg.Tag.Id())
+			continue
+		}
+		results[i].FilesystemInfo = &storage.FilesystemInfo{
+			FilesystemId: filesystem.FilesystemId,
+			Size:         blockDevice.Size,
+		}
+	}
+	return results, nil
+}
+
 type mockMachineAccessor struct {
 	instanceIds map[names.MachineTag]instance.Id
 	watcher     *mockNotifyWatcher
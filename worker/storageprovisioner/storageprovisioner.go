@@ -60,6 +60,10 @@ type VolumeAccessor interface {
 	// that this storage provisioner is responsible for.
 	WatchVolumeAttachments() (watcher.MachineStorageIdsWatcher, error)
 
+	// WatchVolumeResizes watches for changes to the requested sizes
+	// of volumes that this storage provisioner is responsible for.
+	WatchVolumeResizes() (watcher.StringsWatcher, error)
+
 	// Volumes returns details of volumes with the specified tags.
 	Volumes([]names.VolumeTag) ([]params.VolumeResult, error)
 
@@ -79,6 +83,10 @@ type VolumeAccessor interface {
 	// volume attachments with the specified tags.
 	VolumeAttachmentParams([]params.MachineStorageId) ([]params.VolumeAttachmentParamsResult, error)
 
+	// VolumeResizeParams returns the parameters for resizing the
+	// volumes with the specified tags.
+	VolumeResizeParams([]names.VolumeTag) ([]params.VolumeResizeParamsResult, error)
+
 	// SetVolumeInfo records the details of newly provisioned volumes.
 	SetVolumeInfo([]params.Volume) ([]params.ErrorResult, error)
 
@@ -198,6 +206,7 @@ func (w *storageProvisioner) Wait() error {
 func (w *storageProvisioner) loop() error {
 	var (
 		volumesChanges               watcher.StringsChannel
+		volumeResizesChanges         watcher.StringsChannel
 		filesystemsChanges           watcher.StringsChannel
 		volumeAttachmentsChanges     watcher.MachineStorageIdsChannel
 		filesystemAttachmentsChanges watcher.MachineStorageIdsChannel
@@ -227,6 +236,15 @@ func (w *storageProvisioner) loop() error {
 	}
 	volumesChanges = volumesWatcher.Changes()
 
+	volumeResizesWatcher, err := w.config.Volumes.WatchVolumeResizes()
+	if err != nil {
+		return errors.Annotate(err, "watching volume resizes")
+	}
+	if err := w.catacomb.Add(volumeResizesWatcher); err != nil {
+		return errors.Trace(err)
+	}
+	volumeResizesChanges = volumeResizesWatcher.Changes()
+
 	filesystemsWatcher, err := w.config.Filesystems.WatchFilesystems()
 	if err != nil {
 		return errors.Annotate(err, "watching filesystems")
@@ -292,6 +310,13 @@ func (w *storageProvisioner) loop() error {
 			if err := volumesChanged(&ctx, changes); err != nil {
 				return errors.Trace(err)
 			}
+		case changes, ok := <-volumeResizesChanges:
+			if !ok {
+				return errors.New("volume resizes watcher closed")
+			}
+			if err := volumeResizesChanged(&ctx, changes); err != nil {
+				return errors.Trace(err)
+			}
 		case changes, ok := <-volumeAttachmentsChanges:
 			if !ok {
 				return errors.New("volume attachments watcher closed")
@@ -340,6 +365,7 @@ func processSchedule(ctx *context) error {
 	destroyVolumeOps := make(map[names.VolumeTag]*destroyVolumeOp)
 	attachVolumeOps := make(map[params.MachineStorageId]*attachVolumeOp)
 	detachVolumeOps := make(map[params.MachineStorageId]*detachVolumeOp)
+	resizeVolumeOps := make(map[names.VolumeTag]*resizeVolumeOp)
 	createFilesystemOps := make(map[names.FilesystemTag]*createFilesystemOp)
 	destroyFilesystemOps := make(map[names.FilesystemTag]*destroyFilesystemOp)
 	attachFilesystemOps := make(map[params.MachineStorageId]*attachFilesystemOp)
@@ -356,6 +382,8 @@ func processSchedule(ctx *context) error {
 			attachVolumeOps[key.(params.MachineStorageId)] = op
 		case *detachVolumeOp:
 			detachVolumeOps[key.(params.MachineStorageId)] = op
+		case *resizeVolumeOp:
+			resizeVolumeOps[key.(resizeVolumeKey).tag] = op
 		case *createFilesystemOp:
 			createFilesystemOps[key.(names.FilesystemTag)] = op
 		case *destroyFilesystemOp:
@@ -386,6 +414,11 @@ func processSchedule(ctx *context) error {
 			return errors.Annotate(err, "attaching volumes")
 		}
 	}
+	if len(resizeVolumeOps) > 0 {
+		if err := resizeVolumes(ctx, resizeVolumeOps); err != nil {
+			return errors.Annotate(err, "resizing volumes")
+		}
+	}
 	if len(destroyFilesystemOps) > 0 {
 		if err := destroyFilesystems(ctx, destroyFilesystemOps); err != nil {
 			return errors.Annotate(err, "destroying filesystems")
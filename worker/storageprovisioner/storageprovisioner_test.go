@@ -266,6 +266,97 @@ func (s *storageProvisionerSuite) TestCreateVolumeRetry(c *gc.C) {
 	})
 }
 
+func (s *storageProvisionerSuite) TestRestoreVolumeFromSnapshot(c *gc.C) {
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = instance.Id("already-provisioned-1")
+	volumeAccessor.snapshotIds["volume-2"] = "snap-1"
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		c.Assert(volumes, jc.SameContents, []params.Volume{{
+			VolumeTag: "volume-1",
+			Info: params.VolumeInfo{
+				VolumeId:   "id-1",
+				HardwareId: "serial-1",
+				Size:       1024,
+				Persistent: true,
+			},
+		}, {
+			VolumeTag: "volume-2",
+			Info: params.VolumeInfo{
+				VolumeId: "vol-from-snap-1",
+				Size:     1024,
+			},
+		}})
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	var restored []storage.VolumeParams
+	s.provider.restoreSnapshotsFunc = func(args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+		restored = append(restored, args...)
+		results := make([]storage.CreateVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].Volume = &storage.Volume{
+				arg.Tag,
+				storage.VolumeInfo{
+					VolumeId: "vol-from-" + arg.SnapshotId,
+					Size:     arg.Size,
+				},
+			}
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag: "machine-1", AttachmentTag: "volume-1",
+	}, {
+		MachineTag: "machine-1", AttachmentTag: "volume-2",
+	}}
+	volumeAccessor.volumesWatcher.changes <- []string{"1", "2"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(restored, gc.HasLen, 1)
+	c.Assert(restored[0].Tag, gc.Equals, names.NewVolumeTag("2"))
+	c.Assert(restored[0].SnapshotId, gc.Equals, "snap-1")
+}
+
+func (s *storageProvisionerSuite) TestRestoreVolumeFromSnapshotNotSupported(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = instance.Id("already-provisioned-1")
+	volumeAccessor.snapshotIds["volume-1"] = "snap-1"
+
+	// Creation is retried, so only the first status is recorded.
+	statusSet := make(chan interface{}, 1)
+	statusSetter := &mockStatusSetter{}
+	statusSetter.setStatus = func(args []params.EntityStatusArgs) error {
+		select {
+		case statusSet <- args:
+		default:
+		}
+		return nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry, statusSetter: statusSetter}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag: "machine-1", AttachmentTag: "volume-1",
+	}}
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	statusArgs := waitChannel(c, statusSet, "waiting for status to be set")
+	c.Assert(statusArgs, jc.DeepEquals, []params.EntityStatusArgs{{
+		Tag:    "volume-1",
+		Status: "pending",
+		Info:   "restoring volumes from snapshots not supported",
+	}})
+}
+
 func (s *storageProvisionerSuite) TestCreateFilesystemRetry(c *gc.C) {
 	filesystemInfoSet := make(chan interface{})
 	filesystemAccessor := newMockFilesystemAccessor()
@@ -1055,6 +1146,204 @@ func (s *storageProvisionerSuite) TestAttachVolumeBackedFilesystem(c *gc.C) {
 	}})
 }
 
+func (s *storageProvisionerSuite) TestAttachDetachedVolumeBackedFilesystem(c *gc.C) {
+	infoSet := make(chan interface{})
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.setFilesystemAttachmentInfo = func(attachments []params.FilesystemAttachment) ([]params.ErrorResult, error) {
+		infoSet <- attachments
+		return nil, nil
+	}
+
+	args := &workerArgs{
+		scope:       names.NewMachineTag("1"),
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	// The filesystem was created on machine 0, and detached from it;
+	// it is not reported by machine 1's filesystems watcher.
+	filesystemAccessor.provisionedFilesystems["filesystem-0-0"] = params.Filesystem{
+		FilesystemTag: "filesystem-0-0",
+		VolumeTag:     "volume-0",
+		Info: params.FilesystemInfo{
+			FilesystemId: "whatever",
+			Size:         123,
+		},
+	}
+	filesystemAccessor.provisionedMachines["machine-1"] = instance.Id("already-provisioned-1")
+
+	args.volumes.blockDevices[params.MachineStorageId{
+		MachineTag:    "machine-1",
+		AttachmentTag: "volume-0",
+	}] = storage.BlockDevice{
+		DeviceName: "xvdf1",
+		Size:       123,
+	}
+	filesystemAccessor.attachmentsWatcher.changes <- []watcher.MachineStorageId{{
+		MachineTag:    "machine-1",
+		AttachmentTag: "filesystem-0-0",
+	}}
+
+	info := waitChannel(
+		c, infoSet, "waiting for filesystem attachment info to be set",
+	).([]params.FilesystemAttachment)
+	c.Assert(info, jc.DeepEquals, []params.FilesystemAttachment{{
+		FilesystemTag: "filesystem-0-0",
+		MachineTag:    "machine-1",
+		Info: params.FilesystemAttachmentInfo{
+			MountPoint: "/mnt/xvdf1",
+			ReadOnly:   true,
+		},
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolume(c *gc.C) {
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedVolumes["volume-1"] = params.Volume{
+		VolumeTag: "volume-1",
+		Info: params.VolumeInfo{
+			VolumeId:   "vol-1",
+			HardwareId: "serial-1",
+			Size:       1024,
+			Persistent: true,
+		},
+	}
+	volumeAccessor.resizeParams["volume-1"] = params.VolumeResizeParams{
+		VolumeTag: "volume-1",
+		VolumeId:  "vol-1",
+		Provider:  "dummy",
+		Size:      2048,
+	}
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		c.Assert(volumes, jc.DeepEquals, []params.Volume{{
+			VolumeTag: "volume-1",
+			Info: params.VolumeInfo{
+				VolumeId:   "vol-1",
+				HardwareId: "serial-1",
+				Size:       2048,
+				Persistent: true,
+			},
+		}})
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	var resized []storage.VolumeResizeParams
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		resized = append(resized, args...)
+		results := make([]storage.ResizeVolumesResult, len(args))
+		for i, arg := range args {
+			results[i].VolumeInfo = &storage.VolumeInfo{
+				VolumeId: arg.VolumeId,
+				Size:     arg.Size,
+			}
+		}
+		return results, nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	// volume-2 has no pending resize, and is ignored.
+	volumeAccessor.resizesWatcher.changes <- []string{"1", "2"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+	c.Assert(resized, jc.DeepEquals, []storage.VolumeResizeParams{{
+		Tag:      names.NewVolumeTag("1"),
+		VolumeId: "vol-1",
+		Size:     2048,
+	}})
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumeNotSupported(c *gc.C) {
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.resizeParams["volume-1"] = params.VolumeResizeParams{
+		VolumeTag: "volume-1",
+		VolumeId:  "vol-1",
+		Provider:  "dummy",
+		Size:      2048,
+	}
+
+	resizeCalled := make(chan interface{}, 10)
+	s.provider.resizeVolumesFunc = func(args []storage.VolumeResizeParams) ([]storage.ResizeVolumesResult, error) {
+		resizeCalled <- args
+		return []storage.ResizeVolumesResult{{
+			Error: errors.NewNotSupported(nil, "cannot resize a volume that is in use"),
+		}}, nil
+	}
+
+	// mockClock's After fires immediately, so a rescheduled
+	// resize would be retried straight away.
+	args := &workerArgs{volumes: volumeAccessor, clock: &mockClock{}, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.resizesWatcher.changes <- []string{"1"}
+	waitChannel(c, resizeCalled, "waiting for volume to be resized")
+	assertNoEvent(c, resizeCalled, "volume resize retried")
+}
+
+func (s *storageProvisionerSuite) TestGrowVolumeBackedFilesystem(c *gc.C) {
+	filesystemInfoSet := make(chan interface{})
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		filesystemInfoSet <- filesystems
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	args := &workerArgs{
+		scope:       names.NewMachineTag("0"),
+		filesystems: filesystemAccessor,
+		registry:    s.registry,
+	}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), gc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.provisionedFilesystems["filesystem-0-0"] = params.Filesystem{
+		FilesystemTag: "filesystem-0-0",
+		VolumeTag:     "volume-0-0",
+		Info: params.FilesystemInfo{
+			FilesystemId: "whatever",
+			Size:         123,
+		},
+	}
+
+	// The backing volume has been resized, so its
+	// block device is larger than the filesystem.
+	args.volumes.blockDevices[params.MachineStorageId{
+		MachineTag:    "machine-0",
+		AttachmentTag: "volume-0-0",
+	}] = storage.BlockDevice{
+		DeviceName: "xvdf1",
+		Size:       246,
+	}
+	filesystemAccessor.filesystemsWatcher.changes <- []string{"0/0"}
+
+	filesystemInfo := waitChannel(
+		c, filesystemInfoSet,
+		"waiting for filesystem info to be set",
+	).([]params.Filesystem)
+	c.Assert(filesystemInfo, jc.DeepEquals, []params.Filesystem{{
+		FilesystemTag: "filesystem-0-0",
+		VolumeTag:     "volume-0-0",
+		Info: params.FilesystemInfo{
+			FilesystemId: "whatever",
+			Size:         246,
+		},
+	}})
+
+	// The filesystem is not grown again.
+	args.volumes.blockDevicesWatcher.changes <- struct{}{}
+	assertNoEvent(c, filesystemInfoSet, "filesystem info set")
+}
+
 func (s *storageProvisionerSuite) TestResourceTags(c *gc.C) {
 	volumeInfoSet := make(chan interface{})
 	volumeAccessor := newMockVolumeAccessor()
@@ -54,6 +54,46 @@ func volumesChanged(ctx *context, changes []string) error {
 	return nil
 }
 
+// volumeResizesChanged is called when the requested sizes of the volumes
+// with the provided IDs may have changed.
+func volumeResizesChanged(ctx *context, changes []string) error {
+	tags := make([]names.VolumeTag, len(changes))
+	for i, change := range changes {
+		tags[i] = names.NewVolumeTag(change)
+	}
+	results, err := ctx.config.Volumes.VolumeResizeParams(tags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume resize parameters")
+	}
+	var ops []scheduleOp
+	for i, result := range results {
+		if result.Error != nil {
+			if params.IsCodeNotFound(result.Error) || params.IsCodeUnauthorized(result.Error) {
+				// Either there is no resize pending for the
+				// volume, or the volume has been removed.
+				removePendingVolumeResize(ctx, tags[i])
+				continue
+			}
+			return errors.Annotatef(
+				result.Error, "getting resize parameters for volume %s", tags[i].Id(),
+			)
+		}
+		args, providerType, err := volumeResizeParamsFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting volume resize parameters")
+		}
+		ops = append(ops, &resizeVolumeOp{args: args, provider: providerType})
+	}
+	scheduleOperations(ctx, ops...)
+	return nil
+}
+
+// removePendingVolumeResize removes the specified pending volume resize
+// from the schedule if it exists there.
+func removePendingVolumeResize(ctx *context, tag names.VolumeTag) {
+	ctx.schedule.Remove(resizeVolumeKey{tag})
+}
+
 // volumeAttachmentsChanged is called when the lifecycle states of the volume
 // attachments with the provided IDs have been seen to have changed.
 func volumeAttachmentsChanged(ctx *context, watcherIds []watcher.MachineStorageId) error {
@@ -100,6 +140,7 @@ func volumeAttachmentsChanged(ctx *context, watcherIds []watcher.MachineStorageI
 func processDyingVolumes(ctx *context, tags []names.Tag) error {
 	for _, tag := range tags {
 		removePendingVolume(ctx, tag.(names.VolumeTag))
+		removePendingVolumeResize(ctx, tag.(names.VolumeTag))
 	}
 	return nil
 }
@@ -168,6 +209,7 @@ func removePendingVolumeAttachment(ctx *context, id params.MachineStorageId) {
 func processDeadVolumes(ctx *context, tags []names.VolumeTag, volumeResults []params.VolumeResult) error {
 	for _, tag := range tags {
 		removePendingVolume(ctx, tag)
+		removePendingVolumeResize(ctx, tag)
 	}
 	var destroy []names.VolumeTag
 	var remove []names.Tag
@@ -431,6 +473,18 @@ func volumeFromParams(in params.Volume) (storage.Volume, error) {
 	}, nil
 }
 
+func volumeResizeParamsFromParams(in params.VolumeResizeParams) (storage.VolumeResizeParams, storage.ProviderType, error) {
+	volumeTag, err := names.ParseVolumeTag(in.VolumeTag)
+	if err != nil {
+		return storage.VolumeResizeParams{}, "", errors.Trace(err)
+	}
+	return storage.VolumeResizeParams{
+		Tag:      volumeTag,
+		VolumeId: in.VolumeId,
+		Size:     in.Size,
+	}, storage.ProviderType(in.Provider), nil
+}
+
 func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error) {
 	volumeTag, err := names.ParseVolumeTag(in.VolumeTag)
 	if err != nil {
@@ -475,6 +529,7 @@ func volumeParamsFromParams(in params.VolumeParams) (storage.VolumeParams, error
 		in.Attributes,
 		in.Tags,
 		attachment,
+		in.SnapshotId,
 	}, nil
 }
 
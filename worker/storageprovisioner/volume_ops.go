@@ -50,7 +50,7 @@ func createVolumes(ctx *context, ops map[names.VolumeTag]*createVolumeOp) error
 		if len(volumeParams) == 0 {
 			continue
 		}
-		results, err := volumeSource.CreateVolumes(volumeParams)
+		results, err := createVolumesFromSource(volumeSource, volumeParams)
 		if err != nil {
 			return errors.Annotatef(err, "creating volumes from source %q", sourceName)
 		}
@@ -322,6 +322,104 @@ func detachVolumes(ctx *context, ops map[params.MachineStorageId]*detachVolumeOp
 	return nil
 }
 
+// resizeVolumes resizes volumes with the specified parameters.
+func resizeVolumes(ctx *context, ops map[names.VolumeTag]*resizeVolumeOp) error {
+	paramsByProvider := make(map[storage.ProviderType][]storage.VolumeResizeParams)
+	for _, op := range ops {
+		paramsByProvider[op.provider] = append(paramsByProvider[op.provider], op.args)
+	}
+	var reschedule []scheduleOp
+	resized := make(map[names.VolumeTag]storage.VolumeInfo)
+	for providerType, resizeParams := range paramsByProvider {
+		sourceName := string(providerType)
+		source, err := volumeSource(
+			ctx.config.StorageDir, sourceName, providerType, ctx.config.Registry,
+		)
+		if errors.Cause(err) == errNonDynamic {
+			continue
+		} else if err != nil {
+			return errors.Annotate(err, "getting volume source")
+		}
+		resizer, ok := source.(storage.VolumeResizer)
+		if !ok {
+			for _, p := range resizeParams {
+				logger.Warningf(
+					"cannot resize %s: storage provider %q does not support resizing volumes",
+					names.ReadableString(p.Tag), providerType,
+				)
+			}
+			continue
+		}
+		logger.Debugf("resizing volumes: %v", resizeParams)
+		results, err := resizer.ResizeVolumes(resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			tag := resizeParams[i].Tag
+			if errors.IsNotSupported(result.Error) {
+				// The volume cannot be resized as it is,
+				// so retrying the resize would not help.
+				logger.Errorf("cannot resize %s: %v", names.ReadableString(tag), result.Error)
+				continue
+			}
+			if result.Error != nil {
+				// Reschedule the volume resize.
+				reschedule = append(reschedule, ops[tag])
+				logger.Errorf("failed to resize %s: %v", names.ReadableString(tag), result.Error)
+				continue
+			}
+			resized[tag] = *result.VolumeInfo
+		}
+	}
+	scheduleOperations(ctx, reschedule...)
+	if len(resized) == 0 {
+		return nil
+	}
+
+	// Record the new sizes in state, leaving the rest
+	// of the volumes' information intact.
+	tags := make([]names.VolumeTag, 0, len(resized))
+	for tag := range resized {
+		tags = append(tags, tag)
+	}
+	volumeResults, err := ctx.config.Volumes.Volumes(tags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume information")
+	}
+	volumes := make([]storage.Volume, 0, len(tags))
+	for i, result := range volumeResults {
+		if result.Error != nil {
+			return errors.Annotatef(
+				result.Error, "getting information for volume %s", tags[i].Id(),
+			)
+		}
+		volume, err := volumeFromParams(result.Result)
+		if err != nil {
+			return errors.Annotate(err, "getting volume info")
+		}
+		volume.Size = resized[tags[i]].Size
+		volumes = append(volumes, volume)
+	}
+	errorResults, err := ctx.config.Volumes.SetVolumeInfo(volumesFromStorage(volumes))
+	if err != nil {
+		return errors.Annotate(err, "publishing volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			logger.Errorf(
+				"publishing volume %s to state: %v",
+				volumes[i].Tag.Id(),
+				result.Error,
+			)
+		}
+	}
+	for _, v := range volumes {
+		updateVolume(ctx, v)
+	}
+	return nil
+}
+
 // volumeParamsBySource separates the volume parameters by volume source.
 func volumeParamsBySource(
 	baseStorageDir string,
@@ -378,6 +476,54 @@ func validateVolumeParams(
 	return valid, results
 }
 
+// createVolumesFromSource creates volumes with the specified parameters
+// using the volume source. Volumes with a snapshot ID are restored from
+// their snapshots, if the volume source supports snapshots. The results
+// are returned in the same order as the parameters.
+func createVolumesFromSource(
+	volumeSource storage.VolumeSource, volumeParams []storage.VolumeParams,
+) ([]storage.CreateVolumesResult, error) {
+	var createParams, restoreParams []storage.VolumeParams
+	var createIndices, restoreIndices []int
+	for i, params := range volumeParams {
+		if params.SnapshotId == "" {
+			createParams = append(createParams, params)
+			createIndices = append(createIndices, i)
+		} else {
+			restoreParams = append(restoreParams, params)
+			restoreIndices = append(restoreIndices, i)
+		}
+	}
+	results := make([]storage.CreateVolumesResult, len(volumeParams))
+	if len(createParams) > 0 {
+		createResults, err := volumeSource.CreateVolumes(createParams)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for i, result := range createResults {
+			results[createIndices[i]] = result
+		}
+	}
+	if len(restoreParams) == 0 {
+		return results, nil
+	}
+	snapshotter, ok := volumeSource.(storage.VolumeSnapshotter)
+	if !ok {
+		for _, i := range restoreIndices {
+			results[i].Error = errors.NotSupportedf("restoring volumes from snapshots")
+		}
+		return results, nil
+	}
+	restoreResults, err := snapshotter.RestoreSnapshots(restoreParams)
+	if err != nil {
+		return nil, errors.Annotate(err, "restoring volumes from snapshots")
+	}
+	for i, result := range restoreResults {
+		results[restoreIndices[i]] = result
+	}
+	return results, nil
+}
+
 // volumeAttachmentParamsBySource separates the volume attachment parameters by volume source.
 func volumeAttachmentParamsBySource(
 	baseStorageDir string,
@@ -458,6 +604,22 @@ func (op *destroyVolumeOp) key() interface{} {
 	return op.tag
 }
 
+type resizeVolumeOp struct {
+	exponentialBackoff
+	args     storage.VolumeResizeParams
+	provider storage.ProviderType
+}
+
+// resizeVolumeKey is the schedule key for resizeVolumeOps,
+// distinguishing them from other operations on the volume.
+type resizeVolumeKey struct {
+	tag names.VolumeTag
+}
+
+func (op *resizeVolumeOp) key() interface{} {
+	return resizeVolumeKey{op.args.Tag}
+}
+
 type attachVolumeOp struct {
 	exponentialBackoff
 	args storage.VolumeAttachmentParams
@@ -34,7 +34,7 @@ func (opc *operationCallbacks) PrepareHook(hi hook.Info) (string, error) {
 		if err != nil {
 			return "", err
 		}
-	case hi.Kind.IsStorage():
+	case hook.IsStorage(hi.Kind):
 		if err := opc.u.storage.ValidateHook(hi); err != nil {
 			return "", err
 		}
@@ -60,7 +60,7 @@ func (opc *operationCallbacks) CommitHook(hi hook.Info) error {
 	switch {
 	case hi.Kind.IsRelation():
 		return opc.u.relations.CommitHook(hi)
-	case hi.Kind.IsStorage():
+	case hook.IsStorage(hi.Kind):
 		return opc.u.storage.CommitHook(hi)
 	}
 	return nil
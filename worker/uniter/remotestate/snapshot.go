@@ -82,4 +82,6 @@ type StorageSnapshot struct {
 	Life     params.Life
 	Attached bool
 	Location string
+	// Size is the size of the attached storage in MiB.
+	Size uint64
 }
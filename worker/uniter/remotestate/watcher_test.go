@@ -303,6 +303,7 @@ func (s *WatcherSuite) TestStorageChanged(c *gc.C) {
 		Life:       params.Dying,
 		Kind:       params.StorageKindFilesystem,
 		Location:   "somewhere",
+		Size:       1024,
 	}
 	delete(s.st.storageAttachment, storageAttachmentId1)
 	storageTag0Watcher.changes <- struct{}{}
@@ -315,6 +316,7 @@ func (s *WatcherSuite) TestStorageChanged(c *gc.C) {
 			Attached: true,
 			Kind:     params.StorageKindFilesystem,
 			Location: "somewhere",
+			Size:     1024,
 		},
 	})
 }
@@ -13,6 +13,7 @@ type ActionData struct {
 	Tag            names.ActionTag
 	Params         map[string]interface{}
 	Failed         bool
+	Aborted        bool
 	ResultsMessage string
 	ResultsMap     map[string]interface{}
 }
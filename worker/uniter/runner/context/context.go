@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/network"
 	"github.com/juju/juju/status"
+	"github.com/juju/juju/watcher"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
 )
 
@@ -509,6 +510,46 @@ func (ctx *HookContext) SetActionFailed() error {
 	return nil
 }
 
+// LogActionMessage records a progress message for the running Action,
+// which is visible to the user while the Action runs. It returns an
+// error if not called on an Action-containing HookContext.
+func (ctx *HookContext) LogActionMessage(message string) error {
+	if ctx.actionData == nil {
+		return errors.New("not running an action")
+	}
+	return ctx.state.LogActionMessage(ctx.actionData.Tag, message)
+}
+
+// ActionStatus returns the current status of the running action, as
+// recorded by the controller. It returns an error if not called on an
+// Action-containing HookContext.
+func (ctx *HookContext) ActionStatus() (string, error) {
+	if ctx.actionData == nil {
+		return "", errors.New("not running an action")
+	}
+	return ctx.state.ActionStatus(ctx.actionData.Tag)
+}
+
+// WatchActionStatus returns a watcher that notifies of changes to the
+// running action, such as a request to abort it. It returns an error if
+// not called on an Action-containing HookContext.
+func (ctx *HookContext) WatchActionStatus() (watcher.NotifyWatcher, error) {
+	if ctx.actionData == nil {
+		return nil, errors.New("not running an action")
+	}
+	return ctx.state.WatchActionStatus(ctx.actionData.Tag)
+}
+
+// SetActionAborted records that the running action was stopped at the
+// controller's request, so that it is reported as aborted.
+func (ctx *HookContext) SetActionAborted() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if ctx.actionData != nil {
+		ctx.actionData.Aborted = true
+	}
+}
+
 // UpdateActionResults inserts new values for use with action-set and
 // action-fail.  The results struct will be delivered to the controller
 // upon completion of the Action.  It returns an error if not called on an
@@ -746,6 +787,15 @@ func (ctx *HookContext) finalizeAction(err, unhandledErr error) error {
 		}
 		status = params.ActionFailed
 	}
+	mutex.Lock()
+	aborted := ctx.actionData.Aborted
+	mutex.Unlock()
+	if aborted {
+		// The action's processes were killed, so any error only
+		// reflects that.
+		message = "action aborted"
+		status = params.ActionAborted
+	}
 
 	callErr := ctx.state.ActionFinish(tag, status, results, message)
 	if callErr != nil {
@@ -787,6 +837,12 @@ func (ctx *HookContext) killCharmHook() error {
 	}
 }
 
+// GoalState returns the goal state of the executing unit's application
+// and of its relations.
+func (ctx *HookContext) GoalState() (*params.GoalState, error) {
+	return ctx.unit.GoalState()
+}
+
 // NetworkConfig returns the network config for the given bindingName.
 func (ctx *HookContext) NetworkConfig(bindingName string) ([]params.NetworkConfig, error) {
 	return ctx.unit.NetworkConfig(bindingName)
@@ -233,6 +233,12 @@ func (s *InterfaceSuite) TestNonActionCallsToActionMethodsFail(c *gc.C) {
 	c.Check(err, gc.ErrorMatches, "not running an action")
 	err = ctx.UpdateActionResults([]string{"1", "2", "3"}, "value")
 	c.Check(err, gc.ErrorMatches, "not running an action")
+	_, err = ctx.ActionStatus()
+	c.Check(err, gc.ErrorMatches, "not running an action")
+	_, err = ctx.WatchActionStatus()
+	c.Check(err, gc.ErrorMatches, "not running an action")
+	err = ctx.LogActionMessage("foo")
+	c.Check(err, gc.ErrorMatches, "not running an action")
 }
 
 // TestUpdateActionResults demonstrates that UpdateActionResults functions
@@ -295,6 +301,15 @@ func (s *InterfaceSuite) TestSetActionFailed(c *gc.C) {
 	c.Check(actionData.Failed, jc.IsTrue)
 }
 
+// TestSetActionAborted ensures SetActionAborted works properly.
+func (s *InterfaceSuite) TestSetActionAborted(c *gc.C) {
+	hctx := context.GetStubActionContext(nil)
+	hctx.SetActionAborted()
+	actionData, err := hctx.ActionData()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(actionData.Aborted, jc.IsTrue)
+}
+
 // TestSetActionMessage ensures SetActionMessage works properly.
 func (s *InterfaceSuite) TestSetActionMessage(c *gc.C) {
 	hctx := context.GetStubActionContext(nil)
@@ -0,0 +1,59 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"strings"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+)
+
+// ActionLogCommand implements the action-log command.
+type ActionLogCommand struct {
+	cmd.CommandBase
+	ctx     Context
+	message string
+}
+
+// NewActionLogCommand returns a new ActionLogCommand with the given context.
+func NewActionLogCommand(ctx Context) (cmd.Command, error) {
+	return &ActionLogCommand{ctx: ctx}, nil
+}
+
+// Info returns the content for --help.
+func (c *ActionLogCommand) Info() *cmd.Info {
+	doc := `
+action-log records a progress message for the running action. The message
+is timestamped and can be followed with "juju show-action-output --watch"
+while the action runs, and is kept with the action's results.
+Messages longer than 1024 bytes are truncated, and only the 100 most
+recent messages are kept.
+`
+	return &cmd.Info{
+		Name:    "action-log",
+		Args:    "<message>",
+		Purpose: "record a progress message for the current running action",
+		Doc:     doc,
+	}
+}
+
+// SetFlags handles any option flags, but there are none.
+func (c *ActionLogCommand) SetFlags(f *gnuflag.FlagSet) {
+}
+
+// Init sets the message to log.
+func (c *ActionLogCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.New("no message specified")
+	}
+	c.message = strings.Join(args, " ")
+	return nil
+}
+
+// Run records the message against the running Action.
+func (c *ActionLogCommand) Run(ctx *cmd.Context) error {
+	return c.ctx.LogActionMessage(c.message)
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"fmt"
+
+	"github.com/juju/cmd"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type ActionLogSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&ActionLogSuite{})
+
+type actionLogContext struct {
+	jujuc.Context
+	messages []string
+}
+
+func (ctx *actionLogContext) LogActionMessage(message string) error {
+	ctx.messages = append(ctx.messages, message)
+	return nil
+}
+
+type nonActionLogContext struct {
+	jujuc.Context
+}
+
+func (ctx *nonActionLogContext) LogActionMessage(message string) error {
+	return fmt.Errorf("not running an action")
+}
+
+func (s *ActionLogSuite) TestActionLog(c *gc.C) {
+	var actionLogTests = []struct {
+		summary  string
+		command  []string
+		messages []string
+		errMsg   string
+		code     int
+	}{{
+		summary: "a message is required",
+		command: []string{},
+		errMsg:  "error: no message specified\n",
+		code:    2,
+	}, {
+		summary:  "a single argument is logged",
+		command:  []string{"copying tables"},
+		messages: []string{"copying tables"},
+	}, {
+		summary:  "multiple arguments are joined",
+		command:  []string{"copied", "3", "of", "5", "tables"},
+		messages: []string{"copied 3 of 5 tables"},
+	}}
+
+	for i, t := range actionLogTests {
+		c.Logf("test %d: %s", i, t.summary)
+		hctx := &actionLogContext{}
+		com, err := jujuc.NewCommand(hctx, cmdString("action-log"))
+		c.Assert(err, jc.ErrorIsNil)
+		ctx := testing.Context(c)
+		code := cmd.Main(com, ctx, t.command)
+		c.Check(code, gc.Equals, t.code)
+		c.Check(bufferString(ctx.Stderr), gc.Equals, t.errMsg)
+		c.Check(hctx.messages, jc.DeepEquals, t.messages)
+	}
+}
+
+func (s *ActionLogSuite) TestNonActionLogFails(c *gc.C) {
+	hctx := &nonActionLogContext{}
+	com, err := jujuc.NewCommand(hctx, cmdString("action-log"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := testing.Context(c)
+	code := cmd.Main(com, ctx, []string{"oops"})
+	c.Check(code, gc.Equals, 1)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "error: not running an action\n")
+	c.Check(bufferString(ctx.Stdout), gc.Equals, "")
+}
+
+func (s *ActionLogSuite) TestHelp(c *gc.C) {
+	hctx, _ := s.NewHookContext()
+	com, err := jujuc.NewCommand(hctx, cmdString("action-log"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := testing.Context(c)
+	code := cmd.Main(com, ctx, []string{"--help"})
+	c.Assert(code, gc.Equals, 0)
+	c.Assert(bufferString(ctx.Stdout), gc.Equals, `Usage: action-log <message>
+
+Summary:
+record a progress message for the current running action
+
+Details:
+action-log records a progress message for the running action. The message
+is timestamped and can be followed with "juju show-action-output --watch"
+while the action runs, and is kept with the action's results.
+Messages longer than 1024 bytes are truncated, and only the 100 most
+recent messages are kept.
+`)
+	c.Assert(bufferString(ctx.Stderr), gc.Equals, "")
+}
@@ -97,6 +97,9 @@ type actionHookContext interface {
 
 	// SetActionFailed sets a failure state for the Action.
 	SetActionFailed() error
+
+	// LogActionMessage records a progress message for the Action.
+	LogActionMessage(string) error
 }
 
 // ContextUnit is the part of a hook context related to the unit.
@@ -106,6 +109,11 @@ type ContextUnit interface {
 
 	// Config returns the current service configuration of the executing unit.
 	ConfigSettings() (charm.Settings, error)
+
+	// GoalState returns the units expected for the executing unit's
+	// application, and the applications and units expected at the
+	// other end of each of its relations.
+	GoalState() (*params.GoalState, error)
 }
 
 // ContextStatus is the part of a hook context related to the unit's status.
@@ -0,0 +1,103 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc
+
+import (
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/apiserver/params"
+)
+
+// goalStateCommand implements the goal-state command.
+type goalStateCommand struct {
+	cmd.CommandBase
+	ctx Context
+	out cmd.Output
+}
+
+// NewGoalStateCommand returns a new goalStateCommand with the given context.
+func NewGoalStateCommand(ctx Context) (cmd.Command, error) {
+	return &goalStateCommand{ctx: ctx}, nil
+}
+
+// Info is part of the cmd.Command interface.
+func (c *goalStateCommand) Info() *cmd.Info {
+	doc := `
+goal-state prints the units expected for the local application, and the
+applications and units expected at the other end of each of its relations,
+keyed by the local relation name. Units that are still being provisioned
+and units that are dying are included, along with their status. Unlike
+relation-list, which only reports the units that have joined a relation,
+goal-state lets a charm know how many units to wait for.
+`
+	return &cmd.Info{
+		Name:    "goal-state",
+		Purpose: "print the goal state of the local application and its relations",
+		Doc:     doc,
+	}
+}
+
+// SetFlags is part of the cmd.Command interface.
+func (c *goalStateCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// Init is part of the cmd.Command interface.
+func (c *goalStateCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run is part of the cmd.Command interface.
+func (c *goalStateCommand) Run(ctx *cmd.Context) error {
+	goalState, err := c.ctx.GoalState()
+	if err != nil {
+		return errors.Annotate(err, "cannot get goal state")
+	}
+	return c.out.Write(ctx, formatGoalState(goalState))
+}
+
+// goalStateOutput is the output of the goal-state command.
+type goalStateOutput struct {
+	Units     goalStateUnits            `yaml:"units" json:"units"`
+	Relations map[string]goalStateUnits `yaml:"relations" json:"relations"`
+}
+
+type goalStateUnits map[string]goalStateStatus
+
+type goalStateStatus struct {
+	Status string `yaml:"status" json:"status"`
+	Since  string `yaml:"since,omitempty" json:"since,omitempty"`
+}
+
+func formatGoalState(goalState *params.GoalState) goalStateOutput {
+	out := goalStateOutput{
+		Units:     goalStateUnits{},
+		Relations: map[string]goalStateUnits{},
+	}
+	if goalState == nil {
+		return out
+	}
+	out.Units = formatGoalStateUnits(goalState.Units)
+	for name, units := range goalState.Relations {
+		out.Relations[name] = formatGoalStateUnits(units)
+	}
+	return out
+}
+
+func formatGoalStateUnits(units params.UnitsGoalState) goalStateUnits {
+	out := make(goalStateUnits, len(units))
+	for name, unit := range units {
+		status := goalStateStatus{Status: unit.Status}
+		if unit.Since != nil {
+			status.Since = unit.Since.UTC().Format("2006-01-02 15:04:05Z")
+		}
+		out[name] = status
+	}
+	return out
+}
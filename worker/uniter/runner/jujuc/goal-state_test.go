@@ -0,0 +1,105 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuc_test
+
+import (
+	"time"
+
+	"github.com/juju/cmd"
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/params"
+	"github.com/juju/juju/testing"
+	"github.com/juju/juju/worker/uniter/runner/jujuc"
+)
+
+type GoalStateSuite struct {
+	ContextSuite
+}
+
+var _ = gc.Suite(&GoalStateSuite{})
+
+type goalStateContext struct {
+	jujuc.Context
+	goalState *params.GoalState
+	err       error
+}
+
+func (ctx *goalStateContext) GoalState() (*params.GoalState, error) {
+	return ctx.goalState, ctx.err
+}
+
+func (s *GoalStateSuite) newContext() *goalStateContext {
+	since := time.Date(2017, 10, 16, 10, 30, 0, 0, time.UTC)
+	return &goalStateContext{
+		goalState: &params.GoalState{
+			Units: params.UnitsGoalState{
+				"wordpress/0": {Status: "active", Since: &since},
+				"wordpress/1": {Status: "waiting"},
+			},
+			Relations: map[string]params.UnitsGoalState{
+				"db": {
+					"mysql":   {Status: "joined"},
+					"mysql/0": {Status: "active", Since: &since},
+					"mysql/1": {Status: "dying"},
+				},
+			},
+		},
+	}
+}
+
+func (s *GoalStateSuite) runGoalState(c *gc.C, hctx jujuc.Context, args ...string) (*cmd.Context, int) {
+	com, err := jujuc.NewCommand(hctx, cmdString("goal-state"))
+	c.Assert(err, jc.ErrorIsNil)
+	ctx := testing.Context(c)
+	code := cmd.Main(com, ctx, args)
+	return ctx, code
+}
+
+func (s *GoalStateSuite) TestGoalStateYAML(c *gc.C) {
+	ctx, code := s.runGoalState(c, s.newContext())
+	c.Assert(code, gc.Equals, 0)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Check(bufferString(ctx.Stdout), gc.Equals, `
+units:
+  wordpress/0:
+    status: active
+    since: 2017-10-16 10:30:00Z
+  wordpress/1:
+    status: waiting
+relations:
+  db:
+    mysql:
+      status: joined
+    mysql/0:
+      status: active
+      since: 2017-10-16 10:30:00Z
+    mysql/1:
+      status: dying
+`[1:])
+}
+
+func (s *GoalStateSuite) TestGoalStateJSON(c *gc.C) {
+	hctx := s.newContext()
+	hctx.goalState.Relations = nil
+	ctx, code := s.runGoalState(c, hctx, "--format", "json")
+	c.Assert(code, gc.Equals, 0)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "")
+	c.Check(bufferString(ctx.Stdout), gc.Equals, `{"units":{"wordpress/0":{"status":"active","since":"2017-10-16 10:30:00Z"},"wordpress/1":{"status":"waiting"}},"relations":{}}`+"\n")
+}
+
+func (s *GoalStateSuite) TestGoalStateError(c *gc.C) {
+	hctx := &goalStateContext{err: errors.New("boom")}
+	ctx, code := s.runGoalState(c, hctx)
+	c.Check(code, gc.Equals, 1)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "error: cannot get goal state: boom\n")
+}
+
+func (s *GoalStateSuite) TestGoalStateArgs(c *gc.C) {
+	ctx, code := s.runGoalState(c, s.newContext(), "foo")
+	c.Check(code, gc.Equals, 2)
+	c.Check(bufferString(ctx.Stderr), gc.Equals, "error: unrecognized args: [\"foo\"]\n")
+}
@@ -25,6 +25,9 @@ type RestrictedContext struct{}
 // ConfigSettings implements jujuc.Context.
 func (*RestrictedContext) ConfigSettings() (charm.Settings, error) { return nil, ErrRestrictedContext }
 
+// GoalState implements jujuc.Context.
+func (*RestrictedContext) GoalState() (*params.GoalState, error) { return nil, ErrRestrictedContext }
+
 // UnitStatus implements jujuc.Context.
 func (*RestrictedContext) UnitStatus() (*StatusInfo, error) { return nil, ErrRestrictedContext }
 
@@ -133,6 +136,9 @@ func (*RestrictedContext) SetActionMessage(string) error { return ErrRestrictedC
 // SetActionFailed implements jujuc.Context.
 func (*RestrictedContext) SetActionFailed() error { return ErrRestrictedContext }
 
+// LogActionMessage implements jujuc.Context.
+func (*RestrictedContext) LogActionMessage(string) error { return ErrRestrictedContext }
+
 // Component implements jujc.Context.
 func (*RestrictedContext) Component(string) (ContextComponent, error) {
 	return nil, ErrRestrictedContext
@@ -52,6 +52,8 @@ var baseCommands = map[string]creator{
 	"action-get" + cmdSuffix:              NewActionGetCommand,
 	"action-set" + cmdSuffix:              NewActionSetCommand,
 	"action-fail" + cmdSuffix:             NewActionFailCommand,
+	"action-log" + cmdSuffix:              NewActionLogCommand,
+	"goal-state" + cmdSuffix:              NewGoalStateCommand,
 	"relation-ids" + cmdSuffix:            NewRelationIdsCommand,
 	"relation-list" + cmdSuffix:           NewRelationListCommand,
 	"relation-set" + cmdSuffix:            NewRelationSetCommand,
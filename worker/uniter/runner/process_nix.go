@@ -0,0 +1,29 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// +build !windows
+
+package runner
+
+import (
+	"os/exec"
+	"syscall"
+
+	"github.com/juju/juju/worker/uniter/runner/context"
+)
+
+// setProcessGroup makes the command start a new process group, led by
+// the command's process.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcessGroup asks the process group led by proc to exit.
+func terminateProcessGroup(proc context.HookProcess) error {
+	return syscall.Kill(-proc.Pid(), syscall.SIGTERM)
+}
+
+// killProcessGroup kills the process group led by proc.
+func killProcessGroup(proc context.HookProcess) error {
+	return syscall.Kill(-proc.Pid(), syscall.SIGKILL)
+}
@@ -0,0 +1,25 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package runner
+
+import (
+	"os/exec"
+
+	"github.com/juju/juju/worker/uniter/runner/context"
+)
+
+// setProcessGroup does nothing on Windows, where the hook's process
+// is stopped on its own.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// terminateProcessGroup kills proc, as Windows processes cannot be
+// asked to exit.
+func terminateProcessGroup(proc context.HookProcess) error {
+	return proc.Kill()
+}
+
+// killProcessGroup kills proc.
+func killProcessGroup(proc context.HookProcess) error {
+	return proc.Kill()
+}
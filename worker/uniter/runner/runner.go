@@ -18,7 +18,10 @@ import (
 	"github.com/juju/utils/clock"
 	utilexec "github.com/juju/utils/exec"
 
+	"github.com/juju/juju/apiserver/params"
 	"github.com/juju/juju/core/actions"
+	"github.com/juju/juju/watcher"
+	"github.com/juju/juju/worker"
 	"github.com/juju/juju/worker/uniter/runner/context"
 	"github.com/juju/juju/worker/uniter/runner/debug"
 	"github.com/juju/juju/worker/uniter/runner/jujuc"
@@ -27,6 +30,17 @@ import (
 
 var logger = loggo.GetLogger("juju.worker.uniter.runner")
 
+var (
+	// actionProcessPollInterval is how often an action that has been
+	// asked to abort is checked for a process to stop, if it had not
+	// started one when the request arrived.
+	actionProcessPollInterval = time.Second
+
+	// actionAbortGracePeriod is how long the processes of an aborted
+	// action have to exit once terminated, before they are killed.
+	actionAbortGracePeriod = 10 * time.Second
+)
+
 // Runner is responsible for invoking commands in a context.
 type Runner interface {
 
@@ -49,7 +63,11 @@ type Context interface {
 	Id() string
 	HookVars(paths context.Paths) ([]string, error)
 	ActionData() (*context.ActionData, error)
+	ActionStatus() (string, error)
+	WatchActionStatus() (watcher.NotifyWatcher, error)
+	SetActionAborted()
 	SetProcess(process context.HookProcess)
+	GetProcess() context.HookProcess
 	HasExecutionSetUnitStatus() bool
 	ResetExecutionSetUnitStatus()
 
@@ -59,13 +77,18 @@ type Context interface {
 
 // NewRunner returns a Runner backed by the supplied context and paths.
 func NewRunner(context Context, paths context.Paths) Runner {
-	return &runner{context, paths}
+	return newRunner(context, paths, clock.WallClock)
+}
+
+func newRunner(context Context, paths context.Paths, clock clock.Clock) Runner {
+	return &runner{context, paths, clock}
 }
 
 // runner implements Runner.
 type runner struct {
 	context Context
 	paths   context.Paths
+	clock   clock.Clock
 }
 
 func (runner *runner) Context() Context {
@@ -74,13 +97,13 @@ func (runner *runner) Context() Context {
 
 // RunCommands exists to satisfy the Runner interface.
 func (runner *runner) RunCommands(commands string) (*utilexec.ExecResponse, error) {
-	result, err := runner.runCommandsWithTimeout(commands, 0, clock.WallClock)
+	result, err := runner.runCommandsWithTimeout(commands, 0)
 	return result, runner.context.Flush("run commands", err)
 }
 
 // runCommandsWithTimeout is a helper to abstract common code between run commands and
 // juju-run as an action
-func (runner *runner) runCommandsWithTimeout(commands string, timeout time.Duration, clock clock.Clock) (*utilexec.ExecResponse, error) {
+func (runner *runner) runCommandsWithTimeout(commands string, timeout time.Duration) (*utilexec.ExecResponse, error) {
 	srv, err := runner.startJujucServer()
 	if err != nil {
 		return nil, err
@@ -95,7 +118,7 @@ func (runner *runner) runCommandsWithTimeout(commands string, timeout time.Durat
 		Commands:    commands,
 		WorkingDir:  runner.paths.GetCharmDir(),
 		Environment: env,
-		Clock:       clock,
+		Clock:       runner.clock,
 	}
 
 	err = command.Run()
@@ -108,7 +131,7 @@ func (runner *runner) runCommandsWithTimeout(commands string, timeout time.Durat
 	if timeout != 0 {
 		cancel = make(chan struct{})
 		go func() {
-			<-clock.After(timeout)
+			<-runner.clock.After(timeout)
 			close(cancel)
 		}()
 	}
@@ -135,7 +158,7 @@ func (runner *runner) runJujuRunAction() (err error) {
 		logger.Debugf("unable to read juju-run action timeout, will continue running action without one")
 	}
 
-	results, err := runner.runCommandsWithTimeout(command, time.Duration(timeout), clock.WallClock)
+	results, err := runner.runCommandsWithTimeout(command, time.Duration(timeout))
 
 	if err != nil {
 		return runner.context.Flush("juju-run", err)
@@ -192,12 +215,87 @@ func (runner *runner) RunAction(actionName string) error {
 	if _, err := runner.context.ActionData(); err != nil {
 		return errors.Trace(err)
 	}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runner.watchActionAbort(stop)
+	}()
+	defer func() {
+		close(stop)
+		<-done
+	}()
 	if actionName == actions.JujuRunActionName {
 		return runner.runJujuRunAction()
 	}
 	return runner.runCharmHookWithLocation(actionName, "actions")
 }
 
+// watchActionAbort watches the running action until it is asked to
+// abort, or stop is closed. An aborted action is recorded as such, and
+// its process group is terminated, and then killed if it does not exit
+// within the grace period.
+func (runner *runner) watchActionAbort(stop <-chan struct{}) {
+	w, err := runner.context.WatchActionStatus()
+	if errors.IsNotImplemented(err) {
+		logger.Debugf("controller cannot abort running actions")
+		return
+	} else if err != nil {
+		logger.Warningf("cannot watch action status: %v", err)
+		return
+	}
+	defer func() {
+		if err := worker.Stop(w); err != nil {
+			logger.Warningf("stopping action status watcher: %v", err)
+		}
+	}()
+
+	changes := w.Changes()
+	var retry <-chan time.Time
+	var proc context.HookProcess
+	for proc == nil {
+		select {
+		case <-stop:
+			return
+		case _, ok := <-changes:
+			if !ok {
+				logger.Warningf("action status watcher stopped: %v", w.Wait())
+				return
+			}
+			status, err := runner.context.ActionStatus()
+			if err != nil {
+				logger.Warningf("cannot check action status: %v", err)
+				continue
+			}
+			if status != params.ActionAborting {
+				continue
+			}
+			changes = nil
+		case <-retry:
+		}
+		// There is nothing to stop until the action's process
+		// has started.
+		if proc = runner.context.GetProcess(); proc == nil {
+			retry = runner.clock.After(actionProcessPollInterval)
+		}
+	}
+
+	runner.context.SetActionAborted()
+	logger.Infof("aborting action, terminating process %d", proc.Pid())
+	if err := terminateProcessGroup(proc); err != nil {
+		logger.Warningf("cannot terminate action process %d: %v", proc.Pid(), err)
+	}
+	select {
+	case <-stop:
+		return
+	case <-runner.clock.After(actionAbortGracePeriod):
+	}
+	logger.Infof("action process %d still running, killing it", proc.Pid())
+	if err := killProcessGroup(proc); err != nil {
+		logger.Warningf("cannot kill action process %d: %v", proc.Pid(), err)
+	}
+}
+
 // RunHook exists to satisfy the Runner interface.
 func (runner *runner) RunHook(hookName string) error {
 	return runner.runCharmHookWithLocation(hookName, "hooks")
@@ -241,6 +339,11 @@ func (runner *runner) runCharmHook(hookName string, env []string, charmLocation
 	ps := exec.Command(hookCmd[0], hookCmd[1:]...)
 	ps.Env = env
 	ps.Dir = charmDir
+	if charmLocation == "actions" {
+		// Run the action in its own process group, so that it can
+		// be aborted along with any processes it starts.
+		setProcessGroup(ps)
+	}
 	outReader, outWriter, err := os.Pipe()
 	if err != nil {
 		return errors.Errorf("cannot make logging pipe: %v", err)
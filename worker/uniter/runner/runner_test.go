@@ -6,9 +6,11 @@ package runner_test
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/juju/errors"
@@ -19,6 +21,9 @@ import (
 	gc "gopkg.in/check.v1"
 	"gopkg.in/juju/charm.v6-unstable/hooks"
 
+	"github.com/juju/juju/apiserver/params"
+	coretesting "github.com/juju/juju/testing"
+	"github.com/juju/juju/watcher"
 	"github.com/juju/juju/worker/uniter/hook"
 	"github.com/juju/juju/worker/uniter/runner"
 	"github.com/juju/juju/worker/uniter/runner/context"
@@ -151,6 +156,12 @@ type MockContext struct {
 	actionParams    map[string]interface{}
 	actionParamsErr error
 	actionResults   map[string]interface{}
+	actionStatus    string
+	actionChanges   chan struct{}
+	actionWatchErr  error
+	actionAborted   bool
+	mu              sync.Mutex
+	process         context.HookProcess
 	expectPid       int
 	flushBadge      string
 	flushFailure    error
@@ -172,8 +183,35 @@ func (ctx *MockContext) ActionData() (*context.ActionData, error) {
 	return ctx.actionData, nil
 }
 
+func (ctx *MockContext) ActionStatus() (string, error) {
+	return ctx.actionStatus, nil
+}
+
+func (ctx *MockContext) WatchActionStatus() (watcher.NotifyWatcher, error) {
+	if ctx.actionWatchErr != nil {
+		return nil, ctx.actionWatchErr
+	}
+	return &mockNotifyWatcher{
+		changes: ctx.actionChanges,
+		stopped: make(chan struct{}),
+	}, nil
+}
+
+func (ctx *MockContext) SetActionAborted() {
+	ctx.actionAborted = true
+}
+
 func (ctx *MockContext) SetProcess(process context.HookProcess) {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
 	ctx.expectPid = process.Pid()
+	ctx.process = process
+}
+
+func (ctx *MockContext) GetProcess() context.HookProcess {
+	ctx.mu.Lock()
+	defer ctx.mu.Unlock()
+	return ctx.process
 }
 
 func (ctx *MockContext) Prepare() error {
@@ -336,6 +374,106 @@ func (s *RunMockContextSuite) TestRunActionCancelled(c *gc.C) {
 	c.Assert(ctx.actionResults["Stderr"], gc.Equals, nil)
 }
 
+func (s *RunMockContextSuite) TestRunActionAborted(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("action processes are killed outright on windows")
+	}
+	ctx := &MockContext{
+		actionData:    &context.ActionData{},
+		actionStatus:  params.ActionAborting,
+		actionChanges: make(chan struct{}, 1),
+	}
+	s.makeAction(c, "sleep 60")
+	done := s.runActionAsync(ctx, envtesting.NewClock(time.Time{}))
+	s.waitForProcess(c, ctx)
+	ctx.actionChanges <- struct{}{}
+	s.waitForAction(c, done)
+	c.Assert(ctx.actionAborted, jc.IsTrue)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "signal: terminated")
+}
+
+func (s *RunMockContextSuite) TestRunActionAbortedKilled(c *gc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("action processes are killed outright on windows")
+	}
+	ctx := &MockContext{
+		actionData:    &context.ActionData{},
+		actionStatus:  params.ActionAborting,
+		actionChanges: make(chan struct{}, 1),
+	}
+	// The action ignores SIGTERM, so it has to be killed.
+	s.makeAction(c, "trap '' TERM; sleep 60")
+	clock := envtesting.NewClock(time.Time{})
+	done := s.runActionAsync(ctx, clock)
+	s.waitForProcess(c, ctx)
+	ctx.actionChanges <- struct{}{}
+	err := clock.WaitAdvance(10*time.Second, coretesting.LongWait, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	s.waitForAction(c, done)
+	c.Assert(ctx.actionAborted, jc.IsTrue)
+	c.Assert(ctx.flushFailure, gc.ErrorMatches, "signal: killed")
+}
+
+func (s *RunMockContextSuite) TestRunActionNotAborted(c *gc.C) {
+	ctx := &MockContext{
+		actionData:    &context.ActionData{},
+		actionStatus:  params.ActionRunning,
+		actionChanges: make(chan struct{}, 1),
+	}
+	ctx.actionChanges <- struct{}{}
+	s.makeAction(c, "sleep 0.1")
+	err := runner.NewRunnerWithClock(ctx, s.paths, envtesting.NewClock(time.Time{})).RunAction("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.actionAborted, jc.IsFalse)
+	c.Assert(ctx.flushFailure, gc.IsNil)
+}
+
+func (s *RunMockContextSuite) TestRunActionAbortNotImplemented(c *gc.C) {
+	ctx := &MockContext{
+		actionData:     &context.ActionData{},
+		actionWatchErr: errors.NotImplementedf("WatchActionStatus"),
+	}
+	s.makeAction(c, "sleep 0.1")
+	err := runner.NewRunnerWithClock(ctx, s.paths, envtesting.NewClock(time.Time{})).RunAction("something-happened")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ctx.actionAborted, jc.IsFalse)
+	c.Assert(ctx.flushFailure, gc.IsNil)
+}
+
+func (s *RunMockContextSuite) runActionAsync(ctx *MockContext, clock *envtesting.Clock) <-chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- runner.NewRunnerWithClock(ctx, s.paths, clock).RunAction("something-happened")
+	}()
+	return done
+}
+
+func (s *RunMockContextSuite) waitForProcess(c *gc.C, ctx *MockContext) {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if ctx.GetProcess() != nil {
+			return
+		}
+	}
+	c.Fatalf("action process not started")
+}
+
+func (s *RunMockContextSuite) waitForAction(c *gc.C, done <-chan error) {
+	select {
+	case err := <-done:
+		c.Assert(err, jc.ErrorIsNil)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for action to stop")
+	}
+}
+
+func (s *RunMockContextSuite) makeAction(c *gc.C, script string) {
+	dir := filepath.Join(s.paths.GetCharmDir(), "actions")
+	err := os.MkdirAll(dir, 0755)
+	c.Assert(err, jc.ErrorIsNil)
+	err = ioutil.WriteFile(filepath.Join(dir, "something-happened"), []byte("#!/bin/bash\n"+script+"\n"), 0700)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *RunMockContextSuite) TestRunCommandsFlushSuccess(c *gc.C) {
 	expectErr := errors.New("pew pew pew")
 	ctx := &MockContext{
@@ -359,3 +497,22 @@ func (s *RunMockContextSuite) TestRunCommandsFlushFailure(c *gc.C) {
 	c.Assert(ctx.flushFailure, gc.IsNil) // exit code in _ result, as tested elsewhere
 	s.assertRecordedPid(c, ctx.expectPid)
 }
+
+type mockNotifyWatcher struct {
+	changes chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+func (w *mockNotifyWatcher) Changes() watcher.NotifyChannel {
+	return w.changes
+}
+
+func (w *mockNotifyWatcher) Kill() {
+	w.once.Do(func() { close(w.stopped) })
+}
+
+func (w *mockNotifyWatcher) Wait() error {
+	<-w.stopped
+	return nil
+}
@@ -113,6 +113,7 @@ func (s *ContextSuite) SetUpTest(c *gc.C) {
 		s.uniter,
 		s.paths,
 		s.contextFactory,
+		jujutesting.NewClock(time.Time{}),
 	)
 	c.Assert(err, jc.ErrorIsNil)
 	s.factory = factory
@@ -421,3 +421,129 @@ func (s *attachmentsSuite) TestAttachmentsWaitPending(c *gc.C) {
 	err = nextOp(true /* workload installed */)
 	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
 }
+
+func (s *attachmentsSuite) TestAttachmentsResized(c *gc.C) {
+	stateDir := c.MkDir()
+	unitTag := names.NewUnitTag("mysql/0")
+	abort := make(chan struct{})
+
+	storageTag := names.NewStorageTag("data/0")
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return nil, nil
+		},
+	}
+
+	att, err := storage.NewAttachments(st, unitTag, stateDir, abort)
+	c.Assert(err, jc.ErrorIsNil)
+	r := storage.NewResolver(att)
+
+	nextOp := func(size uint64) (operation.Operation, error) {
+		localState := resolver.LocalState{State: operation.State{
+			Kind: operation.Continue,
+		}}
+		return r.NextOp(localState, remotestate.Snapshot{
+			Life: params.Alive,
+			Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+				storageTag: {
+					Kind:     params.StorageKindBlock,
+					Life:     params.Alive,
+					Location: "/dev/sdb",
+					Attached: true,
+					Size:     size,
+				},
+			},
+		}, &mockOperations{})
+	}
+	stateFile := filepath.Join(stateDir, "data-0")
+
+	op, err := nextOp(1024)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run hook storage-attached")
+	err = att.CommitHook(hook.Info{
+		Kind:        hooks.StorageAttached,
+		StorageId:   storageTag.Id(),
+		StorageSize: 1024,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	data, err := ioutil.ReadFile(stateFile)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "attached: true\nsize: 1024\n")
+
+	// The size has not changed, so there is nothing to do.
+	_, err = nextOp(1024)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+
+	// The storage has grown, so storage-resized must be run.
+	op, err = nextOp(2048)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(op.String(), gc.Equals, "run hook storage-resized")
+	hi := hook.Info{
+		Kind:        hook.StorageResized,
+		StorageId:   storageTag.Id(),
+		StorageSize: 2048,
+	}
+	err = att.ValidateHook(hi)
+	c.Assert(err, jc.ErrorIsNil)
+	err = att.CommitHook(hi)
+	c.Assert(err, jc.ErrorIsNil)
+	data, err = ioutil.ReadFile(stateFile)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "attached: true\nsize: 2048\n")
+
+	_, err = nextOp(2048)
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+}
+
+func (s *attachmentsSuite) TestAttachmentsRecordsUnknownSize(c *gc.C) {
+	stateDir := c.MkDir()
+	unitTag := names.NewUnitTag("mysql/0")
+	abort := make(chan struct{})
+
+	// The storage was attached before storage sizes were recorded.
+	storageTag := names.NewStorageTag("data/0")
+	stateFile := filepath.Join(stateDir, "data-0")
+	writeFile(c, stateFile, "attached: true")
+	st := &mockStorageAccessor{
+		unitStorageAttachments: func(u names.UnitTag) ([]params.StorageAttachmentId, error) {
+			return []params.StorageAttachmentId{{
+				StorageTag: storageTag.String(),
+				UnitTag:    unitTag.String(),
+			}}, nil
+		},
+		storageAttachment: func(s names.StorageTag, u names.UnitTag) (params.StorageAttachment, error) {
+			return params.StorageAttachment{
+				Kind:     params.StorageKindBlock,
+				Location: "/dev/sdb",
+				Life:     params.Alive,
+				Size:     1024,
+			}, nil
+		},
+	}
+
+	att, err := storage.NewAttachments(st, unitTag, stateDir, abort)
+	c.Assert(err, jc.ErrorIsNil)
+	r := storage.NewResolver(att)
+
+	// The current size is recorded without running a hook, as
+	// there is no way of knowing whether the storage has grown.
+	localState := resolver.LocalState{State: operation.State{
+		Kind: operation.Continue,
+	}}
+	_, err = r.NextOp(localState, remotestate.Snapshot{
+		Life: params.Alive,
+		Storage: map[names.StorageTag]remotestate.StorageSnapshot{
+			storageTag: {
+				Kind:     params.StorageKindBlock,
+				Life:     params.Alive,
+				Location: "/dev/sdb",
+				Attached: true,
+				Size:     1024,
+			},
+		},
+	}, &mockOperations{})
+	c.Assert(err, gc.Equals, resolver.ErrNoOperation)
+	data, err := ioutil.ReadFile(stateFile)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(string(data), gc.Equals, "attached: true\nsize: 1024\n")
+}
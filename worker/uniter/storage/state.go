@@ -25,6 +25,10 @@ type state struct {
 	// attached records the uniter's knowledge of the
 	// storage attachment state.
 	attached bool
+
+	// size records the size of the storage, in MiB, as
+	// last reported to the charm.
+	size uint64
 }
 
 // ValidateHook returns an error if the supplied hook.Info does not represent
@@ -41,7 +45,7 @@ func (s *state) ValidateHook(hi hook.Info) (err error) {
 		if s.attached {
 			return errors.New("storage already attached")
 		}
-	case hooks.StorageDetaching:
+	case hooks.StorageDetaching, hook.StorageResized:
 		if !s.attached {
 			return errors.New("storage not attached")
 		}
@@ -85,6 +89,7 @@ func readStateFile(dirPath string, tag names.StorageTag) (d *stateFile, err erro
 		return nil, errors.Errorf("invalid storage state file %q: missing 'attached'", d.path)
 	}
 	d.state.attached = *info.Attached
+	d.state.size = info.Size
 	return d, nil
 }
 
@@ -129,13 +134,30 @@ func (d *stateFile) CommitHook(hi hook.Info) (err error) {
 	if hi.Kind == hooks.StorageDetaching {
 		return d.Remove()
 	}
+	size := d.state.size
+	if hi.StorageSize != 0 {
+		size = hi.StorageSize
+	}
+	return d.write(size)
+}
+
+// recordSize atomically writes to disk the size of the storage, without
+// running a hook. It is used to record the initial size of storage that
+// was attached before storage sizes were recorded.
+func (d *stateFile) recordSize(size uint64) (err error) {
+	defer errors.DeferredAnnotatef(&err, "failed to write size for %q on state directory", d.storage.Id())
+	return d.write(size)
+}
+
+func (d *stateFile) write(size uint64) error {
 	attached := true
-	di := diskInfo{&attached}
+	di := diskInfo{&attached, size}
 	if err := utils.WriteYaml(d.path, &di); err != nil {
 		return err
 	}
 	// If write was successful, update own state.
 	d.state.attached = true
+	d.state.size = size
 	return nil
 }
 
@@ -151,5 +173,6 @@ func (d *stateFile) Remove() error {
 
 // diskInfo defines the storage attachment data serialization.
 type diskInfo struct {
-	Attached *bool `yaml:"attached,omitempty"`
+	Attached *bool  `yaml:"attached,omitempty"`
+	Size     uint64 `yaml:"size,omitempty"`
 }
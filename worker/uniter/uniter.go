@@ -465,7 +465,7 @@ func (u *Uniter) init(unitTag names.UnitTag) (err error) {
 		return err
 	}
 	runnerFactory, err := runner.NewFactory(
-		u.st, u.paths, contextFactory,
+		u.st, u.paths, contextFactory, u.clock,
 	)
 	if err != nil {
 		return errors.Trace(err)
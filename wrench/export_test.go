@@ -6,4 +6,6 @@ package wrench
 var (
 	WrenchDir = &wrenchDir
 	Stat      = &stat
+	Now       = &now
+	RandFloat = &randFloat
 )
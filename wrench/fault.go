@@ -0,0 +1,156 @@
+// Copyright 2017 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package wrench
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// Fault describes when a wrench set at runtime with SetFault is
+// active. The zero Fault is active every time it is checked.
+type Fault struct {
+	// Count is the number of times the wrench is active before it
+	// is cleared. Zero means the wrench stays active until it is
+	// cleared with ClearFault.
+	Count int
+
+	// Probability is the chance, between 0 and 1, that the wrench is
+	// active each time it is checked. Zero means it is always active.
+	Probability float64
+
+	// Delay is the time after the wrench is set before it becomes
+	// active.
+	Delay time.Duration
+}
+
+// Validate returns an error if the fault is not valid.
+func (f Fault) Validate() error {
+	if f.Count < 0 {
+		return errors.NotValidf("negative count")
+	}
+	if f.Probability < 0 || f.Probability > 1 {
+		return errors.NotValidf("probability %v", f.Probability)
+	}
+	if f.Delay < 0 {
+		return errors.NotValidf("negative delay")
+	}
+	return nil
+}
+
+type fault struct {
+	Fault
+	activeAfter time.Time
+	triggered   int
+}
+
+var (
+	faultsMu sync.Mutex
+	faults   = make(map[string]*fault)
+
+	// To support patching.
+	now       = time.Now
+	randFloat = rand.Float64
+)
+
+func faultKey(category, feature string) string {
+	return category + "/" + feature
+}
+
+// SetFault sets a wrench for the given category and feature, which is
+// then reported as active by IsActive as described by the fault,
+// replacing any fault previously set for them. Wrench files are still
+// consulted when the fault is not active.
+//
+// As with wrench files, faults can only be set when the wrench
+// directory exists and is owned by the juju user, so that wrenches
+// cannot be thrown on hosts that have not opted in.
+func SetFault(category, feature string, f Fault) error {
+	if category == "" || feature == "" {
+		return errors.NotValidf("empty category or feature")
+	}
+	if err := f.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if !checkWrenchDir(wrenchDir) {
+		return errors.New("wrench directory missing or not owned by the juju user")
+	}
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	faults[faultKey(category, feature)] = &fault{
+		Fault:       f,
+		activeAfter: now().Add(f.Delay),
+	}
+	logger.Infof("wrench fault for %s/%s set: %+v", category, feature, f)
+	return nil
+}
+
+// ClearFault clears the wrench set with SetFault for the given
+// category and feature. It returns false if there was none.
+func ClearFault(category, feature string) bool {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	key := faultKey(category, feature)
+	if _, ok := faults[key]; !ok {
+		return false
+	}
+	delete(faults, key)
+	logger.Infof("wrench fault for %s/%s cleared", category, feature)
+	return true
+}
+
+// ClearFaults clears all the wrenches set with SetFault.
+func ClearFaults() {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	faults = make(map[string]*fault)
+}
+
+// Report returns a description of the wrenches set with SetFault,
+// keyed by "category/feature", for use by the introspection worker.
+func Report() map[string]interface{} {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	report := make(map[string]interface{})
+	for key, f := range faults {
+		info := map[string]interface{}{
+			"active-after": f.activeAfter.Format(time.RFC3339),
+			"triggered":    f.triggered,
+		}
+		if f.Count > 0 {
+			info["remaining"] = f.Count - f.triggered
+		}
+		if f.Probability > 0 {
+			info["probability"] = f.Probability
+		}
+		report[key] = info
+	}
+	return report
+}
+
+// isFaultActive reports whether the wrench set with SetFault for the
+// given category and feature is active, recording the activation.
+func isFaultActive(category, feature string) bool {
+	faultsMu.Lock()
+	defer faultsMu.Unlock()
+	key := faultKey(category, feature)
+	f, ok := faults[key]
+	if !ok {
+		return false
+	}
+	if now().Before(f.activeAfter) {
+		return false
+	}
+	if f.Probability > 0 && randFloat() >= f.Probability {
+		return false
+	}
+	f.triggered++
+	if f.Count > 0 && f.triggered >= f.Count {
+		delete(faults, key)
+	}
+	return true
+}
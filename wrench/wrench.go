@@ -46,12 +46,20 @@ var logger = loggo.GetLogger("juju.wrench")
 //   refuse-upgrade
 //   fail-api-server-start
 //
+// Wrenches may also be set at runtime with SetFault, in which case
+// they can be limited to a number of occurrences, a probability or
+// a delay.
+//
 // The caller need not worry about errors. Any errors that occur will
 // be logged and false will be returned.
 func IsActive(category, feature string) bool {
 	if !IsEnabled() {
 		return false
 	}
+	if isFaultActive(category, feature) {
+		logger.Debugf("wrench for %s/%s is active", category, feature)
+		return true
+	}
 	if !checkWrenchDir(wrenchDir) {
 		return false
 	}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	stdtesting "testing"
+	"time"
 
 	"github.com/juju/loggo"
 	jc "github.com/juju/testing/checkers"
@@ -40,6 +41,7 @@ func (s *wrenchSuite) SetUpTest(c *gc.C) {
 		loggo.RemoveWriter("wrench-tests")
 		// Ensure the wrench is turned off when these tests are done.
 		wrench.SetEnabled(false)
+		wrench.ClearFaults()
 	})
 }
 
@@ -170,6 +172,101 @@ func (s *wrenchSuite) TestSetEnabled(c *gc.C) {
 	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
 }
 
+func (s *wrenchSuite) TestSetFault(c *gc.C) {
+	s.createWrenchDir(c)
+	err := wrench.SetFault("foo", "bar", wrench.Fault{})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "baz"), jc.IsFalse)
+
+	c.Assert(wrench.ClearFault("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsFalse)
+	c.Assert(wrench.ClearFault("foo", "bar"), jc.IsFalse)
+}
+
+func (s *wrenchSuite) TestSetFaultCount(c *gc.C) {
+	s.createWrenchDir(c)
+	s.PatchValue(wrench.Now, func() time.Time {
+		return time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	})
+	err := wrench.SetFault("foo", "bar", wrench.Fault{Count: 2})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(wrench.Report(), jc.DeepEquals, map[string]interface{}{
+		"foo/bar": map[string]interface{}{
+			"active-after": "2017-03-01T12:00:00Z",
+			"triggered":    0,
+			"remaining":    2,
+		},
+	})
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsFalse)
+	c.Assert(wrench.Report(), gc.HasLen, 0)
+}
+
+func (s *wrenchSuite) TestSetFaultProbability(c *gc.C) {
+	s.createWrenchDir(c)
+	rolls := []float64{0.1, 0.9, 0.29}
+	s.PatchValue(wrench.RandFloat, func() float64 {
+		roll := rolls[0]
+		rolls = rolls[1:]
+		return roll
+	})
+	err := wrench.SetFault("foo", "bar", wrench.Fault{Probability: 0.3})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsFalse)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+}
+
+func (s *wrenchSuite) TestSetFaultDelay(c *gc.C) {
+	s.createWrenchDir(c)
+	t0 := time.Date(2017, 3, 1, 12, 0, 0, 0, time.UTC)
+	current := t0
+	s.PatchValue(wrench.Now, func() time.Time { return current })
+	err := wrench.SetFault("foo", "bar", wrench.Fault{Delay: time.Minute})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsFalse)
+	current = t0.Add(time.Minute)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsTrue)
+}
+
+func (s *wrenchSuite) TestSetFaultInvalid(c *gc.C) {
+	s.createWrenchDir(c)
+	for i, test := range []struct {
+		fault wrench.Fault
+		err   string
+	}{{
+		fault: wrench.Fault{Count: -1},
+		err:   "negative count not valid",
+	}, {
+		fault: wrench.Fault{Probability: 1.5},
+		err:   "probability 1.5 not valid",
+	}, {
+		fault: wrench.Fault{Delay: -time.Second},
+		err:   "negative delay not valid",
+	}} {
+		c.Logf("test %d", i)
+		err := wrench.SetFault("foo", "bar", test.fault)
+		c.Check(err, gc.ErrorMatches, test.err)
+	}
+}
+
+func (s *wrenchSuite) TestSetFaultNoDirectory(c *gc.C) {
+	s.PatchValue(wrench.WrenchDir, "/does/not/exist")
+	err := wrench.SetFault("foo", "bar", wrench.Fault{})
+	c.Assert(err, gc.ErrorMatches, "wrench directory missing or not owned by the juju user")
+}
+
+func (s *wrenchSuite) TestSetFaultDisabled(c *gc.C) {
+	s.createWrenchDir(c)
+	err := wrench.SetFault("foo", "bar", wrench.Fault{})
+	c.Assert(err, jc.ErrorIsNil)
+	wrench.SetEnabled(false)
+	c.Assert(wrench.IsActive("foo", "bar"), jc.IsFalse)
+}
+
 var notJujuUid = uint32(os.Getuid() + 1)
 
 func (s *wrenchSuite) AssertActivationLogged(c *gc.C) {